@@ -0,0 +1,248 @@
+// Package accumulator implements the dynamic bilinear accumulator of Nguyen
+// ("Accumulators from Bilinear Pairings and Applications", CT-RSA 2005),
+// with the non-membership witnesses of Au, Tsang, Susilo and Mu ("Dynamic
+// Universal Accumulators for DDH Groups and Their Application to
+// Attribute-Based Anonymous Credential Systems", CT-RSA 2009).
+//
+// A single point V = f(alpha)*P of G1 accumulates a set of scalars, where
+// f(X) is the product of the X + x for each element x of the set, and alpha
+// is the secret key of the manager, who adds and deletes elements. A
+// membership witness for x is W = V/(x+alpha), checked as
+// e(W, x*G2 + alpha*G2) = e(V, G2). A non-membership witness for y is the
+// quotient and the non-zero remainder of the division of f(X) by X + y,
+// committed as C = q(alpha)*P and d = f(-y), checked as
+// e(C, y*G2 + alpha*G2) * e(d*P, G2) = e(V, G2).
+//
+// Each addition or deletion is published as an Update, from which holders
+// update their witnesses without the secret key or the set, as revocation
+// lists in credential systems need: a credential holder proves that its
+// identifier is not in the accumulator of the revoked ones.
+package accumulator
+
+import (
+	"crypto/cipher"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+)
+
+var errInvalidWitness = errors.New("accumulator: invalid witness")
+
+// Element returns the element of an accumulator that represents the given
+// data, such as the identifier of a credential.
+func Element(suite pairing.Suite, data []byte) kyber.Scalar {
+	return suite.G1().Scalar().Pick(suite.XOF(append([]byte("accumulator element "), data...)))
+}
+
+// Accumulator is the public value of an accumulator.
+type Accumulator struct {
+	suite pairing.Suite
+	P     kyber.Point // base point of G1, the value of the empty set
+	Q     kyber.Point // alpha * G2
+	V     kyber.Point // current value
+}
+
+// Update is the addition or the deletion of an element, which takes the
+// accumulator from the value Old to the value New.
+type Update struct {
+	Element kyber.Scalar
+	Added   bool
+	Old     kyber.Point
+	New     kyber.Point
+}
+
+// Apply sets the accumulator to its value after the update.
+func (a *Accumulator) Apply(u *Update) error {
+	if !u.Old.Equal(a.V) {
+		return errors.New("accumulator: update of another value")
+	}
+	a.V = a.suite.G1().Point().Set(u.New)
+	return nil
+}
+
+// key returns x*G2 + alpha*G2.
+func (a *Accumulator) key(x kyber.Scalar) kyber.Point {
+	g2 := a.suite.G2()
+	return g2.Point().Add(g2.Point().Mul(x, nil), a.Q)
+}
+
+// MembershipWitness shows that an element is in the accumulator.
+type MembershipWitness struct {
+	Element kyber.Scalar
+	W       kyber.Point
+}
+
+// VerifyMembership checks that w shows that its element is in the
+// accumulator.
+func (a *Accumulator) VerifyMembership(w *MembershipWitness) error {
+	g1 := a.suite.G1()
+	if !a.suite.PairingCheck([]kyber.Point{w.W, g1.Point().Neg(a.V)},
+		[]kyber.Point{a.key(w.Element), a.suite.G2().Point().Base()}) {
+		return errInvalidWitness
+	}
+	return nil
+}
+
+// Update updates the witness after the update u of the accumulator.
+func (w *MembershipWitness) Update(suite pairing.Suite, u *Update) error {
+	g1 := suite.G1()
+	diff := g1.Scalar().Sub(u.Element, w.Element)
+	if diff.Equal(g1.Scalar().Zero()) {
+		return errors.New("accumulator: update of the element of the witness")
+	}
+	if u.Added {
+		// V'/(x+alpha) = V*(y+alpha)/(x+alpha) = V + (y-x)*W
+		w.W = g1.Point().Add(u.Old, g1.Point().Mul(diff, w.W))
+	} else {
+		// V/((x+alpha)(y+alpha)) = (W - V')/(y-x)
+		w.W = g1.Point().Mul(g1.Scalar().Inv(diff), g1.Point().Sub(w.W, u.New))
+	}
+	return nil
+}
+
+// NonMembershipWitness shows that an element is not in the accumulator.
+type NonMembershipWitness struct {
+	Element kyber.Scalar
+	C       kyber.Point
+	D       kyber.Scalar
+}
+
+// VerifyNonMembership checks that w shows that its element is not in the
+// accumulator.
+func (a *Accumulator) VerifyNonMembership(w *NonMembershipWitness) error {
+	g1 := a.suite.G1()
+	if w.D.Equal(g1.Scalar().Zero()) {
+		return errInvalidWitness
+	}
+	dP := g1.Point().Sub(g1.Point().Mul(w.D, a.P), a.V)
+	if !a.suite.PairingCheck([]kyber.Point{w.C, dP},
+		[]kyber.Point{a.key(w.Element), a.suite.G2().Point().Base()}) {
+		return errInvalidWitness
+	}
+	return nil
+}
+
+// Update updates the witness after the update u of the accumulator.
+func (w *NonMembershipWitness) Update(suite pairing.Suite, u *Update) error {
+	g1 := suite.G1()
+	diff := g1.Scalar().Sub(u.Element, w.Element)
+	if diff.Equal(g1.Scalar().Zero()) {
+		return errors.New("accumulator: update of the element of the witness")
+	}
+	if u.Added {
+		// f(X)(X+x) = (q(X)(X+x) + d)(X+y) + d(x-y), where
+		// (alpha+x)*C + d*P = (x-y)*C + V
+		w.C = g1.Point().Add(u.Old, g1.Point().Mul(diff, w.C))
+		w.D = g1.Scalar().Mul(w.D, diff)
+	} else {
+		// The inverse of the addition.
+		inv := g1.Scalar().Inv(diff)
+		w.C = g1.Point().Mul(inv, g1.Point().Sub(w.C, u.New))
+		w.D = g1.Scalar().Mul(w.D, inv)
+	}
+	return nil
+}
+
+// Manager holds the secret key of an accumulator and its set.
+type Manager struct {
+	suite   pairing.Suite
+	alpha   kyber.Scalar
+	f       kyber.Scalar // f(alpha)
+	members map[string]kyber.Scalar
+	acc     *Accumulator
+}
+
+// NewManager returns the manager of a new accumulator of the empty set,
+// with a secret key and a base point picked from random.
+func NewManager(suite pairing.Suite, random cipher.Stream) *Manager {
+	alpha := suite.G1().Scalar().Pick(random)
+	P := suite.G1().Point().Pick(random)
+	return &Manager{
+		suite:   suite,
+		alpha:   alpha,
+		f:       suite.G1().Scalar().One(),
+		members: make(map[string]kyber.Scalar),
+		acc: &Accumulator{
+			suite: suite,
+			P:     P,
+			Q:     suite.G2().Point().Mul(alpha, nil),
+			V:     P.Clone(),
+		},
+	}
+}
+
+// Accumulator returns the current public value of the accumulator.
+func (m *Manager) Accumulator() *Accumulator {
+	return &Accumulator{
+		suite: m.suite,
+		P:     m.acc.P.Clone(),
+		Q:     m.acc.Q.Clone(),
+		V:     m.acc.V.Clone(),
+	}
+}
+
+// Add adds the element x to the accumulator.
+func (m *Manager) Add(x kyber.Scalar) (*Update, error) {
+	id := x.String()
+	if _, ok := m.members[id]; ok {
+		return nil, errors.New("accumulator: element already in the accumulator")
+	}
+	xa := m.suite.G1().Scalar().Add(x, m.alpha)
+	if xa.Equal(m.suite.G1().Scalar().Zero()) {
+		return nil, errors.New("accumulator: invalid element")
+	}
+	m.members[id] = x.Clone()
+	m.f = m.suite.G1().Scalar().Mul(m.f, xa)
+	return m.update(x, true, xa), nil
+}
+
+// Delete deletes the element x from the accumulator.
+func (m *Manager) Delete(x kyber.Scalar) (*Update, error) {
+	id := x.String()
+	if _, ok := m.members[id]; !ok {
+		return nil, errors.New("accumulator: element not in the accumulator")
+	}
+	delete(m.members, id)
+	inv := m.suite.G1().Scalar().Inv(m.suite.G1().Scalar().Add(x, m.alpha))
+	m.f = m.suite.G1().Scalar().Mul(m.f, inv)
+	return m.update(x, false, inv), nil
+}
+
+// update multiplies the value of the accumulator by s.
+func (m *Manager) update(x kyber.Scalar, added bool, s kyber.Scalar) *Update {
+	u := &Update{Element: x.Clone(), Added: added, Old: m.acc.V.Clone()}
+	m.acc.V = m.suite.G1().Point().Mul(s, m.acc.V)
+	u.New = m.acc.V.Clone()
+	return u
+}
+
+// MembershipWitness returns the witness that the element x is in the
+// accumulator.
+func (m *Manager) MembershipWitness(x kyber.Scalar) (*MembershipWitness, error) {
+	if _, ok := m.members[x.String()]; !ok {
+		return nil, errors.New("accumulator: element not in the accumulator")
+	}
+	inv := m.suite.G1().Scalar().Inv(m.suite.G1().Scalar().Add(x, m.alpha))
+	return &MembershipWitness{x.Clone(), m.suite.G1().Point().Mul(inv, m.acc.V)}, nil
+}
+
+// NonMembershipWitness returns the witness that the element y is not in the
+// accumulator.
+func (m *Manager) NonMembershipWitness(y kyber.Scalar) (*NonMembershipWitness, error) {
+	g1 := m.suite.G1()
+	if _, ok := m.members[y.String()]; ok {
+		return nil, errors.New("accumulator: element in the accumulator")
+	}
+	ya := g1.Scalar().Add(y, m.alpha)
+	if ya.Equal(g1.Scalar().Zero()) {
+		return nil, errors.New("accumulator: invalid element")
+	}
+	// d = f(-y), and q(alpha) = (f(alpha) - d)/(y + alpha)
+	d := g1.Scalar().One()
+	for _, x := range m.members {
+		d.Mul(d, g1.Scalar().Sub(x, y))
+	}
+	q := g1.Scalar().Div(g1.Scalar().Sub(m.f, d), ya)
+	return &NonMembershipWitness{y.Clone(), g1.Point().Mul(q, m.acc.P), d}, nil
+}
@@ -0,0 +1,108 @@
+// +build vartime
+
+package accumulator
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+func elements(n int) []kyber.Scalar {
+	e := make([]kyber.Scalar, n)
+	for i := range e {
+		e[i] = Element(suite, []byte("credential "+strconv.Itoa(i)))
+	}
+	return e
+}
+
+func TestMembership(t *testing.T) {
+	m := NewManager(suite, random.New())
+	e := elements(4)
+	for _, x := range e[:3] {
+		_, err := m.Add(x)
+		require.Nil(t, err)
+	}
+	_, err := m.Add(e[0])
+	require.Error(t, err)
+
+	acc := m.Accumulator()
+	for _, x := range e[:3] {
+		w, err := m.MembershipWitness(x)
+		require.Nil(t, err)
+		require.Nil(t, acc.VerifyMembership(w))
+		w.Element = e[3]
+		require.Error(t, acc.VerifyMembership(w))
+	}
+	_, err = m.MembershipWitness(e[3])
+	require.Error(t, err)
+
+	w, err := m.NonMembershipWitness(e[3])
+	require.Nil(t, err)
+	require.Nil(t, acc.VerifyNonMembership(w))
+	_, err = m.NonMembershipWitness(e[0])
+	require.Error(t, err)
+
+	// A non-membership witness for a member does not verify.
+	w.Element = e[0]
+	require.Error(t, acc.VerifyNonMembership(w))
+	w.Element, w.D = e[3], suite.G1().Scalar().Zero()
+	require.Error(t, acc.VerifyNonMembership(w))
+
+	_, err = m.Delete(e[3])
+	require.Error(t, err)
+}
+
+func TestUpdates(t *testing.T) {
+	m := NewManager(suite, random.New())
+	acc := m.Accumulator()
+	e := elements(6)
+
+	u, err := m.Add(e[0])
+	require.Nil(t, err)
+	require.Nil(t, acc.Apply(u))
+	mw, err := m.MembershipWitness(e[0])
+	require.Nil(t, err)
+	nw, err := m.NonMembershipWitness(e[5])
+	require.Nil(t, err)
+
+	// Holders follow the additions and deletions with the updates alone.
+	apply := func(u *Update) {
+		require.Nil(t, acc.Apply(u))
+		require.Nil(t, mw.Update(suite, u))
+		require.Nil(t, nw.Update(suite, u))
+		require.Nil(t, acc.VerifyMembership(mw))
+		require.Nil(t, acc.VerifyNonMembership(nw))
+	}
+	for _, x := range e[1:5] {
+		u, err := m.Add(x)
+		require.Nil(t, err)
+		apply(u)
+	}
+	for _, x := range e[2:4] {
+		u, err := m.Delete(x)
+		require.Nil(t, err)
+		apply(u)
+	}
+	require.True(t, acc.V.Equal(m.Accumulator().V))
+
+	// An update applies once, and not to the element of the witness.
+	require.Error(t, acc.Apply(u))
+	u, err = m.Delete(e[0])
+	require.Nil(t, err)
+	require.Error(t, mw.Update(suite, u))
+	require.Nil(t, acc.Apply(u))
+	require.Error(t, acc.VerifyMembership(mw))
+
+	u, err = m.Add(e[5])
+	require.Nil(t, err)
+	require.Error(t, nw.Update(suite, u))
+	require.Nil(t, acc.Apply(u))
+	require.Error(t, acc.VerifyNonMembership(nw))
+}
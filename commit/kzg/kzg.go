@@ -0,0 +1,300 @@
+// Package kzg implements the polynomial commitments of Kate, Zaverucha and
+// Goldberg ("Constant-Size Commitments to Polynomials and Their
+// Applications", Asiacrypt 2010) over a pairing suite.
+//
+// A commitment to a polynomial p of degree at most d is a single point
+// [p(tau)] of G1, computed from a structured reference string (SRS) holding
+// the points [tau^i] of G1 for i up to d, and [1] and [tau] of G2, for a
+// secret tau that no one must know. An opening of p at a point z to the
+// value y = p(z) is also a single point, [q(tau)] for the quotient
+// q(X) = (p(X) - y) / (X - z), which is checked with two pairings. Openings
+// of several polynomials at the same point combine into one, and openings
+// at distinct points are verified together, as data availability sampling
+// and PLONK-style proof systems need.
+//
+// The SRS comes from a trusted setup ceremony, whose output LoadSRS checks
+// for consistency. GenerateSRS produces one for tests, whose secret is
+// known to its caller.
+package kzg
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+var errInvalidOpening = errors.New("kzg: invalid opening")
+
+// SRS is a structured reference string.
+type SRS struct {
+	suite pairing.Suite
+	// G1 holds the points tau^i * G1, for i from 0 to the maximal degree
+	G1 []kyber.Point
+	// G2 holds the points G2 and tau * G2
+	G2 []kyber.Point
+}
+
+// GenerateSRS returns an SRS for polynomials of up to the given degree,
+// with a secret picked from random. Whoever runs it learns the secret, with
+// which openings can be forged, so it is only meant for tests.
+func GenerateSRS(suite pairing.Suite, degree int, random cipher.Stream) (*SRS, error) {
+	if degree < 1 {
+		return nil, errors.New("kzg: invalid degree")
+	}
+	tau := suite.G1().Scalar().Pick(random)
+	s := &SRS{suite: suite, G1: make([]kyber.Point, degree+1)}
+	t := suite.G1().Scalar().One()
+	for i := range s.G1 {
+		s.G1[i] = suite.G1().Point().Mul(t, nil)
+		t = suite.G1().Scalar().Mul(t, tau)
+	}
+	s.G2 = []kyber.Point{suite.G2().Point().Base(), suite.G2().Point().Mul(tau, nil)}
+	return s, nil
+}
+
+// LoadSRS returns the SRS of the given points, the output of a trusted
+// setup, after checking that they are made of the standard generators and
+// the successive powers of a same non-zero secret.
+func LoadSRS(suite pairing.Suite, g1, g2 []kyber.Point) (*SRS, error) {
+	if len(g1) < 2 || len(g2) != 2 {
+		return nil, errors.New("kzg: invalid number of points")
+	}
+	if !g1[0].Equal(suite.G1().Point().Base()) || !g2[0].Equal(suite.G2().Point().Base()) {
+		return nil, errors.New("kzg: invalid generators")
+	}
+	if g2[1].Equal(suite.G2().Point().Null()) {
+		return nil, errors.New("kzg: invalid secret")
+	}
+	// e(g1[i+1], G2) = e(g1[i], tau*G2) for all i, checked with a random
+	// linear combination.
+	random := suite.RandomStream()
+	L, R := suite.G1().Point().Null(), suite.G1().Point().Null()
+	for i := 0; i+1 < len(g1); i++ {
+		r := suite.G1().Scalar().Pick(random)
+		L.Add(L, suite.G1().Point().Mul(r, g1[i+1]))
+		R.Add(R, suite.G1().Point().Mul(r, g1[i]))
+	}
+	if !suite.PairingCheck([]kyber.Point{L, R.Neg(R)}, []kyber.Point{g2[0], g2[1]}) {
+		return nil, errors.New("kzg: inconsistent points")
+	}
+	return &SRS{suite: suite, G1: append([]kyber.Point{}, g1...), G2: append([]kyber.Point{}, g2...)}, nil
+}
+
+// Degree returns the maximal degree of the polynomials committed to with
+// the SRS.
+func (s *SRS) Degree() int {
+	return len(s.G1) - 1
+}
+
+// MarshalBinary returns the binary representation of the SRS: the number
+// of points of G1 as a big-endian uint32, the points of G1, and the two
+// points of G2.
+func (s *SRS) MarshalBinary() ([]byte, error) {
+	var b bytes.Buffer
+	var n [4]byte
+	binary.BigEndian.PutUint32(n[:], uint32(len(s.G1)))
+	b.Write(n[:])
+	for _, P := range append(append([]kyber.Point{}, s.G1...), s.G2...) {
+		if _, err := P.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalBinary reads the SRS from its binary representation, and checks
+// it as LoadSRS does.
+func (s *SRS) UnmarshalBinary(suite pairing.Suite, buff []byte) error {
+	if len(buff) < 4 {
+		return errors.New("kzg: invalid SRS length")
+	}
+	n := int(binary.BigEndian.Uint32(buff))
+	l1, l2 := suite.G1().PointLen(), suite.G2().PointLen()
+	if n < 2 || len(buff) != 4+n*l1+2*l2 {
+		return errors.New("kzg: invalid SRS length")
+	}
+	r := bytes.NewReader(buff[4:])
+	g1, g2 := make([]kyber.Point, n), make([]kyber.Point, 2)
+	for i := range g1 {
+		g1[i] = suite.G1().Point()
+		if _, err := g1[i].UnmarshalFrom(r); err != nil {
+			return err
+		}
+	}
+	for i := range g2 {
+		g2[i] = suite.G2().Point()
+		if _, err := g2[i].UnmarshalFrom(r); err != nil {
+			return err
+		}
+	}
+	loaded, err := LoadSRS(suite, g1, g2)
+	if err != nil {
+		return err
+	}
+	*s = *loaded
+	return nil
+}
+
+// Commit returns the commitment to the polynomial of the given
+// coefficients, from the constant one up.
+func (s *SRS) Commit(poly []kyber.Scalar) (kyber.Point, error) {
+	if len(poly) > len(s.G1) {
+		return nil, errors.New("kzg: degree too high")
+	}
+	C := s.suite.G1().Point().Null()
+	for i, c := range poly {
+		C.Add(C, s.suite.G1().Point().Mul(c, s.G1[i]))
+	}
+	return C, nil
+}
+
+// Open returns the value of the polynomial at z, and the proof of it.
+func (s *SRS) Open(poly []kyber.Scalar, z kyber.Scalar) (kyber.Scalar, kyber.Point, error) {
+	if len(poly) > len(s.G1) {
+		return nil, nil, errors.New("kzg: degree too high")
+	}
+	y, q := s.divide(poly, z)
+	pi, err := s.Commit(q)
+	return y, pi, err
+}
+
+// Verify checks that pi proves that the polynomial committed to by C has
+// the value y at z: e(C - y*G1, G2) = e(pi, tau*G2 - z*G2).
+func (s *SRS) Verify(C kyber.Point, z, y kyber.Scalar, pi kyber.Point) error {
+	g1 := s.suite.G1()
+	L := g1.Point().Sub(C, g1.Point().Mul(y, nil))
+	R := s.suite.G2().Point().Sub(s.G2[1], s.suite.G2().Point().Mul(z, nil))
+	if !s.suite.PairingCheck([]kyber.Point{L, g1.Point().Neg(pi)}, []kyber.Point{s.G2[0], R}) {
+		return errInvalidOpening
+	}
+	return nil
+}
+
+// OpenBatch returns the values of the polynomials at z, and a single proof
+// of all of them, for the polynomials combined with the powers of a
+// challenge derived from their commitments, z and the values.
+func (s *SRS) OpenBatch(polys [][]kyber.Scalar, z kyber.Scalar) ([]kyber.Scalar, kyber.Point, error) {
+	commits := make([]kyber.Point, len(polys))
+	ys := make([]kyber.Scalar, len(polys))
+	for i, p := range polys {
+		C, err := s.Commit(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		commits[i] = C
+		ys[i], _ = s.divide(p, z)
+	}
+	gammas, err := s.batchChallenges(commits, z, ys)
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := make([]kyber.Scalar, len(s.G1))
+	for i := range sum {
+		sum[i] = s.suite.G1().Scalar().Zero()
+	}
+	for j, p := range polys {
+		for i, c := range p {
+			sum[i].Add(sum[i], s.suite.G1().Scalar().Mul(gammas[j], c))
+		}
+	}
+	_, q := s.divide(sum, z)
+	pi, err := s.Commit(q)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ys, pi, nil
+}
+
+// VerifyBatch checks that pi proves that the polynomials committed to by
+// the commitments have the values ys at z.
+func (s *SRS) VerifyBatch(commits []kyber.Point, z kyber.Scalar, ys []kyber.Scalar, pi kyber.Point) error {
+	gammas, err := s.batchChallenges(commits, z, ys)
+	if err != nil {
+		return err
+	}
+	C, y := s.suite.G1().Point().Null(), s.suite.G1().Scalar().Zero()
+	for j := range commits {
+		C.Add(C, s.suite.G1().Point().Mul(gammas[j], commits[j]))
+		y.Add(y, s.suite.G1().Scalar().Mul(gammas[j], ys[j]))
+	}
+	return s.Verify(C, z, y, pi)
+}
+
+// VerifyMany checks that each proof pis[i] proves that the polynomial
+// committed to by commits[i] has the value ys[i] at zs[i], with two
+// pairings in all: e(sum(r_i (C_i - y_i*G1 + z_i*pi_i)), G2) =
+// e(sum(r_i pi_i), tau*G2), for weights derived from all the openings.
+func (s *SRS) VerifyMany(commits []kyber.Point, zs, ys []kyber.Scalar, pis []kyber.Point) error {
+	n := len(commits)
+	if n == 0 || len(zs) != n || len(ys) != n || len(pis) != n {
+		return errors.New("kzg: one point, value and proof per commitment is needed")
+	}
+	t := transcript.New(s.transcriptSuite(), "kzg verify many")
+	for i := range commits {
+		t.AppendPoints("C", commits[i])
+		t.AppendScalars("z", zs[i])
+		t.AppendScalars("y", ys[i])
+		t.AppendPoints("pi", pis[i])
+	}
+	g1 := s.suite.G1()
+	L, R := g1.Point().Null(), g1.Point().Null()
+	for i := range commits {
+		r := t.Challenge("r")
+		P := g1.Point().Sub(commits[i], g1.Point().Mul(ys[i], nil))
+		P.Add(P, g1.Point().Mul(zs[i], pis[i]))
+		L.Add(L, g1.Point().Mul(r, P))
+		R.Add(R, g1.Point().Mul(r, pis[i]))
+	}
+	if !s.suite.PairingCheck([]kyber.Point{L, R.Neg(R)}, []kyber.Point{s.G2[0], s.G2[1]}) {
+		return errInvalidOpening
+	}
+	return nil
+}
+
+// batchChallenges returns the powers of the challenge that combine the
+// openings of several polynomials at z.
+func (s *SRS) batchChallenges(commits []kyber.Point, z kyber.Scalar, ys []kyber.Scalar) ([]kyber.Scalar, error) {
+	if len(commits) == 0 || len(ys) != len(commits) {
+		return nil, errors.New("kzg: one value per commitment is needed")
+	}
+	t := transcript.New(s.transcriptSuite(), "kzg open batch")
+	t.AppendPoints("C", commits...)
+	t.AppendScalars("z", z)
+	t.AppendScalars("y", ys...)
+	gamma := t.Challenge("gamma")
+	gammas := make([]kyber.Scalar, len(commits))
+	gammas[0] = s.suite.G1().Scalar().One()
+	for i := 1; i < len(gammas); i++ {
+		gammas[i] = s.suite.G1().Scalar().Mul(gammas[i-1], gamma)
+	}
+	return gammas, nil
+}
+
+// divide returns the value p(z) of the polynomial, and the quotient of
+// p(X) - p(z) by X - z.
+func (s *SRS) divide(poly []kyber.Scalar, z kyber.Scalar) (kyber.Scalar, []kyber.Scalar) {
+	if len(poly) == 0 {
+		return s.suite.G1().Scalar().Zero(), nil
+	}
+	q := make([]kyber.Scalar, len(poly)-1)
+	y := s.suite.G1().Scalar().Set(poly[len(poly)-1])
+	for i := len(poly) - 2; i >= 0; i-- {
+		q[i] = s.suite.G1().Scalar().Set(y)
+		y.Mul(y, z).Add(y, poly[i])
+	}
+	return y, q
+}
+
+// transcriptSuite returns the suite of the transcripts of the challenges,
+// on G1.
+func (s *SRS) transcriptSuite() transcript.Suite {
+	return &struct {
+		kyber.Group
+		pairing.Suite
+	}{s.suite.G1(), s.suite}
+}
@@ -0,0 +1,142 @@
+// +build vartime
+
+package kzg
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+func poly(d int) []kyber.Scalar {
+	p := make([]kyber.Scalar, d+1)
+	for i := range p {
+		p[i] = suite.G1().Scalar().Pick(random.New())
+	}
+	return p
+}
+
+// eval returns the value of p at z.
+func eval(p []kyber.Scalar, z kyber.Scalar) kyber.Scalar {
+	y := suite.G1().Scalar().Zero()
+	for i := len(p) - 1; i >= 0; i-- {
+		y.Mul(y, z).Add(y, p[i])
+	}
+	return y
+}
+
+func TestOpen(t *testing.T) {
+	srs, err := GenerateSRS(suite, 8, random.New())
+	require.NoError(t, err)
+	require.Equal(t, 8, srs.Degree())
+
+	for _, d := range []int{0, 1, 8} {
+		p := poly(d)
+		C, err := srs.Commit(p)
+		require.NoError(t, err)
+		z := suite.G1().Scalar().Pick(random.New())
+		y, pi, err := srs.Open(p, z)
+		require.NoError(t, err)
+		require.True(t, y.Equal(eval(p, z)))
+		require.NoError(t, srs.Verify(C, z, y, pi))
+
+		other := suite.G1().Scalar().Add(y, suite.G1().Scalar().One())
+		require.Error(t, srs.Verify(C, z, other, pi))
+		if d > 0 {
+			// Constant polynomials have the same value everywhere.
+			require.Error(t, srs.Verify(C, other, y, pi))
+		}
+		require.Error(t, srs.Verify(suite.G1().Point().Add(C, srs.G1[0]), z, y, pi))
+	}
+
+	_, err = srs.Commit(poly(9))
+	require.Error(t, err)
+	_, _, err = srs.Open(poly(9), suite.G1().Scalar().One())
+	require.Error(t, err)
+	_, err = GenerateSRS(suite, 0, random.New())
+	require.Error(t, err)
+}
+
+func TestOpenBatch(t *testing.T) {
+	srs, err := GenerateSRS(suite, 4, random.New())
+	require.NoError(t, err)
+	polys := [][]kyber.Scalar{poly(4), poly(2), poly(3)}
+	commits := make([]kyber.Point, len(polys))
+	for i, p := range polys {
+		commits[i], err = srs.Commit(p)
+		require.NoError(t, err)
+	}
+	z := suite.G1().Scalar().Pick(random.New())
+	ys, pi, err := srs.OpenBatch(polys, z)
+	require.NoError(t, err)
+	for i, p := range polys {
+		require.True(t, ys[i].Equal(eval(p, z)))
+	}
+	require.NoError(t, srs.VerifyBatch(commits, z, ys, pi))
+
+	require.Error(t, srs.VerifyBatch(commits, z, []kyber.Scalar{ys[1], ys[0], ys[2]}, pi))
+	require.Error(t, srs.VerifyBatch(commits[:2], z, ys[:2], pi))
+	require.Error(t, srs.VerifyBatch(commits, z, ys[:2], pi))
+}
+
+func TestVerifyMany(t *testing.T) {
+	srs, err := GenerateSRS(suite, 4, random.New())
+	require.NoError(t, err)
+	n := 4
+	commits, pis := make([]kyber.Point, n), make([]kyber.Point, n)
+	zs, ys := make([]kyber.Scalar, n), make([]kyber.Scalar, n)
+	for i := 0; i < n; i++ {
+		p := poly(i + 1)
+		commits[i], err = srs.Commit(p)
+		require.NoError(t, err)
+		zs[i] = suite.G1().Scalar().Pick(random.New())
+		ys[i], pis[i], err = srs.Open(p, zs[i])
+		require.NoError(t, err)
+	}
+	require.NoError(t, srs.VerifyMany(commits, zs, ys, pis))
+
+	// Swapped proofs fail, even if each one is valid.
+	pis[0], pis[1] = pis[1], pis[0]
+	require.Error(t, srs.VerifyMany(commits, zs, ys, pis))
+	require.Error(t, srs.VerifyMany(commits, zs, ys, pis[1:]))
+}
+
+func TestLoadSRS(t *testing.T) {
+	srs, err := GenerateSRS(suite, 4, random.New())
+	require.NoError(t, err)
+	loaded, err := LoadSRS(suite, srs.G1, srs.G2)
+	require.NoError(t, err)
+	require.Equal(t, 4, loaded.Degree())
+
+	buff, err := srs.MarshalBinary()
+	require.NoError(t, err)
+	decoded := new(SRS)
+	require.NoError(t, decoded.UnmarshalBinary(suite, buff))
+	p := poly(4)
+	C, err := decoded.Commit(p)
+	require.NoError(t, err)
+	z := suite.G1().Scalar().Pick(random.New())
+	y, pi, err := srs.Open(p, z)
+	require.NoError(t, err)
+	require.NoError(t, decoded.Verify(C, z, y, pi))
+	require.Error(t, decoded.UnmarshalBinary(suite, buff[1:]))
+
+	// Points that are not successive powers of the secret are rejected.
+	g1 := append([]kyber.Point{}, srs.G1...)
+	g1[2] = suite.G1().Point().Add(g1[2], g1[0])
+	_, err = LoadSRS(suite, g1, srs.G2)
+	require.Error(t, err)
+	_, err = LoadSRS(suite, srs.G1, []kyber.Point{srs.G2[0], suite.G2().Point().Pick(random.New())})
+	require.Error(t, err)
+	_, err = LoadSRS(suite, srs.G1[1:], srs.G2)
+	require.Error(t, err)
+	_, err = LoadSRS(suite, srs.G1, []kyber.Point{srs.G2[0], suite.G2().Point().Null()})
+	require.Error(t, err)
+	_, err = LoadSRS(suite, srs.G1[:1], srs.G2)
+	require.Error(t, err)
+}
@@ -0,0 +1,368 @@
+// Package merkle implements Merkle trees of any arity over the hash function
+// of a suite, with inclusion and consistency proofs.
+//
+// Trees have the shape of those of Certificate Transparency (RFC 6962),
+// generalized to k children per node: the root of n > 1 leaves has as
+// children the trees of the successive chunks of k^m leaves, where k^m is
+// the largest power of k less than n, the last chunk possibly shorter. With
+// k = 2, trees and proofs are those of RFC 6962. Leaves and nodes are hashed
+// with distinct prefixes, so that no node is taken for a leaf, and the tree
+// of no leaves has the hash of the empty string as root.
+//
+// An inclusion proof shows that a leaf is at some index of the tree of a
+// given size, and a consistency proof that the tree of a given size is a
+// prefix of a larger one, as append-only logs need. Both are lists of
+// hashes, whose positions in the tree follow from the sizes and the index.
+// Trees are built in memory from all the leaves with NewTree, or by a
+// Builder that adds leaves one at a time and keeps O(k log n) hashes, for
+// datasets that do not fit in memory.
+package merkle
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/dedis/kyber"
+)
+
+// Suite wraps the functionalities needed by the merkle package.
+type Suite interface {
+	kyber.HashFactory
+}
+
+// Prefixes of the hashes of leaves and nodes
+const (
+	leafPrefix = 0
+	nodePrefix = 1
+)
+
+var errInvalidProof = errors.New("merkle: invalid proof")
+
+// hasher hashes leaves and nodes of trees of a given arity.
+type hasher struct {
+	suite Suite
+	arity int
+}
+
+func newHasher(suite Suite, arity int) (*hasher, error) {
+	if arity < 2 {
+		return nil, errors.New("merkle: invalid arity")
+	}
+	return &hasher{suite, arity}, nil
+}
+
+func (h *hasher) leaf(data []byte) []byte {
+	H := h.suite.Hash()
+	H.Write([]byte{leafPrefix})
+	H.Write(data)
+	return H.Sum(nil)
+}
+
+func (h *hasher) node(children [][]byte) []byte {
+	H := h.suite.Hash()
+	H.Write([]byte{nodePrefix})
+	for _, c := range children {
+		H.Write(c)
+	}
+	return H.Sum(nil)
+}
+
+// split returns the size of the chunks of the children of the root of n > 1
+// leaves: the largest power of the arity less than n.
+func (h *hasher) split(n int) int {
+	s := 1
+	for s*h.arity < n {
+		s *= h.arity
+	}
+	return s
+}
+
+// Tree is a Merkle tree held in memory.
+type Tree struct {
+	h      *hasher
+	leaves [][]byte // hashes of the leaves
+}
+
+// NewTree returns the tree of the given arity, at least 2, of the leaves
+// data.
+func NewTree(suite Suite, arity int, data [][]byte) (*Tree, error) {
+	h, err := newHasher(suite, arity)
+	if err != nil {
+		return nil, err
+	}
+	t := &Tree{h: h, leaves: make([][]byte, len(data))}
+	for i, d := range data {
+		t.leaves[i] = h.leaf(d)
+	}
+	return t, nil
+}
+
+// Size returns the number of leaves of the tree.
+func (t *Tree) Size() int {
+	return len(t.leaves)
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() []byte {
+	if len(t.leaves) == 0 {
+		return t.h.suite.Hash().Sum(nil)
+	}
+	return t.root(t.leaves)
+}
+
+// root returns the root of the tree of the given hashes of leaves.
+func (t *Tree) root(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	return t.h.node(t.children(leaves))
+}
+
+// children returns the roots of the children of the tree of the leaves.
+func (t *Tree) children(leaves [][]byte) [][]byte {
+	s := t.h.split(len(leaves))
+	var c [][]byte
+	for j := 0; j < len(leaves); j += s {
+		c = append(c, t.root(leaves[j:min(j+s, len(leaves))]))
+	}
+	return c
+}
+
+// Prove returns the proof of inclusion of the leaf of index i.
+func (t *Tree) Prove(i int) ([][]byte, error) {
+	if i < 0 || i >= len(t.leaves) {
+		return nil, errors.New("merkle: invalid index")
+	}
+	return clone(t.path(i, t.leaves)), nil
+}
+
+// path returns the siblings of the leaf i on its path to the root of the
+// tree of the leaves, from the bottom up, and at each level in the order of
+// the children.
+func (t *Tree) path(i int, leaves [][]byte) [][]byte {
+	if len(leaves) == 1 {
+		return nil
+	}
+	s := t.h.split(len(leaves))
+	j := i / s
+	proof := t.path(i-j*s, leaves[j*s:min((j+1)*s, len(leaves))])
+	for k, c := range t.children(leaves) {
+		if k != j {
+			proof = append(proof, c)
+		}
+	}
+	return proof
+}
+
+// ProveConsistency returns the proof that the tree of the first m leaves
+// is a prefix of the tree.
+func (t *Tree) ProveConsistency(m int) ([][]byte, error) {
+	if m <= 0 || m > len(t.leaves) {
+		return nil, errors.New("merkle: invalid size")
+	}
+	if m == len(t.leaves) {
+		return nil, nil
+	}
+	return clone(t.subproof(m, t.leaves, true)), nil
+}
+
+// subproof returns the consistency proof of the first m leaves of the tree
+// of the leaves, where whole tells whether they are the whole old tree, as
+// in RFC 6962: the proof in the child of the last of them comes first, then
+// the roots of the children before it, and those of the children after it.
+func (t *Tree) subproof(m int, leaves [][]byte, whole bool) [][]byte {
+	if m == len(leaves) {
+		if whole {
+			return nil
+		}
+		return [][]byte{t.root(leaves)}
+	}
+	s := t.h.split(len(leaves))
+	j := (m - 1) / s
+	proof := t.subproof(m-j*s, leaves[j*s:min((j+1)*s, len(leaves))], whole && j == 0)
+	for k, c := range t.children(leaves) {
+		if k != j {
+			proof = append(proof, c)
+		}
+	}
+	return proof
+}
+
+// VerifyInclusion checks that proof proves that data is the leaf of index i
+// of the tree of the given arity and size, of the given root.
+func VerifyInclusion(suite Suite, arity int, root []byte, size, i int, data []byte, proof [][]byte) error {
+	h, err := newHasher(suite, arity)
+	if err != nil {
+		return err
+	}
+	if i < 0 || i >= size {
+		return errors.New("merkle: invalid index")
+	}
+	r, rest, err := h.pathRoot(i, size, h.leaf(data), proof)
+	if err != nil || len(rest) != 0 || !bytes.Equal(r, root) {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// pathRoot returns the root of the tree of n leaves from the hash of its
+// leaf i and the siblings on its path, and the rest of the proof.
+func (h *hasher) pathRoot(i, n int, leaf []byte, proof [][]byte) ([]byte, [][]byte, error) {
+	if n == 1 {
+		return leaf, proof, nil
+	}
+	s := h.split(n)
+	j := i / s
+	r, proof, err := h.pathRoot(i-j*s, min(s, n-j*s), leaf, proof)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := (n + s - 1) / s
+	if len(proof) < c-1 {
+		return nil, nil, errInvalidProof
+	}
+	children := make([][]byte, 0, c)
+	children = append(children, proof[:j]...)
+	children = append(children, r)
+	children = append(children, proof[j:c-1]...)
+	return h.node(children), proof[c-1:], nil
+}
+
+// VerifyConsistency checks that proof proves that the tree of the given
+// arity and size m, of root oldRoot, is a prefix of the tree of size n, of
+// root newRoot.
+func VerifyConsistency(suite Suite, arity int, m, n int, oldRoot, newRoot []byte, proof [][]byte) error {
+	h, err := newHasher(suite, arity)
+	if err != nil {
+		return err
+	}
+	if m <= 0 || m > n {
+		return errors.New("merkle: invalid size")
+	}
+	if m == n {
+		if len(proof) != 0 || !bytes.Equal(oldRoot, newRoot) {
+			return errInvalidProof
+		}
+		return nil
+	}
+	o, r, rest, err := h.consistencyRoots(m, n, true, oldRoot, proof)
+	if err != nil || len(rest) != 0 || !bytes.Equal(o, oldRoot) || !bytes.Equal(r, newRoot) {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// consistencyRoots returns the roots of the trees of the first m leaves and
+// of all the n leaves from a consistency proof, and the rest of the proof.
+func (h *hasher) consistencyRoots(m, n int, whole bool, oldRoot []byte, proof [][]byte) ([]byte, []byte, [][]byte, error) {
+	if m == n {
+		if whole {
+			return oldRoot, oldRoot, proof, nil
+		}
+		if len(proof) == 0 {
+			return nil, nil, nil, errInvalidProof
+		}
+		return proof[0], proof[0], proof[1:], nil
+	}
+	s := h.split(n)
+	j := (m - 1) / s
+	o, r, proof, err := h.consistencyRoots(m-j*s, min(s, n-j*s), whole && j == 0, oldRoot, proof)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	c := (n + s - 1) / s
+	if len(proof) < c-1 {
+		return nil, nil, nil, errInvalidProof
+	}
+	left, right := proof[:j], proof[j:c-1]
+	children := append(append(append([][]byte{}, left...), r), right...)
+	newRoot := h.node(children)
+	if j > 0 {
+		// The old tree has the same first children, and the old part of
+		// the child j as last one.
+		o = h.node(append(append([][]byte{}, left...), o))
+	}
+	return o, newRoot, proof[c-1:], nil
+}
+
+// Builder builds the root of a tree from leaves added one at a time. It
+// keeps, for each level, the roots of the complete subtrees not yet part of
+// a complete subtree of the next level, at most k-1 per level.
+type Builder struct {
+	h      *hasher
+	size   int
+	levels [][][]byte
+}
+
+// NewBuilder returns a builder of the tree of the given arity of no leaves.
+func NewBuilder(suite Suite, arity int) (*Builder, error) {
+	h, err := newHasher(suite, arity)
+	if err != nil {
+		return nil, err
+	}
+	return &Builder{h: h}, nil
+}
+
+// Add appends the leaf data to the tree.
+func (b *Builder) Add(data []byte) {
+	node := b.h.leaf(data)
+	for l := 0; ; l++ {
+		if l == len(b.levels) {
+			b.levels = append(b.levels, nil)
+		}
+		b.levels[l] = append(b.levels[l], node)
+		if len(b.levels[l]) < b.h.arity {
+			break
+		}
+		node = b.h.node(b.levels[l])
+		b.levels[l] = nil
+	}
+	b.size++
+}
+
+// Size returns the number of leaves added.
+func (b *Builder) Size() int {
+	return b.size
+}
+
+// Root returns the root of the tree of the leaves added so far, the same as
+// that of NewTree.
+func (b *Builder) Root() []byte {
+	// The remaining leaves after the complete subtrees of a level form the
+	// last child of the node of these subtrees.
+	var root []byte
+	for _, subtrees := range b.levels {
+		children := subtrees
+		if root != nil {
+			children = append(append([][]byte{}, subtrees...), root)
+		}
+		switch len(children) {
+		case 0:
+		case 1:
+			root = children[0]
+		default:
+			root = b.h.node(children)
+		}
+	}
+	if root == nil {
+		return b.h.suite.Hash().Sum(nil)
+	}
+	return root
+}
+
+// clone returns a copy of the proof that does not share hashes of leaves
+// with the tree.
+func clone(proof [][]byte) [][]byte {
+	c := make([][]byte, len(proof))
+	for i, h := range proof {
+		c[i] = append([]byte{}, h...)
+	}
+	return c
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
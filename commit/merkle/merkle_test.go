@@ -0,0 +1,136 @@
+package merkle
+
+import (
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func leaves(n int) [][]byte {
+	data := make([][]byte, n)
+	for i := range data {
+		data[i] = []byte("leaf " + strconv.Itoa(i))
+	}
+	return data
+}
+
+// Roots of the prefixes of the leaves of the test vectors of RFC 6962 used
+// by Certificate Transparency implementations.
+func TestRFC6962(t *testing.T) {
+	var data [][]byte
+	for _, s := range []string{"", "00", "10", "2021", "3031", "40414243",
+		"5051525354555657", "606162636465666768696a6b6c6d6e6f"} {
+		d, _ := hex.DecodeString(s)
+		data = append(data, d)
+	}
+	roots := []string{
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+		"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+		"aeb6bcfe274b70a14fb067a5e5578264db0fa9b51af5e0ba159158f329e06e77",
+		"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+		"4e3bbb1f7b478dcfe71fb631631519a3bca12c9aefca1612bfce4c13a86264d4",
+		"76e67dadbcdf1e10e1b74ddc608abd2f98dfb16fbce75277b5232a127f2087ef",
+		"ddb89be403809e325750d3d263cd78929c2942b7942a34b77e122c9594a74c8c",
+		"5dc9da79a70659a9ad559cb701ded9a2ab9d823aad2f4960cfe370eff4604328",
+	}
+	for n, root := range roots {
+		tree, err := NewTree(suite, 2, data[:n])
+		require.Nil(t, err)
+		require.Equal(t, root, hex.EncodeToString(tree.Root()))
+	}
+}
+
+func TestInclusion(t *testing.T) {
+	for _, arity := range []int{2, 3, 4, 16} {
+		for n := 1; n <= 20; n++ {
+			data := leaves(n)
+			tree, err := NewTree(suite, arity, data)
+			require.Nil(t, err)
+			root := tree.Root()
+			for i := range data {
+				proof, err := tree.Prove(i)
+				require.Nil(t, err)
+				require.Nil(t, VerifyInclusion(suite, arity, root, n, i, data[i], proof))
+
+				require.Error(t, VerifyInclusion(suite, arity, root, n, i, []byte("other"), proof))
+				if n > 1 {
+					require.Error(t, VerifyInclusion(suite, arity, root, n, (i+1)%n, data[i], proof))
+					proof[0][0] ^= 1
+					require.Error(t, VerifyInclusion(suite, arity, root, n, i, data[i], proof))
+					require.Error(t, VerifyInclusion(suite, arity, root, n, i, data[i], proof[1:]))
+				}
+				proof = append(proof, root)
+				require.Error(t, VerifyInclusion(suite, arity, root, n, i, data[i], proof))
+			}
+			_, err = tree.Prove(n)
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestConsistency(t *testing.T) {
+	for _, arity := range []int{2, 3, 5} {
+		for n := 1; n <= 30; n++ {
+			tree, err := NewTree(suite, arity, leaves(n))
+			require.Nil(t, err)
+			for m := 1; m <= n; m++ {
+				old, err := NewTree(suite, arity, leaves(m))
+				require.Nil(t, err)
+				proof, err := tree.ProveConsistency(m)
+				require.Nil(t, err)
+				require.Nil(t, VerifyConsistency(suite, arity, m, n, old.Root(), tree.Root(), proof))
+
+				other, err := NewTree(suite, arity, append(leaves(m-1), []byte("other")))
+				require.Nil(t, err)
+				require.Error(t, VerifyConsistency(suite, arity, m, n, other.Root(), tree.Root(), proof))
+				require.Error(t, VerifyConsistency(suite, arity, m, n, old.Root(), other.Root(), proof))
+				if len(proof) > 0 {
+					require.Error(t, VerifyConsistency(suite, arity, m, n, old.Root(), tree.Root(), proof[1:]))
+				}
+				require.Error(t, VerifyConsistency(suite, arity, m, n, old.Root(), tree.Root(), append(proof, old.Root())))
+			}
+			_, err = tree.ProveConsistency(0)
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	for _, arity := range []int{2, 3, 4} {
+		b, err := NewBuilder(suite, arity)
+		require.Nil(t, err)
+		empty, err := NewTree(suite, arity, nil)
+		require.Nil(t, err)
+		require.Equal(t, empty.Root(), b.Root())
+
+		data := leaves(70)
+		for n := 1; n <= len(data); n++ {
+			b.Add(data[n-1])
+			tree, err := NewTree(suite, arity, data[:n])
+			require.Nil(t, err)
+			require.Equal(t, n, b.Size())
+			require.Equal(t, tree.Root(), b.Root())
+		}
+	}
+	_, err := NewBuilder(suite, 1)
+	require.Error(t, err)
+}
+
+func TestDomainSeparation(t *testing.T) {
+	// A node is not taken for a leaf: the tree of two leaves does not have
+	// the root of the leaf made of the concatenation of their hashes.
+	tree, err := NewTree(suite, 2, leaves(2))
+	require.Nil(t, err)
+	h := &hasher{suite, 2}
+	concat := append(h.leaf(leaves(2)[0]), h.leaf(leaves(2)[1])...)
+	single, err := NewTree(suite, 2, [][]byte{concat})
+	require.Nil(t, err)
+	require.NotEqual(t, tree.Root(), single.Root())
+	require.Equal(t, tree.Root(), h.node([][]byte{h.leaf(leaves(2)[0]), h.leaf(leaves(2)[1])}))
+}
@@ -0,0 +1,110 @@
+// Package pedersen implements Pedersen commitments over any group.
+//
+// A commitment to a value v with the blinding factor r is C = v*G + r*H, for
+// two generators G and H of which no one knows the relative discrete
+// logarithm. It hides v perfectly, binds the committer to v as long as
+// discrete logarithms are hard, and is additively homomorphic: the sum of
+// commitments commits to the sum of the values, with the sum of the blinding
+// factors. G is the base point of the group, and H is hashed to the group
+// from a label, so that anyone can check that its discrete logarithm is
+// unknown and that distinct protocols get distinct generators.
+package pedersen
+
+import (
+	"crypto/cipher"
+	"errors"
+
+	"github.com/dedis/kyber"
+)
+
+// Suite wraps the functionalities needed by the pedersen package.
+type Suite interface {
+	kyber.Group
+	kyber.XOFFactory
+}
+
+var errInvalidOpening = errors.New("pedersen: invalid opening")
+
+// Params are the generators of commitments.
+type Params struct {
+	suite Suite
+	G, H  kyber.Point
+}
+
+// NewParams returns the generators of commitments for the protocol of the
+// given label: the base point G of the group, and H, hashed to the group
+// from the label.
+func NewParams(suite Suite, label string) *Params {
+	H := suite.Point().Pick(suite.XOF([]byte("pedersen H " + label)))
+	return &Params{suite: suite, G: suite.Point().Base(), H: H}
+}
+
+// Commit returns the commitment v*G + r*H to the value v with the blinding
+// factor r.
+func (p *Params) Commit(v, r kyber.Scalar) kyber.Point {
+	C := p.suite.Point().Mul(v, p.G)
+	return C.Add(C, p.suite.Point().Mul(r, p.H))
+}
+
+// CommitRandom returns a commitment to the value v with a blinding factor
+// picked from random, and the blinding factor.
+func (p *Params) CommitRandom(v kyber.Scalar, random cipher.Stream) (kyber.Point, kyber.Scalar) {
+	r := p.suite.Scalar().Pick(random)
+	return p.Commit(v, r), r
+}
+
+// Open checks that C is the commitment to the value v with the blinding
+// factor r.
+func (p *Params) Open(C kyber.Point, v, r kyber.Scalar) error {
+	if !p.Commit(v, r).Equal(C) {
+		return errInvalidOpening
+	}
+	return nil
+}
+
+// Add returns the sum of the commitments, which commits to the sum of their
+// values with the sum of their blinding factors.
+func (p *Params) Add(commits ...kyber.Point) kyber.Point {
+	S := p.suite.Point().Null()
+	for _, C := range commits {
+		S.Add(S, C)
+	}
+	return S
+}
+
+// Sub returns the difference of the commitments, which commits to the
+// difference of their values with the difference of their blinding factors.
+func (p *Params) Sub(C1, C2 kyber.Point) kyber.Point {
+	return p.suite.Point().Sub(C1, C2)
+}
+
+// Mul returns s times the commitment, which commits to s times its value
+// with s times its blinding factor.
+func (p *Params) Mul(s kyber.Scalar, C kyber.Point) kyber.Point {
+	return p.suite.Point().Mul(s, C)
+}
+
+// AddValue returns the commitment C with v added to its value, whose
+// blinding factor does not change.
+func (p *Params) AddValue(C kyber.Point, v kyber.Scalar) kyber.Point {
+	return p.suite.Point().Add(C, p.suite.Point().Mul(v, p.G))
+}
+
+// SumBlindings returns the sum of the blinding factors, the blinding factor
+// of the sum of their commitments.
+func (p *Params) SumBlindings(r ...kyber.Scalar) kyber.Scalar {
+	s := p.suite.Scalar().Zero()
+	for _, ri := range r {
+		s.Add(s, ri)
+	}
+	return s
+}
+
+// BalancingBlinding returns the blinding factor of the last output for the
+// sum of the commitments of the outputs to have the same blinding factor as
+// the sum of the commitments of the inputs: the sum of the inputs minus the
+// sum of the other outputs. With it, the outputs sum to the inputs exactly
+// when their values do, as when confidential transactions spend inputs.
+func (p *Params) BalancingBlinding(inputs, outputs []kyber.Scalar) kyber.Scalar {
+	return p.suite.Scalar().Sub(p.SumBlindings(inputs...), p.SumBlindings(outputs...))
+}
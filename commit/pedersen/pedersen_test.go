@@ -0,0 +1,75 @@
+package pedersen
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func TestParams(t *testing.T) {
+	p := NewParams(suite, "test")
+	require.True(t, p.G.Equal(suite.Point().Base()))
+	require.True(t, p.H.Equal(NewParams(suite, "test").H))
+	require.False(t, p.H.Equal(NewParams(suite, "other").H))
+	require.False(t, p.H.Equal(p.G))
+}
+
+func TestCommitOpen(t *testing.T) {
+	p := NewParams(suite, "test")
+	v := suite.Scalar().SetInt64(42)
+	C, r := p.CommitRandom(v, random.New())
+	require.Nil(t, p.Open(C, v, r))
+	require.True(t, C.Equal(p.Commit(v, r)))
+
+	require.Error(t, p.Open(C, suite.Scalar().SetInt64(43), r))
+	require.Error(t, p.Open(C, v, suite.Scalar().Pick(random.New())))
+	require.Error(t, NewParams(suite, "other").Open(C, v, r))
+
+	// Commitments are hiding: the same value gets distinct commitments.
+	C2, _ := p.CommitRandom(v, random.New())
+	require.False(t, C.Equal(C2))
+}
+
+func TestHomomorphism(t *testing.T) {
+	p := NewParams(suite, "test")
+	v1, v2 := suite.Scalar().SetInt64(30), suite.Scalar().SetInt64(12)
+	C1, r1 := p.CommitRandom(v1, random.New())
+	C2, r2 := p.CommitRandom(v2, random.New())
+
+	sum := suite.Scalar().Add(v1, v2)
+	require.Nil(t, p.Open(p.Add(C1, C2), sum, p.SumBlindings(r1, r2)))
+	require.Nil(t, p.Open(p.Sub(C1, C2), suite.Scalar().Sub(v1, v2), suite.Scalar().Sub(r1, r2)))
+	s := suite.Scalar().SetInt64(3)
+	require.Nil(t, p.Open(p.Mul(s, C1), suite.Scalar().Mul(s, v1), suite.Scalar().Mul(s, r1)))
+	require.Nil(t, p.Open(p.AddValue(C1, v2), sum, r1))
+	require.True(t, p.Add().Equal(suite.Point().Null()))
+	require.True(t, p.SumBlindings().Equal(suite.Scalar().Zero()))
+}
+
+func TestBalancingBlinding(t *testing.T) {
+	p := NewParams(suite, "test")
+
+	// Two inputs of 30 and 12 are spent into outputs of 25, 10 and 7.
+	var ins, outs []kyber.Point
+	var rin, rout []kyber.Scalar
+	for _, v := range []int64{30, 12} {
+		C, r := p.CommitRandom(suite.Scalar().SetInt64(v), random.New())
+		ins, rin = append(ins, C), append(rin, r)
+	}
+	for _, v := range []int64{25, 10} {
+		C, r := p.CommitRandom(suite.Scalar().SetInt64(v), random.New())
+		outs, rout = append(outs, C), append(rout, r)
+	}
+	r := p.BalancingBlinding(rin, rout)
+	outs = append(outs, p.Commit(suite.Scalar().SetInt64(7), r))
+	require.True(t, p.Add(ins...).Equal(p.Add(outs...)))
+
+	// Outputs whose values do not add up do not balance.
+	outs[2] = p.Commit(suite.Scalar().SetInt64(8), r)
+	require.False(t, p.Add(ins...).Equal(p.Add(outs...)))
+}
@@ -0,0 +1,176 @@
+// Package vector implements vector commitments over any group: a single
+// point commits to a vector of n scalars, and any set of its positions is
+// opened with a proof of logarithmic size in n.
+//
+// The commitment to the vector v is C = v1*G1 + ... + vn*Gn, for generators
+// hashed to the group. An opening of the positions i1, ..., ik to the
+// values y1, ..., yk is an inner product argument, see package
+// proof/innerproduct, showing that <v, b> = y1 + r*y2 + ... + r^(k-1)*yk,
+// where b has r^(j-1) at position ij and r is derived from the commitment,
+// the positions and the values. Single and batched openings thus have the
+// same size, 2*log2(n) points and two scalars, and a client that only keeps
+// the commitment checks the values that servers send it.
+//
+// Commitments are binding but not hiding: they reveal nothing about the
+// vector only if it contains unpredictable values.
+package vector
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/innerproduct"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+// Suite wraps the functionalities needed by the vector package.
+type Suite interface {
+	kyber.Group
+	kyber.XOFFactory
+}
+
+var errInvalidOpening = errors.New("vector: invalid opening")
+
+// Params are the generators of commitments to vectors of a given maximal
+// size.
+type Params struct {
+	suite Suite
+	label string
+	n     int // size of the vectors, padded to a power of 2
+	G, H  []kyber.Point
+	Q     kyber.Point
+}
+
+// NewParams returns the generators of commitments to vectors of up to n
+// values, for the protocol of the given label. All of them are hashed to
+// the group from the label.
+func NewParams(suite Suite, label string, n int) (*Params, error) {
+	if n <= 0 {
+		return nil, errors.New("vector: invalid size")
+	}
+	size := 1
+	for size < n {
+		size *= 2
+	}
+	p := &Params{suite: suite, label: label, n: size,
+		G: make([]kyber.Point, size), H: make([]kyber.Point, size)}
+	for i := 0; i < size; i++ {
+		p.G[i] = p.generator("G" + strconv.Itoa(i))
+		p.H[i] = p.generator("H" + strconv.Itoa(i))
+	}
+	p.Q = p.generator("Q")
+	return p, nil
+}
+
+func (p *Params) generator(name string) kyber.Point {
+	return p.suite.Point().Pick(p.suite.XOF([]byte("vector " + name + " " + p.label)))
+}
+
+// Commit returns the commitment to the values.
+func (p *Params) Commit(values []kyber.Scalar) (kyber.Point, error) {
+	if len(values) > p.n {
+		return nil, errors.New("vector: too many values")
+	}
+	C := p.suite.Point().Null()
+	for i, v := range values {
+		C.Add(C, p.suite.Point().Mul(v, p.G[i]))
+	}
+	return C, nil
+}
+
+// Open returns the opening of the position i of the commitment to the
+// values.
+func (p *Params) Open(values []kyber.Scalar, i int) (*innerproduct.Proof, error) {
+	return p.OpenBatch(values, []int{i})
+}
+
+// Verify checks that the position i of the commitment C holds the value v.
+func (p *Params) Verify(C kyber.Point, i int, v kyber.Scalar, o *innerproduct.Proof) error {
+	return p.VerifyBatch(C, []int{i}, []kyber.Scalar{v}, o)
+}
+
+// OpenBatch returns the opening of the given positions of the commitment to
+// the values.
+func (p *Params) OpenBatch(values []kyber.Scalar, indices []int) (*innerproduct.Proof, error) {
+	C, err := p.Commit(values)
+	if err != nil {
+		return nil, err
+	}
+	a := make([]kyber.Scalar, p.n)
+	for i := range a {
+		if i < len(values) {
+			a[i] = values[i]
+		} else {
+			a[i] = p.suite.Scalar().Zero()
+		}
+	}
+	opened := make([]kyber.Scalar, len(indices))
+	for j, i := range indices {
+		if i < 0 || i >= p.n {
+			return nil, errors.New("vector: invalid position")
+		}
+		opened[j] = a[i]
+	}
+	t, b, _, err := p.statement(C, indices, opened)
+	if err != nil {
+		return nil, err
+	}
+	return innerproduct.Prove(p.suite, t, p.G, p.H, p.Q, a, b)
+}
+
+// VerifyBatch checks that the given positions of the commitment C hold the
+// values.
+func (p *Params) VerifyBatch(C kyber.Point, indices []int, values []kyber.Scalar, o *innerproduct.Proof) error {
+	t, b, y, err := p.statement(C, indices, values)
+	if err != nil {
+		return err
+	}
+	// P = C + <b, H> + y*Q = <v, G> + <b, H> + <v, b>*Q
+	P := p.suite.Point().Add(C, p.suite.Point().Mul(y, p.Q))
+	for i := range b {
+		if !b[i].Equal(p.suite.Scalar().Zero()) {
+			P.Add(P, p.suite.Point().Mul(b[i], p.H[i]))
+		}
+	}
+	if err := o.Verify(p.suite, t, p.G, p.H, p.Q, P); err != nil {
+		return errInvalidOpening
+	}
+	return nil
+}
+
+// statement returns the transcript of the opening of the positions to the
+// values, the vector b of the powers of its challenge at the positions, and
+// the combination y of the values.
+func (p *Params) statement(C kyber.Point, indices []int, values []kyber.Scalar) (*transcript.Transcript, []kyber.Scalar, kyber.Scalar, error) {
+	if len(indices) == 0 || len(indices) != len(values) {
+		return nil, nil, nil, errors.New("vector: one value per position is needed")
+	}
+	t := transcript.New(p.suite, "vector opening")
+	t.Append("label", []byte(p.label))
+	t.AppendPoints("C", C)
+	for j, i := range indices {
+		if i < 0 || i >= p.n {
+			return nil, nil, nil, errors.New("vector: invalid position")
+		}
+		var buf [4]byte
+		binary.BigEndian.PutUint32(buf[:], uint32(i))
+		t.Append("index", buf[:])
+		t.AppendScalars("value", values[j])
+	}
+	r := t.Challenge("r")
+
+	b := make([]kyber.Scalar, p.n)
+	for i := range b {
+		b[i] = p.suite.Scalar().Zero()
+	}
+	y := p.suite.Scalar().Zero()
+	rj := p.suite.Scalar().One()
+	for j, i := range indices {
+		b[i].Add(b[i], rj)
+		y.Add(y, p.suite.Scalar().Mul(rj, values[j]))
+		rj = p.suite.Scalar().Mul(rj, r)
+	}
+	return t, b, y, nil
+}
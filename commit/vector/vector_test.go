@@ -0,0 +1,83 @@
+package vector
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/innerproduct"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func values(n int) []kyber.Scalar {
+	v := make([]kyber.Scalar, n)
+	for i := range v {
+		v[i] = suite.Scalar().Pick(random.New())
+	}
+	return v
+}
+
+func TestOpen(t *testing.T) {
+	p, err := NewParams(suite, "test", 5)
+	require.Nil(t, err)
+	v := values(5)
+	C, err := p.Commit(v)
+	require.Nil(t, err)
+
+	for i := range v {
+		o, err := p.Open(v, i)
+		require.Nil(t, err)
+		require.Len(t, o.L, 3)
+		require.Nil(t, p.Verify(C, i, v[i], o))
+
+		require.Error(t, p.Verify(C, i, v[(i+1)%5], o))
+		require.Error(t, p.Verify(C, (i+1)%5, v[i], o))
+		require.Error(t, p.Verify(suite.Point().Add(C, p.G[0]), i, v[i], o))
+	}
+
+	// Padded positions hold zero.
+	o, err := p.Open(v, 7)
+	require.Nil(t, err)
+	require.Nil(t, p.Verify(C, 7, suite.Scalar().Zero(), o))
+
+	_, err = p.Open(v, 8)
+	require.Error(t, err)
+	_, err = p.Commit(values(9))
+	require.Error(t, err)
+	_, err = NewParams(suite, "test", 0)
+	require.Error(t, err)
+}
+
+func TestOpenBatch(t *testing.T) {
+	p, err := NewParams(suite, "test", 16)
+	require.Nil(t, err)
+	v := values(16)
+	C, err := p.Commit(v)
+	require.Nil(t, err)
+
+	indices := []int{3, 0, 11}
+	o, err := p.OpenBatch(v, indices)
+	require.Nil(t, err)
+	opened := []kyber.Scalar{v[3], v[0], v[11]}
+	require.Nil(t, p.VerifyBatch(C, indices, opened, o))
+
+	require.Error(t, p.VerifyBatch(C, indices, []kyber.Scalar{v[0], v[3], v[11]}, o))
+	require.Error(t, p.VerifyBatch(C, indices[:2], opened[:2], o))
+	require.Error(t, p.VerifyBatch(C, indices, opened[:2], o))
+	require.Error(t, p.Verify(C, 3, v[3], o))
+
+	// Openings are bound to the parameters.
+	q, err := NewParams(suite, "other", 16)
+	require.Nil(t, err)
+	require.Error(t, q.VerifyBatch(C, indices, opened, o))
+
+	// Openings have a binary encoding.
+	buff, err := o.MarshalBinary()
+	require.Nil(t, err)
+	decoded := new(innerproduct.Proof)
+	require.Nil(t, decoded.UnmarshalBinary(suite, buff))
+	require.Nil(t, p.VerifyBatch(C, indices, opened, decoded))
+}
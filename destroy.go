@@ -0,0 +1,25 @@
+package kyber
+
+// Destroyable is implemented by objects holding secret material, such as
+// private Scalars, key pairs, the shares of a distributed key and the states
+// of XOFs, so that long-running services can scrub it from memory when they
+// no longer need it, rather than wait for the garbage collector.
+//
+// Destroy overwrites the secret material in the memory the object refers to,
+// and leaves the object in an unusable state: it must not be used afterwards,
+// other than to be destroyed again. It is best effort, since the runtime may
+// have copied the memory before, and since the states of some ciphers of the
+// standard library cannot be overwritten, only released.
+type Destroyable interface {
+	Destroy()
+}
+
+// Destroy destroys every object of objs that is Destroyable, and ignores the
+// others, to scrub the secrets of structures made of kyber objects.
+func Destroy(objs ...interface{}) {
+	for _, obj := range objs {
+		if d, ok := obj.(Destroyable); ok {
+			d.Destroy()
+		}
+	}
+}
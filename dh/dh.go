@@ -0,0 +1,54 @@
+// Package dh implements Diffie-Hellman key agreement over any kyber.Group.
+//
+// Rather than using the raw shared point, SharedSecret and SharedKey derive
+// uniformly random keys from it with HKDF (RFC 5869) instantiated with the
+// suite's hash function.
+package dh
+
+import (
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/key"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Suite represents the set of functionalities needed by the package dh.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+}
+
+var errNullPoint = errors.New("dh: shared point is the identity")
+
+// SharedSecret computes the Diffie-Hellman shared point between the private
+// key priv and the public key pub, and derives from it a secret as long as
+// the output of the suite's hash function.
+func SharedSecret(suite Suite, priv kyber.Scalar, pub kyber.Point) ([]byte, error) {
+	return SharedKey(suite, priv, pub, nil, suite.Hash().Size())
+}
+
+// SharedKey computes the Diffie-Hellman shared point between the private key
+// priv and the public key pub, and derives from it a key of the given length
+// with HKDF, using info to bind the key to its context. An error is returned
+// if pub fails key.ValidatePublicKey, such as a point of small order or one
+// with a small-order component, or if the shared point is the identity.
+func SharedKey(suite Suite, priv kyber.Scalar, pub kyber.Point, info []byte, length int) ([]byte, error) {
+	if err := key.ValidatePublicKey(suite, pub); err != nil {
+		return nil, err
+	}
+	shared := suite.Point().Mul(priv, pub)
+	if shared.Equal(suite.Point().Null()) {
+		return nil, errNullPoint
+	}
+	ikm, err := shared.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(suite.Hash, ikm, nil, info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,74 @@
+package dh
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/key"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedSecret(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	alice := key.NewKeyPair(suite)
+	bob := key.NewKeyPair(suite)
+
+	s1, err := SharedSecret(suite, alice.Private, bob.Public)
+	require.NoError(t, err)
+	s2, err := SharedSecret(suite, bob.Private, alice.Public)
+	require.NoError(t, err)
+	assert.Equal(t, s1, s2)
+	assert.Len(t, s1, suite.Hash().Size())
+
+	eve := key.NewKeyPair(suite)
+	s3, err := SharedSecret(suite, eve.Private, alice.Public)
+	require.NoError(t, err)
+	assert.NotEqual(t, s1, s3)
+}
+
+func TestSharedKey(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	alice := key.NewKeyPair(suite)
+	bob := key.NewKeyPair(suite)
+
+	k1, err := SharedKey(suite, alice.Private, bob.Public, []byte("one"), 64)
+	require.NoError(t, err)
+	k2, err := SharedKey(suite, bob.Private, alice.Public, []byte("one"), 64)
+	require.NoError(t, err)
+	assert.Equal(t, k1, k2)
+	assert.Len(t, k1, 64)
+
+	// Different contexts yield independent keys.
+	k3, err := SharedKey(suite, alice.Private, bob.Public, []byte("two"), 64)
+	require.NoError(t, err)
+	assert.False(t, bytes.Equal(k1, k3))
+}
+
+func TestSmallOrderPublicKey(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	alice := key.NewKeyPair(suite)
+
+	_, err := SharedSecret(suite, alice.Private, suite.Point().Null())
+	assert.Error(t, err)
+}
+
+func TestTorsionedPublicKey(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	alice := key.NewKeyPair(suite)
+	bob := key.NewKeyPair(suite)
+
+	// A point of order 8 added to a valid key leaks the private key of
+	// Alice modulo 8 if she does not reject it.
+	T := suite.Point()
+	require.NoError(t, T.UnmarshalBinary([]byte{
+		0xc7, 0x17, 0x6a, 0x70, 0x3d, 0x4d, 0xd8, 0x4f, 0xba, 0x3c, 0x0b, 0x76,
+		0x0d, 0x10, 0x67, 0x0f, 0x2a, 0x20, 0x53, 0xfa, 0x2c, 0x39, 0xcc, 0xc6,
+		0x4e, 0xc7, 0xfd, 0x77, 0x92, 0xac, 0x03, 0x7a,
+	}))
+	_, err := SharedSecret(suite, alice.Private, T)
+	assert.Error(t, err)
+	_, err = SharedSecret(suite, alice.Private, suite.Point().Add(bob.Public, T))
+	assert.Error(t, err)
+}
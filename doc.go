@@ -42,20 +42,101 @@ rather than the multiplicative-group terminology of traditional
 integer groups - but the two are semantically equivalent and the
 interface itself works for both elliptic curve and integer groups.
 
+Timing Guarantees
+
+Only the 'group/edwards25519' sub-package is designed to handle secrets in
+constant time: its scalar arithmetic, the multiplication and addition of its
+points, their encoding with MarshalBinary, and Equal take time independent
+of the values, unless variable time is enabled with AllowsVarTime; its sums
+of many products, of kyber.MultiScalarMultiplier, are the exception, and
+only take public scalars, as in batch verifications. The other
+groups build their arithmetic on math/big, or branch on special points as
+'pairing/bls12381' does, and give no such guarantee, which is why most of
+them are only built with the "vartime" tag; their Equal methods compare
+values in constant time, but the values compared are computed in variable
+time. The points of the curves among them, in 'group/curve25519', 'group/nist'
+and 'group/secp256k1', implement kyber.BlindablePoint, an opt-in blinding
+of the scalar multiplication for those who cannot avoid running them on
+shared hardware. Whatever the group, kyber.ConstantTimeEqual compares, and
+kyber.ConstantTimeSelect and kyber.ConstantTimeSelectBytes select between,
+the encodings of Scalars and Points in constant time, in the manner of
+crypto/subtle.
+
 Higher-level Building Blocks
 
 Various sub-packages provide several specific
 implementations of these cryptographic interfaces.
 In particular, the 'group/mod' sub-package provides implementations
 of modular integer groups underlying conventional DSA-style algorithms.
-The `group/nist` package provides NIST-standardized elliptic curves built on
-the Go crypto library.
+The `group/nist` package provides the NIST-standardized elliptic curves P-256,
+P-384 and P-521 built on the Go crypto library.
 The 'group/edwards25519' sub-package provides the kyber.Group interface
-using the popular Ed25519 curve.
+using the popular Ed25519 curve, as well as the prime-order Ristretto255 group.
+The 'group/secp256k1' sub-package provides the curve used by Bitcoin and
+Ethereum.
+The 'pairing/bls12381' sub-package provides the groups G1, G2 and GT of the
+BLS12-381 pairing-friendly curve, together with the pairing between them.
+The points of these groups, except those of secp256k1, hash messages to the
+curve as specified by RFC 9380, through the kyber.HashablePoint interface,
+with the message expansion of the 'group/hash2curve' sub-package.
+Ed25519 points also implement kyber.Hiding with Elligator 2, so that public
+keys and Diffie-Hellman ephemerals can be sent as uniformly random strings.
 
 Other sub-packages build more interesting high-level cryptographic tools
 atop these primitive interfaces, including:
 
+- commit/accumulator: Dynamic bilinear accumulators, with membership and
+non-membership witnesses that holders update from the published changes.
+
+- commit/kzg: KZG polynomial commitments over a pairing suite, with single
+and batched openings, and the loading and checking of reference strings.
+
+- commit/merkle: Merkle trees of any arity over the hash of a suite, with
+inclusion and consistency proofs, and streaming construction.
+
+- commit/pedersen: Pedersen commitments with a hashed second generator,
+their opening, homomorphic operations, and the arithmetic of blinding factors.
+
+- commit/vector: Vector commitments, with openings of logarithmic size
+of single positions or of batches of them.
+
+- drand: Verification of the public randomness of drand beacons, given the
+information of their network, for applications that consume it without the
+drand client.
+
+- encrypt/camshoup: Camenisch-Shoup verifiable encryption of discrete
+logarithms, with which a trusted third party can later recover a key that a
+prover proved to have encrypted, for key escrow or fair exchange. (Requires
+build tag "vartime".)
+
+- encrypt/ecies: Hybrid public-key encryption of messages of any length, with
+pluggable key derivation and AEAD, and a streaming mode for large plaintexts.
+
+- encrypt/elgamal: ElGamal encryption of points, with the re-randomization and
+homomorphic operations on ciphertexts that shuffles and mixnets rely on, and
+verifiable threshold decryption under a shared key.
+
+- encrypt/hpke: Hybrid Public Key Encryption (RFC 9180) in all its modes,
+compatible with other implementations for its X25519 and P-256 KEMs.
+
+- encrypt/ibe: Boneh-Franklin identity-based encryption over a pairing suite,
+where any string, such as an email address or a round number, is a public
+key, and private keys are BLS signatures of identities.
+
+- encrypt/paillier: The additively homomorphic Paillier cryptosystem, with
+zero-knowledge proofs of well-formed keys and of correct encryption of values
+in a range. (Requires build tag "vartime".)
+
+- encrypt/pre: Unidirectional proxy re-encryption, with which a proxy holding a
+re-encryption key transforms data encrypted for a delegator into data for a
+delegatee, without seeing the plaintext.
+
+- encrypt/signcrypt: Zheng's signcryption, which signs and encrypts a message in
+a single pass, with evidence that lets a third party check its sender.
+
+- encrypt/tle: Timelock encryption of messages for a future round of a
+threshold BLS randomness beacon, which its signature of the round decrypts.
+
 - share: Polynomial commitment and verifiable Shamir secret splitting
 for implementing verifiable 't-of-n' threshold cryptographic schemes.
 This can be used to encrypt a message so that any 2 out of 3 receivers
@@ -71,26 +152,93 @@ without revealing anything about either secret
 or even which branch of the "or" clause is true.
 (Requires build tag "experimental".)
 
+- proof/bulletproof: Bulletproofs range proofs, single and aggregated,
+showing that Pedersen commitments hide values in [0, 2^n)
+without a trusted setup.
+
+- proof/innerproduct: The logarithmic-size inner product argument
+of Bulletproofs, for arguments on committed vectors.
+
+- proof/transcript: Domain-separated transcripts for the Fiat-Shamir
+transform, from which non-interactive proofs such as those of proof/dleq
+derive their challenges.
+
 - sign: The sign directory contains different signature schemes.
 
+- sign/adaptor provides Schnorr adaptor signatures, pre-signatures that become
+valid Schnorr signatures only with a secret that they then reveal, as used in
+atomic swaps.
+
 - sign/anon provides anonymous and pseudonymous public-key encryption and signing,
 where the sender of a signed message or the receiver of an encrypted message
 is defined as an explicit anonymity set containing several public keys
 rather than just one. For example, a member of an organization's board of trustees
 might prove to be a member of the board without revealing which member she is.
+One-out-of-many signatures, whose size is logarithmic in the size of the
+anonymity set, keep sets of thousands of keys practical, and threshold
+signatures prove that at least t distinct members signed.
+
+- sign/bbs provides BBS+ signatures of lists of messages, with zero-knowledge
+proofs that disclose only some of the signed messages, for anonymous
+credentials.
+
+- sign/bip340 provides the x-only Schnorr signatures of Bitcoin's Taproot
+upgrade over the secp256k1 curve. (Requires build tag "vartime".)
+
+- sign/blind provides blind and partially blind Schnorr signatures, with which
+a signer issues signatures on messages it does not see and cannot later link to
+their signing sessions, for example to issue anonymous tokens.
+
+- sign/blindrsa provides the RSA blind signatures of RFC 9474, which are
+standard RSASSA-PSS signatures. (Requires build tag "vartime".)
+
+- sign/bls provides Boneh-Lynn-Shacham signatures over a pairing suite, with
+the aggregation of signatures and public keys.
+
+- sign/tbls provides threshold BLS signatures, where any t out of n holders of
+shares of a private key can jointly produce a BLS signature.
 
 - sign/cosi provides collective signature algorithm, where a bunch of signers create a
 unique, compact and efficiently verifiable signature using the Schnorr signature as a basis.
 
+- sign/ecdsa provides ECDSA signatures with deterministic nonces (RFC 6979),
+encoded in ASN.1 DER for interoperability. (Requires build tag "vartime".)
+
 - sign/eddsa provides a kyber-native implementation of the EdDSA signature scheme.
 
+- sign/frost provides the FROST threshold Schnorr signing protocol, whose
+signatures are verified like sign/schnorr and Ed25519 signatures.
+
+- sign/groupsig provides the short group signatures of Boneh, Boyen and
+Shacham, with which members sign anonymously on behalf of a group while an
+opener can trace signatures back to their signers.
+
+- sign/musig2 provides the MuSig2 multisignature protocol, with which n signers
+jointly produce a single Schnorr signature under their aggregate public key.
+
 - sign/schnorr provides a basic vanilla Schnorr signature scheme implementation.
 
+- sign/tecdsa provides threshold ECDSA signing with identifiable aborts
+(GG20), producing standard ECDSA signatures. (Requires build tag "vartime".)
+
 - shuffle: Verifiable cryptographic shuffles of ElGamal ciphertexts,
 which can be used to implement (for example) voting or auction schemes
 that keep the sources of individual votes or bids private
 without anyone having to trust more than one of the shuffler(s) to shuffle
-votes/bids honestly. (Requires build tag "experimental".)
+votes/bids honestly, and the verifiable decryption of their outputs, under
+a single or a shared key. (Requires build tag "experimental".)
+
+- util/secmem: Memory for private keys and shares outside of the Go heap,
+locked into RAM, excluded from core dumps and enclosed in guard pages, which
+key generation uses once enabled. (Linux only.)
+
+- vdf: Wesolowski's verifiable delay function in an RSA group, whose output
+takes a long sequential computation and is checked fast, to impose delays on
+protocols such as randomness beacons. (Requires build tag "vartime".)
+
+- vrf: Verifiable random functions over Ed25519 (RFC 9381), whose outputs
+can be checked by anyone holding the public key, for example to elect a
+leader or draw a committee.
 
 Disclaimer
 
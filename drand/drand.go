@@ -0,0 +1,308 @@
+// Package drand verifies the randomness published by drand beacons, the
+// threshold BLS randomness beacons run by the League of Entropy, without the
+// drand client.
+//
+// A drand network is described by its Info, which gives its public key, a
+// point of the pairing suite, the period and genesis time of its rounds, and
+// its scheme. In every round, the network publishes a Beacon: the threshold
+// BLS signature of the message of the round under the public key, whose
+// SHA-256 hash is the randomness of the round. The message is the SHA-256
+// hash of the round number, preceded by the signature of the previous round
+// in chained schemes.
+//
+// drand hashes messages to curve points as specified by RFC 9380, as do
+// the groups that implement kyber.HashablePoint, such as those of package
+// pairing/bls12381, so that the beacons of drand networks verify with them.
+// Verify fails with suites whose groups do not, as no beacon of a drand
+// network would verify with them.
+package drand
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+)
+
+// Scheme describes how the beacons of a drand network are signed.
+type Scheme struct {
+	// ID of the scheme in the information of drand networks.
+	ID string
+	// SigsOnG1 is true if signatures are points of G1 and public keys
+	// points of G2, and false for the converse.
+	SigsOnG1 bool
+	// Chained is true if the message of a round includes the signature of
+	// the previous round.
+	Chained bool
+	// DST is the domain separation tag of the hash of messages to curve
+	// points.
+	DST []byte
+}
+
+// The schemes of drand networks. PedersenBLSChained is the default scheme,
+// of the original League of Entropy network.
+var (
+	PedersenBLSChained = &Scheme{
+		ID:      "pedersen-bls-chained",
+		Chained: true,
+		DST:     []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_"),
+	}
+	PedersenBLSUnchained = &Scheme{
+		ID:  "pedersen-bls-unchained",
+		DST: []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_"),
+	}
+	BLSUnchainedG1 = &Scheme{
+		ID:       "bls-unchained-g1-rfc9380",
+		SigsOnG1: true,
+		DST:      []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_"),
+	}
+)
+
+var schemes = []*Scheme{PedersenBLSChained, PedersenBLSUnchained, BLSUnchainedG1}
+
+var errInvalidSignature = errors.New("drand: invalid signature")
+
+var errNotHashable = errors.New("drand: signature group does not implement hash_to_curve")
+
+// SchemeByID returns the scheme of the given ID, or an error if it is
+// unknown.
+func SchemeByID(id string) (*Scheme, error) {
+	for _, s := range schemes {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, errors.New("drand: unknown scheme " + id)
+}
+
+// keyGroup returns the group of the public keys of the scheme.
+func (s *Scheme) keyGroup(suite pairing.Suite) kyber.Group {
+	if s.SigsOnG1 {
+		return suite.G2()
+	}
+	return suite.G1()
+}
+
+// sigGroup returns the group of the signatures of the scheme.
+func (s *Scheme) sigGroup(suite pairing.Suite) kyber.Group {
+	if s.SigsOnG1 {
+		return suite.G1()
+	}
+	return suite.G2()
+}
+
+// Message returns the message signed in the given round: the SHA-256 hash
+// of prev, the signature of the previous round, followed by the round
+// number in big-endian order. prev is ignored by unchained schemes.
+func (s *Scheme) Message(round uint64, prev []byte) []byte {
+	h := sha256.New()
+	if s.Chained {
+		h.Write(prev)
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], round)
+	h.Write(b[:])
+	return h.Sum(nil)
+}
+
+// Info holds the information of a drand network, which its nodes serve at
+// the /info endpoint.
+type Info struct {
+	// PublicKey is the distributed public key of the network.
+	PublicKey kyber.Point
+	// Period is the time between two rounds.
+	Period time.Duration
+	// GenesisTime is the Unix time of the first round.
+	GenesisTime int64
+	// GroupHash identifies the members of the network.
+	GroupHash []byte
+	Scheme    *Scheme
+	// BeaconID tells apart the networks run by the same nodes.
+	BeaconID string
+}
+
+type jsonInfo struct {
+	PublicKey   string `json:"public_key"`
+	Period      int64  `json:"period"`
+	GenesisTime int64  `json:"genesis_time"`
+	Hash        string `json:"hash"`
+	GroupHash   string `json:"groupHash"`
+	SchemeID    string `json:"schemeID"`
+	Metadata    struct {
+		BeaconID string `json:"beaconID"`
+	} `json:"metadata"`
+}
+
+// ParseInfo parses the information of a drand network in the JSON format of
+// drand. It returns an error if the public key is invalid, or if the hash of
+// the information does not match the one it includes.
+func ParseInfo(suite pairing.Suite, data []byte) (*Info, error) {
+	var j jsonInfo
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	info := &Info{
+		Period:      time.Duration(j.Period) * time.Second,
+		GenesisTime: j.GenesisTime,
+		BeaconID:    j.Metadata.BeaconID,
+		Scheme:      PedersenBLSChained,
+	}
+	if info.Period <= 0 {
+		return nil, errors.New("drand: invalid period")
+	}
+	var err error
+	if j.SchemeID != "" {
+		if info.Scheme, err = SchemeByID(j.SchemeID); err != nil {
+			return nil, err
+		}
+	}
+	if info.GroupHash, err = hex.DecodeString(j.GroupHash); err != nil {
+		return nil, err
+	}
+	buf, err := hex.DecodeString(j.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	g := info.Scheme.keyGroup(suite)
+	info.PublicKey = g.Point()
+	if err := info.PublicKey.UnmarshalBinary(buf); err != nil {
+		return nil, err
+	}
+	if info.PublicKey.Equal(g.Point().Null()) {
+		return nil, errors.New("drand: public key is the identity")
+	}
+	if j.Hash != "" {
+		hash, err := hex.DecodeString(j.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(hash, info.Hash()) {
+			return nil, errors.New("drand: wrong hash of information")
+		}
+	}
+	return info, nil
+}
+
+// Hash returns the hash of the information, which identifies the network.
+func (i *Info) Hash() []byte {
+	h := sha256.New()
+	var b [12]byte
+	binary.BigEndian.PutUint32(b[:4], uint32(i.Period/time.Second))
+	binary.BigEndian.PutUint64(b[4:], uint64(i.GenesisTime))
+	h.Write(b[:])
+	buf, _ := i.PublicKey.MarshalBinary()
+	h.Write(buf)
+	h.Write(i.GroupHash)
+	if i.Scheme != PedersenBLSChained {
+		h.Write([]byte(i.Scheme.ID))
+	}
+	if i.BeaconID != "" && i.BeaconID != "default" {
+		h.Write([]byte(i.BeaconID))
+	}
+	return h.Sum(nil)
+}
+
+// RoundAt returns the latest round published at time t, or 0 before the
+// genesis time.
+func (i *Info) RoundAt(t time.Time) uint64 {
+	if t.Unix() < i.GenesisTime {
+		return 0
+	}
+	return uint64((t.Unix()-i.GenesisTime)/int64(i.Period/time.Second)) + 1
+}
+
+// RoundTime returns the time at which the given round is published.
+func (i *Info) RoundTime(round uint64) time.Time {
+	if round == 0 {
+		return time.Unix(i.GenesisTime, 0)
+	}
+	return time.Unix(i.GenesisTime+int64(round-1)*int64(i.Period/time.Second), 0)
+}
+
+// Verify checks that b is a beacon of the network. PreviousSignature is only
+// needed by chained schemes.
+func (i *Info) Verify(suite pairing.Suite, b *Beacon) error {
+	s := i.Scheme
+	sigGroup := s.sigGroup(suite)
+	sig := sigGroup.Point()
+	if _, ok := sig.(kyber.HashablePoint); !ok {
+		return errNotHashable
+	}
+	if err := sig.UnmarshalBinary(b.Signature); err != nil {
+		return err
+	}
+	if sig.Equal(sigGroup.Point().Null()) {
+		return errInvalidSignature
+	}
+	HM := pairing.HashToPoint(suite, sigGroup, s.DST, s.Message(b.Round, b.PreviousSignature))
+	base := s.keyGroup(suite).Point().Base()
+	// e(sig, base) = e(H(m), X), with the arguments of the pairing swapped
+	// when signatures are points of G2
+	var ok bool
+	if s.SigsOnG1 {
+		ok = suite.PairingCheck([]kyber.Point{sig.Neg(sig), HM}, []kyber.Point{base, i.PublicKey})
+	} else {
+		ok = suite.PairingCheck([]kyber.Point{base.Neg(base), i.PublicKey}, []kyber.Point{sig, HM})
+	}
+	if !ok {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// Beacon is the output of a drand network in a round, which its nodes serve
+// at the /public endpoints.
+type Beacon struct {
+	Round     uint64
+	Signature []byte
+	// PreviousSignature is the signature of the previous round, in chained
+	// schemes.
+	PreviousSignature []byte
+}
+
+type jsonBeacon struct {
+	Round             uint64 `json:"round"`
+	Randomness        string `json:"randomness"`
+	Signature         string `json:"signature"`
+	PreviousSignature string `json:"previous_signature"`
+}
+
+// ParseBeacon parses a beacon in the JSON format of drand. It returns an
+// error if the randomness it includes does not match its signature.
+func ParseBeacon(data []byte) (*Beacon, error) {
+	var j jsonBeacon
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	b := &Beacon{Round: j.Round}
+	var err error
+	if b.Signature, err = hex.DecodeString(j.Signature); err != nil {
+		return nil, err
+	}
+	if b.PreviousSignature, err = hex.DecodeString(j.PreviousSignature); err != nil {
+		return nil, err
+	}
+	if j.Randomness != "" {
+		r, err := hex.DecodeString(j.Randomness)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(r, b.Randomness()) {
+			return nil, errors.New("drand: randomness does not match signature")
+		}
+	}
+	return b, nil
+}
+
+// Randomness returns the randomness of the beacon, the SHA-256 hash of its
+// signature. It can only be trusted once the beacon has been verified.
+func (b *Beacon) Randomness() []byte {
+	h := sha256.Sum256(b.Signature)
+	return h[:]
+}
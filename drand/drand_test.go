@@ -0,0 +1,182 @@
+// +build vartime
+
+package drand
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/share"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+type groupSuite struct {
+	kyber.Group
+	pairing.Suite
+}
+
+// mainnet is the information of the League of Entropy mainnet.
+const mainnet = `{
+	"public_key": "868f005eb8e6e4ca0a47c8a77ceaa5309a47978a7c71bc5cce96366b5d7a569937c529eeda66c7293784a9402801af31",
+	"period": 30,
+	"genesis_time": 1595431050,
+	"hash": "8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce",
+	"groupHash": "176f93498eac9ca337150b46d21dd58673ea4e3581185f869672e59fa4cb390a",
+	"schemeID": "pedersen-bls-chained",
+	"metadata": {"beaconID": "default"}
+}`
+
+func TestParseInfo(t *testing.T) {
+	info, err := ParseInfo(suite, []byte(mainnet))
+	require.NoError(t, err)
+	require.Equal(t, PedersenBLSChained, info.Scheme)
+	require.Equal(t, 30*time.Second, info.Period)
+	require.Equal(t, "8990e7a9aaed2ffed73dbd7092123d6f289930540d7651336225dc172e51b2ce", hex.EncodeToString(info.Hash()))
+
+	require.Equal(t, uint64(0), info.RoundAt(time.Unix(1595431049, 0)))
+	require.Equal(t, uint64(1), info.RoundAt(time.Unix(1595431050, 0)))
+	require.Equal(t, uint64(1), info.RoundAt(time.Unix(1595431079, 0)))
+	require.Equal(t, uint64(2), info.RoundAt(time.Unix(1595431080, 0)))
+	require.Equal(t, time.Unix(1595431080, 0), info.RoundTime(2))
+	require.Equal(t, uint64(1000), info.RoundAt(info.RoundTime(1000)))
+
+	_, err = ParseInfo(suite, []byte(mainnet[:len(mainnet)-1]))
+	require.Error(t, err)
+	for old, new := range map[string]string{
+		`"period": 30`:                       `"period": 3`,
+		`"schemeID": "pedersen-bls-chained"`: `"schemeID": "other"`,
+		`"public_key": "868f`:                `"public_key": "968f`,
+		`"hash": "8990`:                      `"hash": "9990`,
+	} {
+		data := []byte(strings.Replace(mainnet, old, new, 1))
+		_, err = ParseInfo(suite, data)
+		require.Error(t, err, new)
+	}
+}
+
+// mainnetRound1 is the first beacon of the League of Entropy mainnet, whose
+// previous signature is the group hash of the network.
+const mainnetRound1 = `{
+	"round": 1,
+	"randomness": "101297f1ca7dc44ef6088d94ad5fb7ba03455dc33d53ddb412bbc4564ed986ec",
+	"signature": "8d61d9100567de44682506aea1a7a6fa6e5491cd27a0a0ed349ef6910ac5ac20ff7bc3e09d7c046566c9f7f3c6f3b10104990e7cb424998203d8f7de586fb7fa5f60045417a432684f85093b06ca91c769f0e7ca19268375e659c2a2352b4655",
+	"previous_signature": "176f93498eac9ca337150b46d21dd58673ea4e3581185f869672e59fa4cb390a"
+}`
+
+func TestVerifyMainnet(t *testing.T) {
+	info, err := ParseInfo(suite, []byte(mainnet))
+	require.NoError(t, err)
+	b, err := ParseBeacon([]byte(mainnetRound1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), b.Round)
+	require.NoError(t, info.Verify(suite, b))
+
+	wrong := *b
+	wrong.Round = 2
+	require.Error(t, info.Verify(suite, &wrong))
+	wrong = *b
+	wrong.PreviousSignature = b.Signature
+	require.Error(t, info.Verify(suite, &wrong))
+}
+
+func TestVerify(t *testing.T) {
+	for _, scheme := range schemes {
+		// A network of n members, any th of which sign the rounds.
+		n, th := 5, 3
+		keyGroup, sigGroup := scheme.keyGroup(suite), scheme.sigGroup(suite)
+		priPoly := share.NewPriPoly(&groupSuite{keyGroup, suite}, th, nil)
+		info := &Info{
+			PublicKey:   priPoly.Commit(nil).Commit(),
+			Period:      3 * time.Second,
+			GenesisTime: time.Now().Unix(),
+			GroupHash:   []byte("group"),
+			Scheme:      scheme,
+		}
+		sign := func(round uint64, prev []byte) *Beacon {
+			HM := pairing.HashToPoint(suite, sigGroup, scheme.DST, scheme.Message(round, prev))
+			var sigs []*share.PubShare
+			for _, s := range priPoly.Shares(n)[1 : th+1] {
+				sigs = append(sigs, &share.PubShare{I: s.I, V: sigGroup.Point().Mul(s.V, HM)})
+			}
+			sig, err := share.RecoverCommit(sigGroup, sigs, th, n)
+			require.NoError(t, err)
+			buf, err := sig.MarshalBinary()
+			require.NoError(t, err)
+			return &Beacon{Round: round, Signature: buf, PreviousSignature: prev}
+		}
+
+		b1 := sign(1, []byte("genesis seed"))
+		require.NoError(t, info.Verify(suite, b1), scheme.ID)
+		b2 := sign(2, b1.Signature)
+		require.NoError(t, info.Verify(suite, b2), scheme.ID)
+
+		data := fmt.Sprintf(`{"round": 2, "randomness": "%x", "signature": "%x", "previous_signature": "%x"}`,
+			b2.Randomness(), b2.Signature, b2.PreviousSignature)
+		parsed, err := ParseBeacon([]byte(data))
+		require.NoError(t, err, scheme.ID)
+		require.Equal(t, b2, parsed, scheme.ID)
+		_, err = ParseBeacon([]byte(strings.Replace(data, `"randomness": "`, `"randomness": "00`, 1)))
+		require.Error(t, err, scheme.ID)
+
+		// Rounds and previous signatures are signed, in chained schemes.
+		wrong := *b2
+		wrong.Round = 3
+		require.Error(t, info.Verify(suite, &wrong), scheme.ID)
+		wrong = *b2
+		wrong.PreviousSignature = b2.Signature
+		if scheme.Chained {
+			require.Error(t, info.Verify(suite, &wrong), scheme.ID)
+		} else {
+			require.NoError(t, info.Verify(suite, &wrong), scheme.ID)
+		}
+		wrong = *b2
+		wrong.Signature = b1.Signature
+		require.Error(t, info.Verify(suite, &wrong), scheme.ID)
+		wrong.Signature = wrong.Signature[1:]
+		require.Error(t, info.Verify(suite, &wrong), scheme.ID)
+
+		// Information round trips through JSON, and its hash covers the
+		// beacon ID.
+		pub, err := info.PublicKey.MarshalBinary()
+		require.NoError(t, err)
+		hash := info.Hash()
+		info.BeaconID = "test"
+		jsonInfo := func(hash []byte) []byte {
+			return []byte(fmt.Sprintf(`{"public_key": "%x", "period": 3, "genesis_time": %d, "hash": "%x", "groupHash": "%x", "schemeID": "%s", "metadata": {"beaconID": "test"}}`,
+				pub, info.GenesisTime, hash, info.GroupHash, scheme.ID))
+		}
+		_, err = ParseInfo(suite, jsonInfo(hash))
+		require.Error(t, err, scheme.ID)
+		parsedInfo, err := ParseInfo(suite, jsonInfo(info.Hash()))
+		require.NoError(t, err, scheme.ID)
+		require.Equal(t, info.Hash(), parsedInfo.Hash(), scheme.ID)
+		require.NoError(t, parsedInfo.Verify(suite, b2), scheme.ID)
+	}
+}
+
+// plainSuite hides the hash_to_curve of the points of its groups.
+type plainSuite struct{ pairing.Suite }
+
+func (s plainSuite) G1() kyber.Group { return plainGroup{s.Suite.G1()} }
+func (s plainSuite) G2() kyber.Group { return plainGroup{s.Suite.G2()} }
+
+type plainGroup struct{ kyber.Group }
+
+func (g plainGroup) Point() kyber.Point { return plainPoint{g.Group.Point()} }
+
+type plainPoint struct{ kyber.Point }
+
+func TestVerifyNotHashable(t *testing.T) {
+	info, err := ParseInfo(suite, []byte(mainnet))
+	require.NoError(t, err)
+	b := &Beacon{Round: 1, Signature: make([]byte, 96)}
+	require.Equal(t, errNotHashable, info.Verify(plainSuite{suite}, b))
+}
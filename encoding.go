@@ -4,11 +4,19 @@ import (
 	"crypto/cipher"
 	"encoding"
 	"io"
+	"sync/atomic"
 )
 
 /*
 Marshaling is a basic interface representing fixed-length (or known-length)
 cryptographic objects or structures having a built-in binary encoding.
+
+The UnmarshalBinary methods of the points and scalars of kyber only accept
+the canonical encoding of each object, the one MarshalBinary returns, so
+that encodings are not malleable: they reject inputs of the wrong length,
+scalars not reduced modulo the group order, and points whose coordinates
+are not reduced or whose sign bit is set for a zero coordinate. See
+AllowNonCanonical for the legacy mode accepting such encodings.
 */
 type Marshaling interface {
 	encoding.BinaryMarshaler
@@ -29,6 +37,23 @@ type Marshaling interface {
 	UnmarshalFrom(r io.Reader) (int, error)
 }
 
+var nonCanonical atomic.Bool
+
+// AllowNonCanonical sets whether UnmarshalBinary accepts the non-canonical
+// encodings of points and scalars that earlier versions of kyber accepted,
+// which decode to the same object as the canonical one. This legacy mode
+// is off by default. It is meant for migrating stored data, and affects
+// every group of the process.
+func AllowNonCanonical(allow bool) {
+	nonCanonical.Store(allow)
+}
+
+// NonCanonicalAllowed reports whether UnmarshalBinary accepts non-canonical
+// encodings, as set by AllowNonCanonical.
+func NonCanonicalAllowed() bool {
+	return nonCanonical.Load()
+}
+
 /*
 Hiding is an alternative encoding interface to encode cryptographic objects
 such that their representation appears indistinguishable from a
@@ -64,7 +89,9 @@ type Hiding interface {
 	// source of random bits. Encoding may consistently fail on
 	// some curve points, in which case this method returns nil,
 	// and the caller must try again after re-randomizing the
-	// object.
+	// object. With encodings that randomize the point itself, such
+	// as that of edwards25519, success also depends on the random
+	// bits, so the caller must keep the first encoding it gets.
 	HideEncode(rand cipher.Stream) []byte
 
 	// Decode a uniform representation of this object from a
@@ -0,0 +1,222 @@
+// +build vartime
+
+// Package camshoup implements the verifiable encryption of discrete
+// logarithms of Camenisch and Shoup, "Practical verifiable encryption and
+// decryption of discrete logarithms" (https://eprint.iacr.org/2002/161).
+//
+// A prover encrypts the discrete logarithm x of a public point X = x*G of
+// any kyber group under the public key of a trusted third party, and
+// proves to a verifier that the ciphertext decrypts to x, without revealing
+// it. The third party can later recover x, for example to escrow a key or
+// to settle a fair exchange. The ciphertexts are secure against chosen
+// ciphertext attacks, and bound to a label, such as the conditions under
+// which the third party is allowed to decrypt them.
+//
+// As in the paper, the proof relies on ring-Pedersen commitment parameters
+// whose factorization the prover does not know, typically created by the
+// verifier with package encrypt/paillier and checked by the prover. The
+// challenge of the proof is derived from a transcript of package
+// proof/transcript. The hash H(U, E, L) of the encryption itself is not a
+// proof challenge and does not use one, so that encryption and decryption
+// need no suite.
+//
+// The package uses math/big and is not constant time, so it must be
+// compiled with the "vartime" compilation flag.
+package camshoup
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/dedis/kyber/internal/bigint"
+	"github.com/dedis/kyber/util/random"
+)
+
+var (
+	one = big.NewInt(1)
+	two = big.NewInt(2)
+)
+
+var errInvalidCiphertext = errors.New("camshoup: invalid ciphertext")
+
+// PublicKey is a Camenisch-Shoup public key: a modulus N, the product of
+// two safe primes, and elements G, Y1 = G^X1, Y2 = G^X2 and Y3 = G^X3 of
+// the squares of Z*_{N^2}.
+type PublicKey struct {
+	N, G, Y1, Y2, Y3 *big.Int
+}
+
+// PrivateKey is a Camenisch-Shoup private key.
+type PrivateKey struct {
+	PublicKey
+	X1, X2, X3 *big.Int
+}
+
+// Ciphertext is the encryption (U, E, V) of a value m with label L and
+// randomness r: U = G^r, E = Y1^r * (1+N)^m and V = |(Y2 * Y3^H(U, E, L))^r|,
+// all modulo N^2.
+type Ciphertext struct {
+	U, E, V *big.Int
+}
+
+// GenerateKey returns a private key whose modulus has the given number of
+// bits, at least 1024.
+func GenerateKey(bits int, rand cipher.Stream) (*PrivateKey, error) {
+	if bits < 1024 {
+		return nil, errors.New("camshoup: modulus too short")
+	}
+	r := &streamReader{rand}
+	var n *big.Int
+	for {
+		p, err := safePrime(r, bits/2)
+		if err != nil {
+			return nil, err
+		}
+		q, err := safePrime(r, bits-bits/2)
+		if err != nil {
+			return nil, err
+		}
+		if n = new(big.Int).Mul(p, q); p.Cmp(q) != 0 && n.BitLen() == bits {
+			break
+		}
+	}
+	pk := PublicKey{N: n}
+	n2 := pk.n2()
+	// With overwhelming probability, G = g'^2N generates the group of the
+	// 2N-th powers, of order (p-1)(q-1)/4.
+	pk.G = new(big.Int).Exp(bigint.RandomUnit(n2, rand), new(big.Int).Lsh(n, 1), n2)
+	max := new(big.Int).Rsh(n2, 2)
+	sk := &PrivateKey{
+		X1: random.Int(max, rand),
+		X2: random.Int(max, rand),
+		X3: random.Int(max, rand),
+	}
+	pk.Y1 = new(big.Int).Exp(pk.G, sk.X1, n2)
+	pk.Y2 = new(big.Int).Exp(pk.G, sk.X2, n2)
+	pk.Y3 = new(big.Int).Exp(pk.G, sk.X3, n2)
+	sk.PublicKey = pk
+	return sk, nil
+}
+
+// safePrime returns a random prime p of the given size such that (p-1)/2
+// is also prime.
+func safePrime(r *streamReader, bits int) (*big.Int, error) {
+	for {
+		q, err := rand.Prime(r, bits-1)
+		if err != nil {
+			return nil, err
+		}
+		p := new(big.Int).Lsh(q, 1)
+		p.Add(p, one)
+		if p.BitLen() == bits && p.ProbablyPrime(20) {
+			return p, nil
+		}
+	}
+}
+
+// streamReader reads the key stream of a cipher.Stream.
+type streamReader struct {
+	cipher.Stream
+}
+
+func (r *streamReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 0
+	}
+	r.XORKeyStream(b, b)
+	return len(b), nil
+}
+
+// n2 returns N^2, the modulus of the ciphertexts.
+func (pk *PublicKey) n2() *big.Int {
+	return new(big.Int).Mul(pk.N, pk.N)
+}
+
+// Encrypt returns the encryption of m, taken modulo N, with the given
+// label.
+func (pk *PublicKey) Encrypt(m *big.Int, label []byte, rand cipher.Stream) *Ciphertext {
+	r := random.Int(new(big.Int).Rsh(pk.N, 2), rand)
+	return pk.encrypt(m, r, label)
+}
+
+func (pk *PublicKey) encrypt(m, r *big.Int, label []byte) *Ciphertext {
+	n2 := pk.n2()
+	c := &Ciphertext{U: new(big.Int).Exp(pk.G, r, n2)}
+	c.E = new(big.Int).Exp(pk.Y1, r, n2)
+	c.E.Mul(c.E, pk.h(m))
+	c.E.Mod(c.E, n2)
+	c.V = new(big.Int).Exp(pk.w(c, label), r, n2)
+	c.V = abs(c.V, n2)
+	return c
+}
+
+// hash returns H(U, E, L), hashing the public key and its inputs with
+// SHA-512, each prefixed with its length.
+func (pk *PublicKey) hash(c *Ciphertext, label []byte) *big.Int {
+	h := sha512.New()
+	values := [][]byte{[]byte("camshoup hash"), pk.N.Bytes(), pk.G.Bytes(),
+		pk.Y1.Bytes(), pk.Y2.Bytes(), pk.Y3.Bytes(), c.U.Bytes(), c.E.Bytes(), label}
+	for _, b := range values {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+		h.Write(l[:])
+		h.Write(b)
+	}
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
+
+// w returns Y2 * Y3^H(U, E, L) mod N^2.
+func (pk *PublicKey) w(c *Ciphertext, label []byte) *big.Int {
+	n2 := pk.n2()
+	w := new(big.Int).Exp(pk.Y3, pk.hash(c, label), n2)
+	w.Mul(w, pk.Y2)
+	return w.Mod(w, n2)
+}
+
+// valid reports whether the components of c are units modulo N^2, with
+// V = |V|.
+func (pk *PublicKey) valid(c *Ciphertext) bool {
+	n2 := pk.n2()
+	return c != nil && bigint.IsUnit(c.U, n2) && bigint.IsUnit(c.E, n2) && bigint.IsUnit(c.V, n2) &&
+		abs(c.V, n2).Cmp(c.V) == 0
+}
+
+// Decrypt returns the plaintext of c with the given label, in [0, N). It
+// returns an error if c is not a valid ciphertext for the label.
+func (sk *PrivateKey) Decrypt(c *Ciphertext, label []byte) (*big.Int, error) {
+	if !sk.valid(c) {
+		return nil, errInvalidCiphertext
+	}
+	n2 := sk.n2()
+	// U^2(X2 + H*X3) = V^2
+	x := new(big.Int).Mul(sk.hash(c, label), sk.X3)
+	x.Add(x, sk.X2)
+	x.Lsh(x, 1)
+	if new(big.Int).Exp(c.U, x, n2).Cmp(new(big.Int).Exp(c.V, two, n2)) != 0 {
+		return nil, errInvalidCiphertext
+	}
+	// (E / U^X1)^2t = 1 + mN mod N^2, with t = 1/2 mod N
+	m := new(big.Int).Exp(c.U, sk.X1, n2)
+	m.ModInverse(m, n2)
+	m.Mul(m, c.E)
+	m.Mod(m, n2)
+	t := new(big.Int).ModInverse(two, sk.N)
+	m.Exp(m, t.Lsh(t, 1), n2)
+	if new(big.Int).Mod(m, sk.N).Cmp(one) != 0 {
+		return nil, errInvalidCiphertext
+	}
+	m.Sub(m, one)
+	return m.Div(m, sk.N), nil
+}
+
+// abs returns x if x <= n/2 and n-x otherwise.
+func abs(x, n *big.Int) *big.Int {
+	if x.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		return new(big.Int).Sub(n, x)
+	}
+	return x
+}
@@ -0,0 +1,101 @@
+// +build vartime
+
+package camshoup
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/dedis/kyber/encrypt/paillier"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/secp256k1"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	setupOnce sync.Once
+	testKey   *PrivateKey
+	testPed   *paillier.PedersenParams
+)
+
+// testSetup returns a key of the third party and the ring-Pedersen
+// parameters of a verifier, generated once since they are slow to create.
+func testSetup(t *testing.T) (*PrivateKey, *paillier.PedersenParams) {
+	setupOnce.Do(func() {
+		rand := random.New()
+		sk, err := GenerateKey(1024, rand)
+		if err != nil {
+			panic(err)
+		}
+		testKey = sk
+		psk, err := paillier.GenerateKey(1024, rand)
+		if err != nil {
+			panic(err)
+		}
+		testPed, _ = paillier.NewPedersenParams(transcript.New(edwards25519.NewBlakeSHA256Ed25519(), "setup"), psk, rand)
+	})
+	return testKey, testPed
+}
+
+func TestEncrypt(t *testing.T) {
+	sk, _ := testSetup(t)
+	rand := random.New()
+	m := big.NewInt(42)
+	c := sk.Encrypt(m, []byte("label"), rand)
+	got, err := sk.Decrypt(c, []byte("label"))
+	require.NoError(t, err)
+	require.Equal(t, 0, m.Cmp(got))
+
+	// The ciphertext is bound to its label.
+	_, err = sk.Decrypt(c, []byte("other"))
+	require.Error(t, err)
+
+	// Modified ciphertexts are rejected.
+	n2 := sk.n2()
+	bad := *c
+	bad.E = sk.h(one)
+	bad.E.Mul(bad.E, c.E).Mod(bad.E, n2)
+	_, err = sk.Decrypt(&bad, []byte("label"))
+	require.Error(t, err)
+	bad = *c
+	bad.V = new(big.Int).Sub(n2, c.V)
+	_, err = sk.Decrypt(&bad, []byte("label"))
+	require.Error(t, err)
+}
+
+func TestVerifiableEncrypt(t *testing.T) {
+	sk, ped := testSetup(t)
+	rand := random.New()
+	label := []byte("escrow")
+	for _, g := range []Suite{
+		edwards25519.NewBlakeSHA256Ed25519(),
+		secp256k1.NewBlakeSHA256Secp256k1(),
+	} {
+		x := g.Scalar().Pick(rand)
+		X := g.Point().Mul(x, nil)
+		c, p, err := VerifiableEncrypt(g, &sk.PublicKey, ped, x, label, rand)
+		require.NoError(t, err)
+		require.NoError(t, p.Verify(g, &sk.PublicKey, ped, c, X, label))
+
+		got, err := sk.DecryptScalar(g, c, label)
+		require.NoError(t, err)
+		require.True(t, x.Equal(got))
+
+		require.Error(t, p.Verify(g, &sk.PublicKey, ped, c, g.Point().Pick(rand), label))
+		require.Error(t, p.Verify(g, &sk.PublicKey, ped, c, X, []byte("other")))
+		other := sk.Encrypt(big.NewInt(1), label, rand)
+		require.Error(t, p.Verify(g, &sk.PublicKey, ped, other, X, label))
+		bad := *p
+		bad.Zm = new(big.Int).Add(p.Zm, one)
+		require.Error(t, bad.Verify(g, &sk.PublicKey, ped, c, X, label))
+	}
+
+	// The modulus must be large enough for the group.
+	small := &PublicKey{N: big.NewInt(1 << 40)}
+	g := edwards25519.NewBlakeSHA256Ed25519()
+	_, _, err := VerifiableEncrypt(g, small, ped, g.Scalar().One(), label, rand)
+	require.Error(t, err)
+}
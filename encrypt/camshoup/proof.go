@@ -0,0 +1,262 @@
+// +build vartime
+
+package camshoup
+
+import (
+	"crypto/cipher"
+	"errors"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/encrypt/paillier"
+	"github.com/dedis/kyber/internal/bigint"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/util/random"
+)
+
+var errInvalidProof = errors.New("camshoup: invalid proof")
+
+// Suite represents the set of functionalities needed by the proofs of the
+// package camshoup: the group of the discrete logarithms, and the XOF of
+// the transcripts of the proofs.
+type Suite interface {
+	kyber.Group
+	kyber.XOFFactory
+}
+
+// Proof proves that a ciphertext decrypts to the discrete logarithm of a
+// point. It holds a ring-Pedersen commitment C to the plaintext, the
+// commitments U, E, V, X and D of the prover, and its responses. It is made
+// non interactive with a transcript of package proof/transcript, and bound
+// to the label of the ciphertext.
+type Proof struct {
+	C          *big.Int
+	U, E, V    *big.Int
+	X          kyber.Point
+	D          *big.Int
+	Zr, Zm, Zs *big.Int
+}
+
+// VerifiableEncrypt encrypts the scalar x of the group of g with the given
+// label, and proves that the ciphertext decrypts to the discrete logarithm
+// of x*G. The proof is made for a verifier that trusts the ring-Pedersen
+// parameters ped.
+func VerifiableEncrypt(g Suite, pk *PublicKey, ped *paillier.PedersenParams, x kyber.Scalar, label []byte, rand cipher.Stream) (*Ciphertext, *Proof, error) {
+	q, err := checkSizes(g, pk)
+	if err != nil {
+		return nil, nil, err
+	}
+	m, err := scalarToInt(g, x)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := random.Int(new(big.Int).Rsh(pk.N, 2), rand)
+	c := pk.encrypt(m, r, label)
+	X := g.Point().Mul(x, nil)
+
+	// The randomness of the responses hides c*r, c*m and c*s up to a
+	// statistical distance of 1/q.
+	q2 := new(big.Int).Mul(q, q)
+	q3 := new(big.Int).Mul(q2, q)
+	rr := random.Int(new(big.Int).Mul(q2, pk.N), rand)
+	mr := random.Int(q3, rand)
+	s := random.Int(new(big.Int).Mul(q, ped.N), rand)
+	sr := random.Int(new(big.Int).Mul(q3, ped.N), rand)
+
+	n2 := pk.n2()
+	rr2 := new(big.Int).Lsh(rr, 1)
+	p := &Proof{
+		C: commit(ped, m, s),
+		U: new(big.Int).Exp(pk.G, rr2, n2),
+		E: new(big.Int).Exp(pk.Y1, rr2, n2),
+		V: new(big.Int).Exp(pk.w(c, label), rr2, n2),
+		X: g.Point().Mul(intToScalar(g, mr), nil),
+		D: commit(ped, mr, sr),
+	}
+	p.E.Mul(p.E, pk.h(new(big.Int).Lsh(mr, 1)))
+	p.E.Mod(p.E, n2)
+
+	e := p.challenge(g, q, pk, ped, c, X, label)
+	p.Zr = new(big.Int).Mul(e, r)
+	p.Zr.Add(p.Zr, rr)
+	p.Zm = new(big.Int).Mul(e, m)
+	p.Zm.Add(p.Zm, mr)
+	p.Zs = new(big.Int).Mul(e, s)
+	p.Zs.Add(p.Zs, sr)
+	return c, p, nil
+}
+
+// Verify checks that the ciphertext c with the given label decrypts to the
+// discrete logarithm of X in the group of g. It returns nil if the proof is
+// valid, and an error otherwise.
+func (p *Proof) Verify(g Suite, pk *PublicKey, ped *paillier.PedersenParams, c *Ciphertext, X kyber.Point, label []byte) error {
+	q, err := checkSizes(g, pk)
+	if err != nil {
+		return err
+	}
+	n2 := pk.n2()
+	q3 := new(big.Int).Exp(q, big.NewInt(3), nil)
+	if p == nil || p.X == nil || ped == nil || ped.N == nil || !pk.valid(c) ||
+		!bigint.IsUnit(p.U, n2) || !bigint.IsUnit(p.E, n2) || !bigint.IsUnit(p.V, n2) ||
+		!bigint.IsUnit(p.C, ped.N) || !bigint.IsUnit(p.D, ped.N) ||
+		p.Zr == nil || p.Zr.Sign() < 0 || p.Zs == nil || p.Zs.Sign() < 0 ||
+		p.Zm == nil || p.Zm.Sign() < 0 || p.Zm.Cmp(q3) > 0 {
+		return errInvalidProof
+	}
+	e := p.challenge(g, q, pk, ped, c, X, label)
+	e2 := new(big.Int).Lsh(e, 1)
+	zr2 := new(big.Int).Lsh(p.Zr, 1)
+
+	// check reports whether b^2zr * t = a * c^2e mod N^2.
+	check := func(b, t, a, c *big.Int) bool {
+		lhs := new(big.Int).Exp(b, zr2, n2)
+		lhs.Mul(lhs, t)
+		lhs.Mod(lhs, n2)
+		rhs := new(big.Int).Exp(c, e2, n2)
+		rhs.Mul(rhs, a)
+		return lhs.Cmp(rhs.Mod(rhs, n2)) == 0
+	}
+	// zm*G = X' + e*X
+	rhs := g.Point().Mul(intToScalar(g, e), X)
+	rhs.Add(rhs, p.X)
+	if !check(pk.G, one, p.U, c.U) ||
+		!check(pk.Y1, pk.h(new(big.Int).Lsh(p.Zm, 1)), p.E, c.E) ||
+		!check(pk.w(c, label), one, p.V, c.V) ||
+		!g.Point().Mul(intToScalar(g, p.Zm), nil).Equal(rhs) {
+		return errInvalidProof
+	}
+	// H1^zm * H2^zs = D * C^e mod N'
+	lhs := commit(ped, p.Zm, p.Zs)
+	crhs := new(big.Int).Exp(p.C, e, ped.N)
+	crhs.Mul(crhs, p.D)
+	if lhs.Cmp(crhs.Mod(crhs, ped.N)) != 0 {
+		return errInvalidProof
+	}
+	return nil
+}
+
+func (p *Proof) challenge(g Suite, q *big.Int, pk *PublicKey, ped *paillier.PedersenParams, c *Ciphertext, X kyber.Point, label []byte) *big.Int {
+	t := transcript.New(g, "camshoup proof")
+	t.AppendInts("public key", pk.N, pk.G, pk.Y1, pk.Y2, pk.Y3)
+	t.AppendInts("pedersen", ped.N, ped.H1, ped.H2)
+	t.AppendInts("ciphertext", c.U, c.E, c.V)
+	t.Append("label", label)
+	t.AppendPoints("point", X)
+	t.AppendInts("commitments", p.C, p.U, p.E, p.V)
+	t.AppendPoints("commitments", p.X)
+	t.AppendInts("commitments", p.D)
+	return t.ChallengeInt("challenge", q)
+}
+
+// DecryptScalar returns the scalar of the group g encrypted in c with the
+// given label by VerifiableEncrypt.
+func (sk *PrivateKey) DecryptScalar(g kyber.Group, c *Ciphertext, label []byte) (kyber.Scalar, error) {
+	if _, err := checkSizes(g, &sk.PublicKey); err != nil {
+		return nil, err
+	}
+	m, err := sk.Decrypt(c, label)
+	if err != nil {
+		return nil, err
+	}
+	// A valid proof only guarantees that the plaintext is congruent to the
+	// discrete logarithm modulo the group order, and possibly negative.
+	if m.Cmp(new(big.Int).Rsh(sk.N, 1)) > 0 {
+		m.Sub(m, sk.N)
+	}
+	return intToScalar(g, m), nil
+}
+
+// h returns (1+N)^m = 1 + mN mod N^2.
+func (pk *PublicKey) h(m *big.Int) *big.Int {
+	h := new(big.Int).Mod(m, pk.N)
+	h.Mul(h, pk.N)
+	return h.Add(h, one)
+}
+
+// commit returns H1^x * H2^r mod N.
+func commit(ped *paillier.PedersenParams, x, r *big.Int) *big.Int {
+	c := new(big.Int).Exp(ped.H1, x, ped.N)
+	c.Mul(c, new(big.Int).Exp(ped.H2, r, ped.N))
+	return c.Mod(c, ped.N)
+}
+
+// checkSizes returns the order q of the group g, after checking that the
+// modulus of the key is larger than 2q^3, so that the plaintexts that the
+// proofs guarantee are not reduced modulo N.
+func checkSizes(g kyber.Group, pk *PublicKey) (*big.Int, error) {
+	minus, err := scalarToInt(g, g.Scalar().SetInt64(-1))
+	if err != nil {
+		return nil, err
+	}
+	q := minus.Add(minus, one)
+	max := new(big.Int).Exp(q, big.NewInt(3), nil)
+	if pk.N == nil || pk.N.Cmp(max.Lsh(max, 1)) <= 0 {
+		return nil, errors.New("camshoup: modulus too short for the group")
+	}
+	return q, nil
+}
+
+// littleEndian reports whether the scalars of the group g are encoded in
+// little-endian order, and returns the length of their encoding.
+func littleEndian(g kyber.Group) (bool, int, error) {
+	one, err := g.Scalar().One().MarshalBinary()
+	if err != nil || len(one) < 2 {
+		return false, 0, errors.New("camshoup: unsupported scalar encoding")
+	}
+	switch {
+	case one[0] == 1 && isZero(one[1:]):
+		return true, len(one), nil
+	case one[len(one)-1] == 1 && isZero(one[:len(one)-1]):
+		return false, len(one), nil
+	}
+	return false, 0, errors.New("camshoup: unsupported scalar encoding")
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// scalarToInt returns the integer value of the scalar s of the group g.
+func scalarToInt(g kyber.Group, s kyber.Scalar) (*big.Int, error) {
+	le, _, err := littleEndian(g)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if le {
+		reverse(b)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// intToScalar returns the scalar of the group g congruent to v, for a
+// group whose encoding is supported by scalarToInt.
+func intToScalar(g kyber.Group, v *big.Int) kyber.Scalar {
+	le, l, _ := littleEndian(g)
+	minus, _ := scalarToInt(g, g.Scalar().SetInt64(-1))
+	v = new(big.Int).Mod(v, minus.Add(minus, one))
+	b := make([]byte, l)
+	v.FillBytes(b)
+	if le {
+		reverse(b)
+	}
+	s := g.Scalar()
+	if err := s.UnmarshalBinary(b); err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
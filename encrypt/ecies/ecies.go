@@ -0,0 +1,214 @@
+// Package ecies implements the Elliptic Curve Integrated Encryption Scheme
+// over any kyber group: hybrid public-key encryption of messages of any
+// length.
+//
+// Seal picks an ephemeral key pair, derives a symmetric key from its
+// Diffie-Hellman secret with the public key of the recipient, and encrypts
+// the message with an AEAD under that key. The key derivation function and
+// the AEAD are pluggable through Options; they default to HKDF with the
+// hash of the suite and AES-256-GCM.
+//
+// A ciphertext is framed as a version byte, followed by the binary encoding
+// of the ephemeral public key and by the AEAD ciphertext. Large plaintexts
+// are encrypted as a stream with NewWriter and decrypted with NewReader,
+// which split them into authenticated segments, so that they are never held
+// in memory at once.
+package ecies
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"hash"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/key"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Suite represents the set of functionalities needed by the package ecies.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+	kyber.Random
+}
+
+// Versions of the ciphertext framing.
+const (
+	versionSeal   = 1
+	versionStream = 2
+)
+
+var (
+	errVersion    = errors.New("ecies: unknown ciphertext version")
+	errCiphertext = errors.New("ecies: invalid ciphertext")
+)
+
+// KDF derives a key of the given length from a shared secret, bound to the
+// context info.
+type KDF func(secret, info []byte, length int) ([]byte, error)
+
+// HKDF returns the HKDF key derivation function (RFC 5869) with the given
+// hash function.
+func HKDF(h func() hash.Hash) KDF {
+	return func(secret, info []byte, length int) ([]byte, error) {
+		key := make([]byte, length)
+		if _, err := io.ReadFull(hkdf.New(h, secret, nil, info), key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+}
+
+// XOF returns the key derivation function that reads the key from the XOF
+// of the suite, seeded with the secret and the context.
+func XOF(suite kyber.XOFFactory) KDF {
+	return func(secret, info []byte, length int) ([]byte, error) {
+		xof := suite.XOF(secret)
+		if _, err := xof.Write(info); err != nil {
+			return nil, err
+		}
+		key := make([]byte, length)
+		if _, err := xof.Read(key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+}
+
+// AEAD describes an authenticated encryption scheme: the size of its keys,
+// and how to create a cipher from a key.
+type AEAD struct {
+	KeySize int
+	New     func(key []byte) (cipher.AEAD, error)
+}
+
+// AES256GCM is AES-256 in Galois/Counter Mode.
+var AES256GCM = AEAD{
+	KeySize: 32,
+	New: func(key []byte) (cipher.AEAD, error) {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(block)
+	},
+}
+
+// ChaCha20Poly1305 is the ChaCha20-Poly1305 AEAD of RFC 8439.
+var ChaCha20Poly1305 = AEAD{
+	KeySize: chacha20poly1305.KeySize,
+	New:     chacha20poly1305.New,
+}
+
+// Options selects the key derivation function and the AEAD of the scheme.
+// Nil options, or zero fields, select the defaults: HKDF with the hash of
+// the suite, and AES-256-GCM.
+type Options struct {
+	KDF  KDF
+	AEAD AEAD
+}
+
+func (o *Options) kdf(suite Suite) KDF {
+	if o == nil || o.KDF == nil {
+		return HKDF(suite.Hash)
+	}
+	return o.KDF
+}
+
+func (o *Options) aead() AEAD {
+	if o == nil || o.AEAD.New == nil {
+		return AES256GCM
+	}
+	return o.AEAD
+}
+
+// Seal encrypts msg for the public key, authenticating the additional data
+// aad along with it, and returns the framed ciphertext.
+func Seal(suite Suite, public kyber.Point, msg, aad []byte, opts *Options) ([]byte, error) {
+	header, aead, err := encapsulate(suite, public, versionSeal, opts)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	return aead.Seal(header, nonce, msg, aad), nil
+}
+
+// Open decrypts the ciphertext with the private key, checking the
+// additional data aad, and returns the message.
+func Open(suite Suite, private kyber.Scalar, ciphertext, aad []byte, opts *Options) ([]byte, error) {
+	size := 1 + suite.PointLen()
+	if len(ciphertext) < size {
+		return nil, errCiphertext
+	}
+	aead, err := decapsulate(suite, private, ciphertext[:size], versionSeal, opts)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	msg, err := aead.Open(nil, nonce, ciphertext[size:], aad)
+	if err != nil {
+		return nil, errCiphertext
+	}
+	return msg, nil
+}
+
+// encapsulate picks an ephemeral key pair, and returns the header of the
+// ciphertext with the AEAD keyed for the public key.
+func encapsulate(suite Suite, public kyber.Point, version byte, opts *Options) ([]byte, cipher.AEAD, error) {
+	r := suite.Scalar().Pick(suite.RandomStream())
+	R := suite.Point().Mul(r, nil)
+	header, err := R.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	header = append([]byte{version}, header...)
+	aead, err := newAEAD(suite, suite.Point().Mul(r, public), public, header, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, aead, nil
+}
+
+// decapsulate parses the header of a ciphertext, and returns the AEAD keyed
+// for it with the private key.
+func decapsulate(suite Suite, private kyber.Scalar, header []byte, version byte, opts *Options) (cipher.AEAD, error) {
+	if header[0] != version {
+		return nil, errVersion
+	}
+	R := suite.Point()
+	if err := R.UnmarshalBinary(header[1:]); err != nil {
+		return nil, err
+	}
+	if err := key.ValidatePublicKey(suite, R); err != nil {
+		return nil, err
+	}
+	public := suite.Point().Mul(private, nil)
+	return newAEAD(suite, suite.Point().Mul(private, R), public, header, opts)
+}
+
+// newAEAD derives the key of the AEAD from the shared point, binding it to
+// the header and to the public key of the recipient.
+func newAEAD(suite Suite, shared, public kyber.Point, header []byte, opts *Options) (cipher.AEAD, error) {
+	if shared.Equal(suite.Point().Null()) {
+		return nil, errors.New("ecies: shared point is the identity")
+	}
+	secret, err := shared.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	pub, err := public.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	info := append([]byte("kyber ECIES"), header...)
+	info = append(info, pub...)
+	scheme := opts.aead()
+	key, err := opts.kdf(suite)(secret, info, scheme.KeySize)
+	if err != nil {
+		return nil, err
+	}
+	return scheme.New(key)
+}
@@ -0,0 +1,114 @@
+package ecies
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealOpen(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	private := suite.Scalar().Pick(suite.RandomStream())
+	public := suite.Point().Mul(private, nil)
+	msg := []byte("Hello ECIES")
+	aad := []byte("context")
+
+	options := []*Options{
+		nil,
+		{AEAD: ChaCha20Poly1305},
+		{KDF: HKDF(sha256.New), AEAD: AES256GCM},
+		{KDF: XOF(suite)},
+	}
+	for _, opts := range options {
+		ct, err := Seal(suite, public, msg, aad, opts)
+		require.NoError(t, err)
+		require.Equal(t, byte(versionSeal), ct[0])
+		pt, err := Open(suite, private, ct, aad, opts)
+		require.NoError(t, err)
+		require.Equal(t, msg, pt)
+
+		_, err = Open(suite, private, ct, []byte("other"), opts)
+		require.Error(t, err)
+		other := suite.Scalar().Pick(suite.RandomStream())
+		_, err = Open(suite, other, ct, aad, opts)
+		require.Error(t, err)
+		for _, i := range []int{0, 1, len(ct) - 1} {
+			bad := append([]byte(nil), ct...)
+			bad[i] ^= 1
+			_, err = Open(suite, private, bad, aad, opts)
+			require.Error(t, err)
+		}
+		_, err = Open(suite, private, ct[:10], aad, opts)
+		require.Error(t, err)
+	}
+
+	// The options must agree.
+	ct, err := Seal(suite, public, msg, nil, nil)
+	require.NoError(t, err)
+	_, err = Open(suite, private, ct, nil, &Options{AEAD: ChaCha20Poly1305})
+	require.Error(t, err)
+}
+
+func TestStream(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	private := suite.Scalar().Pick(suite.RandomStream())
+	public := suite.Point().Mul(private, nil)
+
+	for _, size := range []int{0, 1, segmentSize - 1, segmentSize, 3*segmentSize + 17} {
+		msg := random.Bits(uint(8*size), false, suite.RandomStream())
+		var buf bytes.Buffer
+		w, err := NewWriter(suite, public, &buf, nil)
+		require.NoError(t, err)
+		// Write in uneven pieces.
+		for p := msg; len(p) > 0; {
+			n := 1000
+			if n > len(p) {
+				n = len(p)
+			}
+			_, err = w.Write(p[:n])
+			require.NoError(t, err)
+			p = p[n:]
+		}
+		require.NoError(t, w.Close())
+		_, err = w.Write([]byte{0})
+		require.Error(t, err)
+		ct := buf.Bytes()
+
+		r, err := NewReader(suite, private, bytes.NewReader(ct), nil)
+		require.NoError(t, err)
+		pt, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, len(msg), len(pt))
+		require.True(t, bytes.Equal(msg, pt))
+
+		// Streams and sealed ciphertexts are not interchangeable.
+		_, err = Open(suite, private, ct, nil, nil)
+		require.Error(t, err)
+
+		// Truncating the stream at a segment boundary is detected.
+		header := 1 + suite.PointLen()
+		if size > segmentSize {
+			cut := header + segmentSize + 16
+			r, err = NewReader(suite, private, bytes.NewReader(ct[:cut]), nil)
+			require.NoError(t, err)
+			_, err = ioutil.ReadAll(r)
+			require.Error(t, err)
+		}
+
+		bad := append([]byte(nil), ct...)
+		bad[len(bad)-1] ^= 1
+		r, err = NewReader(suite, private, bytes.NewReader(bad), nil)
+		require.NoError(t, err)
+		_, err = ioutil.ReadAll(r)
+		require.Error(t, err)
+	}
+
+	_, err := NewReader(suite, private, bytes.NewReader(nil), nil)
+	require.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,165 @@
+package ecies
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+)
+
+// segmentSize is the size of the plaintext segments of a stream.
+const segmentSize = 64 * 1024
+
+// segmentNonce returns the nonce of the segment of the given index, which
+// marks whether it is the last one, so that a truncated stream is detected.
+func segmentNonce(aead cipher.AEAD, index uint64, last bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-9:], index)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// writer encrypts a stream. It keeps a full segment buffered until it
+// knows whether more data follows.
+type writer struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	index uint64
+	buf   []byte
+	err   error
+}
+
+// NewWriter returns a writer that encrypts the data written to it for the
+// public key, and writes the ciphertext stream to w. The header of the
+// stream is written at once. Close must be called to write the last
+// segment; it does not close w.
+func NewWriter(suite Suite, public kyber.Point, w io.Writer, opts *Options) (io.WriteCloser, error) {
+	header, aead, err := encapsulate(suite, public, versionStream, opts)
+	if err != nil {
+		return nil, err
+	}
+	if aead.NonceSize() < 9 {
+		return nil, errors.New("ecies: nonce too short for streaming")
+	}
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+	return &writer{w: w, aead: aead, buf: make([]byte, 0, segmentSize)}, nil
+}
+
+func (w *writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n := 0
+	for len(p) > 0 {
+		if len(w.buf) == segmentSize {
+			if err := w.flush(false); err != nil {
+				return n, err
+			}
+		}
+		c := copy(w.buf[len(w.buf):segmentSize], p)
+		w.buf = w.buf[:len(w.buf)+c]
+		p = p[c:]
+		n += c
+	}
+	return n, nil
+}
+
+// Close encrypts and writes the last segment.
+func (w *writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.flush(true); err != nil {
+		return err
+	}
+	w.err = errors.New("ecies: write to closed stream")
+	return nil
+}
+
+func (w *writer) flush(last bool) error {
+	ct := w.aead.Seal(nil, segmentNonce(w.aead, w.index, last), w.buf, nil)
+	w.index++
+	w.buf = w.buf[:0]
+	if _, err := w.w.Write(ct); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
+
+// reader decrypts a stream.
+type reader struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	index uint64
+	buf   []byte // decrypted data not read yet
+	ct    []byte
+	done  bool
+}
+
+// NewReader returns a reader that decrypts the ciphertext stream read from
+// r with the private key. It reads the header of the stream at once. Every
+// segment is authenticated before its data is returned, and a truncated
+// stream results in an error rather than io.EOF.
+func NewReader(suite Suite, private kyber.Scalar, r io.Reader, opts *Options) (io.Reader, error) {
+	header := make([]byte, 1+suite.PointLen())
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	aead, err := decapsulate(suite, private, header, versionStream, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		r:    bufio.NewReader(r),
+		aead: aead,
+		ct:   make([]byte, segmentSize+aead.Overhead()),
+	}, nil
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next reads and decrypts the next segment.
+func (r *reader) next() error {
+	n, err := io.ReadFull(r.r, r.ct)
+	last := false
+	switch {
+	case err == io.ErrUnexpectedEOF || err == io.EOF:
+		last = true
+	case err != nil:
+		return err
+	default:
+		if _, err := r.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+	msg, err := r.aead.Open(r.ct[:0], segmentNonce(r.aead, r.index, last), r.ct[:n], nil)
+	if err != nil {
+		return errCiphertext
+	}
+	r.index++
+	r.buf = msg
+	r.done = last
+	return nil
+}
@@ -0,0 +1,81 @@
+// Package elgamal implements ElGamal encryption of points over any kyber
+// group, together with the operations on ciphertexts that shuffles and
+// mixnets rely on.
+//
+// A ciphertext of a point M under the public key X = x*G is the pair
+// (K, C) = (k*G, M + k*X) for a random scalar k. Short messages can be
+// embedded into a point with EncryptMessage. Ciphertexts can be
+// re-randomized, so that they cannot be linked to the original ciphertext,
+// and are homomorphic: adding two ciphertexts gives a ciphertext of the sum
+// of their points, and multiplying a ciphertext by a scalar one of the
+// multiplied point. With the additive notation of kyber, this is the
+// multiplicative homomorphism of ElGamal encryption.
+//
+// When the private key is shared among n participants, as with package
+// share/dkg, any t of them decrypt a ciphertext without reconstructing the
+// key: each one publishes a decryption share with NewDecryptionShare, with
+// a proof of its correctness, and Combine checks and interpolates the
+// shares into the decrypted point.
+package elgamal
+
+import (
+	"crypto/cipher"
+
+	"github.com/dedis/kyber"
+)
+
+// Ciphertext is an ElGamal ciphertext: the ephemeral key K and the blinded
+// point C.
+type Ciphertext struct {
+	K, C kyber.Point
+}
+
+// Encrypt returns a ciphertext of the point M under the public key.
+func Encrypt(g kyber.Group, public, M kyber.Point, rand cipher.Stream) *Ciphertext {
+	k := g.Scalar().Pick(rand)
+	K := g.Point().Mul(k, nil)
+	C := g.Point().Mul(k, public)
+	return &Ciphertext{K: K, C: C.Add(C, M)}
+}
+
+// EncryptMessage embeds as much of msg as fits into a point, and returns a
+// ciphertext of the point under the public key, with the remainder of msg
+// that did not fit.
+func EncryptMessage(g kyber.Group, public kyber.Point, msg []byte, rand cipher.Stream) (*Ciphertext, []byte) {
+	M := g.Point().Embed(msg, rand)
+	max := g.Point().EmbedLen()
+	if max > len(msg) {
+		max = len(msg)
+	}
+	return Encrypt(g, public, M, rand), msg[max:]
+}
+
+// Decrypt returns the point encrypted by the ciphertext, with the private
+// key.
+func Decrypt(g kyber.Group, private kyber.Scalar, c *Ciphertext) kyber.Point {
+	S := g.Point().Mul(private, c.K)
+	return S.Sub(c.C, S)
+}
+
+// DecryptMessage returns the message embedded into the point encrypted by
+// the ciphertext, with the private key.
+func DecryptMessage(g kyber.Group, private kyber.Scalar, c *Ciphertext) ([]byte, error) {
+	return Decrypt(g, private, c).Data()
+}
+
+// Rerandomize returns a fresh ciphertext of the point encrypted by c under
+// the public key, which cannot be linked to c without the private key.
+func Rerandomize(g kyber.Group, public kyber.Point, c *Ciphertext, rand cipher.Stream) *Ciphertext {
+	return Add(g, c, Encrypt(g, public, g.Point().Null(), rand))
+}
+
+// Add returns a ciphertext of the sum of the points encrypted by a and b,
+// which must be under the same public key.
+func Add(g kyber.Group, a, b *Ciphertext) *Ciphertext {
+	return &Ciphertext{K: g.Point().Add(a.K, b.K), C: g.Point().Add(a.C, b.C)}
+}
+
+// Mul returns a ciphertext of the point encrypted by c multiplied by s.
+func Mul(g kyber.Group, c *Ciphertext, s kyber.Scalar) *Ciphertext {
+	return &Ciphertext{K: g.Point().Mul(s, c.K), C: g.Point().Mul(s, c.C)}
+}
@@ -0,0 +1,58 @@
+package elgamal
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func TestEncrypt(t *testing.T) {
+	rand := suite.RandomStream()
+	x := suite.Scalar().Pick(rand)
+	X := suite.Point().Mul(x, nil)
+
+	msg := []byte("The quick brown fox")
+	c, rest := EncryptMessage(suite, X, msg, rand)
+	require.Empty(t, rest)
+	m, err := DecryptMessage(suite, x, c)
+	require.NoError(t, err)
+	require.Equal(t, msg, m)
+
+	long := make([]byte, suite.Point().EmbedLen()+5)
+	_, rest = EncryptMessage(suite, X, long, rand)
+	require.Len(t, rest, 5)
+
+	// Another key does not decrypt.
+	y := suite.Scalar().Pick(rand)
+	require.False(t, Decrypt(suite, y, c).Equal(Decrypt(suite, x, c)))
+}
+
+func TestRerandomize(t *testing.T) {
+	rand := suite.RandomStream()
+	x := suite.Scalar().Pick(rand)
+	X := suite.Point().Mul(x, nil)
+	M := suite.Point().Pick(rand)
+
+	c := Encrypt(suite, X, M, rand)
+	r := Rerandomize(suite, X, c, rand)
+	require.False(t, r.K.Equal(c.K))
+	require.False(t, r.C.Equal(c.C))
+	require.True(t, Decrypt(suite, x, r).Equal(M))
+}
+
+func TestHomomorphism(t *testing.T) {
+	rand := suite.RandomStream()
+	x := suite.Scalar().Pick(rand)
+	X := suite.Point().Mul(x, nil)
+	M1 := suite.Point().Pick(rand)
+	M2 := suite.Point().Pick(rand)
+	s := suite.Scalar().Pick(rand)
+
+	sum := Add(suite, Encrypt(suite, X, M1, rand), Encrypt(suite, X, M2, rand))
+	require.True(t, Decrypt(suite, x, sum).Equal(suite.Point().Add(M1, M2)))
+	prod := Mul(suite, Encrypt(suite, X, M1, rand), s)
+	require.True(t, Decrypt(suite, x, prod).Equal(suite.Point().Mul(s, M1)))
+}
@@ -0,0 +1,101 @@
+package elgamal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/share"
+)
+
+// Suite represents the set of functionalities needed by threshold
+// decryption.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+	kyber.XOFFactory
+	kyber.Random
+}
+
+// DecryptionShare is the share D = x_i*K of the decryption of a ciphertext
+// by the holder of the share of index i of the private key, with a proof
+// that it used the same x_i as its public share X_i = x_i*G.
+type DecryptionShare struct {
+	Index int
+	D     kyber.Point
+	Proof *dleq.Proof
+}
+
+// NewDecryptionShare returns the decryption share of the ciphertext for
+// the share of the private key, as held by a participant of a threshold
+// setup such as the distributed key generation of package share/dkg.
+func NewDecryptionShare(suite Suite, private *share.PriShare, c *Ciphertext) (*DecryptionShare, error) {
+	t := decryptionTranscript(suite, private.I, c)
+	proof, _, D, err := dleq.NewDLEQProofTranscript(suite, t, suite.Point().Base(), c.K, private.V)
+	if err != nil {
+		return nil, err
+	}
+	return &DecryptionShare{Index: private.I, D: D, Proof: proof}, nil
+}
+
+// VerifyDecryptionShare checks the decryption share of the ciphertext
+// against the public polynomial of the shared key.
+func VerifyDecryptionShare(suite Suite, pub *share.PubPoly, c *Ciphertext, ds *DecryptionShare) error {
+	if ds.Index < 0 || ds.D == nil || ds.Proof == nil {
+		return errors.New("elgamal: malformed decryption share")
+	}
+	X := pub.Eval(ds.Index).V
+	t := decryptionTranscript(suite, ds.Index, c)
+	if err := ds.Proof.VerifyTranscript(suite, t, suite.Point().Base(), c.K, X, ds.D); err != nil {
+		return fmt.Errorf("elgamal: invalid decryption share from participant %d", ds.Index)
+	}
+	return nil
+}
+
+// decryptionTranscript returns the transcript of the proof of the decryption
+// share of the given index of the ciphertext.
+func decryptionTranscript(suite Suite, index int, c *Ciphertext) *transcript.Transcript {
+	t := transcript.New(suite, "elgamal threshold decryption")
+	var i [4]byte
+	binary.BigEndian.PutUint32(i[:], uint32(index))
+	t.Append("index", i[:])
+	t.AppendPoints("ciphertext", c.K, c.C)
+	return t
+}
+
+// Combine checks the decryption shares of the ciphertext, and combines the
+// first t valid ones from distinct participants, where t is the threshold
+// of the public polynomial, into the decrypted point. Invalid shares are
+// left out, so that decryption succeeds as long as t participants are
+// honest; an error is returned if there are not enough valid shares.
+func Combine(suite Suite, pub *share.PubPoly, c *Ciphertext, shares []*DecryptionShare) (kyber.Point, error) {
+	t := pub.Threshold()
+	seen := make(map[int]bool)
+	var valid []*share.PubShare
+	n := 0
+	for _, ds := range shares {
+		if len(valid) == t {
+			break
+		}
+		if ds == nil || seen[ds.Index] || VerifyDecryptionShare(suite, pub, c, ds) != nil {
+			continue
+		}
+		seen[ds.Index] = true
+		valid = append(valid, &share.PubShare{I: ds.Index, V: ds.D})
+		if ds.Index >= n {
+			n = ds.Index + 1
+		}
+	}
+	if len(valid) < t {
+		return nil, errors.New("elgamal: not enough valid decryption shares")
+	}
+	// The shares interpolate to x*K.
+	S, err := share.RecoverCommit(suite, valid, t, n)
+	if err != nil {
+		return nil, err
+	}
+	return S.Sub(c.C, S), nil
+}
@@ -0,0 +1,51 @@
+package elgamal
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/share"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThreshold(t *testing.T) {
+	const n, th = 5, 3
+	rand := suite.RandomStream()
+	poly := share.NewPriPoly(suite, th, nil)
+	pub := poly.Commit(nil)
+	shares := poly.Shares(n)
+
+	// Tally yes votes, encrypted as 1*G, and no votes, as the identity.
+	votes := []int64{1, 0, 1, 1, 0, 1}
+	sum := Encrypt(suite, pub.Commit(), suite.Point().Null(), rand)
+	for _, v := range votes {
+		M := suite.Point().Mul(suite.Scalar().SetInt64(v), nil)
+		sum = Add(suite, sum, Encrypt(suite, pub.Commit(), M, rand))
+	}
+
+	var ds []*DecryptionShare
+	for _, s := range shares {
+		d, err := NewDecryptionShare(suite, s, sum)
+		require.NoError(t, err)
+		require.NoError(t, VerifyDecryptionShare(suite, pub, sum, d))
+		ds = append(ds, d)
+	}
+	expected := suite.Point().Mul(suite.Scalar().SetInt64(4), nil)
+	M, err := Combine(suite, pub, sum, ds[2:])
+	require.NoError(t, err)
+	require.True(t, M.Equal(expected))
+
+	// A wrong share is detected and left out.
+	bad := *ds[0]
+	bad.D = suite.Point().Add(bad.D, suite.Point().Base())
+	require.Error(t, VerifyDecryptionShare(suite, pub, sum, &bad))
+	M, err = Combine(suite, pub, sum, []*DecryptionShare{&bad, ds[1], ds[1], ds[3], ds[4]})
+	require.NoError(t, err)
+	require.True(t, M.Equal(expected))
+
+	// A share of another ciphertext is invalid.
+	require.Error(t, VerifyDecryptionShare(suite, pub, Rerandomize(suite, pub.Commit(), sum, rand), ds[0]))
+
+	// Fewer than t valid shares do not decrypt.
+	_, err = Combine(suite, pub, sum, []*DecryptionShare{&bad, ds[1], ds[1], ds[3]})
+	require.Error(t, err)
+}
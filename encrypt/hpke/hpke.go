@@ -0,0 +1,328 @@
+// Package hpke implements Hybrid Public Key Encryption (RFC 9180).
+//
+// A Suite combines a KEM, a KDF and an AEAD. The sender of messages sets up
+// an encryption context for the public key of a receiver, which returns
+// the encapsulated key enc that the receiver needs to set up the matching
+// decryption context with its private key. The contexts then encrypt and
+// decrypt any number of messages, in order, and export secrets derived from
+// the shared secret. The four modes of the RFC are supported: the base
+// mode, the PSK mode that also authenticates both parties with a
+// pre-shared key, the auth mode that authenticates the sender with its KEM
+// key pair, and the auth-PSK mode that combines both. Suite.Seal and
+// Suite.Open encrypt a single message in the base mode, and
+// Suite.SendExport and Suite.ReceiveExport export a single secret.
+//
+// DHKEMX25519 and DHKEMP256 (which requires the build tag "vartime") are
+// the KEMs of the RFC, and are compatible with other implementations of
+// it. NewDHKEM provides a DHKEM over any other kyber group.
+package hpke
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Modes of HPKE.
+const (
+	modeBase    = 0x00
+	modePSK     = 0x01
+	modeAuth    = 0x02
+	modeAuthPSK = 0x03
+)
+
+var errExportOnly = errors.New("hpke: export-only context")
+
+// Suite is an HPKE cipher suite.
+type Suite struct {
+	kem  KEM
+	kdf  KDF
+	aead AEAD
+	id   []byte
+}
+
+// NewSuite returns the cipher suite of the given KEM, KDF and AEAD.
+func NewSuite(kem KEM, kdf KDF, aead AEAD) *Suite {
+	id := []byte("HPKE\x00\x00\x00\x00\x00\x00")
+	binary.BigEndian.PutUint16(id[4:], kem.ID())
+	binary.BigEndian.PutUint16(id[6:], kdf.ID)
+	binary.BigEndian.PutUint16(id[8:], aead.ID)
+	return &Suite{kem: kem, kdf: kdf, aead: aead, id: id}
+}
+
+// KEM returns the KEM of the suite.
+func (s *Suite) KEM() KEM {
+	return s.kem
+}
+
+// NewSender sets up a context in the base mode to encrypt messages for the
+// public key. It returns the encapsulated key to send to the receiver with
+// the context.
+func (s *Suite) NewSender(public, info []byte, rand cipher.Stream) ([]byte, *Sender, error) {
+	shared, enc, err := s.kem.Encap(public, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := s.keySchedule(modeBase, shared, info, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, &Sender{*c}, nil
+}
+
+// NewSenderPSK is like NewSender, in the PSK mode with the pre-shared key
+// psk of identifier pskID.
+func (s *Suite) NewSenderPSK(public, info, psk, pskID []byte, rand cipher.Stream) ([]byte, *Sender, error) {
+	shared, enc, err := s.kem.Encap(public, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := s.keySchedule(modePSK, shared, info, psk, pskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, &Sender{*c}, nil
+}
+
+// NewSenderAuth is like NewSender, in the auth mode where the sender is
+// authenticated by its private key.
+func (s *Suite) NewSenderAuth(public, info, private []byte, rand cipher.Stream) ([]byte, *Sender, error) {
+	shared, enc, err := s.kem.AuthEncap(public, private, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := s.keySchedule(modeAuth, shared, info, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, &Sender{*c}, nil
+}
+
+// NewSenderAuthPSK is like NewSender, in the auth-PSK mode where the
+// sender is authenticated by its private key, and both parties by the
+// pre-shared key psk of identifier pskID.
+func (s *Suite) NewSenderAuthPSK(public, info, psk, pskID, private []byte, rand cipher.Stream) ([]byte, *Sender, error) {
+	shared, enc, err := s.kem.AuthEncap(public, private, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	c, err := s.keySchedule(modeAuthPSK, shared, info, psk, pskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, &Sender{*c}, nil
+}
+
+// NewReceiver sets up a context in the base mode to decrypt the messages
+// of the sender of the encapsulated key enc with the private key.
+func (s *Suite) NewReceiver(enc, private, info []byte) (*Receiver, error) {
+	shared, err := s.kem.Decap(enc, private)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.keySchedule(modeBase, shared, info, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Receiver{*c}, nil
+}
+
+// NewReceiverPSK is like NewReceiver, in the PSK mode with the pre-shared
+// key psk of identifier pskID.
+func (s *Suite) NewReceiverPSK(enc, private, info, psk, pskID []byte) (*Receiver, error) {
+	shared, err := s.kem.Decap(enc, private)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.keySchedule(modePSK, shared, info, psk, pskID)
+	if err != nil {
+		return nil, err
+	}
+	return &Receiver{*c}, nil
+}
+
+// NewReceiverAuth is like NewReceiver, in the auth mode where the sender is
+// authenticated by its public key.
+func (s *Suite) NewReceiverAuth(enc, private, info, senderPublic []byte) (*Receiver, error) {
+	shared, err := s.kem.AuthDecap(enc, private, senderPublic)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.keySchedule(modeAuth, shared, info, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Receiver{*c}, nil
+}
+
+// NewReceiverAuthPSK is like NewReceiver, in the auth-PSK mode where the
+// sender is authenticated by its public key, and both parties by the
+// pre-shared key psk of identifier pskID.
+func (s *Suite) NewReceiverAuthPSK(enc, private, info, psk, pskID, senderPublic []byte) (*Receiver, error) {
+	shared, err := s.kem.AuthDecap(enc, private, senderPublic)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.keySchedule(modeAuthPSK, shared, info, psk, pskID)
+	if err != nil {
+		return nil, err
+	}
+	return &Receiver{*c}, nil
+}
+
+// Seal encrypts a single message for the public key in the base mode, and
+// returns the encapsulated key and the ciphertext.
+func (s *Suite) Seal(public, info, aad, msg []byte, rand cipher.Stream) ([]byte, []byte, error) {
+	enc, sender, err := s.NewSender(public, info, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	ct, err := sender.Seal(aad, msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, ct, nil
+}
+
+// Open decrypts a single message encrypted in the base mode with Seal.
+func (s *Suite) Open(enc, private, info, aad, ciphertext []byte) ([]byte, error) {
+	receiver, err := s.NewReceiver(enc, private, info)
+	if err != nil {
+		return nil, err
+	}
+	return receiver.Open(aad, ciphertext)
+}
+
+// SendExport exports a single secret of the given length shared with the
+// holder of the public key in the base mode, and returns the encapsulated
+// key with the secret.
+func (s *Suite) SendExport(public, info, exporterContext []byte, length int, rand cipher.Stream) ([]byte, []byte, error) {
+	enc, sender, err := s.NewSender(public, info, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	secret, err := sender.Export(exporterContext, length)
+	if err != nil {
+		return nil, nil, err
+	}
+	return enc, secret, nil
+}
+
+// ReceiveExport returns the secret exported with SendExport.
+func (s *Suite) ReceiveExport(enc, private, info, exporterContext []byte, length int) ([]byte, error) {
+	receiver, err := s.NewReceiver(enc, private, info)
+	if err != nil {
+		return nil, err
+	}
+	return receiver.Export(exporterContext, length)
+}
+
+// keySchedule derives the context of the given mode from the shared
+// secret, as in RFC 9180, section 5.1.
+func (s *Suite) keySchedule(mode byte, shared, info, psk, pskID []byte) (*context, error) {
+	if (len(psk) == 0) != (len(pskID) == 0) {
+		return nil, errors.New("hpke: inconsistent PSK inputs")
+	}
+	withPSK := mode == modePSK || mode == modeAuthPSK
+	if withPSK != (len(psk) > 0) {
+		return nil, errors.New("hpke: PSK input required by the mode only")
+	}
+
+	ksc := []byte{mode}
+	ksc = append(ksc, s.kdf.labeledExtract(s.id, nil, "psk_id_hash", pskID)...)
+	ksc = append(ksc, s.kdf.labeledExtract(s.id, nil, "info_hash", info)...)
+	secret := s.kdf.labeledExtract(s.id, shared, "secret", psk)
+
+	c := &context{suite: s}
+	var err error
+	if s.aead.New != nil {
+		key, err := s.kdf.labeledExpand(s.id, secret, "key", ksc, s.aead.KeySize)
+		if err != nil {
+			return nil, err
+		}
+		if c.aead, err = s.aead.New(key); err != nil {
+			return nil, err
+		}
+		if c.baseNonce, err = s.kdf.labeledExpand(s.id, secret, "base_nonce", ksc, s.aead.NonceSize); err != nil {
+			return nil, err
+		}
+	}
+	c.exporter, err = s.kdf.labeledExpand(s.id, secret, "exp", ksc, s.kdf.Hash().Size())
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// context is the state shared by the contexts of the sender and of the
+// receiver.
+type context struct {
+	suite     *Suite
+	aead      cipher.AEAD // nil if export-only
+	baseNonce []byte
+	seq       uint64
+	exporter  []byte
+}
+
+// nonce returns the nonce of the current sequence number.
+func (c *context) nonce() ([]byte, error) {
+	if c.seq == math.MaxUint64 {
+		return nil, errors.New("hpke: message limit reached")
+	}
+	nonce := append([]byte(nil), c.baseNonce...)
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], c.seq)
+	for i := range seq {
+		nonce[len(nonce)-8+i] ^= seq[i]
+	}
+	return nonce, nil
+}
+
+// Export returns a secret of the given length derived from the context
+// and from exporterContext.
+func (c *context) Export(exporterContext []byte, length int) ([]byte, error) {
+	return c.suite.kdf.labeledExpand(c.suite.id, c.exporter, "sec", exporterContext, length)
+}
+
+// Sender is the encryption context of the sender.
+type Sender struct {
+	context
+}
+
+// Seal encrypts the next message, authenticating the additional data aad
+// with it.
+func (s *Sender) Seal(aad, msg []byte) ([]byte, error) {
+	if s.aead == nil {
+		return nil, errExportOnly
+	}
+	nonce, err := s.nonce()
+	if err != nil {
+		return nil, err
+	}
+	s.seq++
+	return s.aead.Seal(nil, nonce, msg, aad), nil
+}
+
+// Receiver is the decryption context of the receiver.
+type Receiver struct {
+	context
+}
+
+// Open decrypts the next message, checking the additional data aad. A
+// ciphertext that fails to decrypt does not advance the context.
+func (r *Receiver) Open(aad, ciphertext []byte) ([]byte, error) {
+	if r.aead == nil {
+		return nil, errExportOnly
+	}
+	nonce, err := r.nonce()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := r.aead.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, errors.New("hpke: invalid ciphertext")
+	}
+	r.seq++
+	return msg, nil
+}
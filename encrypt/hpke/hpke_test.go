@@ -0,0 +1,336 @@
+package hpke
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+// testKEMs are the KEMs of the test vectors supported by the build.
+var testKEMs = map[uint16]KEM{
+	0x0020: DHKEMX25519(),
+}
+
+var testAEADs = map[uint16]AEAD{
+	0x0001: AES128GCM,
+	0x0002: AES256GCM,
+	0x0003: ChaCha20Poly1305,
+	0xffff: ExportOnly,
+}
+
+var testKDFs = map[uint16]KDF{
+	0x0001: HKDFSHA256,
+	0x0002: HKDFSHA384,
+	0x0003: HKDFSHA512,
+}
+
+// fixedStream is a random stream that returns fixed bytes.
+type fixedStream []byte
+
+func (f *fixedStream) XORKeyStream(dst, src []byte) {
+	for i := range src {
+		dst[i] = src[i] ^ (*f)[i]
+	}
+	*f = (*f)[len(src):]
+}
+
+func unhex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// drawInput reads a length-prefixed input from r.
+func drawInput(r io.Reader) []byte {
+	var l [1]byte
+	r.Read(l[:])
+	b := make([]byte, l[0])
+	r.Read(b)
+	return b
+}
+
+// TestVectors checks the test vectors of RFC 9180. Those of the base mode
+// are in the compact form of the Go standard library, which accumulates 1000
+// encryptions and exports of inputs drawn from SHAKE128 into a SHAKE128
+// digest. Those of the other modes, from Appendix A.1, list some of the
+// encryptions and exports instead.
+func TestVectors(t *testing.T) {
+	type vector struct {
+		Mode           byte   `json:"mode"`
+		KEM            uint16 `json:"kem_id"`
+		KDF            uint16 `json:"kdf_id"`
+		AEAD           uint16 `json:"aead_id"`
+		Info           string `json:"info"`
+		IkmE           string `json:"ikmE"`
+		IkmR           string `json:"ikmR"`
+		IkmS           string `json:"ikmS"`
+		SkRm           string `json:"skRm"`
+		PkRm           string `json:"pkRm"`
+		SkSm           string `json:"skSm"`
+		PkSm           string `json:"pkSm"`
+		PSK            string `json:"psk"`
+		PSKID          string `json:"psk_id"`
+		Enc            string `json:"enc"`
+		AccEncryptions string `json:"encryptions_accumulated"`
+		AccExports     string `json:"exports_accumulated"`
+		Encryptions    []struct {
+			Seq int    `json:"seq"`
+			AAD string `json:"aad"`
+			PT  string `json:"pt"`
+			CT  string `json:"ct"`
+		} `json:"encryptions"`
+		Exports []struct {
+			Context string `json:"exporter_context"`
+			L       int    `json:"L"`
+			Value   string `json:"exported_value"`
+		} `json:"exports"`
+	}
+	var vectors []vector
+	for _, file := range []string{"testdata/rfc9180.json", "testdata/rfc9180-modes.json"} {
+		data, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		var vs []vector
+		require.NoError(t, json.Unmarshal(data, &vs))
+		vectors = append(vectors, vs...)
+	}
+
+	// keyPair returns the key pair derived from ikm if given, checking it
+	// against sk and pk, or else the key pair sk and pk.
+	keyPair := func(kem KEM, ikm, sk, pk, name string) ([]byte, []byte) {
+		if ikm == "" {
+			return unhex(t, sk), unhex(t, pk)
+		}
+		s, p, err := kem.DeriveKeyPair(unhex(t, ikm))
+		require.NoError(t, err, name)
+		require.Equal(t, sk, hex.EncodeToString(s), name)
+		require.Equal(t, pk, hex.EncodeToString(p), name)
+		return s, p
+	}
+
+	modes := 0
+	for _, v := range vectors {
+		name := fmt.Sprintf("mode %d kem %04x kdf %04x aead %04x", v.Mode, v.KEM, v.KDF, v.AEAD)
+		kem, ok := testKEMs[v.KEM]
+		if !ok {
+			continue
+		}
+		suite := NewSuite(kem, testKDFs[v.KDF], testAEADs[v.AEAD])
+		sk, pk := keyPair(kem, v.IkmR, v.SkRm, v.PkRm, name)
+
+		ikmE := fixedStream(unhex(t, v.IkmE))
+		info, psk, pskID := unhex(t, v.Info), unhex(t, v.PSK), unhex(t, v.PSKID)
+		var enc []byte
+		var sender *Sender
+		var receiver *Receiver
+		var err error
+		switch v.Mode {
+		case 0:
+			enc, sender, err = suite.NewSender(pk, info, &ikmE)
+			require.NoError(t, err, name)
+			receiver, err = suite.NewReceiver(enc, sk, info)
+		case 1:
+			enc, sender, err = suite.NewSenderPSK(pk, info, psk, pskID, &ikmE)
+			require.NoError(t, err, name)
+			receiver, err = suite.NewReceiverPSK(enc, sk, info, psk, pskID)
+		case 2:
+			skS, pkS := keyPair(kem, v.IkmS, v.SkSm, v.PkSm, name)
+			enc, sender, err = suite.NewSenderAuth(pk, info, skS, &ikmE)
+			require.NoError(t, err, name)
+			receiver, err = suite.NewReceiverAuth(enc, sk, info, pkS)
+		case 3:
+			skS, pkS := keyPair(kem, v.IkmS, v.SkSm, v.PkSm, name)
+			enc, sender, err = suite.NewSenderAuthPSK(pk, info, psk, pskID, skS, &ikmE)
+			require.NoError(t, err, name)
+			receiver, err = suite.NewReceiverAuthPSK(enc, sk, info, psk, pskID, pkS)
+		default:
+			t.Fatalf("%s: unknown mode", name)
+		}
+		require.NoError(t, err, name)
+		require.Equal(t, v.Enc, hex.EncodeToString(enc), name)
+		if v.Mode != 0 {
+			modes++
+		}
+
+		if v.Encryptions != nil || v.Exports != nil {
+			seq := 0
+			for _, e := range v.Encryptions {
+				aad, msg := unhex(t, e.AAD), unhex(t, e.PT)
+				// the messages of the sequence numbers in between are
+				// not listed, and are only sealed to skip them
+				for ; seq <= e.Seq; seq++ {
+					ct, err := sender.Seal(aad, msg)
+					require.NoError(t, err, name)
+					pt, err := receiver.Open(aad, ct)
+					require.NoError(t, err, name)
+					require.True(t, bytes.Equal(msg, pt), name)
+					if seq == e.Seq {
+						require.Equal(t, e.CT, hex.EncodeToString(ct), name)
+					}
+				}
+			}
+			for _, e := range v.Exports {
+				secret, err := sender.Export(unhex(t, e.Context), e.L)
+				require.NoError(t, err, name)
+				require.Equal(t, e.Value, hex.EncodeToString(secret), name)
+				other, err := receiver.Export(unhex(t, e.Context), e.L)
+				require.NoError(t, err, name)
+				require.Equal(t, secret, other, name)
+			}
+			continue
+		}
+
+		if v.AEAD != ExportOnly.ID {
+			source, sink := sha3.NewShake128(), sha3.NewShake128()
+			for i := 0; i < 1000; i++ {
+				aad, msg := drawInput(source), drawInput(source)
+				ct, err := sender.Seal(aad, msg)
+				require.NoError(t, err, name)
+				sink.Write(ct)
+				pt, err := receiver.Open(aad, ct)
+				require.NoError(t, err, name)
+				require.True(t, bytes.Equal(msg, pt), name)
+			}
+			acc := make([]byte, 16)
+			sink.Read(acc)
+			require.Equal(t, v.AccEncryptions, hex.EncodeToString(acc), name)
+		} else {
+			_, err = sender.Seal(nil, nil)
+			require.Error(t, err, name)
+			_, err = receiver.Open(nil, nil)
+			require.Error(t, err, name)
+		}
+
+		source, sink := sha3.NewShake128(), sha3.NewShake128()
+		for l := 0; l < 1000; l++ {
+			ctx := drawInput(source)
+			secret, err := sender.Export(ctx, l)
+			require.NoError(t, err, name)
+			sink.Write(secret)
+			other, err := receiver.Export(ctx, l)
+			require.NoError(t, err, name)
+			require.Equal(t, secret, other, name)
+		}
+		acc := make([]byte, 16)
+		sink.Read(acc)
+		require.Equal(t, v.AccExports, hex.EncodeToString(acc), name)
+	}
+	require.Equal(t, 3, modes)
+}
+
+func TestModes(t *testing.T) {
+	rand := random.New()
+	kems := []KEM{
+		DHKEMX25519(),
+		NewDHKEM(0xff01, edwards25519.NewBlakeSHA256Ristretto255(), HKDFSHA512),
+	}
+	psk, pskID := []byte("pre-shared key of at least 32 bytes"), []byte("id")
+	info, aad, msg := []byte("info"), []byte("aad"), []byte("Hello HPKE")
+
+	for _, kem := range kems {
+		suite := NewSuite(kem, HKDFSHA256, ChaCha20Poly1305)
+		skR, pkR, err := kem.GenerateKeyPair(rand)
+		require.NoError(t, err)
+		skS, pkS, err := kem.GenerateKeyPair(rand)
+		require.NoError(t, err)
+		_, other, err := kem.GenerateKeyPair(rand)
+		require.NoError(t, err)
+
+		check := func(enc []byte, sender *Sender, receiver *Receiver, err error) {
+			require.NoError(t, err)
+			for i := 0; i < 3; i++ {
+				ct, err := sender.Seal(aad, msg)
+				require.NoError(t, err)
+				_, err = receiver.Open(nil, ct)
+				require.Error(t, err)
+				pt, err := receiver.Open(aad, ct)
+				require.NoError(t, err)
+				require.Equal(t, msg, pt)
+			}
+			a, err := sender.Export([]byte("ctx"), 32)
+			require.NoError(t, err)
+			b, err := receiver.Export([]byte("ctx"), 32)
+			require.NoError(t, err)
+			require.Equal(t, a, b)
+		}
+
+		enc, sender, err := suite.NewSender(pkR, info, rand)
+		require.NoError(t, err)
+		receiver, err := suite.NewReceiver(enc, skR, info)
+		check(enc, sender, receiver, err)
+
+		enc, sender, err = suite.NewSenderPSK(pkR, info, psk, pskID, rand)
+		require.NoError(t, err)
+		receiver, err = suite.NewReceiverPSK(enc, skR, info, psk, pskID)
+		check(enc, sender, receiver, err)
+		receiver, err = suite.NewReceiverPSK(enc, skR, info, []byte("other"), pskID)
+		require.NoError(t, err)
+		ct, err := sender.Seal(aad, msg)
+		require.NoError(t, err)
+		_, err = receiver.Open(aad, ct)
+		require.Error(t, err)
+
+		enc, sender, err = suite.NewSenderAuth(pkR, info, skS, rand)
+		require.NoError(t, err)
+		receiver, err = suite.NewReceiverAuth(enc, skR, info, pkS)
+		check(enc, sender, receiver, err)
+		receiver, err = suite.NewReceiverAuth(enc, skR, info, other)
+		require.NoError(t, err)
+		ct, err = sender.Seal(aad, msg)
+		require.NoError(t, err)
+		_, err = receiver.Open(aad, ct)
+		require.Error(t, err)
+
+		enc, sender, err = suite.NewSenderAuthPSK(pkR, info, psk, pskID, skS, rand)
+		require.NoError(t, err)
+		receiver, err = suite.NewReceiverAuthPSK(enc, skR, info, psk, pskID, pkS)
+		check(enc, sender, receiver, err)
+
+		// The PSK inputs must match the mode.
+		_, _, err = suite.NewSenderPSK(pkR, info, nil, nil, rand)
+		require.Error(t, err)
+		_, _, err = suite.NewSenderPSK(pkR, info, psk, nil, rand)
+		require.Error(t, err)
+
+		// Single-shot encryption and export.
+		enc, ct, err = suite.Seal(pkR, info, aad, msg, rand)
+		require.NoError(t, err)
+		pt, err := suite.Open(enc, skR, info, aad, ct)
+		require.NoError(t, err)
+		require.Equal(t, msg, pt)
+		_, err = suite.Open(enc, skR, []byte("other"), aad, ct)
+		require.Error(t, err)
+
+		enc, secret, err := suite.SendExport(pkR, info, []byte("ctx"), 48, rand)
+		require.NoError(t, err)
+		got, err := suite.ReceiveExport(enc, skR, info, []byte("ctx"), 48)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(secret, got))
+	}
+}
+
+func TestX25519(t *testing.T) {
+	kem := DHKEMX25519()
+	sk, _, err := kem.GenerateKeyPair(random.New())
+	require.NoError(t, err)
+
+	// Points of small order, like those of order 2 (u = 0) and 4 (u = 1),
+	// give an all-zero result and are rejected.
+	zero := make([]byte, 32)
+	_, err = kem.Decap(zero, sk)
+	require.Error(t, err)
+	one := make([]byte, 32)
+	one[0] = 1
+	_, err = kem.Decap(one, sk)
+	require.Error(t, err)
+	_, err = kem.Decap(zero[:31], sk)
+	require.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package hpke
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// KDF is a key derivation function of HPKE, HKDF with the given hash
+// function.
+type KDF struct {
+	ID   uint16
+	Hash func() hash.Hash
+}
+
+// The KDFs of RFC 9180.
+var (
+	HKDFSHA256 = KDF{ID: 0x0001, Hash: sha256.New}
+	HKDFSHA384 = KDF{ID: 0x0002, Hash: sha512.New384}
+	HKDFSHA512 = KDF{ID: 0x0003, Hash: sha512.New}
+)
+
+const versionLabel = "HPKE-v1"
+
+// labeledExtract is LabeledExtract of RFC 9180, section 4.
+func (k KDF) labeledExtract(suiteID, salt []byte, label string, ikm []byte) []byte {
+	labeled := append([]byte(versionLabel), suiteID...)
+	labeled = append(labeled, label...)
+	labeled = append(labeled, ikm...)
+	return hkdf.Extract(k.Hash, labeled, salt)
+}
+
+// labeledExpand is LabeledExpand of RFC 9180, section 4.
+func (k KDF) labeledExpand(suiteID, prk []byte, label string, info []byte, length int) ([]byte, error) {
+	if length < 0 || length > 255*k.Hash().Size() || length > 0xffff {
+		return nil, errors.New("hpke: invalid length of derived key")
+	}
+	labeled := make([]byte, 2, 2+len(versionLabel)+len(suiteID)+len(label)+len(info))
+	binary.BigEndian.PutUint16(labeled, uint16(length))
+	labeled = append(labeled, versionLabel...)
+	labeled = append(labeled, suiteID...)
+	labeled = append(labeled, label...)
+	labeled = append(labeled, info...)
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.Expand(k.Hash, prk, labeled), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AEAD is an authenticated encryption scheme of HPKE, given by the size of
+// its keys and nonces and by the constructor of its ciphers.
+type AEAD struct {
+	ID        uint16
+	KeySize   int
+	NonceSize int
+	New       func(key []byte) (cipher.AEAD, error)
+}
+
+// The AEADs of RFC 9180. ExportOnly provides no encryption: contexts using
+// it can only export secrets.
+var (
+	AES128GCM        = AEAD{ID: 0x0001, KeySize: 16, NonceSize: 12, New: newGCM}
+	AES256GCM        = AEAD{ID: 0x0002, KeySize: 32, NonceSize: 12, New: newGCM}
+	ChaCha20Poly1305 = AEAD{ID: 0x0003, KeySize: chacha20poly1305.KeySize, NonceSize: chacha20poly1305.NonceSize, New: chacha20poly1305.New}
+	ExportOnly       = AEAD{ID: 0xffff}
+)
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
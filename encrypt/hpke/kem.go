@@ -0,0 +1,327 @@
+package hpke
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/key"
+	"github.com/dedis/kyber/util/random"
+)
+
+// KEM is a key encapsulation mechanism of HPKE. Keys are handled in their
+// serialized form, as they are sent over the wire.
+type KEM interface {
+	// ID returns the identifier of the KEM.
+	ID() uint16
+
+	// DeriveKeyPair derives a private and public key pair from the
+	// input keying material ikm.
+	DeriveKeyPair(ikm []byte) (private, public []byte, err error)
+
+	// GenerateKeyPair picks a fresh private and public key pair.
+	GenerateKeyPair(rand cipher.Stream) (private, public []byte, err error)
+
+	// Encap returns a fresh shared secret for the public key, and its
+	// encapsulation enc.
+	Encap(public []byte, rand cipher.Stream) (shared, enc []byte, err error)
+
+	// Decap returns the shared secret encapsulated in enc for the
+	// private key.
+	Decap(enc, private []byte) ([]byte, error)
+
+	// AuthEncap is like Encap, but also authenticates the sender by its
+	// private key.
+	AuthEncap(public, senderPrivate []byte, rand cipher.Stream) (shared, enc []byte, err error)
+
+	// AuthDecap is like Decap, but also checks that the sender holds the
+	// private key of senderPublic.
+	AuthDecap(enc, private, senderPublic []byte) ([]byte, error)
+}
+
+var errDH = errors.New("hpke: invalid Diffie-Hellman result")
+
+// dhGroup is the group of a DHKEM, with the encodings of its keys and of
+// its Diffie-Hellman results.
+type dhGroup interface {
+	privateSize() int
+	deriveKey(k *dhkem, prk []byte) ([]byte, error)
+	public(private []byte) ([]byte, error)
+	dh(private, public []byte) ([]byte, error)
+}
+
+// dhkem is the Diffie-Hellman based KEM of RFC 9180, section 4.1.
+type dhkem struct {
+	id      uint16
+	kdf     KDF
+	group   dhGroup
+	suiteID []byte
+}
+
+func newDHKEM(id uint16, group dhGroup, kdf KDF) *dhkem {
+	suiteID := []byte("KEM\x00\x00")
+	binary.BigEndian.PutUint16(suiteID[3:], id)
+	return &dhkem{id: id, kdf: kdf, group: group, suiteID: suiteID}
+}
+
+// NewDHKEM returns a DHKEM over any kyber group whose points and scalars
+// have canonical binary encodings, which serialize its public and private
+// keys; the result of a Diffie-Hellman exchange is the encoding of the
+// shared point. Private keys are derived, as for the NIST curves of RFC
+// 9180, by rejection sampling of their encodings. The KEM is not one of
+// the RFC, and id must be chosen not to clash with them.
+func NewDHKEM(id uint16, g kyber.Group, kdf KDF) KEM {
+	return newDHKEM(id, &groupDH{g: g}, kdf)
+}
+
+// DHKEMX25519 returns DHKEM(X25519, HKDF-SHA256) of RFC 9180, computed with
+// the edwards25519 group. Unlike the X25519 function, it rejects public
+// keys that are points of the twist of curve25519.
+func DHKEMX25519() KEM {
+	return newDHKEM(0x0020, &x25519DH{g: new(edwards25519.Curve)}, HKDFSHA256)
+}
+
+func (k *dhkem) ID() uint16 {
+	return k.id
+}
+
+func (k *dhkem) DeriveKeyPair(ikm []byte) ([]byte, []byte, error) {
+	prk := k.kdf.labeledExtract(k.suiteID, nil, "dkp_prk", ikm)
+	private, err := k.group.deriveKey(k, prk)
+	if err != nil {
+		return nil, nil, err
+	}
+	public, err := k.group.public(private)
+	if err != nil {
+		return nil, nil, err
+	}
+	return private, public, nil
+}
+
+func (k *dhkem) GenerateKeyPair(rand cipher.Stream) ([]byte, []byte, error) {
+	ikm := make([]byte, k.group.privateSize())
+	random.Bytes(ikm, rand)
+	return k.DeriveKeyPair(ikm)
+}
+
+func (k *dhkem) Encap(public []byte, rand cipher.Stream) ([]byte, []byte, error) {
+	return k.encap(public, nil, rand)
+}
+
+func (k *dhkem) AuthEncap(public, senderPrivate []byte, rand cipher.Stream) ([]byte, []byte, error) {
+	return k.encap(public, senderPrivate, rand)
+}
+
+func (k *dhkem) Decap(enc, private []byte) ([]byte, error) {
+	return k.decap(enc, private, nil)
+}
+
+func (k *dhkem) AuthDecap(enc, private, senderPublic []byte) ([]byte, error) {
+	return k.decap(enc, private, senderPublic)
+}
+
+// encap encapsulates a secret for the public key, authenticated with the
+// private key of the sender if it is not nil.
+func (k *dhkem) encap(public, senderPrivate []byte, rand cipher.Stream) ([]byte, []byte, error) {
+	ephemeral, enc, err := k.GenerateKeyPair(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	dh, err := k.group.dh(ephemeral, public)
+	if err != nil {
+		return nil, nil, err
+	}
+	context := append(append([]byte(nil), enc...), public...)
+	if senderPrivate != nil {
+		dhs, err := k.group.dh(senderPrivate, public)
+		if err != nil {
+			return nil, nil, err
+		}
+		senderPublic, err := k.group.public(senderPrivate)
+		if err != nil {
+			return nil, nil, err
+		}
+		dh = append(dh, dhs...)
+		context = append(context, senderPublic...)
+	}
+	shared, err := k.extractAndExpand(dh, context)
+	if err != nil {
+		return nil, nil, err
+	}
+	return shared, enc, nil
+}
+
+// decap decapsulates the secret of enc with the private key, authenticated
+// with the public key of the sender if it is not nil.
+func (k *dhkem) decap(enc, private, senderPublic []byte) ([]byte, error) {
+	dh, err := k.group.dh(private, enc)
+	if err != nil {
+		return nil, err
+	}
+	public, err := k.group.public(private)
+	if err != nil {
+		return nil, err
+	}
+	context := append(append([]byte(nil), enc...), public...)
+	if senderPublic != nil {
+		dhs, err := k.group.dh(private, senderPublic)
+		if err != nil {
+			return nil, err
+		}
+		dh = append(dh, dhs...)
+		context = append(context, senderPublic...)
+	}
+	return k.extractAndExpand(dh, context)
+}
+
+func (k *dhkem) extractAndExpand(dh, context []byte) ([]byte, error) {
+	prk := k.kdf.labeledExtract(k.suiteID, nil, "eae_prk", dh)
+	return k.kdf.labeledExpand(k.suiteID, prk, "shared_secret", context, k.kdf.Hash().Size())
+}
+
+// groupDH is the dhGroup of a kyber group, with the binary encodings of
+// its points and scalars. If xOnly is set, the result of a Diffie-Hellman
+// exchange is the x-coordinate of the uncompressed SEC1 encoding of the
+// shared point.
+type groupDH struct {
+	g     kyber.Group
+	xOnly bool
+}
+
+func (d *groupDH) privateSize() int {
+	return d.g.ScalarLen()
+}
+
+func (d *groupDH) deriveKey(k *dhkem, prk []byte) ([]byte, error) {
+	for counter := 0; counter < 256; counter++ {
+		b, err := k.kdf.labeledExpand(k.suiteID, prk, "candidate", []byte{byte(counter)}, d.g.ScalarLen())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := d.scalar(b); err == nil {
+			return b, nil
+		}
+	}
+	return nil, errors.New("hpke: cannot derive key pair")
+}
+
+// scalar decodes a private key, which must be the canonical encoding of a
+// non-zero scalar.
+func (d *groupDH) scalar(private []byte) (kyber.Scalar, error) {
+	s := d.g.Scalar()
+	if len(private) != d.g.ScalarLen() || s.UnmarshalBinary(private) != nil {
+		return nil, errors.New("hpke: invalid private key")
+	}
+	b, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(b, private) != 1 || s.Equal(d.g.Scalar().Zero()) {
+		return nil, errors.New("hpke: invalid private key")
+	}
+	return s, nil
+}
+
+func (d *groupDH) public(private []byte) ([]byte, error) {
+	s, err := d.scalar(private)
+	if err != nil {
+		return nil, err
+	}
+	return d.g.Point().Mul(s, nil).MarshalBinary()
+}
+
+func (d *groupDH) dh(private, public []byte) ([]byte, error) {
+	s, err := d.scalar(private)
+	if err != nil {
+		return nil, err
+	}
+	P := d.g.Point()
+	if len(public) != d.g.PointLen() || P.UnmarshalBinary(public) != nil ||
+		key.ValidatePublicKey(d.g, P) != nil {
+		return nil, errors.New("hpke: invalid public key")
+	}
+	Z := d.g.Point().Mul(s, P)
+	if Z.Equal(d.g.Point().Null()) {
+		return nil, errDH
+	}
+	b, err := Z.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if d.xOnly {
+		b = b[1 : 1+(len(b)-1)/2]
+	}
+	return b, nil
+}
+
+// x25519DH is the dhGroup of X25519, computed on the birationally
+// equivalent edwards25519 curve.
+type x25519DH struct {
+	g kyber.Group
+}
+
+func (d *x25519DH) privateSize() int {
+	return 32
+}
+
+func (d *x25519DH) deriveKey(k *dhkem, prk []byte) ([]byte, error) {
+	return k.kdf.labeledExpand(k.suiteID, prk, "sk", nil, 32)
+}
+
+// mul returns the u-coordinate of k*P, where k is the clamped private key
+// of X25519, and P is the base point if nil.
+func (d *x25519DH) mul(private []byte, P kyber.Point) ([]byte, error) {
+	if len(private) != 32 {
+		return nil, errors.New("hpke: invalid private key")
+	}
+	var k [32]byte
+	copy(k[:], private)
+	k[0] &= 248
+	k[31] &= 127
+	k[31] |= 64
+	// k is a multiple of the cofactor, which clears any small-order
+	// component of P: k*P = (k/8)*(8*P), where k/8 can be reduced modulo
+	// the order of the prime-order subgroup.
+	for i := 0; i < 31; i++ {
+		k[i] = k[i]>>3 | k[i+1]<<5
+	}
+	k[31] >>= 3
+	if P == nil {
+		P = d.g.Point().Base()
+	}
+	P = d.g.Point().Mul(d.g.Scalar().SetInt64(8), P)
+	Z := d.g.Point().Mul(d.g.Scalar().SetBytes(k[:]), P)
+	if Z.Equal(d.g.Point().Null()) {
+		return nil, errDH
+	}
+	b, err := Z.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var ed, u [32]byte
+	copy(ed[:], b)
+	if !edwards25519.PublicKeyToCurve25519(&u, &ed) {
+		return nil, errDH
+	}
+	return u[:], nil
+}
+
+func (d *x25519DH) public(private []byte) ([]byte, error) {
+	return d.mul(private, nil)
+}
+
+func (d *x25519DH) dh(private, public []byte) ([]byte, error) {
+	if len(public) != 32 {
+		return nil, errors.New("hpke: invalid public key")
+	}
+	var u, ed [32]byte
+	copy(u[:], public)
+	P := d.g.Point()
+	if !edwards25519.Curve25519ToPublicKey(&ed, &u) || P.UnmarshalBinary(ed[:]) != nil {
+		return nil, errors.New("hpke: invalid public key")
+	}
+	return d.mul(private, P)
+}
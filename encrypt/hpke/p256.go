@@ -0,0 +1,10 @@
+// +build vartime
+
+package hpke
+
+import "github.com/dedis/kyber/group/nist"
+
+// DHKEMP256 returns DHKEM(P-256, HKDF-SHA256) of RFC 9180.
+func DHKEMP256() KEM {
+	return newDHKEM(0x0010, &groupDH{g: nist.NewShakeSHA256P256(), xOnly: true}, HKDFSHA256)
+}
@@ -0,0 +1,7 @@
+// +build vartime
+
+package hpke
+
+func init() {
+	testKEMs[0x0010] = DHKEMP256()
+}
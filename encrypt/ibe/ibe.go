@@ -0,0 +1,160 @@
+// Package ibe implements the identity-based encryption of Boneh and
+// Franklin, "Identity-based encryption from the Weil pairing" (CRYPTO
+// 2001), over a pairing-friendly suite.
+//
+// Any string, such as an email address or a round number, serves as a
+// public key. A private key generator holds a master secret s, created
+// with Setup, and publishes the master public key s*B2, a point of G2.
+// Anyone can encrypt a message for an identity with the master public key
+// only, and the generator extracts the private key of an identity, s*H(id),
+// a point of G1, with Extract.
+//
+// The private key of an identity is the BLS signature of the identity by
+// the generator, exactly as created by package sign/bls, which can be
+// checked with VerifyKey. In particular, a threshold BLS signature of an
+// identity by a distributed generator, such as a randomness beacon signing
+// round numbers, decrypts the ciphertexts for that identity: this is how
+// timelock encryption works. Conversely, a master secret must not be used
+// to sign anything but the identities whose keys it extracts.
+//
+// Encryption follows the FullIdent variant of the paper, which is secure
+// against chosen ciphertext attacks.
+package ibe
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+)
+
+// sigmaSize is the size in bytes of the random value that encrypts the
+// message.
+const sigmaSize = 32
+
+// dst is the domain separation tag of the hash to G1 of identities, that of
+// the messages of package sign/bls.
+var dst = []byte("BLS_SIG_KYBER_G1_NUL_")
+
+var errInvalidCiphertext = errors.New("ibe: invalid ciphertext")
+
+// Ciphertext is the encryption of a message M for an identity: U = r*B2,
+// V = sigma XOR H(e(H(id), s*B2)^r) and W = M XOR H(sigma), where sigma is
+// random and r = H(sigma, M).
+type Ciphertext struct {
+	U kyber.Point
+	V []byte
+	W []byte
+}
+
+// Setup returns a new master secret s of a private key generator and its
+// master public key s*B2.
+func Setup(suite pairing.Suite, random cipher.Stream) (kyber.Scalar, kyber.Point) {
+	s := suite.G2().Scalar().Pick(random)
+	return s, suite.G2().Point().Mul(s, nil)
+}
+
+// Extract returns the private key s*H(id) of the identity.
+func Extract(suite pairing.Suite, master kyber.Scalar, id []byte) kyber.Point {
+	Q := pairing.HashToPoint(suite, suite.G1(), dst, id)
+	return Q.Mul(master, Q)
+}
+
+// VerifyKey checks that key is the private key of the identity for the
+// master public key, by verifying that e(H(id), s*B2) = e(key, B2). It
+// returns nil if the key is valid.
+func VerifyKey(suite pairing.Suite, public kyber.Point, id []byte, key kyber.Point) error {
+	p1 := []kyber.Point{pairing.HashToPoint(suite, suite.G1(), dst, id), suite.G1().Point().Neg(key)}
+	p2 := []kyber.Point{public, suite.G2().Point().Base()}
+	if !suite.PairingCheck(p1, p2) {
+		return errors.New("ibe: invalid private key")
+	}
+	return nil
+}
+
+// Encrypt encrypts msg for the identity under the master public key.
+func Encrypt(suite pairing.Suite, public kyber.Point, id, msg []byte) (*Ciphertext, error) {
+	sigma := make([]byte, sigmaSize)
+	suite.RandomStream().XORKeyStream(sigma, sigma)
+	r := hashToScalar(suite, sigma, msg)
+
+	// e(H(id), s*B2)^r = e(H(id), r*s*B2)
+	U := suite.G2().Point().Mul(r, nil)
+	g := suite.Pair(pairing.HashToPoint(suite, suite.G1(), dst, id), suite.G2().Point().Mul(r, public))
+	mask, err := gtMask(suite, g)
+	if err != nil {
+		return nil, err
+	}
+	return &Ciphertext{
+		U: U,
+		V: xor(sigma, mask),
+		W: xor(msg, sigmaMask(suite, sigma, len(msg))),
+	}, nil
+}
+
+// Decrypt decrypts the ciphertext with the private key of its identity.
+func Decrypt(suite pairing.Suite, key kyber.Point, c *Ciphertext) ([]byte, error) {
+	if c == nil || c.U == nil || len(c.V) != sigmaSize {
+		return nil, errInvalidCiphertext
+	}
+	mask, err := gtMask(suite, suite.Pair(key, c.U))
+	if err != nil {
+		return nil, err
+	}
+	sigma := xor(c.V, mask)
+	msg := xor(c.W, sigmaMask(suite, sigma, len(c.W)))
+	r := hashToScalar(suite, sigma, msg)
+	U, err := suite.G2().Point().Mul(r, nil).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	cU, err := c.U.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare(U, cU) != 1 {
+		return nil, errInvalidCiphertext
+	}
+	return msg, nil
+}
+
+// hashToScalar derives the randomness r of the encryption from sigma and
+// the message.
+func hashToScalar(suite pairing.Suite, sigma, msg []byte) kyber.Scalar {
+	h := suite.XOF([]byte("IBE_BF_KYBER_H3_"))
+	h.Write(sigma)
+	h.Write(msg)
+	return suite.G2().Scalar().Pick(h)
+}
+
+// gtMask hashes a point of GT to the mask of sigma.
+func gtMask(suite pairing.Suite, g kyber.Point) ([]byte, error) {
+	b, err := g.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	h := suite.XOF([]byte("IBE_BF_KYBER_H2_"))
+	h.Write(b)
+	mask := make([]byte, sigmaSize)
+	h.Read(mask)
+	return mask, nil
+}
+
+// sigmaMask hashes sigma to the mask of a message of the given length.
+func sigmaMask(suite pairing.Suite, sigma []byte, length int) []byte {
+	h := suite.XOF([]byte("IBE_BF_KYBER_H4_"))
+	h.Write(sigma)
+	mask := make([]byte, length)
+	h.Read(mask)
+	return mask
+}
+
+func xor(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+	return out
+}
@@ -0,0 +1,76 @@
+// +build vartime
+
+package ibe
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/sign/bls"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+func TestEncrypt(t *testing.T) {
+	master, public := Setup(suite, random.New())
+	id := []byte("alice@example.com")
+	msg := []byte("Hello Boneh-Franklin")
+
+	key := Extract(suite, master, id)
+	require.NoError(t, VerifyKey(suite, public, id, key))
+	c, err := Encrypt(suite, public, id, msg)
+	require.NoError(t, err)
+	got, err := Decrypt(suite, key, c)
+	require.NoError(t, err)
+	require.Equal(t, msg, got)
+
+	// The key of another identity neither verifies nor decrypts.
+	other := Extract(suite, master, []byte("bob@example.com"))
+	require.Error(t, VerifyKey(suite, public, id, other))
+	_, err = Decrypt(suite, other, c)
+	require.Error(t, err)
+
+	// Modified ciphertexts are rejected.
+	bad := *c
+	bad.W = append([]byte(nil), c.W...)
+	bad.W[0] ^= 1
+	_, err = Decrypt(suite, key, &bad)
+	require.Error(t, err)
+	bad = *c
+	bad.U = suite.G2().Point().Add(c.U, suite.G2().Point().Base())
+	_, err = Decrypt(suite, key, &bad)
+	require.Error(t, err)
+	bad = *c
+	bad.V = c.V[1:]
+	_, err = Decrypt(suite, key, &bad)
+	require.Error(t, err)
+
+	// Empty messages are supported.
+	c, err = Encrypt(suite, public, id, nil)
+	require.NoError(t, err)
+	got, err = Decrypt(suite, key, c)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestBLSKey(t *testing.T) {
+	// A private key is a BLS signature of the identity by the generator.
+	master, public := Setup(suite, random.New())
+	id := []byte("round 42")
+	key := Extract(suite, master, id)
+	sig, err := key.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, bls.Verify(suite, public, id, sig))
+
+	sig, err = bls.Sign(suite, master, id)
+	require.NoError(t, err)
+	key = suite.G1().Point()
+	require.NoError(t, key.UnmarshalBinary(sig))
+	c, err := Encrypt(suite, public, id, []byte("Hello BLS"))
+	require.NoError(t, err)
+	got, err := Decrypt(suite, key, c)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello BLS"), got)
+}
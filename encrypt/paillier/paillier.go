@@ -0,0 +1,187 @@
+// +build vartime
+
+// Package paillier implements the Paillier public-key cryptosystem, whose
+// ciphertexts are additively homomorphic: anyone can add two encrypted
+// values, or multiply an encrypted value by a known constant, without
+// decrypting them.
+//
+// The package also provides the zero-knowledge proofs needed to use
+// Paillier encryption between mutually distrustful parties, as in the
+// multiplicative-to-additive share conversions of threshold ECDSA (see
+// package sign/tecdsa): proofs that a modulus is well formed, that
+// ring-Pedersen commitment parameters are, and that ciphertexts were
+// correctly computed from values in a range. The proofs are made
+// non-interactive with the transcripts of package proof/transcript, given by
+// the caller.
+//
+// Plaintexts and ciphertexts are big.Int values. The package uses math/big
+// and is not constant time, so it must be compiled with the "vartime"
+// compilation flag.
+package paillier
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/dedis/kyber/internal/bigint"
+)
+
+var (
+	one  = big.NewInt(1)
+	two  = big.NewInt(2)
+	four = big.NewInt(4)
+)
+
+var errInvalidCiphertext = errors.New("paillier: invalid ciphertext")
+
+// PublicKey is a Paillier public key, a modulus N, with generator N+1.
+type PublicKey struct {
+	N *big.Int
+}
+
+// PrivateKey is a Paillier private key: the factorization of N into two
+// primes P and Q.
+type PrivateKey struct {
+	PublicKey
+	P, Q *big.Int
+}
+
+// GenerateKey returns a Paillier private key whose modulus has the given
+// number of bits, at least 1024. The factors of the modulus are congruent
+// to 3 modulo 4, so that it can be proven well formed with ProveModulus.
+func GenerateKey(bits int, rand cipher.Stream) (*PrivateKey, error) {
+	if bits < 1024 {
+		return nil, errors.New("paillier: modulus too short")
+	}
+	r := &streamReader{rand}
+	for {
+		p, err := blumPrime(r, bits/2)
+		if err != nil {
+			return nil, err
+		}
+		q, err := blumPrime(r, bits-bits/2)
+		if err != nil {
+			return nil, err
+		}
+		if p.Cmp(q) == 0 {
+			continue
+		}
+		sk := &PrivateKey{PublicKey{new(big.Int).Mul(p, q)}, p, q}
+		// The generator N+1 requires gcd(N, phi(N)) = 1.
+		if sk.N.BitLen() != bits || new(big.Int).ModInverse(sk.phi(), sk.N) == nil {
+			continue
+		}
+		return sk, nil
+	}
+}
+
+// blumPrime returns a random prime of the given size congruent to 3 mod 4.
+func blumPrime(r *streamReader, bits int) (*big.Int, error) {
+	for {
+		p, err := rand.Prime(r, bits)
+		if err != nil {
+			return nil, err
+		}
+		if p.Bit(1) == 1 {
+			return p, nil
+		}
+	}
+}
+
+// streamReader reads the key stream of a cipher.Stream.
+type streamReader struct {
+	cipher.Stream
+}
+
+func (r *streamReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 0
+	}
+	r.XORKeyStream(b, b)
+	return len(b), nil
+}
+
+// n2 returns N^2, the modulus of the ciphertexts.
+func (pk *PublicKey) n2() *big.Int {
+	return new(big.Int).Mul(pk.N, pk.N)
+}
+
+// Encrypt returns the encryption of m, with a fresh nonce which it also
+// returns. The plaintext m is taken modulo N.
+func (pk *PublicKey) Encrypt(m *big.Int, rand cipher.Stream) (c, nonce *big.Int) {
+	nonce = bigint.RandomUnit(pk.N, rand)
+	return pk.EncryptWithNonce(m, nonce), nonce
+}
+
+// EncryptWithNonce returns the encryption (1+N)^m * nonce^N mod N^2 of m
+// with the given nonce, a unit modulo N.
+func (pk *PublicKey) EncryptWithNonce(m, nonce *big.Int) *big.Int {
+	n2 := pk.n2()
+	// (1+N)^m = 1 + mN mod N^2
+	c := new(big.Int).Mod(m, pk.N)
+	c.Mul(c, pk.N)
+	c.Add(c, one)
+	c.Mul(c, new(big.Int).Exp(nonce, pk.N, n2))
+	return c.Mod(c, n2)
+}
+
+// Add returns a ciphertext of the sum of the plaintexts of c1 and c2.
+func (pk *PublicKey) Add(c1, c2 *big.Int) *big.Int {
+	n2 := pk.n2()
+	c := new(big.Int).Mul(c1, c2)
+	return c.Mod(c, n2)
+}
+
+// Mul returns a ciphertext of k times the plaintext of c. The constant k
+// can be negative.
+func (pk *PublicKey) Mul(c, k *big.Int) *big.Int {
+	return new(big.Int).Exp(c, k, pk.n2())
+}
+
+// Rerandomize returns a fresh ciphertext of the plaintext of c, which
+// cannot be linked to c without the private key, and its added nonce.
+func (pk *PublicKey) Rerandomize(c *big.Int, rand cipher.Stream) (*big.Int, *big.Int) {
+	zero, nonce := pk.Encrypt(new(big.Int), rand)
+	return pk.Add(c, zero), nonce
+}
+
+// ValidCiphertext reports whether c is a ciphertext for the key, that is a
+// unit modulo N^2.
+func (pk *PublicKey) ValidCiphertext(c *big.Int) bool {
+	return bigint.IsUnit(c, pk.n2())
+}
+
+func (sk *PrivateKey) phi() *big.Int {
+	return new(big.Int).Mul(new(big.Int).Sub(sk.P, one), new(big.Int).Sub(sk.Q, one))
+}
+
+// Decrypt returns the plaintext of c, in [0, N).
+func (sk *PrivateKey) Decrypt(c *big.Int) (*big.Int, error) {
+	if !sk.ValidCiphertext(c) {
+		return nil, errInvalidCiphertext
+	}
+	// m = L(c^phi mod N^2) * phi^-1 mod N, with L(u) = (u-1)/N
+	phi := sk.phi()
+	m := new(big.Int).Exp(c, phi, sk.n2())
+	m.Sub(m, one)
+	m.Div(m, sk.N)
+	m.Mul(m, new(big.Int).ModInverse(phi, sk.N))
+	return m.Mod(m, sk.N), nil
+}
+
+// Nonce returns the nonce of c, a ciphertext of m, which proves that c
+// decrypts to m when published with it.
+func (sk *PrivateKey) Nonce(c, m *big.Int) (*big.Int, error) {
+	if !sk.ValidCiphertext(c) {
+		return nil, errInvalidCiphertext
+	}
+	// c = nonce^N mod N, since (1+N)^m = 1 mod N.
+	nonce := new(big.Int).Mod(c, sk.N)
+	nonce.Exp(nonce, new(big.Int).ModInverse(sk.N, sk.phi()), sk.N)
+	if sk.EncryptWithNonce(m, nonce).Cmp(c) != 0 {
+		return nil, errors.New("paillier: ciphertext does not encrypt plaintext")
+	}
+	return nonce, nil
+}
@@ -0,0 +1,180 @@
+// +build vartime
+
+package paillier
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/dedis/kyber/group/nist"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	keysOnce sync.Once
+	keys     [2]*PrivateKey
+)
+
+// testKeys returns two 2048-bit keys, generated once since they are slow
+// to create.
+func testKeys(t *testing.T) [2]*PrivateKey {
+	keysOnce.Do(func() {
+		for i := range keys {
+			sk, err := GenerateKey(2048, random.New())
+			if err != nil {
+				panic(err)
+			}
+			keys[i] = sk
+		}
+	})
+	return keys
+}
+
+func TestEncrypt(t *testing.T) {
+	rand := random.New()
+	sk, err := GenerateKey(1024, rand)
+	require.NoError(t, err)
+	require.Equal(t, 1024, sk.N.BitLen())
+	pk := &sk.PublicKey
+
+	m1 := random.Int(pk.N, rand)
+	m2 := big.NewInt(42)
+	c1, nonce := pk.Encrypt(m1, rand)
+	c2, _ := pk.Encrypt(m2, rand)
+	require.Equal(t, c1, pk.EncryptWithNonce(m1, nonce))
+	d, err := sk.Decrypt(c1)
+	require.NoError(t, err)
+	require.Equal(t, m1, d)
+
+	n, err := sk.Nonce(c1, m1)
+	require.NoError(t, err)
+	require.Equal(t, nonce, n)
+	_, err = sk.Nonce(c1, m2)
+	require.Error(t, err)
+
+	// Enc(m1) * Enc(m2)^-3 = Enc(m1 - 3*m2)
+	c := pk.Add(c1, pk.Mul(c2, big.NewInt(-3)))
+	d, err = sk.Decrypt(c)
+	require.NoError(t, err)
+	expected := new(big.Int).Sub(m1, big.NewInt(3*42))
+	require.Equal(t, expected.Mod(expected, pk.N), d)
+
+	r, _ := pk.Rerandomize(c1, rand)
+	require.NotEqual(t, c1, r)
+	d, err = sk.Decrypt(r)
+	require.NoError(t, err)
+	require.Equal(t, m1, d)
+
+	_, err = sk.Decrypt(new(big.Int).Mul(pk.N, big.NewInt(2)))
+	require.Error(t, err)
+	_, err = sk.Decrypt(pk.n2())
+	require.Error(t, err)
+
+	_, err = GenerateKey(512, rand)
+	require.Error(t, err)
+}
+
+// tr returns a new transcript of the given label for the proofs.
+func tr(label string) *transcript.Transcript {
+	return transcript.New(nist.NewBlakeSHA256P256(), label)
+}
+
+func TestModulusProof(t *testing.T) {
+	rand := random.New()
+	sk := testKeys(t)[0]
+	p, err := ProveModulus(tr("setup"), sk, rand)
+	require.NoError(t, err)
+	require.NoError(t, p.Verify(tr("setup"), sk.N))
+	require.Error(t, p.Verify(tr("other"), sk.N))
+	require.Error(t, p.Verify(tr("setup"), testKeys(t)[1].N))
+
+	// A modulus with a factor congruent to 1 modulo 4 cannot be proven.
+	p1 := new(big.Int)
+	for p1.Bit(1) == 1 || !p1.ProbablyPrime(20) {
+		p1 = random.Int(new(big.Int).Lsh(one, 1024), rand)
+	}
+	bad := &PrivateKey{PublicKey{new(big.Int).Mul(p1, sk.Q)}, p1, sk.Q}
+	if p, err = ProveModulus(tr("setup"), bad, rand); err == nil {
+		require.Error(t, p.Verify(tr("setup"), bad.N))
+	}
+}
+
+func TestPedersenProof(t *testing.T) {
+	ped, p := NewPedersenParams(tr("setup"), testKeys(t)[0], random.New())
+	require.NoError(t, p.Verify(tr("setup"), ped))
+	require.Error(t, p.Verify(tr("other"), ped))
+
+	bad := *ped
+	bad.H2 = new(big.Int).Add(bad.H2, one)
+	require.Error(t, p.Verify(tr("setup"), &bad))
+	bad.H2 = one
+	require.Error(t, p.Verify(tr("setup"), &bad))
+}
+
+func TestRangeProof(t *testing.T) {
+	rand := random.New()
+	q := nist.NewBlakeSHA256P256().Order()
+	keys := testKeys(t)
+	pk := &keys[0].PublicKey
+	ped, _ := NewPedersenParams(tr("setup"), keys[1], rand)
+
+	m := random.Int(q, rand)
+	c, nonce := pk.Encrypt(m, rand)
+	p := ProveRange(tr("context"), q, pk, ped, c, m, nonce, rand)
+	require.NoError(t, p.Verify(tr("context"), q, pk, ped, c))
+	require.Error(t, p.Verify(tr("other"), q, pk, ped, c))
+	c2, _ := pk.Encrypt(m, rand)
+	require.Error(t, p.Verify(tr("context"), q, pk, ped, c2))
+
+	// A plaintext far out of range cannot be proven.
+	m = new(big.Int).Exp(q, big.NewInt(4), nil)
+	c, nonce = pk.Encrypt(m, rand)
+	p = ProveRange(tr("context"), q, pk, ped, c, m, nonce, rand)
+	require.Error(t, p.Verify(tr("context"), q, pk, ped, c))
+}
+
+func TestAffineProof(t *testing.T) {
+	rand := random.New()
+	g := nist.NewBlakeSHA256P256()
+	q := g.Order()
+	keys := testKeys(t)
+	pk := &keys[0].PublicKey
+	ped, _ := NewPedersenParams(tr("setup"), keys[0], rand)
+
+	c1, _ := pk.Encrypt(random.Int(q, rand), rand)
+	x := random.Int(q, rand)
+	y := random.Int(new(big.Int).Exp(q, big.NewInt(5), nil), rand)
+	enc, nonce := pk.Encrypt(y, rand)
+	c2 := pk.Add(pk.Mul(c1, x), enc)
+	X := g.Point().Mul(scalar(g, x), nil)
+
+	p := ProveAffine(tr("context"), g, q, pk, ped, c1, c2, x, y, nonce, nil, rand)
+	require.NoError(t, p.Verify(tr("context"), g, q, pk, ped, c1, c2, nil))
+	require.Error(t, p.Verify(tr("context"), g, q, pk, ped, c1, c2, X))
+	require.Error(t, p.Verify(tr("context"), g, q, pk, ped, c2, c1, nil))
+
+	p = ProveAffine(tr("context"), g, q, pk, ped, c1, c2, x, y, nonce, X, rand)
+	require.NoError(t, p.Verify(tr("context"), g, q, pk, ped, c1, c2, X))
+	require.Error(t, p.Verify(tr("context"), g, q, pk, ped, c1, c2, g.Point().Base()))
+}
+
+func TestLogProof(t *testing.T) {
+	rand := random.New()
+	g := nist.NewBlakeSHA256P256()
+	q := g.Order()
+	keys := testKeys(t)
+	pk := &keys[0].PublicKey
+	ped, _ := NewPedersenParams(tr("setup"), keys[1], rand)
+
+	x := random.Int(q, rand)
+	c, nonce := pk.Encrypt(x, rand)
+	R := g.Point().Pick(rand)
+	Q := g.Point().Mul(scalar(g, x), R)
+	p := ProveLog(tr("context"), g, q, pk, ped, c, R, Q, x, nonce, rand)
+	require.NoError(t, p.Verify(tr("context"), g, q, pk, ped, c, R, Q))
+	require.Error(t, p.Verify(tr("context"), g, q, pk, ped, c, R, R))
+	require.Error(t, p.Verify(tr("context"), g, q, pk, ped, c, Q, Q))
+}
@@ -0,0 +1,498 @@
+// +build vartime
+
+package paillier
+
+import (
+	"crypto/cipher"
+	"errors"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/bigint"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/util/random"
+)
+
+// The proofs are made non-interactive with the Fiat-Shamir heuristic, with
+// the transcript given by the caller, which should identify the session and
+// the prover; see package proof/transcript. A proof appends its statement
+// and commitments to the transcript, so that the verifier must check it on
+// a transcript in the same state as the one of the prover. The proofs about ciphertexts are those of
+// Gennaro and Goldfeder, https://eprint.iacr.org/2019/114, appendix A, and
+// the proofs about the parameters follow Canetti et al.,
+// https://eprint.iacr.org/2021/060, section 6.
+
+// setupRounds is the number of repetitions of the binary-challenge proofs
+// about parameters, for a soundness error of 2^-80.
+const setupRounds = 80
+
+var errInvalidProof = errors.New("paillier: invalid proof")
+
+// scalar returns the scalar of the group with value v, for groups whose
+// scalars are big-endian integers.
+func scalar(g kyber.Group, v *big.Int) kyber.Scalar {
+	return g.Scalar().SetBytes(v.Bytes())
+}
+
+// PedersenParams are ring-Pedersen commitment parameters: a modulus N and
+// two quadratic residues H1 and H2 that generate the same subgroup of
+// Z*_N, with discrete logarithms in base each other only known to the
+// owner of the factorization of N. A commitment to x with randomness r is
+// H1^x * H2^r mod N.
+type PedersenParams struct {
+	N, H1, H2 *big.Int
+}
+
+// PedersenProof proves that H1 and H2 generate the same group, by proving
+// the knowledge of the discrete logarithm of each in base the other.
+type PedersenProof struct {
+	A, Z       []*big.Int // H2 in base H1
+	AInv, ZInv []*big.Int // H1 in base H2
+}
+
+// NewPedersenParams returns ring-Pedersen parameters modulo the modulus
+// of the key, with a proof of their correctness on the transcript t.
+func NewPedersenParams(t *transcript.Transcript, sk *PrivateKey, rand cipher.Stream) (*PedersenParams, *PedersenProof) {
+	phi := sk.phi()
+	tau := bigint.RandomUnit(sk.N, rand)
+	lambda := bigint.RandomUnit(phi, rand)
+	h1 := new(big.Int).Exp(tau, two, sk.N)
+	h2 := new(big.Int).Exp(h1, lambda, sk.N)
+	a, z := provePrm(t, sk.N, h1, h2, lambda, phi, rand)
+	inv := new(big.Int).ModInverse(lambda, phi)
+	aInv, zInv := provePrm(t, sk.N, h2, h1, inv, phi, rand)
+	return &PedersenParams{sk.N, h1, h2}, &PedersenProof{a, z, aInv, zInv}
+}
+
+// commit returns H1^x * H2^r mod N.
+func (p *PedersenParams) commit(x, r *big.Int) *big.Int {
+	c := new(big.Int).Exp(p.H1, x, p.N)
+	c.Mul(c, new(big.Int).Exp(p.H2, r, p.N))
+	return c.Mod(c, p.N)
+}
+
+// check reports whether H1^x * H2^r = c^e * a mod N.
+func (p *PedersenParams) check(x, r, c, e, a *big.Int) bool {
+	if !bigint.IsUnit(c, p.N) || !bigint.IsUnit(a, p.N) {
+		return false
+	}
+	rhs := new(big.Int).Exp(c, e, p.N)
+	rhs.Mul(rhs, a)
+	rhs.Mod(rhs, p.N)
+	return p.commit(x, r).Cmp(rhs) == 0
+}
+
+// Verify checks the proof of the parameters on the transcript t. It returns
+// nil if the proof is valid, and an error otherwise.
+func (p *PedersenProof) Verify(t *transcript.Transcript, ped *PedersenParams) error {
+	if p == nil || ped == nil || ped.N == nil ||
+		!bigint.IsUnit(ped.H1, ped.N) || !bigint.IsUnit(ped.H2, ped.N) || ped.H1.Cmp(one) == 0 ||
+		!verifyPrm(t, ped.N, ped.H1, ped.H2, p.A, p.Z) ||
+		!verifyPrm(t, ped.N, ped.H2, ped.H1, p.AInv, p.ZInv) {
+		return errors.New("paillier: invalid ring-Pedersen parameters")
+	}
+	return nil
+}
+
+// provePrm proves the knowledge of x with h = g^x mod N, where the order of
+// g divides order.
+func provePrm(t *transcript.Transcript, N, g, h, x, order *big.Int, rand cipher.Stream) (A, Z []*big.Int) {
+	A = make([]*big.Int, setupRounds)
+	Z = make([]*big.Int, setupRounds)
+	a := make([]*big.Int, setupRounds)
+	for i := range a {
+		a[i] = random.Int(order, rand)
+		A[i] = new(big.Int).Exp(g, a[i], N)
+	}
+	e := prmChallenge(t, N, g, h, A)
+	for i := range a {
+		Z[i] = a[i]
+		if e[i/8]>>uint(i%8)&1 == 1 {
+			Z[i].Add(Z[i], x)
+			Z[i].Mod(Z[i], order)
+		}
+	}
+	return A, Z
+}
+
+// prmChallenge returns the bits of the challenges of a proof of the
+// discrete logarithm of h in base g.
+func prmChallenge(t *transcript.Transcript, N, g, h *big.Int, A []*big.Int) []byte {
+	t.Append("proof", []byte("paillier prm"))
+	t.AppendInts("modulus", N)
+	t.AppendInts("base", g, h)
+	t.AppendInts("commitments", A...)
+	return t.ChallengeBytes("challenge", (setupRounds+7)/8)
+}
+
+func verifyPrm(t *transcript.Transcript, N, g, h *big.Int, A, Z []*big.Int) bool {
+	if len(A) != setupRounds || len(Z) != setupRounds {
+		return false
+	}
+	for i := range A {
+		if !bigint.IsUnit(A[i], N) || !bigint.InRange(Z[i], N) {
+			return false
+		}
+	}
+	e := prmChallenge(t, N, g, h, A)
+	for i := range A {
+		rhs := new(big.Int).Set(A[i])
+		if e[i/8]>>uint(i%8)&1 == 1 {
+			rhs.Mul(rhs, h)
+			rhs.Mod(rhs, N)
+		}
+		if new(big.Int).Exp(g, Z[i], N).Cmp(rhs) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ModulusProof proves that a modulus is the product of two primes
+// congruent to 3 modulo 4, and thus a valid Paillier modulus.
+type ModulusProof struct {
+	W    *big.Int
+	X, Z []*big.Int
+	A, B []bool
+}
+
+// ProveModulus proves on the transcript t that the modulus of the key, as
+// created by GenerateKey, is well formed.
+func ProveModulus(t *transcript.Transcript, sk *PrivateKey, rand cipher.Stream) (*ModulusProof, error) {
+	N := sk.N
+	var w *big.Int
+	for w == nil || big.Jacobi(w, N) != -1 {
+		w = bigint.RandomUnit(N, rand)
+	}
+	nInv := new(big.Int).ModInverse(N, sk.phi())
+	if nInv == nil {
+		return nil, errors.New("paillier: modulus shares a factor with phi(N)")
+	}
+	p := &ModulusProof{
+		W: w,
+		X: make([]*big.Int, setupRounds),
+		Z: make([]*big.Int, setupRounds),
+		A: make([]bool, setupRounds),
+		B: make([]bool, setupRounds),
+	}
+	minus := new(big.Int).Sub(N, one)
+	for i, y := range modChallenges(t, N, w) {
+		p.Z[i] = new(big.Int).Exp(y, nInv, N)
+		// Exactly one of y, -y, wy and -wy is a quadratic residue, since
+		// -1 is a non-residue modulo both primes and w modulo only one.
+		for ab := 0; ab < 4; ab++ {
+			a, b := ab&1 == 1, ab&2 == 2
+			v := new(big.Int).Set(y)
+			if a {
+				v.Mul(v, minus)
+			}
+			if b {
+				v.Mul(v, w)
+			}
+			v.Mod(v, N)
+			if big.Jacobi(new(big.Int).Mod(v, sk.P), sk.P) == 1 &&
+				big.Jacobi(new(big.Int).Mod(v, sk.Q), sk.Q) == 1 {
+				p.A[i], p.B[i] = a, b
+				p.X[i] = sk.fourthRoot(v)
+				break
+			}
+		}
+		if p.X[i] == nil {
+			return nil, errors.New("paillier: modulus is not a Blum integer")
+		}
+	}
+	return p, nil
+}
+
+// fourthRoot returns a fourth root of the quadratic residue v, computed as
+// the square root of its square root that is itself a residue.
+func (sk *PrivateKey) fourthRoot(v *big.Int) *big.Int {
+	root := func(p *big.Int) *big.Int {
+		e := new(big.Int).Add(p, one)
+		e.Rsh(e, 2)
+		e.Mul(e, e)
+		e.Mod(e, new(big.Int).Sub(p, one))
+		return new(big.Int).Exp(v, e, p)
+	}
+	xp, xq := root(sk.P), root(sk.Q)
+	// x = xp + p * ((xq - xp) * p^-1 mod q)
+	t := new(big.Int).Sub(xq, xp)
+	t.Mul(t, new(big.Int).ModInverse(sk.P, sk.Q))
+	t.Mod(t, sk.Q)
+	t.Mul(t, sk.P)
+	return t.Add(t, xp)
+}
+
+// modChallenges derives the elements of Z_N whose roots are shown in a
+// modulus proof.
+func modChallenges(t *transcript.Transcript, N, w *big.Int) []*big.Int {
+	t.Append("proof", []byte("paillier mod"))
+	t.AppendInts("modulus", N)
+	t.AppendInts("non-residue", w)
+	ys := make([]*big.Int, setupRounds)
+	size := (N.BitLen() + 128 + 7) / 8
+	for i := range ys {
+		y := new(big.Int).SetBytes(t.ChallengeBytes("challenge", size))
+		ys[i] = y.Mod(y, N)
+	}
+	return ys
+}
+
+// Verify checks the proof for the modulus N on the transcript t. It returns
+// nil if the proof is valid, and an error otherwise.
+func (p *ModulusProof) Verify(t *transcript.Transcript, N *big.Int) error {
+	if p == nil || N == nil || len(p.X) != setupRounds || len(p.Z) != setupRounds ||
+		len(p.A) != setupRounds || len(p.B) != setupRounds ||
+		N.Bit(0) == 0 || N.ProbablyPrime(20) || !bigint.IsUnit(p.W, N) ||
+		big.Jacobi(p.W, N) != -1 {
+		return errInvalidProof
+	}
+	minus := new(big.Int).Sub(N, one)
+	for i, y := range modChallenges(t, N, p.W) {
+		if !bigint.IsUnit(p.X[i], N) || !bigint.IsUnit(p.Z[i], N) ||
+			new(big.Int).Exp(p.Z[i], N, N).Cmp(y) != 0 {
+			return errInvalidProof
+		}
+		v := new(big.Int).Set(y)
+		if p.A[i] {
+			v.Mul(v, minus)
+		}
+		if p.B[i] {
+			v.Mul(v, p.W)
+		}
+		v.Mod(v, N)
+		if new(big.Int).Exp(p.X[i], four, N).Cmp(v) != 0 {
+			return errInvalidProof
+		}
+	}
+	return nil
+}
+
+// RangeProof proves that a ciphertext c encrypts a value smaller than q^3,
+// for a value proven smaller than q. It is made for a verifier that owns
+// the ring-Pedersen parameters.
+type RangeProof struct {
+	Z, U, W, S, S1, S2 *big.Int
+}
+
+// ProveRange proves on the transcript t that c, the encryption of m with
+// the given nonce, is in range. The plaintext m must be smaller than q.
+func ProveRange(t *transcript.Transcript, q *big.Int, pk *PublicKey, ped *PedersenParams, c, m, nonce *big.Int, rand cipher.Stream) *RangeProof {
+	q3 := new(big.Int).Exp(q, big.NewInt(3), nil)
+	alpha := random.Int(q3, rand)
+	beta := bigint.RandomUnit(pk.N, rand)
+	gamma := random.Int(new(big.Int).Mul(q3, ped.N), rand)
+	rho := random.Int(new(big.Int).Mul(q, ped.N), rand)
+
+	p := &RangeProof{
+		Z: ped.commit(m, rho),
+		U: pk.EncryptWithNonce(alpha, beta),
+		W: ped.commit(alpha, gamma),
+	}
+	e := rangeChallenge(t, q, pk, ped, c, p)
+	p.S = new(big.Int).Exp(nonce, e, pk.N)
+	p.S.Mul(p.S, beta)
+	p.S.Mod(p.S, pk.N)
+	p.S1 = new(big.Int).Mul(e, m)
+	p.S1.Add(p.S1, alpha)
+	p.S2 = new(big.Int).Mul(e, rho)
+	p.S2.Add(p.S2, gamma)
+	return p
+}
+
+// Verify checks the proof for the ciphertext c on the transcript t. It
+// returns nil if the proof is valid, and an error otherwise.
+func (p *RangeProof) Verify(t *transcript.Transcript, q *big.Int, pk *PublicKey, ped *PedersenParams, c *big.Int) error {
+	q3 := new(big.Int).Exp(q, big.NewInt(3), nil)
+	if p == nil || !pk.ValidCiphertext(c) || !pk.ValidCiphertext(p.U) ||
+		!bigint.IsUnit(p.S, pk.N) || !bigint.InRange(p.S1, q3) || !bigint.NonNegative(p.S2) ||
+		!bigint.IsUnit(p.Z, ped.N) || !bigint.IsUnit(p.W, ped.N) {
+		return errInvalidProof
+	}
+	e := rangeChallenge(t, q, pk, ped, c, p)
+	// Gamma^s1 * s^N = u * c^e mod N^2
+	if pk.EncryptWithNonce(p.S1, p.S).Cmp(pk.Add(pk.Mul(c, e), p.U)) != 0 ||
+		!ped.check(p.S1, p.S2, p.Z, e, p.W) {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// statement appends the Paillier key and ring-Pedersen parameters of a
+// proof about ciphertexts, and its ciphertexts, to the transcript.
+func statement(t *transcript.Transcript, proof string, pk *PublicKey, ped *PedersenParams, cs ...*big.Int) {
+	t.Append("proof", []byte(proof))
+	t.AppendInts("paillier", pk.N)
+	t.AppendInts("pedersen", ped.N, ped.H1, ped.H2)
+	t.AppendInts("ciphertexts", cs...)
+}
+
+func rangeChallenge(t *transcript.Transcript, q *big.Int, pk *PublicKey, ped *PedersenParams, c *big.Int, p *RangeProof) *big.Int {
+	statement(t, "paillier range", pk, ped, c)
+	t.AppendInts("commitments", p.Z, p.U, p.W)
+	return t.ChallengeInt("challenge", q)
+}
+
+// AffineProof proves that a ciphertext c2 was computed from c1 as
+// c1^x * Enc(y), with x smaller than q^3 and y smaller than q^7, for values
+// proven smaller than q and q^5. When it carries U, it also proves that
+// X = x*G in a group of order q. It is made for a verifier that owns the
+// ring-Pedersen parameters.
+type AffineProof struct {
+	Z, ZPrm, T, V, W, S, S1, S2, T1, T2 *big.Int
+	U                                   kyber.Point
+}
+
+func affineChallenge(t *transcript.Transcript, q *big.Int, pk *PublicKey, ped *PedersenParams, c1, c2 *big.Int, X kyber.Point, p *AffineProof) *big.Int {
+	statement(t, "paillier affine", pk, ped, c1, c2)
+	t.AppendInts("commitments", p.Z, p.ZPrm, p.T, p.V, p.W)
+	if X != nil {
+		t.AppendPoints("point", X, p.U)
+	}
+	return t.ChallengeInt("challenge", q)
+}
+
+// ProveAffine proves on the transcript t that c2 = c1^x * Enc(y, nonce),
+// and that X = x*G unless X is nil. The group g must have order q and big-endian scalars,
+// and the modulus must be larger than q^8.
+func ProveAffine(t *transcript.Transcript, g kyber.Group, q *big.Int, pk *PublicKey, ped *PedersenParams, c1, c2, x, y, nonce *big.Int, X kyber.Point, rand cipher.Stream) *AffineProof {
+	q3 := new(big.Int).Exp(q, big.NewInt(3), nil)
+	q7 := new(big.Int).Exp(q, big.NewInt(7), nil)
+	qN := new(big.Int).Mul(q, ped.N)
+	q3N := new(big.Int).Mul(q3, ped.N)
+	alpha := random.Int(q3, rand)
+	rho := random.Int(qN, rand)
+	rhoPrm := random.Int(q3N, rand)
+	sigma := random.Int(qN, rand)
+	beta := bigint.RandomUnit(pk.N, rand)
+	gamma := random.Int(q7, rand)
+	tau := random.Int(q3N, rand)
+
+	p := &AffineProof{
+		Z:    ped.commit(x, rho),
+		ZPrm: ped.commit(alpha, rhoPrm),
+		T:    ped.commit(y, sigma),
+		V:    pk.Add(pk.Mul(c1, alpha), pk.EncryptWithNonce(gamma, beta)),
+		W:    ped.commit(gamma, tau),
+	}
+	if X != nil {
+		p.U = g.Point().Mul(scalar(g, alpha), nil)
+	}
+	e := affineChallenge(t, q, pk, ped, c1, c2, X, p)
+	p.S = new(big.Int).Exp(nonce, e, pk.N)
+	p.S.Mul(p.S, beta)
+	p.S.Mod(p.S, pk.N)
+	p.S1 = new(big.Int).Mul(e, x)
+	p.S1.Add(p.S1, alpha)
+	p.S2 = new(big.Int).Mul(e, rho)
+	p.S2.Add(p.S2, rhoPrm)
+	p.T1 = new(big.Int).Mul(e, y)
+	p.T1.Add(p.T1, gamma)
+	p.T2 = new(big.Int).Mul(e, sigma)
+	p.T2.Add(p.T2, tau)
+	return p
+}
+
+// Verify checks the proof for the ciphertexts c1 and c2, and the point X
+// unless it is nil, on the transcript t. It returns nil if the proof is
+// valid, and an error otherwise.
+func (p *AffineProof) Verify(t *transcript.Transcript, g kyber.Group, q *big.Int, pk *PublicKey, ped *PedersenParams, c1, c2 *big.Int, X kyber.Point) error {
+	q3 := new(big.Int).Exp(q, big.NewInt(3), nil)
+	q7 := new(big.Int).Exp(q, big.NewInt(7), nil)
+	if p == nil || !pk.ValidCiphertext(c1) || !pk.ValidCiphertext(c2) ||
+		!pk.ValidCiphertext(p.V) || !bigint.IsUnit(p.S, pk.N) ||
+		!bigint.InRange(p.S1, q3) || !bigint.InRange(p.T1, q7) ||
+		!bigint.NonNegative(p.S2) || !bigint.NonNegative(p.T2) || (X != nil) != (p.U != nil) ||
+		!bigint.IsUnit(p.Z, ped.N) || !bigint.IsUnit(p.ZPrm, ped.N) || !bigint.IsUnit(p.T, ped.N) || !bigint.IsUnit(p.W, ped.N) {
+		return errInvalidProof
+	}
+	e := affineChallenge(t, q, pk, ped, c1, c2, X, p)
+	if !ped.check(p.S1, p.S2, p.Z, e, p.ZPrm) || !ped.check(p.T1, p.T2, p.T, e, p.W) {
+		return errInvalidProof
+	}
+	// c1^s1 * Gamma^t1 * s^N = c2^e * v mod N^2
+	lhs := pk.Add(pk.Mul(c1, p.S1), pk.EncryptWithNonce(p.T1, p.S))
+	if lhs.Cmp(pk.Add(pk.Mul(c2, e), p.V)) != 0 {
+		return errInvalidProof
+	}
+	if X != nil {
+		// s1*G = e*X + u
+		rhs := g.Point().Mul(scalar(g, e), X)
+		rhs.Add(rhs, p.U)
+		if !g.Point().Mul(scalar(g, p.S1), nil).Equal(rhs) {
+			return errInvalidProof
+		}
+	}
+	return nil
+}
+
+// LogProof proves that a ciphertext c encrypts a value x smaller than q^3,
+// for a value proven smaller than q, such that Q = x*R in a group of order
+// q. It is made for a verifier that owns the ring-Pedersen parameters.
+type LogProof struct {
+	Z          *big.Int
+	U1         kyber.Point
+	U2, U3     *big.Int
+	S1, S2, S3 *big.Int
+}
+
+func logChallenge(t *transcript.Transcript, q *big.Int, pk *PublicKey, ped *PedersenParams, c *big.Int, R, Q kyber.Point, p *LogProof) *big.Int {
+	statement(t, "paillier log", pk, ped, c)
+	t.AppendPoints("points", R, Q)
+	t.AppendInts("commitments", p.Z)
+	t.AppendPoints("commitments", p.U1)
+	t.AppendInts("commitments", p.U2, p.U3)
+	return t.ChallengeInt("challenge", q)
+}
+
+// ProveLog proves on the transcript t that c, the encryption of x with the
+// given nonce, is such that Q = x*R. The group g must have order q and big-endian scalars.
+func ProveLog(t *transcript.Transcript, g kyber.Group, q *big.Int, pk *PublicKey, ped *PedersenParams, c *big.Int, R, Q kyber.Point, x, nonce *big.Int, rand cipher.Stream) *LogProof {
+	q3 := new(big.Int).Exp(q, big.NewInt(3), nil)
+	alpha := random.Int(q3, rand)
+	beta := bigint.RandomUnit(pk.N, rand)
+	rho := random.Int(new(big.Int).Mul(q, ped.N), rand)
+	gamma := random.Int(new(big.Int).Mul(q3, ped.N), rand)
+
+	p := &LogProof{
+		Z:  ped.commit(x, rho),
+		U1: g.Point().Mul(scalar(g, alpha), R),
+		U2: pk.EncryptWithNonce(alpha, beta),
+		U3: ped.commit(alpha, gamma),
+	}
+	e := logChallenge(t, q, pk, ped, c, R, Q, p)
+	p.S1 = new(big.Int).Mul(e, x)
+	p.S1.Add(p.S1, alpha)
+	p.S2 = new(big.Int).Exp(nonce, e, pk.N)
+	p.S2.Mul(p.S2, beta)
+	p.S2.Mod(p.S2, pk.N)
+	p.S3 = new(big.Int).Mul(e, rho)
+	p.S3.Add(p.S3, gamma)
+	return p
+}
+
+// Verify checks the proof for the ciphertext c and the points R and Q on
+// the transcript t. It returns nil if the proof is valid, and an error
+// otherwise.
+func (p *LogProof) Verify(t *transcript.Transcript, g kyber.Group, q *big.Int, pk *PublicKey, ped *PedersenParams, c *big.Int, R, Q kyber.Point) error {
+	q3 := new(big.Int).Exp(q, big.NewInt(3), nil)
+	if p == nil || p.U1 == nil || !pk.ValidCiphertext(c) ||
+		!pk.ValidCiphertext(p.U2) || !bigint.IsUnit(p.S2, pk.N) ||
+		!bigint.InRange(p.S1, q3) || !bigint.NonNegative(p.S3) ||
+		!bigint.IsUnit(p.Z, ped.N) || !bigint.IsUnit(p.U3, ped.N) {
+		return errInvalidProof
+	}
+	e := logChallenge(t, q, pk, ped, c, R, Q, p)
+	// s1*R = e*Q + u1
+	rhs := g.Point().Mul(scalar(g, e), Q)
+	rhs.Add(rhs, p.U1)
+	if !g.Point().Mul(scalar(g, p.S1), R).Equal(rhs) {
+		return errInvalidProof
+	}
+	// Gamma^s1 * s2^N = c^e * u2 mod N^2
+	if pk.EncryptWithNonce(p.S1, p.S2).Cmp(pk.Add(pk.Mul(c, e), p.U2)) != 0 ||
+		!ped.check(p.S1, p.S3, p.Z, e, p.U3) {
+		return errInvalidProof
+	}
+	return nil
+}
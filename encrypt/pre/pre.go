@@ -0,0 +1,201 @@
+// Package pre implements unidirectional proxy re-encryption over any kyber
+// group, following the single-proxy variant of Umbral (Nuñez, "Umbral: a
+// threshold proxy re-encryption scheme", 2018).
+//
+// Data encrypted for a delegator with Encrypt is made of a capsule, which
+// encapsulates the symmetric key of the data for the public key of the
+// delegator, and of the data encrypted under that key. The delegator
+// decrypts it with Decrypt. To share the data with a delegatee, the
+// delegator gives a re-encryption key, created with NewReKey for the public
+// key of the delegatee, to a proxy. The proxy transforms capsules with
+// ReEncrypt, without learning the keys they encapsulate, and the delegatee
+// decrypts the data with DecryptReEncrypted.
+//
+// Re-encryption is unidirectional: a re-encryption key from the delegator
+// to the delegatee does not allow re-encrypting data from the delegatee to
+// the delegator, and the delegatee cannot re-encrypt the data further.
+// However, a proxy colluding with the delegatee can recover the private key
+// of the delegator.
+package pre
+
+import (
+	"crypto/cipher"
+	"crypto/sha512"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Suite represents the set of functionalities needed by the package pre.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+	kyber.Random
+}
+
+var errCapsule = errors.New("pre: invalid capsule")
+
+// Capsule encapsulates the key of encrypted data for the public key A of
+// the delegator: it holds E = r*G, V = u*G and s = u + r*H(E, V) for random
+// r and u, and the key is derived from (r + u)*A.
+type Capsule struct {
+	E, V kyber.Point
+	S    kyber.Scalar
+}
+
+// ReKey is a re-encryption key from a delegator of private key a to a
+// delegatee of public key B: K = a / d, where d = H(X, B, x*B) for a fresh
+// key pair (x, X).
+type ReKey struct {
+	K kyber.Scalar
+	X kyber.Point
+}
+
+// ReCapsule is a capsule transformed by a proxy for the delegatee: it holds
+// E' = K*E and V' = K*V, with the public part X of the re-encryption key.
+type ReCapsule struct {
+	E, V, X kyber.Point
+}
+
+// Encrypt encrypts msg for the public key, and returns the capsule of its
+// key with the ciphertext.
+func Encrypt(suite Suite, public kyber.Point, msg []byte) (*Capsule, []byte, error) {
+	rand := suite.RandomStream()
+	r := suite.Scalar().Pick(rand)
+	u := suite.Scalar().Pick(rand)
+	E := suite.Point().Mul(r, nil)
+	V := suite.Point().Mul(u, nil)
+	h, err := hashToScalar(suite, E, V)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := &Capsule{E: E, V: V, S: h.Mul(h, r).Add(h, u)}
+
+	shared := suite.Point().Mul(suite.Scalar().Add(r, u), public)
+	aead, err := newAEAD(suite, shared, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	return c, aead.Seal(nil, nonce, msg, nil), nil
+}
+
+// Decrypt decrypts the ciphertext of the capsule with the private key of
+// the delegator.
+func Decrypt(suite Suite, private kyber.Scalar, c *Capsule, ciphertext []byte) ([]byte, error) {
+	if err := verifyCapsule(suite, c); err != nil {
+		return nil, err
+	}
+	shared := suite.Point().Add(c.E, c.V)
+	return open(suite, shared.Mul(private, shared), c, ciphertext)
+}
+
+// NewReKey returns the re-encryption key from the delegator of the private
+// key to the delegatee of the public key.
+func NewReKey(suite Suite, private kyber.Scalar, delegatee kyber.Point) (*ReKey, error) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X := suite.Point().Mul(x, nil)
+	d, err := hashToScalar(suite, X, delegatee, suite.Point().Mul(x, delegatee))
+	if err != nil {
+		return nil, err
+	}
+	if d.Equal(suite.Scalar().Zero()) {
+		return nil, errors.New("pre: degenerate re-encryption key")
+	}
+	return &ReKey{K: d.Div(private, d), X: X}, nil
+}
+
+// ReEncrypt transforms the capsule with the re-encryption key, after
+// checking that it is valid.
+func ReEncrypt(suite Suite, rk *ReKey, c *Capsule) (*ReCapsule, error) {
+	if err := verifyCapsule(suite, c); err != nil {
+		return nil, err
+	}
+	return &ReCapsule{
+		E: suite.Point().Mul(rk.K, c.E),
+		V: suite.Point().Mul(rk.K, c.V),
+		X: rk.X,
+	}, nil
+}
+
+// DecryptReEncrypted decrypts the ciphertext of the capsule c, re-encrypted
+// into rc, with the private key of the delegatee.
+func DecryptReEncrypted(suite Suite, private kyber.Scalar, c *Capsule, rc *ReCapsule, ciphertext []byte) ([]byte, error) {
+	if err := verifyCapsule(suite, c); err != nil {
+		return nil, err
+	}
+	// d*(E' + V') = (a/d)*d*(r + u)*G = (r + u)*A
+	public := suite.Point().Mul(private, nil)
+	d, err := hashToScalar(suite, rc.X, public, suite.Point().Mul(private, rc.X))
+	if err != nil {
+		return nil, err
+	}
+	shared := suite.Point().Add(rc.E, rc.V)
+	return open(suite, shared.Mul(d, shared), c, ciphertext)
+}
+
+// verifyCapsule checks that s*G = V + H(E, V)*E.
+func verifyCapsule(suite Suite, c *Capsule) error {
+	h, err := hashToScalar(suite, c.E, c.V)
+	if err != nil {
+		return err
+	}
+	right := suite.Point().Mul(h, c.E)
+	right.Add(right, c.V)
+	if !suite.Point().Mul(c.S, nil).Equal(right) {
+		return errCapsule
+	}
+	return nil
+}
+
+func open(suite Suite, shared kyber.Point, c *Capsule, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(suite, shared, c)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	msg, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("pre: invalid ciphertext")
+	}
+	return msg, nil
+}
+
+// newAEAD derives the key of the data from the shared point, bound to the
+// capsule.
+func newAEAD(suite Suite, shared kyber.Point, c *Capsule) (cipher.AEAD, error) {
+	if shared.Equal(suite.Point().Null()) {
+		return nil, errCapsule
+	}
+	ikm, err := shared.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	info := []byte("pre")
+	for _, P := range []kyber.Point{c.E, c.V} {
+		b, err := P.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		info = append(info, b...)
+	}
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(suite.Hash, ikm, nil, info), key); err != nil {
+		return nil, err
+	}
+	return chacha20poly1305.New(key)
+}
+
+func hashToScalar(g kyber.Group, points ...kyber.Point) (kyber.Scalar, error) {
+	h := sha512.New()
+	h.Write([]byte("pre"))
+	for _, P := range points {
+		if _, err := P.MarshalTo(h); err != nil {
+			return nil, err
+		}
+	}
+	return g.Scalar().SetBytes(h.Sum(nil)), nil
+}
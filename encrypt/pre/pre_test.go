@@ -0,0 +1,66 @@
+package pre
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func keyPair(suite Suite) (kyber.Scalar, kyber.Point) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	return x, suite.Point().Mul(x, nil)
+}
+
+func TestReEncrypt(t *testing.T) {
+	suites := []Suite{
+		edwards25519.NewBlakeSHA256Ed25519(),
+		edwards25519.NewBlakeSHA256Ristretto255(),
+	}
+	msg := []byte("Hello proxy re-encryption")
+	for _, suite := range suites {
+		a, A := keyPair(suite)
+		b, B := keyPair(suite)
+		c, C := keyPair(suite)
+
+		capsule, ct, err := Encrypt(suite, A, msg)
+		require.NoError(t, err)
+		pt, err := Decrypt(suite, a, capsule, ct)
+		require.NoError(t, err)
+		require.Equal(t, msg, pt)
+		_, err = Decrypt(suite, b, capsule, ct)
+		require.Error(t, err)
+
+		rk, err := NewReKey(suite, a, B)
+		require.NoError(t, err)
+		rc, err := ReEncrypt(suite, rk, capsule)
+		require.NoError(t, err)
+		pt, err = DecryptReEncrypted(suite, b, capsule, rc, ct)
+		require.NoError(t, err)
+		require.Equal(t, msg, pt)
+
+		// The re-encrypted capsule is for the delegatee only.
+		_, err = DecryptReEncrypted(suite, c, capsule, rc, ct)
+		require.Error(t, err)
+
+		// Re-encryption is unidirectional: a key from A to B does not
+		// re-encrypt capsules of B for A.
+		capsuleB, ctB, err := Encrypt(suite, B, msg)
+		require.NoError(t, err)
+		rcB, err := ReEncrypt(suite, rk, capsuleB)
+		require.NoError(t, err)
+		_, err = DecryptReEncrypted(suite, a, capsuleB, rcB, ctB)
+		require.Error(t, err)
+
+		// Invalid capsules are rejected by the proxy.
+		bad := *capsule
+		bad.S = suite.Scalar().Add(bad.S, suite.Scalar().One())
+		_, err = ReEncrypt(suite, rk, &bad)
+		require.Error(t, err)
+		bad = *capsule
+		bad.V = C
+		_, err = ReEncrypt(suite, rk, &bad)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,239 @@
+// Package signcrypt implements the signcryption scheme of Zheng ("Digital
+// Signcryption or How to Achieve Cost(Signature & Encryption) << Cost(Signature)
+// + Cost(Encryption)", CRYPTO 1997) over any kyber group.
+//
+// Signcrypt encrypts a message for a recipient and signs it in a single
+// pass, with one scalar multiplication. The sender picks a random x and
+// derives the keys of the message from the point K = x*B, where B is the
+// public key of the recipient. The message is encrypted with
+// ChaCha20-Poly1305 under the first key, and signed by r = H(k2, A, B, msg),
+// for the second key k2 and the public key A of the sender, and by
+// s = x / (r + a), for the private key a of the sender. The ciphertext is the
+// binary encoding of r and s, followed by the encrypted message:
+// its overhead is two scalars and an authentication tag, one point less
+// than a Schnorr signature with an ECIES ciphertext. Unsigncrypt recovers K
+// as (b*s)*(A + r*G), using the private key b of the recipient, and checks
+// both the encryption and the signature.
+//
+// Only the recipient can check the signature, since it requires K. For
+// non-repudiation, the recipient creates with Prove the evidence that lets
+// anyone holding it, such as a judge, check that the sender signed the
+// message, and learn the message, with VerifyEvidence. The evidence reveals
+// K along with a proof that it was computed with the private key of the
+// recipient, but nothing else about that key.
+package signcrypt
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/sha512"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/proof/transcript"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Suite represents the set of functionalities needed by the package
+// signcrypt.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+	kyber.XOFFactory
+	kyber.Random
+}
+
+var errInvalid = errors.New("signcrypt: invalid ciphertext")
+
+// Signcrypt signs msg with the private key of the sender and encrypts it
+// for the public key of the recipient.
+func Signcrypt(suite Suite, private kyber.Scalar, recipient kyber.Point, msg []byte) ([]byte, error) {
+	public := suite.Point().Mul(private, nil)
+	for {
+		x := suite.Scalar().Pick(suite.RandomStream())
+		K := suite.Point().Mul(x, recipient)
+		aead, k2, err := deriveKeys(suite, K, public, recipient)
+		if err != nil {
+			return nil, err
+		}
+		r, err := hash(suite, k2, public, recipient, msg)
+		if err != nil {
+			return nil, err
+		}
+		// s = x / (r + a), which fails in the unlikely case where
+		// r + a = 0.
+		ra := suite.Scalar().Add(r, private)
+		if ra.Equal(suite.Scalar().Zero()) {
+			continue
+		}
+		s := suite.Scalar().Div(x, ra)
+
+		var b bytes.Buffer
+		if _, err := r.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+		if _, err := s.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, aead.NonceSize())
+		return aead.Seal(b.Bytes(), nonce, msg, nil), nil
+	}
+}
+
+// Unsigncrypt decrypts the ciphertext with the private key of the recipient
+// and checks that it was signed by the sender, and returns the message.
+func Unsigncrypt(suite Suite, private kyber.Scalar, sender kyber.Point, ciphertext []byte) ([]byte, error) {
+	r, s, ct, err := decode(suite, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	public := suite.Point().Mul(private, nil)
+	K := suite.Point().Mul(suite.Scalar().Mul(private, s), commitment(suite, sender, r))
+	return open(suite, K, sender, public, r, ct)
+}
+
+// Evidence is the evidence that a ciphertext was signed by its sender: the
+// point K of the ciphertext, and a proof that it is the product of the
+// private key of the recipient with the ephemeral point X = s*(A + r*G)
+// determined by the signature.
+type Evidence struct {
+	K     kyber.Point
+	Proof *dleq.Proof
+}
+
+// Prove returns the evidence that the ciphertext received with the private
+// key of the recipient was signed by the sender. It checks the ciphertext
+// first, so as not to create evidence for an invalid one.
+func Prove(suite Suite, private kyber.Scalar, sender kyber.Point, ciphertext []byte) (*Evidence, error) {
+	r, s, ct, err := decode(suite, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	X := suite.Point().Mul(s, commitment(suite, sender, r))
+	t := evidenceTranscript(suite, sender, ciphertext)
+	proof, public, K, err := dleq.NewDLEQProofTranscript(suite, t, suite.Point().Base(), X, private)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := open(suite, K, sender, public, r, ct); err != nil {
+		return nil, err
+	}
+	return &Evidence{K: K, Proof: proof}, nil
+}
+
+// VerifyEvidence checks the evidence that the ciphertext sent to the
+// recipient was signed by the sender, and returns the message.
+func VerifyEvidence(suite Suite, sender, recipient kyber.Point, ciphertext []byte, ev *Evidence) ([]byte, error) {
+	r, s, ct, err := decode(suite, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	X := suite.Point().Mul(s, commitment(suite, sender, r))
+	t := evidenceTranscript(suite, sender, ciphertext)
+	if err := ev.Proof.VerifyTranscript(suite, t, suite.Point().Base(), X, recipient, ev.K); err != nil {
+		return nil, errors.New("signcrypt: invalid evidence")
+	}
+	return open(suite, ev.K, sender, recipient, r, ct)
+}
+
+// evidenceTranscript returns the transcript of the proof of the evidence of
+// the ciphertext from the sender.
+func evidenceTranscript(suite Suite, sender kyber.Point, ciphertext []byte) *transcript.Transcript {
+	t := transcript.New(suite, "signcrypt evidence")
+	t.AppendPoints("sender", sender)
+	t.Append("ciphertext", ciphertext)
+	return t
+}
+
+// commitment returns A + r*G.
+func commitment(suite Suite, sender kyber.Point, r kyber.Scalar) kyber.Point {
+	return suite.Point().Add(sender, suite.Point().Mul(r, nil))
+}
+
+// open decrypts the encrypted message ct with the keys of K, and checks its
+// signature r.
+func open(suite Suite, K, sender, recipient kyber.Point, r kyber.Scalar, ct []byte) ([]byte, error) {
+	aead, k2, err := deriveKeys(suite, K, sender, recipient)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	msg, err := aead.Open(nil, nonce, ct, nil)
+	if err != nil {
+		return nil, errInvalid
+	}
+	h, err := hash(suite, k2, sender, recipient, msg)
+	if err != nil {
+		return nil, err
+	}
+	if !h.Equal(r) {
+		return nil, errInvalid
+	}
+	return msg, nil
+}
+
+// decode splits a ciphertext into the signature r, s and the encrypted
+// message.
+func decode(suite Suite, ciphertext []byte) (kyber.Scalar, kyber.Scalar, []byte, error) {
+	n := suite.ScalarLen()
+	if len(ciphertext) < 2*n+chacha20poly1305.Overhead {
+		return nil, nil, nil, errInvalid
+	}
+	r, s := suite.Scalar(), suite.Scalar()
+	if err := r.UnmarshalBinary(ciphertext[:n]); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := s.UnmarshalBinary(ciphertext[n : 2*n]); err != nil {
+		return nil, nil, nil, err
+	}
+	if s.Equal(suite.Scalar().Zero()) {
+		return nil, nil, nil, errInvalid
+	}
+	return r, s, ciphertext[2*n:], nil
+}
+
+// deriveKeys derives from K the AEAD that encrypts the message and the key
+// of its signature, bound to the keys of both parties.
+func deriveKeys(suite Suite, K, sender, recipient kyber.Point) (cipher.AEAD, []byte, error) {
+	if K.Equal(suite.Point().Null()) {
+		return nil, nil, errors.New("signcrypt: shared point is the identity")
+	}
+	ikm, err := K.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	var info bytes.Buffer
+	info.WriteString("signcrypt")
+	if _, err := sender.MarshalTo(&info); err != nil {
+		return nil, nil, err
+	}
+	if _, err := recipient.MarshalTo(&info); err != nil {
+		return nil, nil, err
+	}
+	keys := make([]byte, 2*chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(suite.Hash, ikm, nil, info.Bytes()), keys); err != nil {
+		return nil, nil, err
+	}
+	aead, err := chacha20poly1305.New(keys[:chacha20poly1305.KeySize])
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, keys[chacha20poly1305.KeySize:], nil
+}
+
+// hash returns r = H(k2 || A || B || msg).
+func hash(suite Suite, k2 []byte, sender, recipient kyber.Point, msg []byte) (kyber.Scalar, error) {
+	h := sha512.New()
+	h.Write(k2)
+	if _, err := sender.MarshalTo(h); err != nil {
+		return nil, err
+	}
+	if _, err := recipient.MarshalTo(h); err != nil {
+		return nil, err
+	}
+	h.Write(msg)
+	return suite.Scalar().SetBytes(h.Sum(nil)), nil
+}
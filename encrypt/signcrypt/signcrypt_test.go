@@ -0,0 +1,83 @@
+package signcrypt
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+func keyPair(suite Suite) (kyber.Scalar, kyber.Point) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	return x, suite.Point().Mul(x, nil)
+}
+
+func TestSigncrypt(t *testing.T) {
+	suites := []Suite{
+		edwards25519.NewBlakeSHA256Ed25519(),
+		edwards25519.NewBlakeSHA256Ristretto255(),
+	}
+	msg := []byte("Hello signcryption")
+	for _, suite := range suites {
+		a, A := keyPair(suite)
+		b, B := keyPair(suite)
+		c, C := keyPair(suite)
+
+		ct, err := Signcrypt(suite, a, B, msg)
+		require.NoError(t, err)
+		require.Equal(t, 2*suite.ScalarLen()+len(msg)+16, len(ct))
+
+		pt, err := Unsigncrypt(suite, b, A, ct)
+		require.NoError(t, err)
+		require.Equal(t, msg, pt)
+
+		// Others can neither decrypt it nor claim to have sent it.
+		_, err = Unsigncrypt(suite, c, A, ct)
+		require.Error(t, err)
+		_, err = Unsigncrypt(suite, b, C, ct)
+		require.Error(t, err)
+		for _, i := range []int{0, suite.ScalarLen(), len(ct) - 1} {
+			bad := append([]byte(nil), ct...)
+			bad[i] ^= 1
+			_, err = Unsigncrypt(suite, b, A, bad)
+			require.Error(t, err)
+		}
+		_, err = Unsigncrypt(suite, b, A, ct[:2*suite.ScalarLen()])
+		require.Error(t, err)
+	}
+}
+
+func TestEvidence(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	msg := []byte("Hello signcryption")
+	a, A := keyPair(suite)
+	b, B := keyPair(suite)
+	_, C := keyPair(suite)
+
+	ct, err := Signcrypt(suite, a, B, msg)
+	require.NoError(t, err)
+	ev, err := Prove(suite, b, A, ct)
+	require.NoError(t, err)
+	pt, err := VerifyEvidence(suite, A, B, ct, ev)
+	require.NoError(t, err)
+	require.Equal(t, msg, pt)
+
+	// The evidence holds for that sender and ciphertext only.
+	_, err = VerifyEvidence(suite, C, B, ct, ev)
+	require.Error(t, err)
+	other, err := Signcrypt(suite, a, B, msg)
+	require.NoError(t, err)
+	_, err = VerifyEvidence(suite, A, B, other, ev)
+	require.Error(t, err)
+
+	// The recipient cannot create evidence for a ciphertext that was not
+	// signed by the sender.
+	_, err = Prove(suite, b, C, ct)
+	require.Error(t, err)
+
+	// Evidence with a wrong shared point is rejected.
+	ev.K = suite.Point().Add(ev.K, suite.Point().Base())
+	_, err = VerifyEvidence(suite, A, B, ct, ev)
+	require.Error(t, err)
+}
@@ -0,0 +1,93 @@
+// Package tle implements timelock encryption against a threshold BLS
+// randomness beacon: a message is encrypted for a future round of the
+// beacon, and can only be decrypted once the beacon has published its
+// signature of that round.
+//
+// The beacon signs the message of every round, RoundMessage, with a
+// threshold BLS signature under its public key, as created by package
+// sign/tbls. That signature is the private key of the round in the
+// identity-based encryption of package encrypt/ibe, for which Encrypt
+// encrypts with the public key of the beacon only. No one learns the
+// message before the round, as long as fewer than a threshold of the
+// members of the beacon collude to sign it in advance.
+package tle
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/encrypt/ibe"
+	"github.com/dedis/kyber/pairing"
+)
+
+// sigmaSize is the size of the V component of IBE ciphertexts.
+const sigmaSize = 32
+
+var errInvalidCiphertext = errors.New("tle: invalid ciphertext")
+
+// RoundMessage returns the message signed by the beacon in the given
+// round, the SHA-256 hash of the round number in big-endian order.
+func RoundMessage(round uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], round)
+	h := sha256.Sum256(b[:])
+	return h[:]
+}
+
+// Encrypt encrypts msg so that it can be decrypted with the signature of the
+// given round by the beacon of public key beaconPub, a point of G2. The
+// ciphertext is round || U || V || W, with the 8-byte big-endian round
+// number and the components of the IBE ciphertext.
+func Encrypt(suite pairing.Suite, beaconPub kyber.Point, round uint64, msg []byte) ([]byte, error) {
+	c, err := ibe.Encrypt(suite, beaconPub, RoundMessage(round), msg)
+	if err != nil {
+		return nil, err
+	}
+	U, err := c.U.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 8, 8+len(U)+len(c.V)+len(c.W))
+	binary.BigEndian.PutUint64(out, round)
+	out = append(out, U...)
+	out = append(out, c.V...)
+	return append(out, c.W...), nil
+}
+
+// Round returns the round of the beacon whose signature decrypts the
+// ciphertext.
+func Round(ct []byte) (uint64, error) {
+	if len(ct) < 8 {
+		return 0, errInvalidCiphertext
+	}
+	return binary.BigEndian.Uint64(ct), nil
+}
+
+// Decrypt decrypts the ciphertext with the signature of its round by the
+// beacon, a BLS signature in its binary encoding. It returns an error if
+// the signature is not that of the round of the ciphertext.
+func Decrypt(suite pairing.Suite, beaconSig, ct []byte) ([]byte, error) {
+	l := suite.G2().PointLen()
+	if len(ct) < 8+l+sigmaSize {
+		return nil, errInvalidCiphertext
+	}
+	key := suite.G1().Point()
+	if err := key.UnmarshalBinary(beaconSig); err != nil {
+		return nil, err
+	}
+	c := &ibe.Ciphertext{
+		U: suite.G2().Point(),
+		V: ct[8+l : 8+l+sigmaSize],
+		W: ct[8+l+sigmaSize:],
+	}
+	if err := c.U.UnmarshalBinary(ct[8 : 8+l]); err != nil {
+		return nil, errInvalidCiphertext
+	}
+	msg, err := ibe.Decrypt(suite, key, c)
+	if err != nil {
+		return nil, errInvalidCiphertext
+	}
+	return msg, nil
+}
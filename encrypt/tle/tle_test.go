@@ -0,0 +1,68 @@
+// +build vartime
+
+package tle
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/sign/bls"
+	"github.com/dedis/kyber/sign/tbls"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+type g2Suite struct {
+	kyber.Group
+	pairing.Suite
+}
+
+func TestTimelock(t *testing.T) {
+	// A beacon of n members, any t of which sign the rounds.
+	n, th := 5, 3
+	priPoly := share.NewPriPoly(&g2Suite{suite.G2(), suite}, th, nil)
+	pubPoly := priPoly.Commit(nil)
+	beacon := func(round uint64) []byte {
+		msg := RoundMessage(round)
+		var sigs [][]byte
+		for _, s := range priPoly.Shares(n)[:th] {
+			sig, err := tbls.Sign(suite, s, msg)
+			require.NoError(t, err)
+			sigs = append(sigs, sig)
+		}
+		sig, err := tbls.Recover(suite, pubPoly, msg, sigs, th, n)
+		require.NoError(t, err)
+		require.NoError(t, bls.Verify(suite, pubPoly.Commit(), msg, sig))
+		return sig
+	}
+
+	msg := []byte("Hello from the past")
+	ct, err := Encrypt(suite, pubPoly.Commit(), 42, msg)
+	require.NoError(t, err)
+	round, err := Round(ct)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), round)
+
+	got, err := Decrypt(suite, beacon(42), ct)
+	require.NoError(t, err)
+	require.Equal(t, msg, got)
+
+	// The signature of another round does not decrypt.
+	_, err = Decrypt(suite, beacon(41), ct)
+	require.Error(t, err)
+
+	// Modified or truncated ciphertexts are rejected.
+	sig := beacon(42)
+	bad := append([]byte(nil), ct...)
+	bad[len(bad)-1] ^= 1
+	_, err = Decrypt(suite, sig, bad)
+	require.Error(t, err)
+	_, err = Decrypt(suite, sig, ct[:40])
+	require.Error(t, err)
+	_, err = Round(ct[:7])
+	require.Error(t, err)
+}
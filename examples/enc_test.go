@@ -3,39 +3,10 @@ package examples
 import (
 	"fmt"
 
-	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/encrypt/elgamal"
 	"github.com/dedis/kyber/group/edwards25519"
-	"github.com/dedis/kyber/util/random"
 )
 
-func ElGamalEncrypt(group kyber.Group, pubkey kyber.Point, message []byte) (
-	K, C kyber.Point, remainder []byte) {
-
-	// Embed the message (or as much of it as will fit) into a curve point.
-	M := group.Point().Embed(message, random.New())
-	max := group.Point().EmbedLen()
-	if max > len(message) {
-		max = len(message)
-	}
-	remainder = message[max:]
-	// ElGamal-encrypt the point to produce ciphertext (K,C).
-	k := group.Scalar().Pick(random.New()) // ephemeral private key
-	K = group.Point().Mul(k, nil)          // ephemeral DH public key
-	S := group.Point().Mul(k, pubkey)      // ephemeral DH shared secret
-	C = S.Add(S, M)                        // message blinded with secret
-	return
-}
-
-func ElGamalDecrypt(group kyber.Group, prikey kyber.Scalar, K, C kyber.Point) (
-	message []byte, err error) {
-
-	// ElGamal-decrypt the ciphertext (K,C) to reproduce the message.
-	S := group.Point().Mul(prikey, K) // regenerate shared secret
-	M := group.Point().Sub(C, S)      // use to un-blind the message
-	message, err = M.Data()           // extract the embedded data
-	return
-}
-
 /*
 This example illustrates how the crypto toolkit may be used
 to perform "pure" ElGamal encryption,
@@ -55,6 +26,8 @@ as just one of many examples,
 the proactively verifiable anonymous messaging scheme prototyped in Verdict
 (see http://dedis.cs.yale.edu/dissent/papers/verdict-abs).
 
+The encrypt/elgamal package implements this encryption, together with the
+re-randomization and homomorphic operations on ciphertexts used by shuffles.
 For fancier versions of ElGamal encryption implemented in this toolkit
 see for example anon.Encrypt, which encrypts a message for
 one of several possible receivers forming an explicit anonymity set.
@@ -68,10 +41,10 @@ func Example_elGamalEncryption() {
 
 	// ElGamal-encrypt a message using the public key.
 	m := []byte("The quick brown fox")
-	K, C, _ := ElGamalEncrypt(suite, A, m)
+	c, _ := elgamal.EncryptMessage(suite, A, m, suite.RandomStream())
 
 	// Decrypt it using the corresponding private key.
-	mm, err := ElGamalDecrypt(suite, a, K, C)
+	mm, err := elgamal.DecryptMessage(suite, a, c)
 
 	// Make sure it worked!
 	if err != nil {
@@ -58,6 +58,29 @@ type Scalar interface {
 	SetBytes([]byte) Scalar
 }
 
+// WideScalar is implemented by the Scalars with a dedicated reduction of
+// 64-byte integers, such as a constant-time one. For orders of up to 384
+// bits, the reduction of uniform bytes, such as a 64-byte hash, is uniform
+// up to a statistical distance of 2^-128, unlike that of a hash no longer
+// than the order.
+type WideScalar interface {
+	// SetBytesWide sets the receiver to the 64-byte integer b reduced
+	// modulo the group order, in the endianess of SetBytes, and returns
+	// it.
+	SetBytesWide(b [64]byte) Scalar
+}
+
+// SetBytesWide sets s to the 64-byte integer b reduced modulo the group
+// order, in the endianess of SetBytes, and returns s. It uses the
+// SetBytesWide of s if s implements WideScalar, and SetBytes, which
+// reduces its input as well, otherwise.
+func SetBytesWide(s Scalar, b [64]byte) Scalar {
+	if w, ok := s.(WideScalar); ok {
+		return w.SetBytesWide(b)
+	}
+	return s.SetBytes(b[:])
+}
+
 // A Point kyber.y represents an element of a public-key cryptographic Group.
 // For example,
 // this is a number modulo the prime P in a DSA-style Schnorr group,
@@ -122,11 +145,146 @@ type Point interface {
 // Scalars and Points, never on secret ones.
 //
 // To compile variable time suites into the library, you must give the
-// option "-tags vertime" to "go build" or "go test".
+// option "-tags vartime" to "go build" or "go test". Objects are constant
+// time by default wherever their group supports it; verification code,
+// which only handles public values, opts them in with SetVarTime or
+// VarTimePoint, so that signing code is unaffected.
 type AllowsVarTime interface {
 	AllowVarTime(bool)
 }
 
+// SetVarTime calls AllowVarTime(varTime) on those of objs that implement
+// AllowsVarTime, and ignores the others, for code that works with any
+// group.
+func SetVarTime(varTime bool, objs ...interface{}) {
+	for _, obj := range objs {
+		if v, ok := obj.(AllowsVarTime); ok {
+			v.AllowVarTime(varTime)
+		}
+	}
+}
+
+// VarTimePoint returns a new Point of g on which variable time operations
+// are allowed, if g supports them, for computations on public points such
+// as the products of a signature verification.
+func VarTimePoint(g Group) Point {
+	P := g.Point()
+	SetVarTime(true, P)
+	return P
+}
+
+// HashablePoint is implemented by the Points of groups that support
+// hashing to curves as specified by RFC 9380. Unlike Pick and Embed,
+// the resulting points have no known discrete logarithm, and are the
+// same across implementations of the same suite of RFC 9380.
+// The domain separation tag dst identifies the protocol and its use
+// of the hash, and must differ between distinct uses.
+type HashablePoint interface {
+	// HashToPoint sets the receiver to the hash of msg in the domain
+	// dst, with the random oracle hash_to_curve, whose output is
+	// uniformly distributed. It returns the receiver.
+	HashToPoint(msg, dst []byte) Point
+
+	// EncodeToPoint sets the receiver to the encoding of msg in the
+	// domain dst, with the faster nonuniform encode_to_curve, which
+	// suits protocols that do not need a random oracle. It returns the
+	// receiver.
+	EncodeToPoint(msg, dst []byte) Point
+}
+
+// ValidatablePoint is implemented by the Points of groups that can check
+// that a point, typically decoded from an untrusted peer, is an element of
+// the prime-order group. Multiplying a secret scalar by a point outside of
+// it, such as one with a small-order component on a curve with a cofactor,
+// leaks the scalar modulo the order of that component.
+type ValidatablePoint interface {
+	// Valid reports whether the point is an element of the prime-order
+	// group: on the curve and, if the curve has a cofactor, in its
+	// prime-order subgroup. The identity is valid.
+	Valid() bool
+}
+
+// CofactorPoint is implemented by the Points of groups with a cofactor,
+// such as edwards25519, that can clear the small-order component of a
+// point.
+type CofactorPoint interface {
+	// MulCofactor sets the receiver to h*P, where h is the cofactor of the
+	// curve, which lies in the prime-order group. It returns the receiver.
+	MulCofactor(P Point) Point
+}
+
+// BlindablePoint is implemented by the Points of groups whose scalar
+// multiplication is not constant time, such as those built on math/big, to
+// harden it against side channels on shared hardware. After a call to
+// SetBlinding with a non-nil rand, Mul on the receiver splits the scalar
+// into two random shares and masks the point with a random point, both
+// drawn afresh from rand for every multiplication, so that the operations
+// observed do not repeat with the scalar or the point. It is about four
+// times slower, and no substitute for a constant-time implementation.
+type BlindablePoint interface {
+	// SetBlinding enables the blinding of Mul on the receiver with the
+	// randomness of rand, or disables it if rand is nil.
+	SetBlinding(rand cipher.Stream)
+}
+
+// MultiScalarMultiplier is implemented by the Points of groups with a
+// dedicated multi-scalar multiplication, which computes a sum of many
+// products of scalars and points in much less time than the products take
+// on their own. It is the core of batch verifications, and runs in variable
+// time, so that it suits public scalars and points only.
+type MultiScalarMultiplier interface {
+	// MultiScalarMult sets the receiver to the sum of scalars[i]*points[i],
+	// where a nil point stands for the standard base point, and returns
+	// it. It panics if the slices have different lengths.
+	MultiScalarMult(scalars []Scalar, points []Point) Point
+}
+
+// FixedBase multiplies a fixed point by scalars faster than Point.Mul, with
+// a table of multiples of the point computed once, which pays off for a
+// point that many products share, such as a second generator. It is safe
+// for concurrent use.
+type FixedBase interface {
+	// Mul returns s times the fixed point.
+	Mul(s Scalar) Point
+}
+
+// PrecomputablePoint is implemented by the Points of groups that can build
+// a FixedBase for any point. These groups also multiply their standard
+// base point, in Mul(s, nil), with such a table.
+type PrecomputablePoint interface {
+	// Precompute returns the FixedBase of the current value of the
+	// receiver, which later changes of the receiver do not affect.
+	Precompute() FixedBase
+}
+
+// PointsUnmarshaler is implemented by the Groups that decode many points
+// faster together than one by one, such as those whose decompression
+// divides in the field, which share a single inversion among the points.
+type PointsUnmarshaler interface {
+	// UnmarshalPoints returns new Points decoded from the encodings in
+	// data, as UnmarshalBinary decodes them, or the error of the first
+	// invalid encoding.
+	UnmarshalPoints(data [][]byte) ([]Point, error)
+}
+
+// UnmarshalPoints decodes the encodings in data into new Points of g, such
+// as the inputs of a shuffle, with the UnmarshalPoints of g if g implements
+// PointsUnmarshaler, or else one by one. It returns the error of the first
+// invalid encoding.
+func UnmarshalPoints(g Group, data [][]byte) ([]Point, error) {
+	if u, ok := g.(PointsUnmarshaler); ok {
+		return u.UnmarshalPoints(data)
+	}
+	points := make([]Point, len(data))
+	for i, b := range data {
+		points[i] = g.Point()
+		if err := points[i].UnmarshalBinary(b); err != nil {
+			return nil, err
+		}
+	}
+	return points, nil
+}
+
 // Group interface represents a mathematical group
 // usable for Diffie-Hellman key exchange, ElGamal encryption,
 // and the related body of public-key cryptographic algorithms
@@ -8,13 +8,15 @@ import (
 	"math/big"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/blind"
+	"github.com/dedis/kyber/internal/marshalling"
 )
 
 type basicPoint struct {
-	x, y mod.Int
-	c    *BasicCurve
+	x, y  mod.Int
+	c     *BasicCurve
+	blind cipher.Stream // randomness of blinded Mul, if set
 }
 
 func (P *basicPoint) initXY(x, y *big.Int, c kyber.Group) {
@@ -71,6 +73,12 @@ func (P *basicPoint) HideDecode(rep []byte) {
 	P.c.hide.HideDecode(P, rep)
 }
 
+// Valid reports whether P is on the curve and, unless the curve is the full
+// group, in its prime-order subgroup.
+func (P *basicPoint) Valid() bool {
+	return P.c.validPoint(P)
+}
+
 // Equal tests for two Points on the same curve
 func (P *basicPoint) Equal(P2 kyber.Point) bool {
 	E2 := P2.(*basicPoint)
@@ -118,6 +126,20 @@ func (P *basicPoint) Pick(rand cipher.Stream) kyber.Point {
 	return P.Embed(nil, rand)
 }
 
+// HashToPoint sets P to the hash of msg in the domain dst, with
+// hash_to_curve of RFC 9380 and the Elligator 2 map.
+func (P *basicPoint) HashToPoint(msg, dst []byte) kyber.Point {
+	P.c.hashToPoint(P, msg, dst, 2)
+	return P
+}
+
+// EncodeToPoint sets P to the encoding of msg in the domain dst, with
+// encode_to_curve of RFC 9380 and the Elligator 2 map.
+func (P *basicPoint) EncodeToPoint(msg, dst []byte) kyber.Point {
+	P.c.hashToPoint(P, msg, dst, 1)
+	return P
+}
+
 // Data extracts embedded data from a point group element
 func (P *basicPoint) Data() ([]byte, error) {
 	return P.c.data(&P.x, &P.y)
@@ -175,8 +197,17 @@ func (P *basicPoint) Neg(A kyber.Point) kyber.Point {
 	return P
 }
 
+// SetBlinding enables the blinding of Mul on P with the randomness of
+// rand, or disables it if rand is nil, as in kyber.BlindablePoint.
+func (P *basicPoint) SetBlinding(rand cipher.Stream) {
+	P.blind = rand
+}
+
 // Multiply point p by scalar s using the repeated doubling method.
 func (P *basicPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
+	if P.blind != nil {
+		return P.Set(blind.Mul(P.c, s, G, P.blind))
+	}
 	v := s.(*mod.Int).V
 	if G == nil {
 		return P.Base().Mul(s, P)
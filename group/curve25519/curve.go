@@ -7,14 +7,25 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/fixedbase"
 )
 
 var zero = big.NewInt(0)
 var one = big.NewInt(1)
 
+var errNonCanonical = errors.New("non-canonical elliptic curve point")
+var errInvalidPoint = errors.New("invalid elliptic curve point")
+
+// temps pools the field elements that hold the intermediate values of the
+// additions and doublings of projective and extended points, so that the
+// words of these values are reused from one operation to the next rather
+// than allocated afresh.
+var temps = sync.Pool{New: func() interface{} { return new([10]mod.Int) }}
+
 // Extension of Point interface for elliptic curve X,Y coordinate access
 type point interface {
 	kyber.Point
@@ -46,7 +57,18 @@ type curve struct {
 
 	null kyber.Point // Identity point for this group
 
-	hide hiding // Uniform point encoding method
+	hide hiding   // Uniform point encoding method
+	h2c  h2cParam // Parameters of hashing to the curve
+
+	baseOnce  sync.Once
+	baseTable *fixedbase.Table // Multiples of the base point, once needed
+}
+
+// baseMul sets P to s times the standard base point, with a table of its
+// multiples built on the first call.
+func (c *curve) baseMul(P kyber.Point, s kyber.Scalar) kyber.Point {
+	c.baseOnce.Do(func() { c.baseTable = fixedbase.New(c.self, nil) })
+	return c.baseTable.MulTo(P, s)
 }
 
 func (c *curve) String() string {
@@ -148,6 +170,8 @@ func (c *curve) init(self kyber.Group, p *Param, fullGroup bool,
 	}
 	base.initXY(bx, by, self)
 
+	c.h2c.init(c)
+
 	// Uniform representation encoding methods,
 	// only useful when using the full group.
 	// (Points taken from the subgroup would be trivially recognizable.)
@@ -214,6 +238,57 @@ func (c *curve) encodePoint(x, y *mod.Int) []byte {
 // hence Diffie-Hellman exchange can be done without subgroup checking
 // without exposing more than the least-significant bits of the scalar.
 func (c *curve) decodePoint(bb []byte, x, y *mod.Int) error {
+	xsign, err := c.decodeY(bb, y)
+	if err != nil {
+		return err
+	}
+	if !c.solveForX(x, y) {
+		return errInvalidPoint
+	}
+	return c.setXSign(x, xsign)
+}
+
+// decodePoints decodes the points of data into the coordinates x and y as
+// decodePoint does, but with the divisions of solveForX replaced by one
+// inversion of all the denominators, and returns the error of the first
+// invalid point.
+func (c *curve) decodePoints(data [][]byte, x, y []*mod.Int) error {
+	errs := make([]error, len(data))
+	xsigns := make([]uint, len(data))
+	nums := make([]mod.Int, len(data))
+	dens := make([]kyber.Scalar, len(data))
+	for i, b := range data {
+		den := new(mod.Int).Init64(0, &c.P)
+		dens[i] = den
+		if xsigns[i], errs[i] = c.decodeY(b, y[i]); errs[i] == nil {
+			c.xRatio(&nums[i], den, y[i])
+		}
+	}
+
+	// The denominators of the invalid points are left at zero, which
+	// BatchInvert skips.
+	kyber.BatchInvert(dens)
+	for i := range data {
+		if errs[i] != nil {
+			return errs[i]
+		}
+		nums[i].Mul(&nums[i], dens[i])
+		if !x[i].Sqrt(&nums[i]) {
+			return errInvalidPoint
+		}
+		if err := c.setXSign(x[i], xsigns[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeY decodes the y-coordinate of the encoding bb of a point, and
+// returns the sign bit of its x-coordinate.
+func (c *curve) decodeY(bb []byte, y *mod.Int) (uint, error) {
+	if len(bb) == 0 {
+		return 0, errInvalidPoint
+	}
 
 	// Convert from little-endian
 	//fmt.Printf("decoding:\n%s\n", hex.Dump(bb))
@@ -228,14 +303,24 @@ func (c *curve) decodePoint(bb []byte, x, y *mod.Int) error {
 	y.V.SetBytes(b)
 	y.M = &c.P
 
-	// Compute the corresponding x-coordinate
-	if !c.solveForX(x, y) {
-		return errors.New("invalid elliptic curve point")
+	// Unless kyber.AllowNonCanonical is set, reject the encodings that
+	// encodePoint does not produce.
+	if !kyber.NonCanonicalAllowed() &&
+		(len(bb) != c.PointLen() || y.V.Cmp(&c.P) >= 0) {
+		return 0, errNonCanonical
 	}
+	return xsign, nil
+}
+
+// setXSign negates the x-coordinate x, a square root, if its sign differs
+// from xsign.
+func (c *curve) setXSign(x *mod.Int, xsign uint) error {
 	if c.coordSign(x) != xsign {
+		if !kyber.NonCanonicalAllowed() && x.V.Sign() == 0 {
+			return errNonCanonical
+		}
 		x.Neg(x)
 	}
-
 	return nil
 }
 
@@ -248,13 +333,21 @@ func (c *curve) decodePoint(bb []byte, x, y *mod.Int) error {
 // false if there is no x-coordinate corresponding to the chosen y-coordinate.
 //
 func (c *curve) solveForX(x, y *mod.Int) bool {
-	var yy, t1, t2 mod.Int
+	var t1, t2 mod.Int
+
+	c.xRatio(&t1, &t2, y)
+	t2.Div(&t1, &t2)   // t2 = x^2
+	return x.Sqrt(&t2) // may fail if not a square
+}
+
+// xRatio sets num and den to the numerator and denominator of the x^2 of
+// solveForX.
+func (c *curve) xRatio(num, den, y *mod.Int) {
+	var yy mod.Int
 
-	yy.Mul(y, y)                     // yy = y^2
-	t1.Sub(&c.one, &yy)              // t1 = 1 - y^-2
-	t2.Mul(&c.d, &yy).Sub(&c.a, &t2) // t2 = a - d*y^2
-	t2.Div(&t1, &t2)                 // t2 = x^2
-	return x.Sqrt(&t2)               // may fail if not a square
+	yy.Mul(y, y)                      // yy = y^2
+	num.Sub(&c.one, &yy)              // num = 1 - y^2
+	den.Mul(&c.d, &yy).Sub(&c.a, den) // den = a - d*y^2
 }
 
 // Test if a supposed point is on the curve,
@@ -3,10 +3,13 @@
 package curve25519
 
 import (
+	"bytes"
+	"encoding/hex"
 	"testing"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/internal/race"
 	"github.com/dedis/kyber/util/random"
 	"github.com/dedis/kyber/util/test"
 )
@@ -49,6 +52,58 @@ func TestE521(t *testing.T) {
 	test.GroupTest(new(ExtendedCurve).Init(ParamE521(), false))
 }
 
+func TestEd448(t *testing.T) {
+	test.GroupTest(new(ExtendedCurve).Init(ParamEd448(), false))
+}
+
+func TestSuiteAscon25519(t *testing.T) {
+	test.SuiteTest(NewAsconCurve25519(false))
+}
+
+func TestSuiteEd448(t *testing.T) {
+	test.SuiteTest(NewShakeSHA512Ed448())
+}
+
+func TestSuiteEd448ReadWrite(t *testing.T) {
+	suite := NewShakeSHA512Ed448()
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X := suite.Point().Mul(x, nil)
+	var buf bytes.Buffer
+	if err := suite.Write(&buf, x, X); err != nil {
+		t.Fatal(err)
+	}
+	y, Y := suite.Scalar(), suite.Point()
+	if err := suite.Read(&buf, y, Y); err != nil {
+		t.Fatal(err)
+	}
+	if !x.Equal(y) || !X.Equal(Y) {
+		t.Fatal("read back other values")
+	}
+}
+
+// Check the public key of the first Ed448 test vector of RFC 8032, Section 7.4.
+func TestEd448PublicKey(t *testing.T) {
+	suite := NewShakeSHA512Ed448()
+	secret, _ := hex.DecodeString("6c82a562cb808d10d632be89c8513ebf6c929f34ddfa8c9f" +
+		"63c9960ef6e348a3528c8a3fcc2f044e39a3fc5b94492f8f032e7549a20098f95b")
+	public := "5fd7449b59b461fd2ce787ec616ad46a1da1342485a70e1f8a0ea75d80e96778" +
+		"edf124769b46c7061bd6783df1e50f6cd1fa1abeafe8256180"
+
+	h := make([]byte, 114)
+	suite.XOF(secret).Read(h)
+	a := h[:57]
+	a[0] &= 0xfc
+	a[55] |= 0x80
+	a[56] = 0
+	reverse(a, a) // scalars are big-endian
+
+	A := suite.Point().Mul(suite.Scalar().SetBytes(a), nil)
+	b, _ := A.MarshalBinary()
+	if hex.EncodeToString(b) != public {
+		t.Fatal("unexpected public key:", hex.EncodeToString(b))
+	}
+}
+
 func TestSetBytesBE(t *testing.T) {
 	g := new(ExtendedCurve).Init(ParamE521(), false)
 	s := g.Scalar()
@@ -190,3 +245,95 @@ func BenchmarkElligator1(b *testing.B) {
 func BenchmarkElligator2(b *testing.B) {
 	testHiding(new(ExtendedCurve).Init(Param25519(), true), b.N)
 }
+
+func TestNonCanonical(t *testing.T) {
+	for _, enc := range []string{
+		"eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"0100000000000000000000000000000000000000000000000000000000000080",
+		"010000000000000000000000000000000000000000000000000000000000000000",
+	} {
+		b, _ := hex.DecodeString(enc)
+		for _, P := range []kyber.Point{testSuite.Point(), new(ProjectiveCurve).Init(Param25519(), false).Point()} {
+			if err := P.UnmarshalBinary(b); err == nil {
+				t.Fatal("non-canonical point accepted:", enc)
+			}
+			kyber.AllowNonCanonical(true)
+			err := P.UnmarshalBinary(b)
+			kyber.AllowNonCanonical(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !P.Equal(testSuite.Point().Null()) {
+				t.Fatal("non-canonical point is not the identity:", enc)
+			}
+		}
+	}
+}
+
+func TestPointAllocs(t *testing.T) {
+	if race.Enabled {
+		t.Skip("sync.Pool drops values under the race detector")
+	}
+	for _, g := range []kyber.Group{
+		new(ProjectiveCurve).Init(Param25519(), false),
+		new(ExtendedCurve).Init(Param25519(), false),
+	} {
+		rand := random.New()
+		s := g.Scalar().Pick(rand)
+		P := g.Point().Pick(rand)
+		Q := g.Point().Pick(rand)
+		R := g.Point().Mul(s, Q)
+		allocs := testing.AllocsPerRun(10, func() {
+			R.Add(R, P)
+			R.Sub(R, Q)
+			R.Mul(s, Q)
+			R.Mul(s, nil)
+		})
+		if allocs != 0 {
+			t.Fatalf("%s: %v allocations", g, allocs)
+		}
+	}
+}
+
+func TestUnmarshalPoints(t *testing.T) {
+	rand := random.New()
+	for _, g := range []kyber.Group{
+		new(ProjectiveCurve).Init(Param25519(), false),
+		new(ExtendedCurve).Init(ParamE382(), false),
+	} {
+		data := make([][]byte, 5)
+		for i := range data {
+			P := g.Point().Pick(rand)
+			if i == 1 {
+				P.Null()
+			}
+			data[i], _ = P.MarshalBinary()
+		}
+		points, err := kyber.UnmarshalPoints(g, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, P := range points {
+			Q := g.Point()
+			if err := Q.UnmarshalBinary(data[i]); err != nil || !P.Equal(Q) {
+				t.Fatalf("%s: point %d decoded differently", g, i)
+			}
+		}
+
+		// An encoding of a y-coordinate without a matching x-coordinate
+		// before a non-canonical one.
+		bad := make([]byte, g.PointLen())
+		for g.Point().UnmarshalBinary(bad) != errInvalidPoint {
+			rand.XORKeyStream(bad, bad)
+		}
+		data[2] = bad
+		data[3] = append(data[3], 0)
+		if _, err := kyber.UnmarshalPoints(g, data); err != errInvalidPoint {
+			t.Fatalf("%s: wrong error %v for an invalid point", g, err)
+		}
+		data[2], data[3] = data[3], data[2]
+		if _, err := kyber.UnmarshalPoints(g, data); err != errNonCanonical {
+			t.Fatalf("%s: wrong error %v for a non-canonical point", g, err)
+		}
+	}
+}
@@ -9,13 +9,16 @@ import (
 	"math/big"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/blind"
+	"github.com/dedis/kyber/internal/fixedbase"
+	"github.com/dedis/kyber/internal/marshalling"
 )
 
 type extPoint struct {
 	X, Y, Z, T mod.Int
 	c          *ExtendedCurve
+	blind      cipher.Stream // randomness of blinded Mul, if set
 }
 
 func (P *extPoint) initXY(x, y *big.Int, c kyber.Group) {
@@ -76,6 +79,12 @@ func (P *extPoint) HideDecode(rep []byte) {
 	P.c.hide.HideDecode(P, rep)
 }
 
+// Valid reports whether P is on the curve and, unless the curve is the full
+// group, in its prime-order subgroup.
+func (P *extPoint) Valid() bool {
+	return P.c.validPoint(P)
+}
+
 // Equality test for two Points on the same curve.
 // We can avoid inversions here because:
 //
@@ -152,6 +161,20 @@ func (P *extPoint) Pick(rand cipher.Stream) kyber.Point {
 	return P
 }
 
+// HashToPoint sets P to the hash of msg in the domain dst, with
+// hash_to_curve of RFC 9380 and the Elligator 2 map.
+func (P *extPoint) HashToPoint(msg, dst []byte) kyber.Point {
+	P.c.hashToPoint(P, msg, dst, 2)
+	return P
+}
+
+// EncodeToPoint sets P to the encoding of msg in the domain dst, with
+// encode_to_curve of RFC 9380 and the Elligator 2 map.
+func (P *extPoint) EncodeToPoint(msg, dst []byte) kyber.Point {
+	P.c.hashToPoint(P, msg, dst, 1)
+	return P
+}
+
 // Extract embedded data from a point group element
 func (P *extPoint) Data() ([]byte, error) {
 	P.normalize()
@@ -165,20 +188,22 @@ func (P *extPoint) Add(CP1, CP2 kyber.Point) kyber.Point {
 	X1, Y1, Z1, T1 := &P1.X, &P1.Y, &P1.Z, &P1.T
 	X2, Y2, Z2, T2 := &P2.X, &P2.Y, &P2.Z, &P2.T
 	X3, Y3, Z3, T3 := &P.X, &P.Y, &P.Z, &P.T
-	var A, B, C, D, E, F, G, H mod.Int
+	t := temps.Get().(*[10]mod.Int)
+	defer temps.Put(t)
+	A, B, C, D, E, F, G, H := &t[0], &t[1], &t[2], &t[3], &t[4], &t[5], &t[6], &t[7]
 
 	A.Mul(X1, X2)
 	B.Mul(Y1, Y2)
-	C.Mul(T1, T2).Mul(&C, &P.c.d)
+	C.Mul(T1, T2).Mul(C, &P.c.d)
 	D.Mul(Z1, Z2)
-	E.Add(X1, Y1).Mul(&E, F.Add(X2, Y2)).Sub(&E, &A).Sub(&E, &B)
-	F.Sub(&D, &C)
-	G.Add(&D, &C)
-	H.Mul(&P.c.a, &A).Sub(&B, &H)
-	X3.Mul(&E, &F)
-	Y3.Mul(&G, &H)
-	T3.Mul(&E, &H)
-	Z3.Mul(&F, &G)
+	E.Add(X1, Y1).Mul(E, F.Add(X2, Y2)).Sub(E, A).Sub(E, B)
+	F.Sub(D, C)
+	G.Add(D, C)
+	H.Mul(&P.c.a, A).Sub(B, H)
+	X3.Mul(E, F)
+	Y3.Mul(G, H)
+	T3.Mul(E, H)
+	Z3.Mul(F, G)
 	return P
 }
 
@@ -189,20 +214,22 @@ func (P *extPoint) Sub(CP1, CP2 kyber.Point) kyber.Point {
 	X1, Y1, Z1, T1 := &P1.X, &P1.Y, &P1.Z, &P1.T
 	X2, Y2, Z2, T2 := &P2.X, &P2.Y, &P2.Z, &P2.T
 	X3, Y3, Z3, T3 := &P.X, &P.Y, &P.Z, &P.T
-	var A, B, C, D, E, F, G, H mod.Int
+	t := temps.Get().(*[10]mod.Int)
+	defer temps.Put(t)
+	A, B, C, D, E, F, G, H := &t[0], &t[1], &t[2], &t[3], &t[4], &t[5], &t[6], &t[7]
 
 	A.Mul(X1, X2)
 	B.Mul(Y1, Y2)
-	C.Mul(T1, T2).Mul(&C, &P.c.d)
+	C.Mul(T1, T2).Mul(C, &P.c.d)
 	D.Mul(Z1, Z2)
-	E.Add(X1, Y1).Mul(&E, F.Sub(Y2, X2)).Add(&E, &A).Sub(&E, &B)
-	F.Add(&D, &C)
-	G.Sub(&D, &C)
-	H.Mul(&P.c.a, &A).Add(&B, &H)
-	X3.Mul(&E, &F)
-	Y3.Mul(&G, &H)
-	T3.Mul(&E, &H)
-	Z3.Mul(&F, &G)
+	E.Add(X1, Y1).Mul(E, F.Sub(Y2, X2)).Add(E, A).Sub(E, B)
+	F.Add(D, C)
+	G.Sub(D, C)
+	H.Mul(&P.c.a, A).Add(B, H)
+	X3.Mul(E, F)
+	Y3.Mul(G, H)
+	T3.Mul(E, H)
+	Z3.Mul(F, G)
 	return P
 }
 
@@ -223,33 +250,45 @@ func (P *extPoint) Neg(CA kyber.Point) kyber.Point {
 // https://www.iacr.org/archive/asiacrypt2008/53500329/53500329.pdf
 func (P *extPoint) double() {
 	X1, Y1, Z1, T1 := &P.X, &P.Y, &P.Z, &P.T
-	var A, B, C, D, E, F, G, H mod.Int
+	t := temps.Get().(*[10]mod.Int)
+	defer temps.Put(t)
+	A, B, C, D, E, F, G, H := &t[0], &t[1], &t[2], &t[3], &t[4], &t[5], &t[6], &t[7]
 
 	A.Mul(X1, X1)
 	B.Mul(Y1, Y1)
-	C.Mul(Z1, Z1).Add(&C, &C)
-	D.Mul(&P.c.a, &A)
-	E.Add(X1, Y1).Mul(&E, &E).Sub(&E, &A).Sub(&E, &B)
-	G.Add(&D, &B)
-	F.Sub(&G, &C)
-	H.Sub(&D, &B)
-	X1.Mul(&E, &F)
-	Y1.Mul(&G, &H)
-	T1.Mul(&E, &H)
-	Z1.Mul(&F, &G)
-}
-
-// Multiply point p by scalar s using the repeated doubling method.
+	C.Mul(Z1, Z1).Add(C, C)
+	D.Mul(&P.c.a, A)
+	E.Add(X1, Y1).Mul(E, E).Sub(E, A).Sub(E, B)
+	G.Add(D, B)
+	F.Sub(G, C)
+	H.Sub(D, B)
+	X1.Mul(E, F)
+	Y1.Mul(G, H)
+	T1.Mul(E, H)
+	Z1.Mul(F, G)
+}
+
+// SetBlinding enables the blinding of Mul on P with the randomness of
+// rand, or disables it if rand is nil, as in kyber.BlindablePoint.
+func (P *extPoint) SetBlinding(rand cipher.Stream) {
+	P.blind = rand
+}
+
+// Multiply point p by scalar s using the repeated doubling method,
+// or with a precomputed table for the standard base point.
 //
 // Currently doesn't implement the optimization of
 // switching between projective and extended coordinates during
 // scalar multiplication.
 //
 func (P *extPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
-	v := s.(*mod.Int).V
+	if P.blind != nil {
+		return P.Set(blind.Mul(P.c, s, G, P.blind))
+	}
 	if G == nil {
-		return P.Base().Mul(s, P)
+		return P.c.baseMul(P, s)
 	}
+	v := s.(*mod.Int).V
 	T := P
 	if G == P { // Must use temporary for in-place multiply
 		T = &extPoint{}
@@ -267,6 +306,12 @@ func (P *extPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
 	return P
 }
 
+// Precompute returns a kyber.FixedBase for P, whose table of multiples is
+// that with which Mul multiplies the base point.
+func (P *extPoint) Precompute() kyber.FixedBase {
+	return fixedbase.New(P.c.self, P)
+}
+
 // ExtendedCurve implements Twisted Edwards curves
 // using projective coordinate representation (X:Y:Z),
 // satisfying the identities x = X/Z, y = Y/Z.
@@ -306,3 +351,24 @@ func (c *ExtendedCurve) Init(p *Param, fullGroup bool) *ExtendedCurve {
 	c.curve.init(c, p, fullGroup, &c.null, &c.base)
 	return c
 }
+
+// UnmarshalPoints decodes the points of data as UnmarshalBinary does, with
+// one field inversion for all of them rather than one for each.
+func (c *ExtendedCurve) UnmarshalPoints(data [][]byte) ([]kyber.Point, error) {
+	points := make([]kyber.Point, len(data))
+	x := make([]*mod.Int, len(data))
+	y := make([]*mod.Int, len(data))
+	for i := range data {
+		P := &extPoint{c: c}
+		points[i], x[i], y[i] = P, &P.X, &P.Y
+	}
+	if err := c.decodePoints(data, x, y); err != nil {
+		return nil, err
+	}
+	for i := range points {
+		P := points[i].(*extPoint)
+		P.Z.Init64(1, &c.P)
+		P.T.Mul(&P.X, &P.Y)
+	}
+	return points, nil
+}
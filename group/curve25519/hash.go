@@ -0,0 +1,136 @@
+// +build vartime
+
+package curve25519
+
+import (
+	"crypto/sha512"
+	"math/big"
+
+	"github.com/dedis/kyber/group/hash2curve"
+)
+
+// h2cParam holds the parameters of hashing to a twisted Edwards curve with
+// the Elligator 2 map of RFC 9380, Section 6.7.1, to its birationally
+// equivalent Montgomery curve K*t^2 = s^3 + J*s^2 + s, where
+// J = 2(a+d)/(a-d) and K = 4/(a-d). When K is a square, as for Curve25519,
+// the map goes to the equivalent curve of K = 1 and the rational map to the
+// Edwards curve is scaled by sqrt(K), as in Appendix D.1 of RFC 9380, so
+// that hashes to Curve25519 are those of the suites for edwards25519.
+// There are no standard suites for the other curves, whose hashes follow
+// the same construction.
+type h2cParam struct {
+	z    big.Int // non-square Z of the map, as found by find_z_ell2
+	j, k big.Int // J and K of the Montgomery curve of the map
+	c    big.Int // scale of the rational map to the Edwards curve
+	l    int     // bytes per field element in hash_to_field
+}
+
+func (h *h2cParam) init(c *curve) {
+	p := &c.P
+	amd := new(big.Int).Sub(&c.A, &c.D)
+	amd.ModInverse(amd.Mod(amd, p), p)
+	h.j.Add(&c.A, &c.D).Lsh(&h.j, 1).Mul(&h.j, amd).Mod(&h.j, p)
+	h.k.Lsh(amd, 2).Mod(&h.k, p)
+
+	h.c.SetInt64(1)
+	if sqrtK := new(big.Int).ModSqrt(&h.k, p); sqrtK != nil {
+		if sqrtK.Bit(0) == 1 {
+			sqrtK.Sub(p, sqrtK)
+		}
+		h.c.Set(sqrtK)
+		h.k.SetInt64(1)
+	}
+
+	// Z is the non-square of least absolute value, positive first.
+	for n := int64(1); ; n++ {
+		if big.Jacobi(h.z.SetInt64(n), p) < 0 {
+			break
+		}
+		if big.Jacobi(h.z.Sub(p, big.NewInt(n)), p) < 0 {
+			break
+		}
+	}
+
+	// L = ceil((ceil(log2(p)) + k) / 8) for the security level k of
+	// half the bits of the order of the prime-order subgroup.
+	h.l = (p.BitLen() + c.Q.BitLen()/2 + 7) / 8
+}
+
+// hashToPoint sets P to the hash of msg in the domain dst, with the sum of
+// the maps of count field elements hashed with expand_message_xmd over
+// SHA-512, times the cofactor: hash_to_curve for count = 2, and
+// encode_to_curve for count = 1.
+func (c *curve) hashToPoint(P point, msg, dst []byte, count int) {
+	u, err := hash2curve.HashToField(hash2curve.ExpanderXMD(sha512.New), msg, dst, &c.P, 1, c.h2c.l, count)
+	if err != nil {
+		panic(err)
+	}
+	c.mapToCurve(P, u[0])
+	Q := c.self.Point().(point)
+	for _, ui := range u[1:] {
+		c.mapToCurve(Q, ui)
+		P.Add(P, Q)
+	}
+	P.Mul(c.self.Scalar().SetInt64(int64(c.R)), P)
+}
+
+// mapToCurve sets P to the image of u by the Elligator 2 map and the
+// rational map to the Edwards curve.
+func (c *curve) mapToCurve(P point, u *big.Int) {
+	p := &c.P
+	h := &c.h2c
+	reduce := func(x *big.Int) *big.Int { return x.Mod(x, p) }
+	inv := func(x *big.Int) *big.Int { return new(big.Int).ModInverse(x, p) }
+
+	// g(x) = x^3 + (J/K)*x^2 + x/K^2
+	jk := reduce(new(big.Int).Mul(&h.j, inv(&h.k)))
+	k2 := reduce(new(big.Int).Mul(&h.k, &h.k))
+	g := func(x *big.Int) *big.Int {
+		gx := new(big.Int).Add(x, jk)
+		gx.Mul(gx, x)
+		gx.Mul(gx, x)
+		gx.Add(gx, new(big.Int).Mul(x, inv(k2)))
+		return reduce(gx)
+	}
+
+	// x1 = -(J/K) / (1 + Z*u^2), or -(J/K) if the denominator is zero
+	x1 := new(big.Int).Mul(u, u)
+	x1.Mul(x1, &h.z)
+	x1.Add(x1, one)
+	reduce(x1)
+	if x1.Sign() != 0 {
+		x1.Mul(jk, inv(x1))
+	} else {
+		x1.Set(jk)
+	}
+	reduce(x1.Neg(x1))
+
+	// x = x1 and sgn0(y) = 1 if g(x1) is square,
+	// x = x2 = -x1 - J/K and sgn0(y) = 0 otherwise
+	x, sign := x1, uint(1)
+	gx := g(x1)
+	if big.Jacobi(gx, p) < 0 {
+		x = reduce(new(big.Int).Sub(new(big.Int).Neg(x1), jk))
+		sign = 0
+		gx = g(x)
+	}
+	y := new(big.Int).ModSqrt(gx, p)
+	if y.Bit(0) != sign {
+		reduce(y.Sub(p, y))
+	}
+
+	// (s, t) = (x*K, y*K), and (x, y) = (c*s/t, (s-1)/(s+1)) on the
+	// Edwards curve, or the identity if a denominator is zero.
+	s := reduce(new(big.Int).Mul(x, &h.k))
+	t := reduce(new(big.Int).Mul(y, &h.k))
+	s1 := reduce(new(big.Int).Add(s, one))
+	if t.Sign() == 0 || s1.Sign() == 0 {
+		P.initXY(zero, one, c.self)
+		return
+	}
+	ex := new(big.Int).Mul(&h.c, s)
+	reduce(ex.Mul(ex, inv(t)))
+	ey := new(big.Int).Sub(s, one)
+	reduce(ey.Mul(ey, inv(s1)))
+	P.initXY(ex, ey, c.self)
+}
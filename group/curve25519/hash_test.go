@@ -0,0 +1,61 @@
+// +build vartime
+
+package curve25519
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/mod"
+)
+
+// Hashes to Curve25519 are those of the edwards25519 suites of RFC 9380.
+func TestHashToPoint25519(t *testing.T) {
+	ed := new(edwards25519.Curve)
+	groups := []kyber.Group{
+		new(BasicCurve).Init(Param25519(), false),
+		new(ProjectiveCurve).Init(Param25519(), false),
+		new(ExtendedCurve).Init(Param25519(), true),
+	}
+	dst := []byte("QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_RO_")
+	for _, g := range groups {
+		for _, msg := range []string{"", "abc", "abcdef0123456789"} {
+			want, _ := ed.Point().(kyber.HashablePoint).HashToPoint([]byte(msg), dst).MarshalBinary()
+			got, _ := g.Point().(kyber.HashablePoint).HashToPoint([]byte(msg), dst).MarshalBinary()
+			if !bytes.Equal(want, got) {
+				t.Fatalf("%s: wrong hash of %q: %x", g, msg, got)
+			}
+			want, _ = ed.Point().(kyber.HashablePoint).EncodeToPoint([]byte(msg), dst).MarshalBinary()
+			got, _ = g.Point().(kyber.HashablePoint).EncodeToPoint([]byte(msg), dst).MarshalBinary()
+			if !bytes.Equal(want, got) {
+				t.Fatalf("%s: wrong encoding of %q: %x", g, msg, got)
+			}
+		}
+	}
+}
+
+// Hashes to the other curves are points of their prime-order subgroups.
+func TestHashToPoint(t *testing.T) {
+	for _, p := range []*Param{Param1174(), ParamE382(), Param41417(), ParamE521(), ParamEd448()} {
+		g := new(ExtendedCurve).Init(p, true)
+		order := g.Scalar().(*mod.Int)
+		order.V.Set(&p.Q)
+		for _, msg := range []string{"", "abc"} {
+			P := g.Point().(kyber.HashablePoint).HashToPoint([]byte(msg), []byte("dst"))
+			Q := g.Point().(kyber.HashablePoint).EncodeToPoint([]byte(msg), []byte("dst"))
+			for _, R := range []kyber.Point{P, Q} {
+				if R.Equal(g.Point().Null()) {
+					t.Fatalf("%s: identity hash of %q", p, msg)
+				}
+				if !g.Point().Mul(order, R).Equal(g.Point().Null()) {
+					t.Fatalf("%s: hash of %q not in the prime-order subgroup", p, msg)
+				}
+			}
+			if P.Equal(Q) || P.Equal(g.Point().(kyber.HashablePoint).HashToPoint([]byte(msg), []byte("other"))) {
+				t.Fatalf("%s: hashes of %q collide", p, msg)
+			}
+		}
+	}
+}
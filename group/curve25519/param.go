@@ -12,6 +12,13 @@
 // are isomorphic to curves having c == 1.
 //
 // For details see Bernstein et al, "Twisted Edwards Curves", http://eprint.iacr.org/2008/013.pdf
+//
+// The points of ProjectiveCurve and ExtendedCurve keep their coordinates
+// in place and compute with pooled temporaries, so that Add, Sub, Neg and
+// Mul allocate nothing once a point holds a value, except when Mul
+// multiplies the receiver itself, which it copies first. Loops that keep
+// updating the same points thus leave nothing to the garbage collector.
+// The reference BasicCurve allocates in every operation.
 package curve25519
 
 import (
@@ -159,3 +166,24 @@ func ParamE521() *Param {
 	p.PBY.SetString("12", 10)
 	return &p
 }
+
+// Parameters for Ed448-Goldilocks, the untwisted Edwards form of Curve448,
+// as specified in:
+// Hamburg, "Ed448-Goldilocks, a new elliptic curve",
+// http://eprint.iacr.org/2015/625.pdf
+//
+// and RFC 8032, "Edwards-Curve Digital Signature Algorithm (EdDSA)".
+func ParamEd448() *Param {
+	var p Param
+	var qs big.Int
+	p.Name = "Ed448"
+	p.P.SetBit(zero, 448, 1).Sub(&p.P, new(big.Int).SetBit(zero, 224, 1)).Sub(&p.P, one) // p = 2^448-2^224-1
+	qs.SetString("13818066809895115352007386748515426880336692474882178609894547503885", 10)
+	p.Q.SetBit(zero, 446, 1).Sub(&p.Q, &qs)
+	p.R = 4
+	p.A.SetInt64(1)
+	p.D.SetInt64(-39081)
+	p.PBX.SetString("224580040295924300187604334099896036246789641632564134246125461686950415467406032909029192869357953282578032075146446173674602635247710", 10)
+	p.PBY.SetString("298819210078481492676017930443930673437544040154080242095928241372331506189835876003536878655418784733982303233503462500531545062832660", 10)
+	return &p
+}
@@ -8,13 +8,16 @@ import (
 	"math/big"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/blind"
+	"github.com/dedis/kyber/internal/fixedbase"
+	"github.com/dedis/kyber/internal/marshalling"
 )
 
 type projPoint struct {
 	X, Y, Z mod.Int
 	c       *ProjectiveCurve
+	blind   cipher.Stream // randomness of blinded Mul, if set
 }
 
 func (P *projPoint) initXY(x, y *big.Int, c kyber.Group) {
@@ -68,6 +71,12 @@ func (P *projPoint) HideDecode(rep []byte) {
 	P.c.hide.HideDecode(P, rep)
 }
 
+// Valid reports whether P is on the curve and, unless the curve is the full
+// group, in its prime-order subgroup.
+func (P *projPoint) Valid() bool {
+	return P.c.validPoint(P)
+}
+
 // Equality test for two Points on the same curve.
 // We can avoid inversions here because:
 //
@@ -132,6 +141,20 @@ func (P *projPoint) Pick(rand cipher.Stream) kyber.Point {
 	return P.Embed(nil, rand)
 }
 
+// HashToPoint sets P to the hash of msg in the domain dst, with
+// hash_to_curve of RFC 9380 and the Elligator 2 map.
+func (P *projPoint) HashToPoint(msg, dst []byte) kyber.Point {
+	P.c.hashToPoint(P, msg, dst, 2)
+	return P
+}
+
+// EncodeToPoint sets P to the encoding of msg in the domain dst, with
+// encode_to_curve of RFC 9380 and the Elligator 2 map.
+func (P *projPoint) EncodeToPoint(msg, dst []byte) kyber.Point {
+	P.c.hashToPoint(P, msg, dst, 1)
+	return P
+}
+
 // Extract embedded data from a point group element
 func (P *projPoint) Data() ([]byte, error) {
 	P.normalize()
@@ -149,24 +172,26 @@ func (P *projPoint) Add(CP1, CP2 kyber.Point) kyber.Point {
 	P2 := CP2.(*projPoint)
 	X1, Y1, Z1 := &P1.X, &P1.Y, &P1.Z
 	X2, Y2, Z2 := &P2.X, &P2.Y, &P2.Z
-	var A, B, C, D, E, F, G, X3, Y3, Z3 mod.Int
+	t := temps.Get().(*[10]mod.Int)
+	defer temps.Put(t)
+	A, B, C, D, E, F, G, X3, Y3, Z3 := &t[0], &t[1], &t[2], &t[3], &t[4], &t[5], &t[6], &t[7], &t[8], &t[9]
 
 	A.Mul(Z1, Z2)
-	B.Mul(&A, &A)
+	B.Mul(A, A)
 	C.Mul(X1, X2)
 	D.Mul(Y1, Y2)
-	E.Mul(&C, &D).Mul(&P.c.d, &E)
-	F.Sub(&B, &E)
-	G.Add(&B, &E)
-	X3.Add(X1, Y1).Mul(&X3, Z3.Add(X2, Y2)).Sub(&X3, &C).Sub(&X3, &D).
-		Mul(&F, &X3).Mul(&A, &X3)
-	Y3.Mul(&P.c.a, &C).Sub(&D, &Y3).Mul(&G, &Y3).Mul(&A, &Y3)
-	Z3.Mul(&F, &G)
+	E.Mul(C, D).Mul(&P.c.d, E)
+	F.Sub(B, E)
+	G.Add(B, E)
+	X3.Add(X1, Y1).Mul(X3, Z3.Add(X2, Y2)).Sub(X3, C).Sub(X3, D).
+		Mul(F, X3).Mul(A, X3)
+	Y3.Mul(&P.c.a, C).Sub(D, Y3).Mul(G, Y3).Mul(A, Y3)
+	Z3.Mul(F, G)
 
 	P.c = P1.c
-	P.X.Set(&X3)
-	P.Y.Set(&Y3)
-	P.Z.Set(&Z3)
+	P.X.Set(X3)
+	P.Y.Set(Y3)
+	P.Z.Set(Z3)
 	return P
 }
 
@@ -176,24 +201,26 @@ func (P *projPoint) Sub(CP1, CP2 kyber.Point) kyber.Point {
 	P2 := CP2.(*projPoint)
 	X1, Y1, Z1 := &P1.X, &P1.Y, &P1.Z
 	X2, Y2, Z2 := &P2.X, &P2.Y, &P2.Z
-	var A, B, C, D, E, F, G, X3, Y3, Z3 mod.Int
+	t := temps.Get().(*[10]mod.Int)
+	defer temps.Put(t)
+	A, B, C, D, E, F, G, X3, Y3, Z3 := &t[0], &t[1], &t[2], &t[3], &t[4], &t[5], &t[6], &t[7], &t[8], &t[9]
 
 	A.Mul(Z1, Z2)
-	B.Mul(&A, &A)
+	B.Mul(A, A)
 	C.Mul(X1, X2)
 	D.Mul(Y1, Y2)
-	E.Mul(&C, &D).Mul(&P.c.d, &E)
-	F.Add(&B, &E)
-	G.Sub(&B, &E)
-	X3.Add(X1, Y1).Mul(&X3, Z3.Sub(Y2, X2)).Add(&X3, &C).Sub(&X3, &D).
-		Mul(&F, &X3).Mul(&A, &X3)
-	Y3.Mul(&P.c.a, &C).Add(&D, &Y3).Mul(&G, &Y3).Mul(&A, &Y3)
-	Z3.Mul(&F, &G)
+	E.Mul(C, D).Mul(&P.c.d, E)
+	F.Add(B, E)
+	G.Sub(B, E)
+	X3.Add(X1, Y1).Mul(X3, Z3.Sub(Y2, X2)).Add(X3, C).Sub(X3, D).
+		Mul(F, X3).Mul(A, X3)
+	Y3.Mul(&P.c.a, C).Add(D, Y3).Mul(G, Y3).Mul(A, Y3)
+	Z3.Mul(F, G)
 
 	P.c = P1.c
-	P.X.Set(&X3)
-	P.Y.Set(&Y3)
-	P.Z.Set(&Z3)
+	P.X.Set(X3)
+	P.Y.Set(Y3)
+	P.Z.Set(Z3)
 	return P
 }
 
@@ -210,26 +237,38 @@ func (P *projPoint) Neg(CA kyber.Point) kyber.Point {
 
 // Optimized point doubling for use in scalar multiplication.
 func (P *projPoint) double() {
-	var B, C, D, E, F, H, J mod.Int
+	t := temps.Get().(*[10]mod.Int)
+	defer temps.Put(t)
+	B, C, D, E, F, H, J := &t[0], &t[1], &t[2], &t[3], &t[4], &t[5], &t[6]
 
-	B.Add(&P.X, &P.Y).Mul(&B, &B)
+	B.Add(&P.X, &P.Y).Mul(B, B)
 	C.Mul(&P.X, &P.X)
 	D.Mul(&P.Y, &P.Y)
-	E.Mul(&P.c.a, &C)
-	F.Add(&E, &D)
+	E.Mul(&P.c.a, C)
+	F.Add(E, D)
 	H.Mul(&P.Z, &P.Z)
-	J.Add(&H, &H).Sub(&F, &J)
-	P.X.Sub(&B, &C).Sub(&P.X, &D).Mul(&P.X, &J)
-	P.Y.Sub(&E, &D).Mul(&F, &P.Y)
-	P.Z.Mul(&F, &J)
+	J.Add(H, H).Sub(F, J)
+	P.X.Sub(B, C).Sub(&P.X, D).Mul(&P.X, J)
+	P.Y.Sub(E, D).Mul(F, &P.Y)
+	P.Z.Mul(F, J)
+}
+
+// SetBlinding enables the blinding of Mul on P with the randomness of
+// rand, or disables it if rand is nil, as in kyber.BlindablePoint.
+func (P *projPoint) SetBlinding(rand cipher.Stream) {
+	P.blind = rand
 }
 
-// Multiply point p by scalar s using the repeated doubling method.
+// Multiply point p by scalar s using the repeated doubling method,
+// or with a precomputed table for the standard base point.
 func (P *projPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
-	v := s.(*mod.Int).V
+	if P.blind != nil {
+		return P.Set(blind.Mul(P.c, s, G, P.blind))
+	}
 	if G == nil {
-		return P.Base().Mul(s, P)
+		return P.c.baseMul(P, s)
 	}
+	v := s.(*mod.Int).V
 	T := P
 	if G == P { // Must use temporary for in-place multiply
 		T = &projPoint{}
@@ -247,6 +286,11 @@ func (P *projPoint) Mul(s kyber.Scalar, G kyber.Point) kyber.Point {
 	return P
 }
 
+// Precompute returns a kyber.FixedBase for P, like that of Mul(s, nil).
+func (P *projPoint) Precompute() kyber.FixedBase {
+	return fixedbase.New(P.c.self, P)
+}
+
 // ProjectiveCurve implements Twisted Edwards curves
 // using projective coordinate representation (X:Y:Z),
 // satisfying the identities x = X/Z, y = Y/Z.
@@ -274,3 +318,20 @@ func (c *ProjectiveCurve) Init(p *Param, fullGroup bool) *ProjectiveCurve {
 	c.curve.init(c, p, fullGroup, &c.null, &c.base)
 	return c
 }
+
+// UnmarshalPoints decodes the points of data as UnmarshalBinary does, but
+// shares a single field inversion among them.
+func (c *ProjectiveCurve) UnmarshalPoints(data [][]byte) ([]kyber.Point, error) {
+	points := make([]kyber.Point, len(data))
+	x := make([]*mod.Int, len(data))
+	y := make([]*mod.Int, len(data))
+	for i := range data {
+		P := &projPoint{c: c}
+		P.Z.Init64(1, &c.P)
+		points[i], x[i], y[i] = P, &P.X, &P.Y
+	}
+	if err := c.decodePoints(data, x, y); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
@@ -5,20 +5,34 @@ package curve25519
 import (
 	"crypto/cipher"
 	"crypto/sha256"
+	"crypto/sha512"
 	"hash"
 	"io"
 	"reflect"
+	"sync"
 
 	"github.com/dedis/fixbuf"
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/fips"
 
-	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/ascon"
 	"github.com/dedis/kyber/xof/blake"
+	"github.com/dedis/kyber/xof/keccak"
 )
 
 type SuiteEd25519 struct {
 	ProjectiveCurve
+	pool     *kyber.Pool
+	poolOnce sync.Once
+}
+
+// Pool returns the pool of temporary Points and Scalars shared by the users
+// of the suite, as in kyber.Pooling.
+func (s *SuiteEd25519) Pool() *kyber.Pool {
+	s.poolOnce.Do(func() { s.pool = kyber.NewPool(s) })
+	return s.pool
 }
 
 // SHA256 hash function
@@ -59,3 +73,117 @@ func NewBlakeSHA256Curve25519(fullGroup bool) *SuiteEd25519 {
 	suite.Init(Param25519(), fullGroup)
 	return suite
 }
+
+// NewBlakeSHA256Curve25519Checked is NewBlakeSHA256Curve25519, except that it
+// returns an error wrapping suites.ErrNotFIPSApproved if FIPS mode is
+// required.
+func NewBlakeSHA256Curve25519Checked(fullGroup bool) (*SuiteEd25519, error) {
+	if err := fips.Check("Curve25519"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA256Curve25519(fullGroup), nil
+}
+
+// SuiteAscon25519 is a Curve25519 suite whose hash and XOF are Ascon-Hash
+// and Ascon-Xof, for constrained devices where neither AES hardware nor a
+// large SHA-3 state is affordable.
+type SuiteAscon25519 struct {
+	SuiteEd25519
+}
+
+// Hash returns a newly instanciated Ascon-Hash hash function.
+func (s *SuiteAscon25519) Hash() hash.Hash {
+	return ascon.NewHash()
+}
+
+// XOF returns an XOF which is implemented via Ascon-Xof.
+func (s *SuiteAscon25519) XOF(seed []byte) kyber.XOF {
+	return ascon.New(seed)
+}
+
+// String returns the name of the underlying curve suffixed with "-Ascon".
+func (s *SuiteAscon25519) String() string {
+	return s.SuiteEd25519.String() + "-Ascon"
+}
+
+// NewAsconCurve25519 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/ascon, for both hashing and the XOF, and
+// Curve25519.
+//
+// If fullGroup is false, then the group is the prime-order subgroup.
+func NewAsconCurve25519(fullGroup bool) *SuiteAscon25519 {
+	suite := new(SuiteAscon25519)
+	suite.Init(Param25519(), fullGroup)
+	return suite
+}
+
+// NewAsconCurve25519Checked is NewAsconCurve25519, except that it returns an
+// error wrapping suites.ErrNotFIPSApproved if FIPS mode is required.
+func NewAsconCurve25519Checked(fullGroup bool) (*SuiteAscon25519, error) {
+	if err := fips.Check("Curve25519-Ascon"); err != nil {
+		return nil, err
+	}
+	return NewAsconCurve25519(fullGroup), nil
+}
+
+// SuiteEd448 is a suite at the 224-bit security level, based on the
+// Ed448-Goldilocks curve.
+type SuiteEd448 struct {
+	ExtendedCurve
+	pool     *kyber.Pool
+	poolOnce sync.Once
+}
+
+// Pool returns the pool of temporary Points and Scalars shared by the users
+// of the suite, as in kyber.Pooling.
+func (s *SuiteEd448) Pool() *kyber.Pool {
+	s.poolOnce.Do(func() { s.pool = kyber.NewPool(s) })
+	return s.pool
+}
+
+// SHA512 hash function
+func (s *SuiteEd448) Hash() hash.Hash {
+	return sha512.New()
+}
+
+func (s *SuiteEd448) XOF(seed []byte) kyber.XOF {
+	return keccak.New(seed)
+}
+
+func (s *SuiteEd448) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *SuiteEd448) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+func (s *SuiteEd448) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+func (s *SuiteEd448) RandomStream() cipher.Stream {
+	return random.New()
+}
+
+// NewShakeSHA512Ed448 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/keccak (SHAKE256), SHA-512, and the
+// prime-order subgroup of the Ed448-Goldilocks curve.
+//
+// The scalars created by this group implement kyber.Scalar's SetBytes
+// method, interpreting the bytes as a big-endian integer, so as to be
+// compatible with the Go standard library's big.Int type.
+func NewShakeSHA512Ed448() *SuiteEd448 {
+	suite := new(SuiteEd448)
+	suite.Init(ParamEd448(), false)
+	return suite
+}
+
+// NewShakeSHA512Ed448Checked is NewShakeSHA512Ed448, except that it returns
+// an error wrapping suites.ErrNotFIPSApproved if FIPS mode is required.
+func NewShakeSHA512Ed448Checked() (*SuiteEd448, error) {
+	if err := fips.Check("Ed448"); err != nil {
+		return nil, err
+	}
+	return NewShakeSHA512Ed448(), nil
+}
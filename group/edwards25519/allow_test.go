@@ -14,3 +14,10 @@ func TestNotVartime(t *testing.T) {
 		t.Fatal("expected Point to NOT allow var time")
 	}
 }
+
+func TestVarTimePointConstantTime(t *testing.T) {
+	P := kyber.VarTimePoint(tSuite)
+	if P.(*point).varTime {
+		t.Fatal("variable time allowed without the vartime tag")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
 )
 
 func TestVartime(t *testing.T) {
@@ -20,3 +21,26 @@ func TestVartime(t *testing.T) {
 		t.Fatal("expected Point to allow var time")
 	}
 }
+
+func TestVarTimePoint(t *testing.T) {
+	rand := random.New()
+	s := tSuite.Scalar().Pick(rand)
+	for _, g := range []kyber.Group{tSuite, tRistretto} {
+		A := g.Point().Pick(rand)
+		P := kyber.VarTimePoint(g)
+		if !P.Mul(s, A).Equal(g.Point().Mul(s, A)) {
+			t.Fatalf("%s: variable time product differs", g)
+		}
+		kyber.SetVarTime(false, P, s, nil)
+		switch P := P.(type) {
+		case *point:
+			if P.varTime {
+				t.Fatal("variable time not disabled")
+			}
+		case *ristrettoPoint:
+			if P.varTime {
+				t.Fatal("variable time not disabled")
+			}
+		}
+	}
+}
@@ -34,6 +34,12 @@ func (c *Curve) Scalar() kyber.Scalar {
 	return &scalar{}
 }
 
+// ScalarAt returns a zero Scalar stored in the first 32 bytes of b, such
+// as secure memory of util/secmem. It implements secmem.Allocator.
+func (c *Curve) ScalarAt(b []byte) kyber.Scalar {
+	return scalarAt(b)
+}
+
 // PointLen returns 32, the size in bytes of an encoded Point on the Ed25519 curve.
 func (c *Curve) PointLen() int {
 	return 32
@@ -1,8 +1,11 @@
 package edwards25519
 
 import (
+	"bytes"
+	"encoding/hex"
 	"testing"
 
+	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/util/test"
 )
 
@@ -11,6 +14,24 @@ var groupBench = test.NewGroupBench(tSuite)
 
 func TestSuite(t *testing.T) { test.SuiteTest(tSuite) }
 
+func TestChaCha20Suite(t *testing.T) { test.SuiteTest(NewChaCha20SHA256Ed25519()) }
+
+func TestCurve25519ToPublicKey(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		var pub, u, back [32]byte
+		b, _ := tSuite.Point().Pick(tSuite.RandomStream()).MarshalBinary()
+		copy(pub[:], b)
+		if !PublicKeyToCurve25519(&u, &pub) || !Curve25519ToPublicKey(&back, &u) {
+			t.Fatal("conversion failed")
+		}
+		// The sign of the x-coordinate is lost.
+		pub[31] &= 0x7f
+		if !bytes.Equal(pub[:], back[:]) {
+			t.Fatal("conversion does not round trip")
+		}
+	}
+}
+
 func BenchmarkScalarAdd(b *testing.B)    { groupBench.ScalarAdd(b.N) }
 func BenchmarkScalarSub(b *testing.B)    { groupBench.ScalarSub(b.N) }
 func BenchmarkScalarNeg(b *testing.B)    { groupBench.ScalarNeg(b.N) }
@@ -29,3 +50,48 @@ func BenchmarkPointBaseMul(b *testing.B) { groupBench.PointBaseMul(b.N) }
 func BenchmarkPointPick(b *testing.B)    { groupBench.PointPick(b.N) }
 func BenchmarkPointEncode(b *testing.B)  { groupBench.PointEncode(b.N) }
 func BenchmarkPointDecode(b *testing.B)  { groupBench.PointDecode(b.N) }
+
+func TestNonCanonicalPoint(t *testing.T) {
+	for _, enc := range []string{
+		// y = 2^255-18, reducing to the identity
+		"eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// the identity with the sign bit set
+		"0100000000000000000000000000000000000000000000000000000000000080",
+	} {
+		b, _ := hex.DecodeString(enc)
+		P := tSuite.Point()
+		if err := P.UnmarshalBinary(b); err == nil {
+			t.Fatal("non-canonical point accepted:", enc)
+		}
+
+		kyber.AllowNonCanonical(true)
+		err := P.UnmarshalBinary(b)
+		kyber.AllowNonCanonical(false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !P.Equal(tSuite.Point().Null()) {
+			t.Fatal("non-canonical point is not the identity:", enc)
+		}
+	}
+}
+
+func TestMulCofactor(t *testing.T) {
+	// a point of order 2
+	b, _ := hex.DecodeString("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	T := tSuite.Point()
+	if err := T.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	P := tSuite.Point().Pick(tSuite.RandomStream())
+	P8 := tSuite.Point().Mul(tSuite.Scalar().SetInt64(8), P)
+
+	Q := tSuite.Point().Add(P, T)
+	Q.(kyber.CofactorPoint).MulCofactor(Q)
+	if !Q.Equal(P8) {
+		t.Fatal("cofactor not cleared")
+	}
+	if !Q.(kyber.ValidatablePoint).Valid() {
+		t.Fatal("8*P not in the prime-order subgroup")
+	}
+}
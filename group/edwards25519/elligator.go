@@ -53,14 +53,33 @@ func PublicKeyToCurve25519(curve25519Public *[32]byte, publicKey *[32]byte) bool
 	return true
 }
 
+// Curve25519ToPublicKey converts a curve25519 public key into the Ed25519
+// public key with the same u-coordinate and a positive x-coordinate. It
+// returns false if there is no such point, when the curve25519 public key is
+// a point of the twist.
+func Curve25519ToPublicKey(publicKey *[32]byte, curve25519Public *[32]byte) bool {
+	// The inverse of the isomorphism above is y=(u-1)/(u+1).
+	var u, y, one, den fieldElement
+	feFromBytes(&u, curve25519Public[:])
+	feOne(&one)
+	feAdd(&den, &u, &one)
+	feInvert(&den, &den)
+	feSub(&y, &u, &one)
+	feMul(&y, &y, &den)
+	feToBytes(publicKey, &y)
+
+	var A extendedGroupElement
+	return A.FromBytes(publicKey[:])
+}
+
 // sqrtMinusA is sqrt(-486662)
 var sqrtMinusA = fieldElement{
-	12222970, 8312128, 11511410, -9067497, 15300785, 241793, -25456130, -14121551, 12187136, -3972024,
+	557817479725543, 1643290402203250, 16226468853936, 1304118542701054, 1985241807451647,
 }
 
 // sqrtMinusHalf is sqrt(-1/2)
 var sqrtMinusHalf = fieldElement{
-	-17256545, 3971863, 28865457, -1750208, 27359696, -16640980, 12573105, 1002827, -163343, 11073975,
+	266547196637087, 2134345371906993, 1135042577398223, 67298593331632, 743161882051057,
 }
 
 // halfQMinus1Bytes is (2^255-20)/2 expressed in little endian form.
@@ -0,0 +1,13 @@
+// +build !purego
+
+package edwards25519
+
+// feMul is feMulGeneric in assembly.
+//
+//go:noescape
+func feMul(h, x, y *fieldElement)
+
+// feSquare is feSquareGeneric in assembly.
+//
+//go:noescape
+func feSquare(h, x *fieldElement)
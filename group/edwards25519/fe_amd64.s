@@ -0,0 +1,321 @@
+// +build !purego
+
+#include "textflag.h"
+
+// func feMul(h, x, y *fieldElement)
+//
+// feMul is feMulGeneric, with the column sums r0...r4 held in the register
+// pairs R9:R8, R11:R10, R13:R12, R15:R14 and DI:SI. The multiples of 19 of
+// the limbs of x are computed again for every column, as MULQ takes one of
+// its operands in AX anyway.
+TEXT ·feMul(SB), NOSPLIT, $0-24
+	MOVQ x+8(FP), CX
+	MOVQ y+16(FP), BX
+
+	// r0 = x0*y0 + 19*x1*y4 + 19*x2*y3 + 19*x3*y2 + 19*x4*y1
+	MOVQ 0(CX), AX
+	MULQ 0(BX)
+	MOVQ AX, R8
+	MOVQ DX, R9
+	MOVQ 8(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 16(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 24(BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 24(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 16(BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 32(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 8(BX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+
+	// r1 = x0*y1 + x1*y0 + 19*x2*y4 + 19*x3*y3 + 19*x4*y2
+	MOVQ 0(CX), AX
+	MULQ 8(BX)
+	MOVQ AX, R10
+	MOVQ DX, R11
+	MOVQ 8(CX), AX
+	MULQ 0(BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 16(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 24(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 24(BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 32(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 16(BX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+
+	// r2 = x0*y2 + x1*y1 + x2*y0 + 19*x3*y4 + 19*x4*y3
+	MOVQ 0(CX), AX
+	MULQ 16(BX)
+	MOVQ AX, R12
+	MOVQ DX, R13
+	MOVQ 8(CX), AX
+	MULQ 8(BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 16(CX), AX
+	MULQ 0(BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 24(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 32(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 24(BX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+
+	// r3 = x0*y3 + x1*y2 + x2*y1 + x3*y0 + 19*x4*y4
+	MOVQ 0(CX), AX
+	MULQ 24(BX)
+	MOVQ AX, R14
+	MOVQ DX, R15
+	MOVQ 8(CX), AX
+	MULQ 16(BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 16(CX), AX
+	MULQ 8(BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 24(CX), AX
+	MULQ 0(BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 32(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 32(BX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+
+	// r4 = x0*y4 + x1*y3 + x2*y2 + x3*y1 + x4*y0
+	MOVQ 0(CX), AX
+	MULQ 32(BX)
+	MOVQ AX, SI
+	MOVQ DX, DI
+	MOVQ 8(CX), AX
+	MULQ 24(BX)
+	ADDQ AX, SI
+	ADCQ DX, DI
+	MOVQ 16(CX), AX
+	MULQ 16(BX)
+	ADDQ AX, SI
+	ADCQ DX, DI
+	MOVQ 24(CX), AX
+	MULQ 8(BX)
+	ADDQ AX, SI
+	ADCQ DX, DI
+	MOVQ 32(CX), AX
+	MULQ 0(BX)
+	ADDQ AX, SI
+	ADCQ DX, DI
+
+	// carry the bits above 51 of each column to the next one
+	MOVQ $0x0007ffffffffffff, AX
+	SHLQ $13, R8, R9
+	ANDQ AX, R8
+	SHLQ $13, R10, R11
+	ANDQ AX, R10
+	SHLQ $13, R12, R13
+	ANDQ AX, R12
+	SHLQ $13, R14, R15
+	ANDQ AX, R14
+	SHLQ $13, SI, DI
+	ANDQ AX, SI
+	IMUL3Q $19, DI, DI
+	ADDQ DI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+	ADDQ R15, SI
+
+	// feCarry
+	MOVQ R8, R9
+	SHRQ $51, R9
+	MOVQ R10, R11
+	SHRQ $51, R11
+	MOVQ R12, R13
+	SHRQ $51, R13
+	MOVQ R14, R15
+	SHRQ $51, R15
+	MOVQ SI, DI
+	SHRQ $51, DI
+	ANDQ AX, R8
+	ANDQ AX, R10
+	ANDQ AX, R12
+	ANDQ AX, R14
+	ANDQ AX, SI
+	IMUL3Q $19, DI, DI
+	ADDQ DI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+	ADDQ R15, SI
+
+	MOVQ h+0(FP), DI
+	MOVQ R8, 0(DI)
+	MOVQ R10, 8(DI)
+	MOVQ R12, 16(DI)
+	MOVQ R14, 24(DI)
+	MOVQ SI, 32(DI)
+	RET
+
+// func feSquare(h, x *fieldElement)
+//
+// feSquare is feSquareGeneric, with the same registers as feMul.
+TEXT ·feSquare(SB), NOSPLIT, $0-16
+	MOVQ x+8(FP), CX
+
+	// r0 = x0*x0 + 38*x1*x4 + 38*x2*x3
+	MOVQ 0(CX), AX
+	MULQ 0(CX)
+	MOVQ AX, R8
+	MOVQ DX, R9
+	MOVQ 8(CX), AX
+	IMUL3Q $38, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+	MOVQ 16(CX), AX
+	IMUL3Q $38, AX, AX
+	MULQ 24(CX)
+	ADDQ AX, R8
+	ADCQ DX, R9
+
+	// r1 = 2*x0*x1 + 38*x2*x4 + 19*x3*x3
+	MOVQ 0(CX), AX
+	SHLQ $1, AX
+	MULQ 8(CX)
+	MOVQ AX, R10
+	MOVQ DX, R11
+	MOVQ 16(CX), AX
+	IMUL3Q $38, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+	MOVQ 24(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 24(CX)
+	ADDQ AX, R10
+	ADCQ DX, R11
+
+	// r2 = 2*x0*x2 + x1*x1 + 38*x3*x4
+	MOVQ 0(CX), AX
+	SHLQ $1, AX
+	MULQ 16(CX)
+	MOVQ AX, R12
+	MOVQ DX, R13
+	MOVQ 8(CX), AX
+	MULQ 8(CX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+	MOVQ 24(CX), AX
+	IMUL3Q $38, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, R12
+	ADCQ DX, R13
+
+	// r3 = 2*x0*x3 + 2*x1*x2 + 19*x4*x4
+	MOVQ 0(CX), AX
+	SHLQ $1, AX
+	MULQ 24(CX)
+	MOVQ AX, R14
+	MOVQ DX, R15
+	MOVQ 8(CX), AX
+	SHLQ $1, AX
+	MULQ 16(CX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+	MOVQ 32(CX), AX
+	IMUL3Q $19, AX, AX
+	MULQ 32(CX)
+	ADDQ AX, R14
+	ADCQ DX, R15
+
+	// r4 = 2*x0*x4 + 2*x1*x3 + x2*x2
+	MOVQ 0(CX), AX
+	SHLQ $1, AX
+	MULQ 32(CX)
+	MOVQ AX, SI
+	MOVQ DX, DI
+	MOVQ 8(CX), AX
+	SHLQ $1, AX
+	MULQ 24(CX)
+	ADDQ AX, SI
+	ADCQ DX, DI
+	MOVQ 16(CX), AX
+	MULQ 16(CX)
+	ADDQ AX, SI
+	ADCQ DX, DI
+
+	// carry the bits above 51 of each column to the next one
+	MOVQ $0x0007ffffffffffff, AX
+	SHLQ $13, R8, R9
+	ANDQ AX, R8
+	SHLQ $13, R10, R11
+	ANDQ AX, R10
+	SHLQ $13, R12, R13
+	ANDQ AX, R12
+	SHLQ $13, R14, R15
+	ANDQ AX, R14
+	SHLQ $13, SI, DI
+	ANDQ AX, SI
+	IMUL3Q $19, DI, DI
+	ADDQ DI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+	ADDQ R15, SI
+
+	// feCarry
+	MOVQ R8, R9
+	SHRQ $51, R9
+	MOVQ R10, R11
+	SHRQ $51, R11
+	MOVQ R12, R13
+	SHRQ $51, R13
+	MOVQ R14, R15
+	SHRQ $51, R15
+	MOVQ SI, DI
+	SHRQ $51, DI
+	ANDQ AX, R8
+	ANDQ AX, R10
+	ANDQ AX, R12
+	ANDQ AX, R14
+	ANDQ AX, SI
+	IMUL3Q $19, DI, DI
+	ADDQ DI, R8
+	ADDQ R9, R10
+	ADDQ R11, R12
+	ADDQ R13, R14
+	ADDQ R15, SI
+
+	MOVQ h+0(FP), DI
+	MOVQ R8, 0(DI)
+	MOVQ R10, 8(DI)
+	MOVQ R12, 16(DI)
+	MOVQ R14, 24(DI)
+	MOVQ SI, 32(DI)
+	RET
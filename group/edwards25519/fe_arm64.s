@@ -0,0 +1,292 @@
+// +build arm64asm,!purego
+
+#include "textflag.h"
+
+// func feMul(h, x, y *fieldElement)
+//
+// feMul is feMulGeneric. The limbs of x are in R2-R6, those of y in R7-R11
+// and the multiples of 19 of x1...x4 in R12-R15. The column sums r0...r4
+// take two registers each, the low half first, from R16 on, and R0 and R1
+// hold the products as they are added.
+TEXT ·feMul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R0
+	LDP (R0), (R2, R3)
+	LDP 16(R0), (R4, R5)
+	MOVD 32(R0), R6
+	MOVD y+16(FP), R1
+	LDP (R1), (R7, R8)
+	LDP 16(R1), (R9, R10)
+	MOVD 32(R1), R11
+
+	MOVD $19, R0
+	MUL R0, R3, R12
+	MUL R0, R4, R13
+	MUL R0, R5, R14
+	MUL R0, R6, R15
+
+	// r0 = x0*y0 + 19*x1*y4 + 19*x2*y3 + 19*x3*y2 + 19*x4*y1
+	MUL R7, R2, R16
+	UMULH R7, R2, R17
+	MUL R11, R12, R0
+	UMULH R11, R12, R1
+	ADDS R0, R16, R16
+	ADC R1, R17, R17
+	MUL R10, R13, R0
+	UMULH R10, R13, R1
+	ADDS R0, R16, R16
+	ADC R1, R17, R17
+	MUL R9, R14, R0
+	UMULH R9, R14, R1
+	ADDS R0, R16, R16
+	ADC R1, R17, R17
+	MUL R8, R15, R0
+	UMULH R8, R15, R1
+	ADDS R0, R16, R16
+	ADC R1, R17, R17
+
+	// r1 = x0*y1 + x1*y0 + 19*x2*y4 + 19*x3*y3 + 19*x4*y2
+	MUL R8, R2, R19
+	UMULH R8, R2, R20
+	MUL R7, R3, R0
+	UMULH R7, R3, R1
+	ADDS R0, R19, R19
+	ADC R1, R20, R20
+	MUL R11, R13, R0
+	UMULH R11, R13, R1
+	ADDS R0, R19, R19
+	ADC R1, R20, R20
+	MUL R10, R14, R0
+	UMULH R10, R14, R1
+	ADDS R0, R19, R19
+	ADC R1, R20, R20
+	MUL R9, R15, R0
+	UMULH R9, R15, R1
+	ADDS R0, R19, R19
+	ADC R1, R20, R20
+
+	// r2 = x0*y2 + x1*y1 + x2*y0 + 19*x3*y4 + 19*x4*y3
+	MUL R9, R2, R21
+	UMULH R9, R2, R22
+	MUL R8, R3, R0
+	UMULH R8, R3, R1
+	ADDS R0, R21, R21
+	ADC R1, R22, R22
+	MUL R7, R4, R0
+	UMULH R7, R4, R1
+	ADDS R0, R21, R21
+	ADC R1, R22, R22
+	MUL R11, R14, R0
+	UMULH R11, R14, R1
+	ADDS R0, R21, R21
+	ADC R1, R22, R22
+	MUL R10, R15, R0
+	UMULH R10, R15, R1
+	ADDS R0, R21, R21
+	ADC R1, R22, R22
+
+	// r3 = x0*y3 + x1*y2 + x2*y1 + x3*y0 + 19*x4*y4
+	MUL R10, R2, R23
+	UMULH R10, R2, R24
+	MUL R9, R3, R0
+	UMULH R9, R3, R1
+	ADDS R0, R23, R23
+	ADC R1, R24, R24
+	MUL R8, R4, R0
+	UMULH R8, R4, R1
+	ADDS R0, R23, R23
+	ADC R1, R24, R24
+	MUL R7, R5, R0
+	UMULH R7, R5, R1
+	ADDS R0, R23, R23
+	ADC R1, R24, R24
+	MUL R11, R15, R0
+	UMULH R11, R15, R1
+	ADDS R0, R23, R23
+	ADC R1, R24, R24
+
+	// r4 = x0*y4 + x1*y3 + x2*y2 + x3*y1 + x4*y0
+	MUL R11, R2, R25
+	UMULH R11, R2, R26
+	MUL R10, R3, R0
+	UMULH R10, R3, R1
+	ADDS R0, R25, R25
+	ADC R1, R26, R26
+	MUL R9, R4, R0
+	UMULH R9, R4, R1
+	ADDS R0, R25, R25
+	ADC R1, R26, R26
+	MUL R8, R5, R0
+	UMULH R8, R5, R1
+	ADDS R0, R25, R25
+	ADC R1, R26, R26
+	MUL R7, R6, R0
+	UMULH R7, R6, R1
+	ADDS R0, R25, R25
+	ADC R1, R26, R26
+
+	// carry the bits above 51 of each column to the next one
+	MOVD $19, R2
+	EXTR $51, R16, R17, R3
+	EXTR $51, R19, R20, R4
+	EXTR $51, R21, R22, R5
+	EXTR $51, R23, R24, R6
+	EXTR $51, R25, R26, R7
+	AND $0x7ffffffffffff, R16, R16
+	AND $0x7ffffffffffff, R19, R19
+	AND $0x7ffffffffffff, R21, R21
+	AND $0x7ffffffffffff, R23, R23
+	AND $0x7ffffffffffff, R25, R25
+	MUL R2, R7, R7
+	ADD R7, R16, R16
+	ADD R3, R19, R19
+	ADD R4, R21, R21
+	ADD R5, R23, R23
+	ADD R6, R25, R25
+
+	// feCarry
+	LSR $51, R16, R3
+	LSR $51, R19, R4
+	LSR $51, R21, R5
+	LSR $51, R23, R6
+	LSR $51, R25, R7
+	AND $0x7ffffffffffff, R16, R16
+	AND $0x7ffffffffffff, R19, R19
+	AND $0x7ffffffffffff, R21, R21
+	AND $0x7ffffffffffff, R23, R23
+	AND $0x7ffffffffffff, R25, R25
+	MUL R2, R7, R7
+	ADD R7, R16, R16
+	ADD R3, R19, R19
+	ADD R4, R21, R21
+	ADD R5, R23, R23
+	ADD R6, R25, R25
+
+	MOVD h+0(FP), R0
+	STP (R16, R19), (R0)
+	STP (R21, R23), 16(R0)
+	MOVD R25, 32(R0)
+	RET
+
+// func feSquare(h, x *fieldElement)
+//
+// feSquare is feSquareGeneric. The limbs of x are in R2-R6 and the multiples
+// of them that feSquareGeneric uses in R7-R13; the registers are otherwise
+// used as in feMul.
+TEXT ·feSquare(SB), NOSPLIT, $0-16
+	MOVD x+8(FP), R0
+	LDP (R0), (R2, R3)
+	LDP 16(R0), (R4, R5)
+	MOVD 32(R0), R6
+
+	MOVD $19, R0
+	MOVD $38, R1
+	MUL R1, R3, R7
+	MUL R1, R4, R8
+	ADD R2, R2, R9
+	MUL R0, R5, R10
+	MUL R1, R5, R11
+	ADD R3, R3, R12
+	MUL R0, R6, R13
+
+	// r0 = x0*x0 + 38*x1*x4 + 38*x2*x3
+	MUL R2, R2, R14
+	UMULH R2, R2, R15
+	MUL R6, R7, R0
+	UMULH R6, R7, R1
+	ADDS R0, R14, R14
+	ADC R1, R15, R15
+	MUL R5, R8, R0
+	UMULH R5, R8, R1
+	ADDS R0, R14, R14
+	ADC R1, R15, R15
+
+	// r1 = 2*x0*x1 + 38*x2*x4 + 19*x3*x3
+	MUL R3, R9, R16
+	UMULH R3, R9, R17
+	MUL R6, R8, R0
+	UMULH R6, R8, R1
+	ADDS R0, R16, R16
+	ADC R1, R17, R17
+	MUL R5, R10, R0
+	UMULH R5, R10, R1
+	ADDS R0, R16, R16
+	ADC R1, R17, R17
+
+	// r2 = 2*x0*x2 + x1*x1 + 38*x3*x4
+	MUL R4, R9, R19
+	UMULH R4, R9, R20
+	MUL R3, R3, R0
+	UMULH R3, R3, R1
+	ADDS R0, R19, R19
+	ADC R1, R20, R20
+	MUL R6, R11, R0
+	UMULH R6, R11, R1
+	ADDS R0, R19, R19
+	ADC R1, R20, R20
+
+	// r3 = 2*x0*x3 + 2*x1*x2 + 19*x4*x4
+	MUL R5, R9, R21
+	UMULH R5, R9, R22
+	MUL R4, R12, R0
+	UMULH R4, R12, R1
+	ADDS R0, R21, R21
+	ADC R1, R22, R22
+	MUL R6, R13, R0
+	UMULH R6, R13, R1
+	ADDS R0, R21, R21
+	ADC R1, R22, R22
+
+	// r4 = 2*x0*x4 + 2*x1*x3 + x2*x2
+	MUL R6, R9, R23
+	UMULH R6, R9, R24
+	MUL R5, R12, R0
+	UMULH R5, R12, R1
+	ADDS R0, R23, R23
+	ADC R1, R24, R24
+	MUL R4, R4, R0
+	UMULH R4, R4, R1
+	ADDS R0, R23, R23
+	ADC R1, R24, R24
+
+	// carry the bits above 51 of each column to the next one
+	MOVD $19, R2
+	EXTR $51, R14, R15, R3
+	EXTR $51, R16, R17, R4
+	EXTR $51, R19, R20, R5
+	EXTR $51, R21, R22, R6
+	EXTR $51, R23, R24, R7
+	AND $0x7ffffffffffff, R14, R14
+	AND $0x7ffffffffffff, R16, R16
+	AND $0x7ffffffffffff, R19, R19
+	AND $0x7ffffffffffff, R21, R21
+	AND $0x7ffffffffffff, R23, R23
+	MUL R2, R7, R7
+	ADD R7, R14, R14
+	ADD R3, R16, R16
+	ADD R4, R19, R19
+	ADD R5, R21, R21
+	ADD R6, R23, R23
+
+	// feCarry
+	LSR $51, R14, R3
+	LSR $51, R16, R4
+	LSR $51, R19, R5
+	LSR $51, R21, R6
+	LSR $51, R23, R7
+	AND $0x7ffffffffffff, R14, R14
+	AND $0x7ffffffffffff, R16, R16
+	AND $0x7ffffffffffff, R19, R19
+	AND $0x7ffffffffffff, R21, R21
+	AND $0x7ffffffffffff, R23, R23
+	MUL R2, R7, R7
+	ADD R7, R14, R14
+	ADD R3, R16, R16
+	ADD R4, R19, R19
+	ADD R5, R21, R21
+	ADD R6, R23, R23
+
+	MOVD h+0(FP), R0
+	STP (R14, R16), (R0)
+	STP (R19, R21), 16(R0)
+	MOVD R23, 32(R0)
+	RET
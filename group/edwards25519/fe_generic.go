@@ -0,0 +1,11 @@
+// +build !amd64,!arm64 arm64,!arm64asm purego
+
+package edwards25519
+
+func feMul(h, f, g *fieldElement) {
+	feMulGeneric(h, f, g)
+}
+
+func feSquare(h, f *fieldElement) {
+	feSquareGeneric(h, f)
+}
@@ -0,0 +1,168 @@
+package edwards25519
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+// feBig returns the integer represented by the limbs of f, unreduced.
+func feBig(f *fieldElement) *big.Int {
+	v := new(big.Int)
+	for i := len(f) - 1; i >= 0; i-- {
+		v.Lsh(v, 51)
+		v.Add(v, new(big.Int).SetUint64(f[i]))
+	}
+	return v
+}
+
+// feBytesBig returns the integer encoded by feToBytes.
+func feBytesBig(f *fieldElement) *big.Int {
+	var s [32]byte
+	feToBytes(&s, f)
+	for i := 0; i < 16; i++ {
+		s[i], s[31-i] = s[31-i], s[i]
+	}
+	return new(big.Int).SetBytes(s[:])
+}
+
+// feTestValues returns field elements with limbs up to the 2^54 that feMul
+// and feSquare accept: the edge cases, then random ones.
+func feTestValues() []fieldElement {
+	const max54 = 1<<54 - 1
+	const max51 = 1<<51 - 1
+	values := []fieldElement{
+		{},
+		{1},
+		{max51 - 18, max51, max51, max51, max51}, // p - 1
+		{max51 - 17, max51, max51, max51, max51}, // p
+		{max51, max51, max51, max51, max51},
+		{max51 + 1<<18, max51 + 1<<13, max51 + 1<<13, max51 + 1<<13, max51 + 1<<13},
+		{max54, max54, max54, max54, max54},
+		{max54, 0, max54, 0, max54},
+		{0, 0, 0, 0, max54},
+	}
+	rand := random.New()
+	bound := new(big.Int).Lsh(big.NewInt(1), 54)
+	for i := 0; i < 200; i++ {
+		var f fieldElement
+		for j := range f {
+			f[j] = random.Int(bound, rand).Uint64() >> uint(i%4)
+		}
+		values = append(values, f)
+	}
+	return values
+}
+
+// checkLimbs fails if h has a limb that the operations do not return.
+func checkLimbs(t *testing.T, op string, h *fieldElement) {
+	for _, l := range h {
+		if l >= 1<<51+1<<18 {
+			t.Fatalf("%s returned the limb %x of %v", op, l, h)
+		}
+	}
+}
+
+func TestFeMul(t *testing.T) {
+	values := feTestValues()
+	for i := range values {
+		x, y := &values[i], &values[(i*7+1)%len(values)]
+		var h, w fieldElement
+		feMul(&h, x, y)
+		feMulGeneric(&w, x, y)
+		if h != w {
+			t.Fatalf("feMul(%v, %v) = %v, not %v", x, y, h, w)
+		}
+		checkLimbs(t, "feMul", &h)
+		want := new(big.Int).Mul(feBig(x), feBig(y))
+		want.Mod(want, prime)
+		if feBytesBig(&h).Cmp(want) != 0 {
+			t.Fatalf("feMul(%v, %v) = %v, not %x", x, y, h, want)
+		}
+
+		feSquare(&h, x)
+		feSquareGeneric(&w, x)
+		if h != w {
+			t.Fatalf("feSquare(%v) = %v, not %v", x, h, w)
+		}
+		checkLimbs(t, "feSquare", &h)
+		want.Mul(feBig(x), feBig(x))
+		want.Mod(want, prime)
+		if feBytesBig(&h).Cmp(want) != 0 {
+			t.Fatalf("feSquare(%v) = %v, not %x", x, h, want)
+		}
+
+		// in place, as feInvert does it
+		w = *x
+		feMul(&w, &w, &w)
+		feSquare(&h, x)
+		if h != w {
+			t.Fatalf("feMul(x, x, x) = %v, not %v", w, h)
+		}
+	}
+}
+
+func TestFeAddSub(t *testing.T) {
+	values := feTestValues()
+	for i := range values {
+		// the operands of feAdd and feSub have been through an operation
+		x, y := values[i], values[(i*5+2)%len(values)]
+		feCarry(&x)
+		feCarry(&y)
+
+		var h fieldElement
+		feAdd(&h, &x, &y)
+		checkLimbs(t, "feAdd", &h)
+		want := new(big.Int).Add(feBig(&x), feBig(&y))
+		if feBytesBig(&h).Cmp(want.Mod(want, prime)) != 0 {
+			t.Fatalf("feAdd(%v, %v) = %v, not %x", x, y, h, want)
+		}
+
+		feSub(&h, &x, &y)
+		checkLimbs(t, "feSub", &h)
+		want.Sub(feBig(&x), feBig(&y))
+		if feBytesBig(&h).Cmp(want.Mod(want, prime)) != 0 {
+			t.Fatalf("feSub(%v, %v) = %v, not %x", x, y, h, want)
+		}
+	}
+}
+
+func TestFeToBytes(t *testing.T) {
+	for _, f := range feTestValues() {
+		want := new(big.Int).Mod(feBig(&f), prime)
+		if feBytesBig(&f).Cmp(want) != 0 {
+			t.Fatalf("feToBytes(%v) = %x, not %x", f, feBytesBig(&f), want)
+		}
+
+		var s [32]byte
+		var g fieldElement
+		feToBytes(&s, &f)
+		feFromBytes(&g, s[:])
+		if feBig(&g).Cmp(want) != 0 {
+			t.Fatalf("feFromBytes(%x) = %v", s, g)
+		}
+	}
+}
+
+func BenchmarkFeMul(b *testing.B) {
+	x := feTestValues()[10]
+	h := x
+	for i := 0; i < b.N; i++ {
+		feMul(&h, &h, &x)
+	}
+}
+
+func BenchmarkFeSquare(b *testing.B) {
+	h := feTestValues()[10]
+	for i := 0; i < b.N; i++ {
+		feSquare(&h, &h)
+	}
+}
+
+func BenchmarkFeInvert(b *testing.B) {
+	h := feTestValues()[10]
+	for i := 0; i < b.N; i++ {
+		feInvert(&h, &h)
+	}
+}
@@ -0,0 +1,82 @@
+package edwards25519
+
+import (
+	"github.com/dedis/kyber"
+)
+
+// fixedBase is the kyber.FixedBase of edwards25519 and Ristretto255
+// points. rows[i][k] is (k+1) * 16^i * A in cached form, so that a product
+// takes one constant-time selection and one addition per signed nibble of
+// the scalar, like geScalarMult without its doublings.
+type fixedBase struct {
+	rows      [64][8]cachedGroupElement
+	ristretto bool
+}
+
+func newFixedBase(A *extendedGroupElement, ristretto bool) *fixedBase {
+	f := &fixedBase{ristretto: ristretto}
+	var t completedGroupElement
+	var u extendedGroupElement
+	var r projectiveGroupElement
+	B := *A
+	for i := range f.rows {
+		row := &f.rows[i]
+		B.ToCached(&row[0])
+		for k := 1; k < len(row); k++ {
+			t.Add(&B, &row[k-1])
+			t.ToExtended(&u)
+			u.ToCached(&row[k])
+		}
+		B.ToProjective(&r)
+		for j := 0; j < 4; j++ {
+			r.Double(&t)
+			t.ToProjective(&r)
+		}
+		t.ToExtended(&B)
+	}
+	return f
+}
+
+// Precompute returns the kyber.FixedBase of P, whose products take about
+// a third of the time of Mul and run in constant time as well.
+func (P *point) Precompute() kyber.FixedBase {
+	return newFixedBase(&P.ge, false)
+}
+
+// Precompute returns the kyber.FixedBase of P, as for edwards25519 points.
+func (P *ristrettoPoint) Precompute() kyber.FixedBase {
+	return newFixedBase(&P.ge, true)
+}
+
+// Mul returns s times the point of f, in constant time.
+func (f *fixedBase) Mul(s kyber.Scalar) kyber.Point {
+	a := &s.(*scalar).v
+
+	// Signed nibbles between -8 and 8, as in geScalarMult.
+	var e [64]int8
+	for i, v := range a {
+		e[2*i] = int8(v & 15)
+		e[2*i+1] = int8((v >> 4) & 15)
+	}
+	carry := int8(0)
+	for i := 0; i < 63; i++ {
+		e[i] += carry
+		carry = (e[i] + 8) >> 4
+		e[i] -= carry << 4
+	}
+	e[63] += carry
+
+	var h extendedGroupElement
+	var c cachedGroupElement
+	var t completedGroupElement
+	h.Zero()
+	for i := range e {
+		selectCached(&c, &f.rows[i], int32(e[i]))
+		t.Add(&h, &c)
+		t.ToExtended(&h)
+	}
+	if f.ristretto {
+		return &ristrettoPoint{ge: h}
+	}
+	return &point{ge: h}
+}
@@ -14,7 +14,7 @@ func geScalarMultVartime(h *extendedGroupElement, a *[32]byte,
 	var aSlide [256]int8
 	var Ai [8]cachedGroupElement // A,3A,5A,7A,9A,11A,13A,15A
 	var t completedGroupElement
-	var u, A2 extendedGroupElement
+	var u extendedGroupElement
 	var r projectiveGroupElement
 	var i int
 
@@ -26,14 +26,7 @@ func geScalarMultVartime(h *extendedGroupElement, a *[32]byte,
 	// in addition-ready cached group element form.
 	// We only need odd multiples of A because slide()
 	// produces only odd-multiple clumps of bits.
-	A.ToCached(&Ai[0])
-	A.Double(&t)
-	t.ToExtended(&A2)
-	for i := 0; i < 7; i++ {
-		t.Add(&A2, &Ai[i])
-		t.ToExtended(&u)
-		u.ToCached(&Ai[i+1])
-	}
+	oddMultiples(&Ai, A)
 
 	// Process the multiplications from most-significant bit downward
 	for i = 255; ; i-- {
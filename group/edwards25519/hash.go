@@ -0,0 +1,159 @@
+package edwards25519
+
+import (
+	"crypto/sha512"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/hash2curve"
+)
+
+// sqrtMinusAMinus2 is the even square root of -486664, which scales the
+// birational map from curve25519 to edwards25519.
+var sqrtMinusAMinus2 = feFromDecimal("6853475219497561581579357271197624642482790079785650197046958215289687604742")
+
+// HashToPoint sets P to the hash of msg in the domain dst, with the
+// hash_to_curve suite edwards25519_XMD:SHA-512_ELL2_RO_ of RFC 9380.
+// The result is a point of the prime-order subgroup.
+func (P *point) HashToPoint(msg, dst []byte) kyber.Point {
+	return P.hash(msg, dst, 2)
+}
+
+// EncodeToPoint sets P to the encoding of msg in the domain dst, with the
+// encode_to_curve suite edwards25519_XMD:SHA-512_ELL2_NU_ of RFC 9380.
+// The result is a point of the prime-order subgroup.
+func (P *point) EncodeToPoint(msg, dst []byte) kyber.Point {
+	return P.hash(msg, dst, 1)
+}
+
+// hash sets P to the sum of the Elligator 2 maps of count field elements
+// hashed from msg, times the cofactor 8.
+func (P *point) hash(msg, dst []byte, count int) kyber.Point {
+	uniform, err := hash2curve.ExpandMessageXMD(sha512.New, msg, dst, 48*count)
+	if err != nil {
+		// 96 bytes are well within the limits of expand_message_xmd.
+		panic(err)
+	}
+
+	var u fieldElement
+	var Q cachedGroupElement
+	var r completedGroupElement
+	feFromUniformBytes(&u, uniform[:48])
+	P.ge.fromElligator2(&u)
+	for i := 1; i < count; i++ {
+		var E extendedGroupElement
+		feFromUniformBytes(&u, uniform[48*i:48*(i+1)])
+		E.fromElligator2(&u)
+		E.ToCached(&Q)
+		r.Add(&P.ge, &Q)
+		r.ToExtended(&P.ge)
+	}
+	for i := 0; i < 3; i++ {
+		P.ge.Double(&r)
+		r.ToExtended(&P.ge)
+	}
+	return P
+}
+
+// feFromUniformBytes sets h to the 48-byte big-endian integer b modulo
+// 2^255-19, as hash_to_field does. With b = hi*2^256 + lo,
+// h = lo mod 2^255 + 19*(lo >> 255) + 38*hi.
+func feFromUniformBytes(h *fieldElement, b []byte) {
+	var lo, hi [32]byte
+	for i := 0; i < 32; i++ {
+		lo[i] = b[47-i]
+	}
+	for i := 0; i < 16; i++ {
+		hi[i] = b[15-i]
+	}
+
+	var l, t, c fieldElement
+	feFromBytes(&l, lo[:]) // ignores the top bit of lo
+	feFromBytes(h, hi[:])
+	c[0] = 38
+	feMul(h, h, &c)
+	t[0] = 19 * uint64(lo[31]>>7)
+	feAdd(h, h, &t)
+	feAdd(h, h, &l)
+}
+
+// fromElligator2 sets p to the image of u by the Elligator 2 map to
+// curve25519 of RFC 9380, Section 6.7.1, with Z = 2, followed by the
+// birational map to edwards25519 of Appendix D.1, in constant time.
+func (p *extendedGroupElement) fromElligator2(u *fieldElement) {
+	var one, minusA, x1, x2, gx, y1, y2, s, t, tmp fieldElement
+	feOne(&one)
+	feNeg(&minusA, &paramA)
+
+	// x1 = -A / (1 + 2*u^2), or -A if the denominator is zero
+	feSquare(&tmp, u)
+	feAdd(&tmp, &tmp, &tmp)
+	feAdd(&tmp, &tmp, &one)
+	feInvert(&tmp, &tmp)
+	feMul(&x1, &minusA, &tmp)
+	feCMove(&x1, &minusA, feIsNonZero(&x1)^1)
+
+	// x2 = -x1 - A
+	feSub(&x2, &minusA, &x1)
+
+	// s = x1 if x1^3 + A*x1^2 + x1 is square, x2 otherwise, and t is
+	// the square root of g(s) whose sign is whether x1 was chosen.
+	curve25519G(&gx, &x1)
+	isSquare := feSqrtRatioM1(&y1, &gx, &one)
+	curve25519G(&gx, &x2)
+	feSqrtRatioM1(&y2, &gx, &one)
+	feCopy(&s, &x2)
+	feCMove(&s, &x1, isSquare)
+	feCopy(&t, &y2)
+	feCMove(&t, &y1, isSquare)
+	feNeg(&tmp, &t)
+	feCMove(&t, &tmp, int32(feIsNegative(&t))^isSquare)
+
+	// (x, y) = (sqrt(-486664)*s/t, (s-1)/(s+1)) in extended coordinates,
+	// or the identity if a denominator is zero.
+	var xn, yn, yd fieldElement
+	feMul(&xn, &sqrtMinusAMinus2, &s)
+	feSub(&yn, &s, &one)
+	feAdd(&yd, &s, &one)
+	feMul(&p.X, &xn, &yd)
+	feMul(&p.Y, &yn, &t)
+	feMul(&p.Z, &t, &yd)
+	feMul(&p.T, &xn, &yn)
+
+	var zero extendedGroupElement
+	zero.Zero()
+	exceptional := feIsNonZero(&p.Z) ^ 1
+	feCMove(&p.X, &zero.X, exceptional)
+	feCMove(&p.Y, &zero.Y, exceptional)
+	feCMove(&p.Z, &zero.Z, exceptional)
+	feCMove(&p.T, &zero.T, exceptional)
+}
+
+// curve25519G sets h to x^3 + A*x^2 + x, the right-hand side of the
+// equation of curve25519.
+func curve25519G(h, x *fieldElement) {
+	var t, one fieldElement
+	feOne(&one)
+	feAdd(&t, x, &paramA)
+	feMul(&t, &t, x)
+	feAdd(&t, &t, &one)
+	feMul(h, &t, x)
+}
+
+// HashToPoint sets P to the hash of msg in the domain dst, with the
+// hash_to_curve suite ristretto255_XMD:SHA-512_R255MAP_RO_ of RFC 9380,
+// Appendix B: the element derived by SetUniformBytes from 64 bytes of
+// expand_message_xmd.
+func (P *ristrettoPoint) HashToPoint(msg, dst []byte) kyber.Point {
+	uniform, err := hash2curve.ExpandMessageXMD(sha512.New, msg, dst, 64)
+	if err != nil {
+		panic(err)
+	}
+	return P.SetUniformBytes(uniform)
+}
+
+// EncodeToPoint is the same as HashToPoint, since Ristretto255 only has a
+// hash_to_curve suite, which is about as fast as the encodings of other
+// groups.
+func (P *ristrettoPoint) EncodeToPoint(msg, dst []byte) kyber.Point {
+	return P.HashToPoint(msg, dst)
+}
@@ -0,0 +1,107 @@
+package edwards25519
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/hash2curve"
+)
+
+// encodeAffine returns the encoding of the point of edwards25519 of the
+// given big-endian hexadecimal coordinates.
+func encodeAffine(t *testing.T, x, y string) []byte {
+	bx, err := hex.DecodeString(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	by, err := hex.DecodeString(y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, 32)
+	for i := range b {
+		b[i] = by[31-i]
+	}
+	b[31] |= (bx[31] & 1) << 7
+	return b
+}
+
+// Test vectors of Appendix J.5 of RFC 9380.
+func TestHashToPoint(t *testing.T) {
+	vectors := []struct {
+		dst    string
+		encode bool
+		msg    string
+		x, y   string
+	}{
+		{"QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_RO_", false, "",
+			"3c3da6925a3c3c268448dcabb47ccde5439559d9599646a8260e47b1e4822fc6",
+			"09a6c8561a0b22bef63124c588ce4c62ea83a3c899763af26d795302e115dc21"},
+		{"QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_RO_", false, "abc",
+			"608040b42285cc0d72cbb3985c6b04c935370c7361f4b7fbdb1ae7f8c1a8ecad",
+			"1a8395b88338f22e435bbd301183e7f20a5f9de643f11882fb237f88268a5531"},
+		{"QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_NU_", true, "",
+			"1ff2b70ecf862799e11b7ae744e3489aa058ce805dd323a936375a84695e76da",
+			"222e314d04a4d5725e9f2aff9fb2a6b69ef375a1214eb19021ceab2d687f0f9b"},
+		{"QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_NU_", true, "abc",
+			"5f13cc69c891d86927eb37bd4afc6672360007c63f68a33ab423a3aa040fd2a8",
+			"67732d50f9a26f73111dd1ed5dba225614e538599db58ba30aaea1f5c827fa42"},
+	}
+	for _, v := range vectors {
+		p := tSuite.Point().(kyber.HashablePoint)
+		var q kyber.Point
+		if v.encode {
+			q = p.EncodeToPoint([]byte(v.msg), []byte(v.dst))
+		} else {
+			q = p.HashToPoint([]byte(v.msg), []byte(v.dst))
+		}
+		b, err := q.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(b, encodeAffine(t, v.x, v.y)) {
+			t.Fatalf("wrong point for %q in %s: %x", v.msg, v.dst, b)
+		}
+	}
+}
+
+func TestFeFromUniformBytes(t *testing.T) {
+	// 255, 2^256 + 2^255 - 1, p + 5, and 2^384 - 1
+	for _, c := range []struct{ in, out string }{
+		{"0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000ff",
+			"ff00000000000000000000000000000000000000000000000000000000000000"},
+		{"000000000000000000000000000000017fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"3800000000000000000000000000000000000000000000000000000000000000"},
+		{"000000000000000000000000000000007ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff2",
+			"0500000000000000000000000000000000000000000000000000000000000000"},
+		{"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"ffffffffffffffffffffffffffffffff25000000000000000000000000000000"},
+	} {
+		in, _ := hex.DecodeString(c.in)
+		var h fieldElement
+		var out [32]byte
+		feFromUniformBytes(&h, in)
+		feToBytes(&out, &h)
+		if hex.EncodeToString(out[:]) != c.out {
+			t.Fatalf("wrong reduction of %s: %x", c.in, out)
+		}
+	}
+}
+
+func TestRistrettoHashToPoint(t *testing.T) {
+	msg, dst := []byte("abc"), []byte("QUUX-V01-CS02-with-ristretto255_XMD:SHA-512_R255MAP_RO_")
+	uniform, err := hash2curve.ExpandMessageXMD(sha512.New, msg, dst, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := tRistretto.Point().(kyber.HashablePoint).HashToPoint(msg, dst)
+	if !p.Equal(tRistretto.Point().(*ristrettoPoint).SetUniformBytes(uniform)) {
+		t.Fatal("wrong hash to Ristretto255")
+	}
+	if !p.Equal(tRistretto.Point().(kyber.HashablePoint).EncodeToPoint(msg, dst)) {
+		t.Fatal("encoding differs from hash")
+	}
+}
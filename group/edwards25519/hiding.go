@@ -0,0 +1,127 @@
+package edwards25519
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"math/big"
+)
+
+// lowOrder holds the multiples 1T through 8T of a point T of order 8,
+// which generate the torsion subgroup of edwards25519.
+var lowOrder [8]cachedGroupElement
+
+// cofactorInvScalar is the inverse of the cofactor modulo the prime order,
+// which maps 8P back to P in the prime-order subgroup.
+var cofactorInvScalar = newScalarInt(new(big.Int).ModInverse(cofactor, primeOrder))
+
+func init() {
+	b, _ := hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	var T, U extendedGroupElement
+	if !T.FromBytes(b) {
+		panic("edwards25519: invalid point of order 8")
+	}
+	var r completedGroupElement
+	T.ToCached(&lowOrder[0])
+	for i := 0; i < 7; i++ {
+		r.Add(&T, &lowOrder[i])
+		r.ToExtended(&U)
+		U.ToCached(&lowOrder[i+1])
+	}
+}
+
+// HideLen returns the length of the uniform representative of a point.
+func (P *point) HideLen() int {
+	return 32
+}
+
+// HideEncode returns a 32-byte representative of P, indistinguishable
+// from uniformly random bytes, such that HideDecode maps it back to P.
+// It uses the inverse of the Elligator 2 map of hashing to curves, and
+// randomizes the representative with a low-order point and the two unused
+// top bits, both drawn from rand, since the representatives of points of
+// the prime-order subgroup alone are distinguishable.
+//
+// Only about half of the points have a representative for any given
+// randomization, so HideEncode returns nil when P+T has none. Since
+// whether it succeeds depends on rand, the caller must use the first
+// representative it gets, and must pick a fresh point on failure rather
+// than retry with the same point, which would bias the representatives.
+// HideEncode runs in constant time, except for whether it fails.
+func (P *point) HideEncode(rand cipher.Stream) []byte {
+	var pad [2]byte
+	rand.XORKeyStream(pad[:], pad[:])
+
+	// Q = P + kT for a random k in [0, 8)
+	var c cachedGroupElement
+	var r completedGroupElement
+	var Q extendedGroupElement
+	selectCached(&c, &lowOrder, int32(pad[0]&7))
+	r.Add(&P.ge, &c)
+	r.ToExtended(&Q)
+
+	// The Montgomery point of Q is (s, t) = ((Z+Y)/(Z-Y), c*s*Z/X), with
+	// c = sqrt(-486664), if neither denominator is zero.
+	var one, zMinusY, zPlusY, den, s, t fieldElement
+	feOne(&one)
+	feSub(&zMinusY, &Q.Z, &Q.Y)
+	feAdd(&zPlusY, &Q.Z, &Q.Y)
+	feMul(&den, &zMinusY, &Q.X)
+	ok := feIsNonZero(&den)
+	feInvert(&den, &den)
+	feMul(&s, &zPlusY, &Q.X)
+	feMul(&s, &s, &den)
+	feMul(&t, &zPlusY, &Q.Z)
+	feMul(&t, &t, &den)
+	feMul(&t, &t, &sqrtMinusAMinus2)
+
+	// The map of fromElligator2 gives s = -A/(1+2r^2) with sgn0(t) = 1,
+	// so that r^2 = -(s+A)/(2s), or s = A/(1+2r^2) - A with sgn0(t) = 0,
+	// so that r^2 = -s/(2(s+A)).
+	var two, sPlusA, num, dn, tmp fieldElement
+	feAdd(&two, &one, &one)
+	feAdd(&sPlusA, &s, &paramA)
+	feNeg(&num, &s)
+	feCopy(&dn, &sPlusA)
+	negative := int32(feIsNegative(&t))
+	feNeg(&tmp, &sPlusA)
+	feCMove(&num, &tmp, negative)
+	feCMove(&dn, &s, negative)
+	feMul(&dn, &dn, &two)
+
+	// feSqrtRatioM1 fails when dn = 0, since then num != 0. Of the two
+	// roots, the representative is the one below 2^254.
+	var rep fieldElement
+	var buf [32]byte
+	ok &= feSqrtRatioM1(&rep, &num, &dn)
+	if ok == 0 {
+		return nil
+	}
+	feToBytes(&buf, &rep)
+	feNeg(&tmp, &rep)
+	feCMove(&rep, &tmp, feBytesLE(&buf, &halfQMinus1Bytes)^1)
+	feToBytes(&buf, &rep)
+	buf[31] |= pad[1] & 0xc0
+	return buf[:]
+}
+
+// HideDecode sets P to the point represented by rep with the Elligator 2
+// map, projected to the prime-order subgroup to remove the low-order
+// component added by HideEncode. Every 32-byte string decodes to a point.
+func (P *point) HideDecode(rep []byte) {
+	if len(rep) != 32 {
+		panic("edwards25519: wrong representative length")
+	}
+	var buf [32]byte
+	copy(buf[:], rep)
+	buf[31] &= 0x3f
+
+	var u fieldElement
+	var r completedGroupElement
+	feFromBytes(&u, buf[:])
+	P.ge.fromElligator2(&u)
+	for i := 0; i < 3; i++ {
+		P.ge.Double(&r)
+		r.ToExtended(&P.ge)
+	}
+	geScalarMult(&P.ge, &cofactorInvScalar.v, &P.ge)
+}
@@ -0,0 +1,76 @@
+package edwards25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+)
+
+func TestLowOrder(t *testing.T) {
+	var zero cachedGroupElement
+	zero.Zero()
+	var Z, P extendedGroupElement
+	Z.Zero()
+	var r completedGroupElement
+	r.Add(&Z, &lowOrder[7])
+	r.ToExtended(&P)
+	if !(&point{ge: P}).Equal(&point{ge: Z}) {
+		t.Fatal("8T is not the identity")
+	}
+	r.Add(&Z, &lowOrder[3])
+	r.ToExtended(&P)
+	if (&point{ge: P}).Equal(&point{ge: Z}) {
+		t.Fatal("4T is the identity")
+	}
+}
+
+func TestHideEncode(t *testing.T) {
+	rand := random.New()
+	encoded := 0
+	var top byte
+	for i := 0; i < 200; i++ {
+		P := tSuite.Point().Pick(rand)
+		rep := P.(kyber.Hiding).HideEncode(rand)
+		if rep == nil {
+			continue
+		}
+		encoded++
+		if len(rep) != P.(kyber.Hiding).HideLen() {
+			t.Fatal("wrong representative length")
+		}
+		top |= rep[31] & 0xc0
+
+		Q := tSuite.Point()
+		Q.(kyber.Hiding).HideDecode(rep)
+		if !Q.Equal(P) {
+			t.Fatal("representative does not decode to the point")
+		}
+	}
+	// Each encoding succeeds with probability about 1/2.
+	if encoded < 60 || encoded > 140 {
+		t.Fatalf("%d of 200 points encoded", encoded)
+	}
+	if top != 0xc0 {
+		t.Fatal("top bits of the representatives are not randomized")
+	}
+}
+
+func TestHideDecode(t *testing.T) {
+	rand := random.New()
+	P := tSuite.Point()
+	Q := tSuite.Point()
+	for i := 0; i < 20; i++ {
+		rep := random.Bits(256, false, rand)
+		P.(kyber.Hiding).HideDecode(rep)
+		Q.Mul(primeOrderScalar, P)
+		if !Q.Equal(tSuite.Point().Null()) {
+			t.Fatal("decoded point is not in the prime-order subgroup")
+		}
+		rep[31] ^= 0xc0
+		Q.(kyber.Hiding).HideDecode(rep)
+		if !Q.Equal(P) {
+			t.Fatal("top bits of the representative are not ignored")
+		}
+	}
+}
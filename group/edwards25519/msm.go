@@ -0,0 +1,173 @@
+package edwards25519
+
+import (
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/msm"
+)
+
+// MultiScalarMult sets P to the sum of scalars[i]*points[i], where a nil
+// point stands for the base point, as in kyber.MultiScalarMultiplier. Its
+// running time depends on the scalars, so that it is only meant for public
+// ones, such as those of batch verifications.
+func (P *point) MultiScalarMult(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("edwards25519: scalars and points of different lengths")
+	}
+	A := make([]*extendedGroupElement, len(points))
+	for i, Q := range points {
+		if Q == nil {
+			A[i] = &baseext
+		} else {
+			A[i] = &Q.(*point).ge
+		}
+	}
+	geMultiScalarMult(&P.ge, scalarValues(scalars), A)
+	return P
+}
+
+// MultiScalarMult sets P to the sum of scalars[i]*points[i], where a nil
+// point stands for the generator, in time that depends on the scalars.
+func (P *ristrettoPoint) MultiScalarMult(scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("edwards25519: scalars and points of different lengths")
+	}
+	A := make([]*extendedGroupElement, len(points))
+	for i, Q := range points {
+		if Q == nil {
+			A[i] = &baseext
+		} else {
+			A[i] = &Q.(*ristrettoPoint).ge
+		}
+	}
+	geMultiScalarMult(&P.ge, scalarValues(scalars), A)
+	return P
+}
+
+func scalarValues(scalars []kyber.Scalar) []*[32]byte {
+	a := make([]*[32]byte, len(scalars))
+	for i, s := range scalars {
+		a[i] = &s.(*scalar).v
+	}
+	return a
+}
+
+// geMultiScalarMult computes h = a[0]*A[0] + ... + a[n-1]*A[n-1], with
+// Straus' method on the sliding windows of geScalarMultVartime for short
+// sums, and Pippenger's for long ones, as msm.Window chooses. h may alias
+// any of the A[i].
+func geMultiScalarMult(h *extendedGroupElement, a []*[32]byte, A []*extendedGroupElement) {
+	if c := msm.Window(len(A), 256); c != 0 {
+		gePippenger(h, c, a, A)
+		return
+	}
+
+	slides := make([][256]int8, len(A))
+	tables := make([][8]cachedGroupElement, len(A))
+	top := -1
+	for i := range A {
+		slide(&slides[i], a[i])
+		oddMultiples(&tables[i], A[i])
+		for j := 255; j > top; j-- {
+			if slides[i][j] != 0 {
+				top = j
+			}
+		}
+	}
+
+	var t completedGroupElement
+	var u extendedGroupElement
+	var r projectiveGroupElement
+	r.Zero()
+	for j := top; j >= 0; j-- {
+		r.Double(&t)
+		for i := range A {
+			if d := slides[i][j]; d > 0 {
+				t.ToExtended(&u)
+				t.Add(&u, &tables[i][d/2])
+			} else if d < 0 {
+				t.ToExtended(&u)
+				t.Sub(&u, &tables[i][-d/2])
+			}
+		}
+		t.ToProjective(&r)
+	}
+	if top < 0 {
+		h.Zero()
+		return
+	}
+	t.ToExtended(h)
+}
+
+// gePippenger computes the sum of geMultiScalarMult with Pippenger's method
+// on the signed digits of c bits of msm.Digits. For every digit position,
+// from the most significant down, it doubles the sum c times, gathers the
+// points in buckets by digit, and adds the buckets weighted by their digits
+// with two running sums.
+func gePippenger(h *extendedGroupElement, c uint, a []*[32]byte, A []*extendedGroupElement) {
+	digits := make([][]int32, len(A))
+	cached := make([]cachedGroupElement, len(A))
+	for i := range A {
+		digits[i] = msm.Digits(a[i][:], c)
+		A[i].ToCached(&cached[i])
+	}
+
+	// buckets[k] is the sum of the points of digit ±(k+1).
+	buckets := make([]extendedGroupElement, 1<<(c-1))
+	var sum, running, seg extendedGroupElement
+	var t completedGroupElement
+	var r projectiveGroupElement
+	var cc cachedGroupElement
+	sum.Zero()
+	for d := len(digits[0]) - 1; d >= 0; d-- {
+		sum.ToProjective(&r)
+		for j := uint(0); j < c; j++ {
+			r.Double(&t)
+			t.ToProjective(&r)
+		}
+		t.ToExtended(&sum)
+
+		for k := range buckets {
+			buckets[k].Zero()
+		}
+		for i := range A {
+			switch k := digits[i][d]; {
+			case k > 0:
+				t.Add(&buckets[k-1], &cached[i])
+				t.ToExtended(&buckets[k-1])
+			case k < 0:
+				t.Sub(&buckets[-k-1], &cached[i])
+				t.ToExtended(&buckets[-k-1])
+			}
+		}
+
+		running.Zero()
+		seg.Zero()
+		for k := len(buckets) - 1; k >= 0; k-- {
+			buckets[k].ToCached(&cc)
+			t.Add(&running, &cc)
+			t.ToExtended(&running)
+			running.ToCached(&cc)
+			t.Add(&seg, &cc)
+			t.ToExtended(&seg)
+		}
+		seg.ToCached(&cc)
+		t.Add(&sum, &cc)
+		t.ToExtended(&sum)
+	}
+	*h = sum
+}
+
+// oddMultiples sets Ai to A, 3A, 5A, ..., 15A, the multiples by which
+// the sliding windows of slide multiply A.
+func oddMultiples(Ai *[8]cachedGroupElement, A *extendedGroupElement) {
+	var t completedGroupElement
+	var u, A2 extendedGroupElement
+	A.ToCached(&Ai[0])
+	A.Double(&t)
+	t.ToExtended(&A2)
+	for i := 0; i < 7; i++ {
+		t.Add(&A2, &Ai[i])
+		t.ToExtended(&u)
+		u.ToCached(&Ai[i+1])
+	}
+}
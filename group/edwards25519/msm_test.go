@@ -0,0 +1,60 @@
+package edwards25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+)
+
+// msmInputs returns n random scalars and points of g, with the base point
+// at every third position, and their sum of products.
+func msmInputs(g kyber.Group, n int) ([]kyber.Scalar, []kyber.Point, kyber.Point) {
+	rand := random.New()
+	scalars := make([]kyber.Scalar, n)
+	points := make([]kyber.Point, n)
+	sum := g.Point().Null()
+	for i := range scalars {
+		scalars[i] = g.Scalar().Pick(rand)
+		if i%3 != 0 {
+			points[i] = g.Point().Pick(rand)
+		}
+		sum.Add(sum, g.Point().Mul(scalars[i], points[i]))
+	}
+	return scalars, points, sum
+}
+
+func TestMultiScalarMult(t *testing.T) {
+	// Straus' method up to a few tens of products, and Pippenger's beyond.
+	for _, g := range []kyber.Group{tSuite, tRistretto} {
+		for _, n := range []int{0, 1, 2, 33, 300} {
+			scalars, points, want := msmInputs(g, n)
+			P := g.Point().(kyber.MultiScalarMultiplier)
+			if !P.MultiScalarMult(scalars, points).Equal(want) {
+				t.Fatalf("%s: wrong sum of %d products", g, n)
+			}
+		}
+	}
+}
+
+func TestMultiScalarMultAlias(t *testing.T) {
+	for _, n := range []int{2, 300} {
+		scalars, points, want := msmInputs(tSuite, n)
+		P := points[1]
+		if !P.(kyber.MultiScalarMultiplier).MultiScalarMult(scalars, points).Equal(want) {
+			t.Fatalf("wrong sum of %d products into one of the points", n)
+		}
+	}
+}
+
+func benchMultiScalarMult(b *testing.B, n int) {
+	scalars, points, _ := msmInputs(tSuite, n)
+	P := tSuite.Point().(kyber.MultiScalarMultiplier)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		P.MultiScalarMult(scalars, points)
+	}
+}
+
+func BenchmarkMultiScalarMult64(b *testing.B)   { benchMultiScalarMult(b, 64) }
+func BenchmarkMultiScalarMult1024(b *testing.B) { benchMultiScalarMult(b, 1024) }
@@ -12,16 +12,26 @@
 // performs extremely well, typically comparable to native C
 // implementations.  The tradeoff is that this code is completely
 // specialized to a single curve.
+//
+// Field elements have five limbs of 51 bits. Their multiplication and
+// squaring, feMul and feSquare, are written in assembly for amd64, and
+// every other operation, the scalar arithmetic modulo the group order
+// included, is written in Go. The arm64 assembly of feMul and feSquare has
+// not been run on arm64 hardware yet, and is only built with the opt-in
+// "arm64asm" compilation flag. The "purego" flag selects the Go code on
+// all architectures.
 package edwards25519
 
 import (
+	"bytes"
 	"crypto/cipher"
+	"crypto/subtle"
 	"encoding/hex"
 	"errors"
 	"io"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/internal/marshalling"
 )
 
 type point struct {
@@ -45,10 +55,22 @@ func (P *point) MarshalBinary() ([]byte, error) {
 	return b[:], nil
 }
 
+// UnmarshalBinary decodes a point. Unless kyber.AllowNonCanonical is set,
+// it rejects the encodings of y-coordinates of at least 2^255-19 and of
+// x = 0 with the sign bit set, which are not what MarshalBinary returns.
 func (P *point) UnmarshalBinary(b []byte) error {
-	if !P.ge.FromBytes(b) {
+	var ge extendedGroupElement
+	if !ge.FromBytes(b) {
 		return errors.New("invalid Ed25519 curve point")
 	}
+	if !kyber.NonCanonicalAllowed() {
+		var c [32]byte
+		ge.ToBytes(&c)
+		if !bytes.Equal(b, c[:]) {
+			return errors.New("non-canonical Ed25519 curve point")
+		}
+	}
+	P.ge = ge
 	return nil
 }
 
@@ -66,12 +88,43 @@ func (P *point) Equal(P2 kyber.Point) bool {
 	var b1, b2 [32]byte
 	P.ge.ToBytes(&b1)
 	P2.(*point).ge.ToBytes(&b2)
-	for i := range b1 {
-		if b1[i] != b2[i] {
-			return false
-		}
+	return subtle.ConstantTimeCompare(b1[:], b2[:]) == 1
+}
+
+// Valid reports whether P is on the curve and in the prime-order subgroup,
+// as points decoded by UnmarshalBinary need not be: there are also the
+// points of order 8 and their sums with those of the subgroup.
+func (P *point) Valid() bool {
+	// -X^2 + Y^2 = Z^2 + d*T^2 and X*Y = Z*T, with Z != 0
+	var x2, y2, z2, t2, xy, zt fieldElement
+	feSquare(&x2, &P.ge.X)
+	feSquare(&y2, &P.ge.Y)
+	feSquare(&z2, &P.ge.Z)
+	feSquare(&t2, &P.ge.T)
+	feSub(&y2, &y2, &x2)
+	feMul(&t2, &t2, &d)
+	feAdd(&z2, &z2, &t2)
+	feMul(&xy, &P.ge.X, &P.ge.Y)
+	feMul(&zt, &P.ge.Z, &P.ge.T)
+	if feEqual(&y2, &z2)&feEqual(&xy, &zt)&feIsNonZero(&P.ge.Z) != 1 {
+		return false
 	}
-	return true
+
+	var Q point
+	geScalarMult(&Q.ge, &primeOrderScalar.v, &P.ge)
+	return Q.Equal(new(point).Null())
+}
+
+// MulCofactor sets P to 8*P2, which lies in the prime-order subgroup, with
+// three doublings.
+func (P *point) MulCofactor(P2 kyber.Point) kyber.Point {
+	var r completedGroupElement
+	P.ge = P2.(*point).ge
+	for i := 0; i < 3; i++ {
+		P.ge.Double(&r)
+		r.ToExtended(&P.ge)
+	}
+	return P
 }
 
 // Set point to be equal to P2.
@@ -9,3 +9,10 @@ package edwards25519
 func (P *point) AllowVarTime(varTime bool) {
 	P.varTime = varTime
 }
+
+// AllowVarTime sets a flag in this object which determines if a faster
+// but variable time implementation can be used. Set this only on Points
+// which represent public information.
+func (P *ristrettoPoint) AllowVarTime(varTime bool) {
+	P.varTime = varTime
+}
@@ -0,0 +1,494 @@
+package edwards25519
+
+import (
+	"crypto/cipher"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+)
+
+// This file implements the Ristretto255 prime-order group, as specified in
+// RFC 9496, on top of the Ed25519 field and group arithmetic of this package.
+// Ristretto255 points are internally represented by Ed25519 points, but the
+// encoding, decoding and equality functions quotient out the cofactor, so
+// that every valid encoding denotes an element of a group of prime order l.
+
+// Ristretto255 represents the Ristretto255 prime-order group.
+// Like Curve, it has no parameters and requires no initialization.
+// Its scalars are the same as those of the Ed25519 curve.
+type Ristretto255 struct {
+}
+
+// Return the name of the group, "Ristretto255".
+func (c *Ristretto255) String() string {
+	return "Ristretto255"
+}
+
+// ScalarLen returns 32, the size in bytes of an encoded Scalar.
+func (c *Ristretto255) ScalarLen() int {
+	return 32
+}
+
+// Scalar creates a new Scalar modulo the prime order of the group.
+func (c *Ristretto255) Scalar() kyber.Scalar {
+	return &scalar{}
+}
+
+// ScalarAt returns a zero Scalar stored in the first 32 bytes of b. It
+// implements secmem.Allocator.
+func (c *Ristretto255) ScalarAt(b []byte) kyber.Scalar {
+	return scalarAt(b)
+}
+
+// PointLen returns 32, the size in bytes of an encoded Ristretto255 element.
+func (c *Ristretto255) PointLen() int {
+	return 32
+}
+
+// Point creates a new Ristretto255 group element.
+func (c *Ristretto255) Point() kyber.Point {
+	P := new(ristrettoPoint)
+	P.ge.Zero()
+	return P
+}
+
+// Constants of RFC 9496, Section 4.1.
+var (
+	sqrtADMinusOne   fieldElement // sqrt(a*d - 1)
+	invSqrtAMinusD   fieldElement // 1 / sqrt(a - d)
+	oneMinusDSq      fieldElement // 1 - d^2
+	dMinusOneSq      fieldElement // (d - 1)^2
+	feMinusOne       fieldElement // -1
+	errRistrettoData = errors.New("invalid embedded data length")
+)
+
+func init() {
+	sqrtADMinusOne = feFromDecimal("25063068953384623474111414158702152701244531502492656460079210482610430750235")
+	invSqrtAMinusD = feFromDecimal("54469307008909316920995813868745141605393597292927456921205312896311721017578")
+	feOne(&feMinusOne)
+	feNeg(&feMinusOne, &feMinusOne)
+
+	var t fieldElement
+	feSquare(&t, &d)
+	feOne(&oneMinusDSq)
+	feSub(&oneMinusDSq, &oneMinusDSq, &t)
+	feAdd(&t, &d, &feMinusOne)
+	feSquare(&dMinusOneSq, &t)
+}
+
+// feFromDecimal converts a decimal integer smaller than 2^255-19
+// into a field element.
+func feFromDecimal(s string) fieldElement {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid decimal field element " + s)
+	}
+	var b [32]byte
+	vb := v.Bytes()
+	for i := range vb {
+		b[i] = vb[len(vb)-1-i]
+	}
+	var fe fieldElement
+	feFromBytes(&fe, b[:])
+	return fe
+}
+
+// feEqual returns 1 if f == g and 0 otherwise, in constant time.
+func feEqual(f, g *fieldElement) int32 {
+	var t fieldElement
+	feSub(&t, f, g)
+	return feIsNonZero(&t) ^ 1
+}
+
+// feAbs sets h to the non-negative one of f and -f.
+func feAbs(h, f *fieldElement) {
+	var n fieldElement
+	feNeg(&n, f)
+	feCopy(h, f)
+	feCMove(h, &n, int32(feIsNegative(f)))
+}
+
+// feSqrtRatioM1 sets r to the non-negative square root of u/v if it
+// exists, and returns 1. Otherwise it sets r to the non-negative
+// square root of sqrt(-1)*u/v and returns 0.
+func feSqrtRatioM1(r, u, v *fieldElement) int32 {
+	var v3, v7, t, check, negU, negUi, rPrime fieldElement
+
+	feSquare(&v3, v)
+	feMul(&v3, &v3, v) // v^3
+	feSquare(&v7, &v3)
+	feMul(&v7, &v7, v) // v^7
+
+	feMul(&t, u, &v7)
+	fePow22523(&t, &t) // (u*v^7)^((p-5)/8)
+	feMul(&t, &t, &v3)
+	feMul(&t, &t, u) // (u*v^3)*(u*v^7)^((p-5)/8)
+
+	feSquare(&check, &t)
+	feMul(&check, &check, v)
+
+	feNeg(&negU, u)
+	feMul(&negUi, &negU, &sqrtM1)
+
+	correctSign := feEqual(&check, u)
+	flippedSign := feEqual(&check, &negU)
+	flippedSignI := feEqual(&check, &negUi)
+
+	feMul(&rPrime, &t, &sqrtM1)
+	feCMove(&t, &rPrime, flippedSign|flippedSignI)
+	feAbs(r, &t)
+
+	return correctSign | flippedSign
+}
+
+type ristrettoPoint struct {
+	ge      extendedGroupElement
+	varTime bool
+}
+
+func (P *ristrettoPoint) String() string {
+	var b [32]byte
+	P.encode(&b)
+	return hex.EncodeToString(b[:])
+}
+
+func (P *ristrettoPoint) MarshalSize() int {
+	return 32
+}
+
+func (P *ristrettoPoint) MarshalBinary() ([]byte, error) {
+	var b [32]byte
+	P.encode(&b)
+	return b[:], nil
+}
+
+func (P *ristrettoPoint) UnmarshalBinary(b []byte) error {
+	if len(b) != 32 || !P.decode(b) {
+		return errors.New("invalid Ristretto255 point")
+	}
+	return nil
+}
+
+func (P *ristrettoPoint) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(P, w)
+}
+
+func (P *ristrettoPoint) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(P, r)
+}
+
+// encode computes the canonical encoding of P (RFC 9496, Section 4.3.2).
+func (P *ristrettoPoint) encode(s *[32]byte) {
+	var u1, u2, t, invSqrt, den1, den2, zInv fieldElement
+	var ix0, iy0, enchantedDen, x, y, denInv fieldElement
+	X, Y, Z, T := &P.ge.X, &P.ge.Y, &P.ge.Z, &P.ge.T
+
+	feAdd(&u1, Z, Y)
+	feSub(&t, Z, Y)
+	feMul(&u1, &u1, &t)
+	feMul(&u2, X, Y)
+
+	feSquare(&t, &u2)
+	feMul(&t, &t, &u1)
+	var one fieldElement
+	feOne(&one)
+	feSqrtRatioM1(&invSqrt, &one, &t)
+
+	feMul(&den1, &invSqrt, &u1)
+	feMul(&den2, &invSqrt, &u2)
+	feMul(&zInv, &den1, &den2)
+	feMul(&zInv, &zInv, T)
+
+	feMul(&ix0, X, &sqrtM1)
+	feMul(&iy0, Y, &sqrtM1)
+	feMul(&enchantedDen, &den1, &invSqrtAMinusD)
+
+	feMul(&t, T, &zInv)
+	rotate := int32(feIsNegative(&t))
+
+	feCopy(&x, X)
+	feCopy(&y, Y)
+	feCopy(&denInv, &den2)
+	feCMove(&x, &iy0, rotate)
+	feCMove(&y, &ix0, rotate)
+	feCMove(&denInv, &enchantedDen, rotate)
+
+	feMul(&t, &x, &zInv)
+	var negY fieldElement
+	feNeg(&negY, &y)
+	feCMove(&y, &negY, int32(feIsNegative(&t)))
+
+	feSub(&t, Z, &y)
+	feMul(&t, &denInv, &t)
+	feAbs(&t, &t)
+	feToBytes(s, &t)
+}
+
+// decode sets P to the element encoded in b, and reports whether
+// b is the canonical encoding of a valid element (RFC 9496, Section 4.3.1).
+func (P *ristrettoPoint) decode(b []byte) bool {
+	var s, ss, u1, u2, u2Sq, v, t, invSqrt, denX, denY, x, y fieldElement
+	var check [32]byte
+
+	feFromBytes(&s, b)
+	feToBytes(&check, &s)
+	canonical := subtle.ConstantTimeCompare(check[:], b)
+	negative := int(feIsNegative(&s))
+
+	feSquare(&ss, &s)
+	feOne(&u1)
+	feSub(&u1, &u1, &ss)
+	feOne(&u2)
+	feAdd(&u2, &u2, &ss)
+	feSquare(&u2Sq, &u2)
+
+	feSquare(&v, &u1)
+	feMul(&v, &v, &d)
+	feNeg(&v, &v)
+	feSub(&v, &v, &u2Sq)
+
+	feMul(&t, &v, &u2Sq)
+	var one fieldElement
+	feOne(&one)
+	wasSquare := feSqrtRatioM1(&invSqrt, &one, &t)
+
+	feMul(&denX, &invSqrt, &u2)
+	feMul(&denY, &invSqrt, &denX)
+	feMul(&denY, &denY, &v)
+
+	feAdd(&x, &s, &s)
+	feMul(&x, &x, &denX)
+	feAbs(&x, &x)
+	feMul(&y, &u1, &denY)
+	feMul(&t, &x, &y)
+
+	ok := canonical & (negative ^ 1) & int(wasSquare) &
+		int(feIsNegative(&t)^1) & int(feIsNonZero(&y))
+	if ok != 1 {
+		return false
+	}
+
+	P.ge.X = x
+	P.ge.Y = y
+	feOne(&P.ge.Z)
+	P.ge.T = t
+	return true
+}
+
+// elligator implements the Ristretto255 one-way map MAP
+// (RFC 9496, Section 4.3.4), mapping the field element t to a point.
+func (P *ristrettoPoint) elligator(t *fieldElement) {
+	var r, u, v, s, sPrime, c, n, w0, w1, w2, w3, tmp fieldElement
+	var one fieldElement
+	feOne(&one)
+
+	feSquare(&r, t)
+	feMul(&r, &r, &sqrtM1)
+
+	feAdd(&u, &r, &one)
+	feMul(&u, &u, &oneMinusDSq)
+
+	feMul(&tmp, &r, &d)
+	feSub(&v, &feMinusOne, &tmp)
+	feAdd(&tmp, &r, &d)
+	feMul(&v, &v, &tmp)
+
+	wasSquare := feSqrtRatioM1(&s, &u, &v)
+	feMul(&sPrime, &s, t)
+	feAbs(&sPrime, &sPrime)
+	feNeg(&sPrime, &sPrime)
+	feCMove(&s, &sPrime, wasSquare^1)
+
+	feCopy(&c, &r)
+	feCMove(&c, &feMinusOne, wasSquare)
+
+	feSub(&tmp, &r, &one)
+	feMul(&n, &c, &tmp)
+	feMul(&n, &n, &dMinusOneSq)
+	feSub(&n, &n, &v)
+
+	feAdd(&w0, &s, &s)
+	feMul(&w0, &w0, &v)
+	feMul(&w1, &n, &sqrtADMinusOne)
+	feSquare(&tmp, &s)
+	feSub(&w2, &one, &tmp)
+	feAdd(&w3, &one, &tmp)
+
+	feMul(&P.ge.X, &w0, &w3)
+	feMul(&P.ge.Y, &w2, &w1)
+	feMul(&P.ge.Z, &w1, &w3)
+	feMul(&P.ge.T, &w0, &w2)
+}
+
+// SetUniformBytes sets P to the element derived from 64 uniformly
+// random bytes b, such as the output of a hash function, using the
+// element derivation function of RFC 9496, Section 4.3.4. The
+// resulting distribution is indistinguishable from a uniform one.
+func (P *ristrettoPoint) SetUniformBytes(b []byte) kyber.Point {
+	if len(b) != 64 {
+		panic("Ristretto255 SetUniformBytes requires 64 bytes")
+	}
+	var t fieldElement
+	var Q ristrettoPoint
+
+	feFromBytes(&t, b[:32])
+	P.elligator(&t)
+	feFromBytes(&t, b[32:])
+	Q.elligator(&t)
+	return P.Add(P, &Q)
+}
+
+// Valid always reports true: Ristretto255 has prime order, and every
+// element UnmarshalBinary accepts belongs to it.
+func (P *ristrettoPoint) Valid() bool {
+	return true
+}
+
+// Equal tests whether two elements are equal, in the sense of RFC 9496.
+// Distinct Ed25519 representatives of the same element compare equal.
+func (P *ristrettoPoint) Equal(P2 kyber.Point) bool {
+	Q := &P2.(*ristrettoPoint).ge
+	var a, b fieldElement
+
+	feMul(&a, &P.ge.X, &Q.Y)
+	feMul(&b, &P.ge.Y, &Q.X)
+	eq := feEqual(&a, &b)
+
+	feMul(&a, &P.ge.Y, &Q.Y)
+	feMul(&b, &P.ge.X, &Q.X)
+	eq |= feEqual(&a, &b)
+
+	return eq == 1
+}
+
+// Set point to be equal to P2.
+func (P *ristrettoPoint) Set(P2 kyber.Point) kyber.Point {
+	P.ge = P2.(*ristrettoPoint).ge
+	return P
+}
+
+// Clone creates a new point with the same value as P.
+func (P *ristrettoPoint) Clone() kyber.Point {
+	return &ristrettoPoint{ge: P.ge}
+}
+
+// Set to the identity element.
+func (P *ristrettoPoint) Null() kyber.Point {
+	P.ge.Zero()
+	return P
+}
+
+// Set to the standard generator, which is the Ed25519 base point.
+func (P *ristrettoPoint) Base() kyber.Point {
+	P.ge = baseext
+	return P
+}
+
+func (P *ristrettoPoint) EmbedLen() int {
+	// Reserve the least-significant 8 bits for embedded data length,
+	// and the most-significant 16 bits for pseudo-randomness.
+	return (255 - 8 - 16) / 8
+}
+
+func (P *ristrettoPoint) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	if data == nil {
+		return P.Pick(rand)
+	}
+
+	// How many bytes to embed?
+	dl := P.EmbedLen()
+	if dl > len(data) {
+		dl = len(data)
+	}
+
+	for {
+		// Pick a random encoding with the embedded data, and retry until
+		// it happens to be a valid one. The length is stored shifted by one
+		// bit, since valid encodings must be non-negative (even).
+		var b [32]byte
+		random.Bytes(b[:], rand)
+		b[0] = byte(dl << 1)
+		copy(b[1:1+dl], data)
+		b[31] &= 0x7f
+		if P.decode(b[:]) {
+			return P
+		}
+	}
+}
+
+// Pick sets P to a uniformly distributed element derived from rand.
+// Used with an XOF keyed by a message, this hashes the message to a point.
+func (P *ristrettoPoint) Pick(rand cipher.Stream) kyber.Point {
+	var b [64]byte
+	random.Bytes(b[:], rand)
+	return P.SetUniformBytes(b[:])
+}
+
+// Extract embedded data from a point group element
+func (P *ristrettoPoint) Data() ([]byte, error) {
+	var b [32]byte
+	P.encode(&b)
+	dl := int(b[0] >> 1) // extract length byte
+	if dl > P.EmbedLen() {
+		return nil, errRistrettoData
+	}
+	return b[1 : 1+dl], nil
+}
+
+func (P *ristrettoPoint) Add(P1, P2 kyber.Point) kyber.Point {
+	E1 := P1.(*ristrettoPoint)
+	E2 := P2.(*ristrettoPoint)
+
+	var t2 cachedGroupElement
+	var r completedGroupElement
+
+	E2.ge.ToCached(&t2)
+	r.Add(&E1.ge, &t2)
+	r.ToExtended(&P.ge)
+
+	return P
+}
+
+func (P *ristrettoPoint) Sub(P1, P2 kyber.Point) kyber.Point {
+	E1 := P1.(*ristrettoPoint)
+	E2 := P2.(*ristrettoPoint)
+
+	var t2 cachedGroupElement
+	var r completedGroupElement
+
+	E2.ge.ToCached(&t2)
+	r.Sub(&E1.ge, &t2)
+	r.ToExtended(&P.ge)
+
+	return P
+}
+
+// Neg finds the negative of point A.
+func (P *ristrettoPoint) Neg(A kyber.Point) kyber.Point {
+	P.ge.Neg(&A.(*ristrettoPoint).ge)
+	return P
+}
+
+// Mul multiplies point A by scalar s, or the generator if A is nil.
+func (P *ristrettoPoint) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
+
+	a := &s.(*scalar).v
+
+	if A == nil {
+		geScalarMultBase(&P.ge, a)
+	} else {
+		if P.varTime {
+			geScalarMultVartime(&P.ge, a, &A.(*ristrettoPoint).ge)
+		} else {
+			geScalarMult(&P.ge, a, &A.(*ristrettoPoint).ge)
+		}
+	}
+
+	return P
+}
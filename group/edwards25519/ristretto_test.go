@@ -0,0 +1,184 @@
+package edwards25519
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+)
+
+var tRistretto = NewBlakeSHA256Ristretto255()
+
+func TestRistrettoSuite(t *testing.T) { test.SuiteTest(tRistretto) }
+
+// Encodings of the multiples 0*B ... 15*B of the generator,
+// from RFC 9496, Appendix A.1.
+var ristrettoMultiples = []string{
+	"0000000000000000000000000000000000000000000000000000000000000000",
+	"e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76",
+	"6a493210f7499cd17fecb510ae0cea23a110e8d5b901f8acadd3095c73a3b919",
+	"94741f5d5d52755ece4f23f044ee27d5d1ea1e2bd196b462166b16152a9d0259",
+	"da80862773358b466ffadfe0b3293ab3d9fd53c5ea6c955358f568322daf6a57",
+	"e882b131016b52c1d3337080187cf768423efccbb517bb495ab812c4160ff44e",
+	"f64746d3c92b13050ed8d80236a7f0007c3b3f962f5ba793d19a601ebb1df403",
+	"44f53520926ec81fbd5a387845beb7df85a96a24ece18738bdcfa6a7822a176d",
+	"903293d8f2287ebe10e2374dc1a53e0bc887e592699f02d077d5263cdd55601c",
+	"02622ace8f7303a31cafc63f8fc48fdc16e1c8c8d234b2f0d6685282a9076031",
+	"20706fd788b2720a1ed2a5dad4952b01f413bcf0e7564de8cdc816689e2db95f",
+	"bce83f8ba5dd2fa572864c24ba1810f9522bc6004afe95877ac73241cafdab42",
+	"e4549ee16b9aa03099ca208c67adafcafa4c3f3e4e5303de6026e3ca8ff84460",
+	"aa52e000df2e16f55fb1032fc33bc42742dad6bd5a8fc0be0167436c5948501f",
+	"46376b80f409b29dc2b5f6f0c52591990896e5716f41477cd30085ab7f10301e",
+	"e0c418f7c8d9c4cdd7395b93ea124f3ad99021bb681dfc3302a9d99a2e53e64e",
+}
+
+func TestRistrettoMultiples(t *testing.T) {
+	B := tRistretto.Point().Base()
+	P := tRistretto.Point().Null()
+	for i, enc := range ristrettoMultiples {
+		if P.String() != enc {
+			t.Fatalf("%d*B: expected %s, got %s", i, enc, P.String())
+		}
+
+		b, _ := hex.DecodeString(enc)
+		Q := tRistretto.Point()
+		if err := Q.UnmarshalBinary(b); err != nil {
+			t.Fatalf("%d*B: %v", i, err)
+		}
+		if !Q.Equal(P) {
+			t.Fatalf("%d*B: decoded point differs", i)
+		}
+
+		R := tRistretto.Point().Mul(tRistretto.Scalar().SetInt64(int64(i)), nil)
+		if !R.Equal(P) {
+			t.Fatalf("%d*B: scalar multiplication differs", i)
+		}
+		P.Add(P, B)
+	}
+}
+
+// One-way map test vectors from RFC 9496, Appendix A.3: 64 uniform
+// input bytes and the encoding of the resulting element.
+var ristrettoUniform = []struct{ input, output string }{
+	{"5d1be09e3d0c82fc538112490e35701979d99e06ca3e2b5b54bffe8b4dc772c1" +
+		"4d98b696a1bbfb5ca32c436cc61c16563790306c79eaca7705668b47dffe5bb6",
+		"3066f82a1a747d45120d1740f14358531a8f04bbffe6a819f86dfe50f44a0a46"},
+	{"f116b34b8f17ceb56e8732a60d913dd10cce47a6d53bee9204be8b44f6678b27" +
+		"0102a56902e2488c46120e9276cfe54638286b9e4b3cdb470b542d46c2068d38",
+		"f26e5b6f7d362d2d2a94c5d0e7602cb4773c95a2e5c31a64f133189fa76ed61b"},
+	{"8422e1bbdaab52938b81fd602effb6f89110e1e57208ad12d9ad767e2e25510c" +
+		"27140775f9337088b982d83d7fcf0b2fa1edffe51952cbe7365e95c86eaf325c",
+		"006ccd2a9e6867e6a2c5cea83d3302cc9de128dd2a9a57dd8ee7b9d7ffe02826"},
+	{"ac22415129b61427bf464e17baee8db65940c233b98afce8d17c57beeb7876c2" +
+		"150d15af1cb1fb824bbd14955f2b57d08d388aab431a391cfc33d5bafb5dbbaf",
+		"f8f0c87cf237953c5890aec3998169005dae3eca1fbb04548c635953c817f92a"},
+	{"165d697a1ef3d5cf3c38565beefcf88c0f282b8e7dbd28544c483432f1cec767" +
+		"5debea8ebb4e5fe7d6f6e5db15f15587ac4d4d4a1de7191e0c1ca6664abcc413",
+		"ae81e7dedf20a497e10c304a765c1767a42d6e06029758d2d7e8ef7cc4c41179"},
+	{"a836e6c9a9ca9f1e8d486273ad56a78c70cf18f0ce10abb1c7172ddd605d7fd2" +
+		"979854f47ae1ccf204a33102095b4200e5befc0465accc263175485f0e17ea5c",
+		"e2705652ff9f5e44d3e841bf1c251cf7dddb77d140870d1ab2ed64f1a9ce8628"},
+	{"2cdc11eaeb95daf01189417cdddbf95952993aa9cb9c640eb5058d09702c7462" +
+		"2c9965a697a3b345ec24ee56335b556e677b30e6f90ac77d781064f866a3c982",
+		"80bd07262511cdde4863f8a7434cef696750681cb9510eea557088f76d9e5065"},
+	// Non-canonical and high-bit field elements map as their reductions.
+	{"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"304282791023b73128d277bdcb5c7746ef2eac08dde9f2983379cb8e5ef0517f"},
+	{"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"304282791023b73128d277bdcb5c7746ef2eac08dde9f2983379cb8e5ef0517f"},
+	{"0000000000000000000000000000000000000000000000000000000000000080" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"304282791023b73128d277bdcb5c7746ef2eac08dde9f2983379cb8e5ef0517f"},
+	{"0000000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000080",
+		"0000000000000000000000000000000000000000000000000000000000000000"},
+}
+
+func TestRistrettoUniformBytes(t *testing.T) {
+	for i, v := range ristrettoUniform {
+		b, _ := hex.DecodeString(v.input)
+		P := tRistretto.Point().(*ristrettoPoint).SetUniformBytes(b)
+		if P.String() != v.output {
+			t.Fatalf("vector %d: expected %s, got %s", i, v.output, P.String())
+		}
+	}
+}
+
+func TestRistrettoBadEncodings(t *testing.T) {
+	bad := []string{
+		// Non-canonical field elements.
+		"00ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// Negative field elements.
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		"01ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// Truncated.
+		"e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d",
+	}
+	for _, enc := range bad {
+		b, _ := hex.DecodeString(enc)
+		if err := tRistretto.Point().UnmarshalBinary(b); err == nil {
+			t.Fatalf("%s: expected decoding to fail", enc)
+		}
+	}
+}
+
+func TestRistrettoTorsion(t *testing.T) {
+	// The Ed25519 point (0,-1) of order 2 is the identity in Ristretto255,
+	// so adding it must not change an element or its encoding.
+	var T2 ristrettoPoint
+	T2.ge.Zero()
+	feNeg(&T2.ge.Y, &T2.ge.Y)
+	if !T2.Equal(tRistretto.Point().Null()) {
+		t.Fatal("2-torsion point should equal the identity")
+	}
+
+	P := tRistretto.Point().Pick(random.New())
+	Q := tRistretto.Point().Add(P, &T2)
+	if !P.Equal(Q) || P.String() != Q.String() {
+		t.Fatal("adding a torsion point changed the element")
+	}
+}
+
+func TestRistrettoDecodedMul(t *testing.T) {
+	// Multiplying a decoded element must give the same result as
+	// multiplying the element it was encoded from.
+	rand := random.New()
+	for i := 0; i < 100; i++ {
+		P := tRistretto.Point().Pick(rand)
+		b, _ := P.MarshalBinary()
+		Q := tRistretto.Point()
+		if err := Q.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+		s := tRistretto.Scalar().Pick(rand)
+		if !tRistretto.Point().Mul(s, P).Equal(tRistretto.Point().Mul(s, Q)) {
+			t.Fatal("multiplication of a decoded element is wrong")
+		}
+	}
+}
+
+func TestRistrettoPick(t *testing.T) {
+	// Picking from identically seeded XOFs hashes to the same element.
+	P1 := tRistretto.Point().Pick(tRistretto.XOF([]byte("message")))
+	P2 := tRistretto.Point().Pick(tRistretto.XOF([]byte("message")))
+	P3 := tRistretto.Point().Pick(tRistretto.XOF([]byte("other message")))
+	if !P1.Equal(P2) || P1.Equal(P3) {
+		t.Fatal("hash to point is not deterministic")
+	}
+}
+
+func TestRistrettoEmbed(t *testing.T) {
+	data := []byte("ristretto")
+	P := tRistretto.Point().Embed(data, random.New())
+	got, err := P.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(data) {
+		t.Fatalf("expected %q, got %q", data, got)
+	}
+}
@@ -11,10 +11,11 @@ import (
 	"errors"
 	"io"
 	"math/big"
+	"unsafe"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -67,6 +68,11 @@ func (s *scalar) Zero() kyber.Scalar {
 	return s
 }
 
+// Destroy overwrites the scalar with zero, as in kyber.Destroyable.
+func (s *scalar) Destroy() {
+	s.v = [32]byte{}
+}
+
 // Set to the multiplicative identity (1)
 func (s *scalar) One() kyber.Scalar {
 	s.v = [32]byte{1}
@@ -141,6 +147,13 @@ func (s *scalar) SetBytes(b []byte) kyber.Scalar {
 	return s.setInt(mod.NewIntBytes(b, primeOrder, mod.LittleEndian))
 }
 
+// SetBytesWide sets s to b, interpreted as a little endian integer, modulo
+// the prime order, in constant time.
+func (s *scalar) SetBytesWide(b [64]byte) kyber.Scalar {
+	scReduce(&s.v, &b)
+	return s
+}
+
 // String returns the string representation of this scalar (fixed length of 32 bytes, little endian).
 func (s *scalar) String() string {
 	b, _ := s.toInt().MarshalBinary()
@@ -155,16 +168,33 @@ func (s *scalar) MarshalSize() int {
 	return 32
 }
 
-// MarshalBinary returns the binary representation of this scalar.
+// MarshalBinary returns the binary representation of this scalar, reduced
+// modulo the prime order in constant time.
 func (s *scalar) MarshalBinary() ([]byte, error) {
-	return s.toInt().MarshalBinary()
+	var wide [64]byte
+	var b [32]byte
+	copy(wide[:], s.v[:])
+	scReduce(&b, &wide)
+	return b[:], nil
 }
 
-// UnmarshalBinary reads the binary representation of a scalar.
+// UnmarshalBinary reads the binary representation of a scalar. Unless
+// kyber.AllowNonCanonical is set, it rejects values that are not reduced
+// modulo the prime order.
 func (s *scalar) UnmarshalBinary(buf []byte) error {
 	if len(buf) != 32 {
 		return errors.New("wrong size buffer")
 	}
+	if !kyber.NonCanonicalAllowed() {
+		// buf < l if and only if it is its own reduction.
+		var wide [64]byte
+		var reduced [32]byte
+		copy(wide[:], buf)
+		scReduce(&reduced, &wide)
+		if subtle.ConstantTimeCompare(buf, reduced[:]) != 1 {
+			return errors.New("non-canonical scalar")
+		}
+	}
 	copy(s.v[:], buf)
 	return nil
 }
@@ -181,6 +211,17 @@ func (s *scalar) UnmarshalFrom(r io.Reader) (int, error) {
 	return marshalling.ScalarUnmarshalFrom(s, r)
 }
 
+// scalarAt returns a zero scalar stored in the first 32 bytes of b, which
+// the scalar may occupy since it holds no pointers.
+func scalarAt(b []byte) *scalar {
+	if len(b) < 32 {
+		panic("edwards25519: scalar storage shorter than 32 bytes")
+	}
+	s := (*scalar)(unsafe.Pointer(&b[0]))
+	s.Zero()
+	return s
+}
+
 func newScalarInt(i *big.Int) *scalar {
 	s := scalar{}
 	s.setInt(mod.NewInt(i, fullOrder))
@@ -115,6 +115,89 @@ func TestSetBytesLE(t *testing.T) {
 	}
 }
 
+func TestSetBytesWide(t *testing.T) {
+	var b [64]byte
+	for i := 0; i < 100; i++ {
+		random.Bytes(b[:], random.New())
+		if i == 0 {
+			for j := range b {
+				b[j] = 0xff
+			}
+		}
+		s := new(scalar).SetBytesWide(b)
+		if !s.Equal(new(scalar).SetBytes(b[:])) {
+			t.Fatal("wide reduction differs from SetBytes:", s)
+		}
+	}
+}
+
+func TestNonCanonicalScalar(t *testing.T) {
+	// l = -1 + 1
+	b, _ := minusOne.MarshalBinary()
+	b[0]++
+	s := new(scalar)
+	if err := s.UnmarshalBinary(b); err == nil {
+		t.Fatal("non-canonical scalar accepted")
+	}
+	b[0]--
+	if err := s.UnmarshalBinary(b); err != nil || !s.Equal(minusOne) {
+		t.Fatal("canonical scalar rejected:", err)
+	}
+
+	b[0]++
+	kyber.AllowNonCanonical(true)
+	err := s.UnmarshalBinary(b)
+	kyber.AllowNonCanonical(false)
+	if err != nil {
+		t.Fatal("legacy mode rejected non-canonical scalar:", err)
+	}
+	if s.String() != zero.String() {
+		t.Fatal("non-canonical scalar is not zero:", s)
+	}
+	if !kyber.ConstantTimeEqual(s, zero) {
+		t.Fatal("non-canonical scalar is not encoded reduced")
+	}
+}
+
+func TestScalarDestroy(t *testing.T) {
+	s := tSuite.Scalar().Pick(random.New())
+	s.(kyber.Destroyable).Destroy()
+	if !s.Equal(zero) {
+		t.Fatal("scalar not overwritten")
+	}
+}
+
+func TestConstantTimeSelect(t *testing.T) {
+	rand := random.New()
+	x := tSuite.Scalar().Pick(rand)
+	y := tSuite.Scalar().Pick(rand)
+	s := tSuite.Scalar()
+	if !kyber.ConstantTimeSelect(1, s, x, y).Equal(x) {
+		t.Fatal("select of 1 is not x")
+	}
+	if !kyber.ConstantTimeSelect(0, s, x, y).Equal(y) {
+		t.Fatal("select of 0 is not y")
+	}
+	if !kyber.ConstantTimeEqual(s, y) || kyber.ConstantTimeEqual(s, x) {
+		t.Fatal("wrong constant-time equality of scalars")
+	}
+
+	P := tSuite.Point().Mul(x, nil)
+	Q := tSuite.Point().Mul(y, nil)
+	pb, _ := P.MarshalBinary()
+	qb, _ := Q.MarshalBinary()
+	R := tSuite.Point()
+	if err := R.UnmarshalBinary(kyber.ConstantTimeSelectBytes(1, pb, qb)); err != nil || !R.Equal(P) {
+		t.Fatal("select of 1 is not P")
+	}
+	if err := R.UnmarshalBinary(kyber.ConstantTimeSelectBytes(0, pb, qb)); err != nil || !R.Equal(Q) {
+		t.Fatal("select of 0 is not Q")
+	}
+	if !kyber.ConstantTimeEqual(R, Q) || kyber.ConstantTimeEqual(R, P) {
+		t.Fatal("wrong constant-time equality of points")
+	}
+}
+
 func testSimple(t *testing.T, new func() kyber.Scalar) {
 	s1 := new()
 	s2 := new()
@@ -450,3 +533,30 @@ func scSubFact(s, a, c *[32]byte) {
 
 	scReduceLimbs(limbs)
 }
+
+func TestBatchInvert(t *testing.T) {
+	kyber.BatchInvert(nil)
+
+	rand := random.New()
+	for _, n := range []int{1, 2, 7} {
+		scalars := make([]kyber.Scalar, n)
+		orig := make([]kyber.Scalar, n)
+		for i := range scalars {
+			scalars[i] = tSuite.Scalar().Pick(rand)
+			if i%3 == 1 {
+				scalars[i].Zero()
+			}
+			orig[i] = scalars[i].Clone()
+		}
+		kyber.BatchInvert(scalars)
+		for i, s := range scalars {
+			if orig[i].Equal(zero) {
+				if !s.Equal(zero) {
+					t.Fatal("zero scalar not left as is")
+				}
+			} else if !s.Equal(tSuite.Scalar().Inv(orig[i])) {
+				t.Fatal("wrong inverse of scalar", i, "of", n)
+			}
+		}
+	}
+}
@@ -6,19 +6,31 @@ import (
 	"hash"
 	"io"
 	"reflect"
+	"sync"
 
 	"github.com/dedis/fixbuf"
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/internal/fips"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
 	"github.com/dedis/kyber/xof/blake"
+	"github.com/dedis/kyber/xof/chacha"
 )
 
 // SuiteEd25519 implements some basic functionalities such as Group, HashFactory,
 // and XOFFactory.
 type SuiteEd25519 struct {
 	Curve
-	r cipher.Stream
+	r        cipher.Stream
+	pool     *kyber.Pool
+	poolOnce sync.Once
+}
+
+// Pool returns the pool of temporary Points and Scalars shared by the users
+// of the suite, as in kyber.Pooling.
+func (s *SuiteEd25519) Pool() *kyber.Pool {
+	s.poolOnce.Do(func() { s.pool = kyber.NewPool(s) })
+	return s.pool
 }
 
 // Hash returns a newly instanciated sha256 hash function.
@@ -61,6 +73,16 @@ func NewBlakeSHA256Ed25519() *SuiteEd25519 {
 	return suite
 }
 
+// NewBlakeSHA256Ed25519Checked returns the suite of NewBlakeSHA256Ed25519,
+// or an error wrapping suites.ErrNotFIPSApproved if FIPS mode is required,
+// as Ed25519 is not approved.
+func NewBlakeSHA256Ed25519Checked() (*SuiteEd25519, error) {
+	if err := fips.Check("Ed25519"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA256Ed25519(), nil
+}
+
 // NewBlakeSHA256Ed25519WithRand returns a cipher suite based on package
 // github.com/dedis/kyber/xof/blake, SHA-256, and the Ed25519 curve.
 // It produces cryptographically random numbers via the provided stream r.
@@ -69,3 +91,121 @@ func NewBlakeSHA256Ed25519WithRand(r cipher.Stream) *SuiteEd25519 {
 	suite.r = r
 	return suite
 }
+
+// SuiteChaCha20Ed25519 is an Ed25519 suite whose XOF is based on the
+// ChaCha20 stream cipher, for platforms without AES or SHA-3 hardware.
+type SuiteChaCha20Ed25519 struct {
+	SuiteEd25519
+}
+
+// XOF returns an XOF which is implemented via the ChaCha20 stream cipher.
+func (s *SuiteChaCha20Ed25519) XOF(key []byte) kyber.XOF {
+	return chacha.New(key)
+}
+
+// String returns the name of the suite, "Ed25519-ChaCha20".
+func (s *SuiteChaCha20Ed25519) String() string {
+	return "Ed25519-ChaCha20"
+}
+
+// NewChaCha20SHA256Ed25519 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/chacha, SHA-256, and the Ed25519 curve.
+// It produces cryptographically random numbers via package crypto/rand.
+func NewChaCha20SHA256Ed25519() *SuiteChaCha20Ed25519 {
+	suite := new(SuiteChaCha20Ed25519)
+	return suite
+}
+
+// NewChaCha20SHA256Ed25519Checked returns the suite of
+// NewChaCha20SHA256Ed25519, or an error wrapping suites.ErrNotFIPSApproved if
+// FIPS mode is required.
+func NewChaCha20SHA256Ed25519Checked() (*SuiteChaCha20Ed25519, error) {
+	if err := fips.Check("Ed25519-ChaCha20"); err != nil {
+		return nil, err
+	}
+	return NewChaCha20SHA256Ed25519(), nil
+}
+
+// NewChaCha20SHA256Ed25519WithRand returns a cipher suite based on package
+// github.com/dedis/kyber/xof/chacha, SHA-256, and the Ed25519 curve.
+// It produces cryptographically random numbers via the provided stream r.
+func NewChaCha20SHA256Ed25519WithRand(r cipher.Stream) *SuiteChaCha20Ed25519 {
+	suite := new(SuiteChaCha20Ed25519)
+	suite.r = r
+	return suite
+}
+
+// SuiteRistretto255 implements the same functionalities as SuiteEd25519,
+// over the Ristretto255 prime-order group.
+type SuiteRistretto255 struct {
+	Ristretto255
+	r        cipher.Stream
+	pool     *kyber.Pool
+	poolOnce sync.Once
+}
+
+// Pool returns the pool of temporary Points and Scalars shared by the users
+// of the suite, as in kyber.Pooling.
+func (s *SuiteRistretto255) Pool() *kyber.Pool {
+	s.poolOnce.Do(func() { s.pool = kyber.NewPool(s) })
+	return s.pool
+}
+
+// Hash returns a newly instanciated sha256 hash function.
+func (s *SuiteRistretto255) Hash() hash.Hash {
+	return sha256.New()
+}
+
+// XOF returns an XOF which is implemented via the Blake2b hash.
+func (s *SuiteRistretto255) XOF(key []byte) kyber.XOF {
+	return blake.New(key)
+}
+
+func (s *SuiteRistretto255) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *SuiteRistretto255) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+// New implements the kyber.Encoding interface
+func (s *SuiteRistretto255) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// RandomStream returns a cipher.Stream that returns a key stream
+// from crypto/rand.
+func (s *SuiteRistretto255) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
+
+// NewBlakeSHA256Ristretto255 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake, SHA-256, and the Ristretto255 group.
+// It produces cryptographically random numbers via package crypto/rand.
+func NewBlakeSHA256Ristretto255() *SuiteRistretto255 {
+	suite := new(SuiteRistretto255)
+	return suite
+}
+
+// NewBlakeSHA256Ristretto255Checked returns the suite of
+// NewBlakeSHA256Ristretto255, or an error wrapping suites.ErrNotFIPSApproved
+// if FIPS mode is required.
+func NewBlakeSHA256Ristretto255Checked() (*SuiteRistretto255, error) {
+	if err := fips.Check("Ristretto255"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA256Ristretto255(), nil
+}
+
+// NewBlakeSHA256Ristretto255WithRand returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake, SHA-256, and the Ristretto255 group.
+// It produces cryptographically random numbers via the provided stream r.
+func NewBlakeSHA256Ristretto255WithRand(r cipher.Stream) *SuiteRistretto255 {
+	suite := new(SuiteRistretto255)
+	suite.r = r
+	return suite
+}
@@ -0,0 +1,49 @@
+package edwards25519
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+)
+
+func TestPool(t *testing.T) {
+	pool := tSuite.Pool()
+	if kyber.PoolOf(tSuite) != pool || tSuite.Pool() != pool {
+		t.Fatal("suite has more than one pool")
+	}
+	if _, ok := pool.Point().(*point); !ok {
+		t.Fatal("pool hands out points of another group")
+	}
+	if _, ok := pool.Scalar().(*scalar); !ok {
+		t.Fatal("pool hands out scalars of another group")
+	}
+
+	// A group without a pool of its own gets a fresh one.
+	other := kyber.PoolOf(new(Curve))
+	if other == pool || other == kyber.PoolOf(new(Curve)) {
+		t.Fatal("pool of a group without one is shared")
+	}
+	if _, ok := other.Point().(*point); !ok {
+		t.Fatal("new pool hands out points of another group")
+	}
+}
+
+func TestPoolRelease(t *testing.T) {
+	pool := tSuite.Pool()
+	rand := random.New()
+	s := pool.Scalar().Pick(rand)
+	ss := []kyber.Scalar{pool.Scalar().Pick(rand), nil}
+	P := pool.Point().Pick(rand)
+	pool.Release(s, ss, P, []kyber.Point{nil}, nil)
+	if !s.Equal(zero) || !ss[0].Equal(zero) {
+		t.Fatal("released scalar not zeroed")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("release of a byte slice does not panic")
+		}
+	}()
+	pool.Release([]byte{1})
+}
@@ -0,0 +1,135 @@
+// Package hash2curve implements the building blocks of RFC 9380, "Hashing
+// to Elliptic Curves", shared by the groups whose points implement
+// kyber.HashablePoint: the expansion of messages into uniform bytes, with
+// expand_message_xmd and expand_message_xof, and the hashing of messages to
+// field elements, with hash_to_field.
+//
+// The maps to curves and the clearing of cofactors are specific to each
+// curve, and live in the packages of the groups.
+package hash2curve
+
+import (
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+)
+
+// oversizeDST prefixes the hash of domain separation tags longer than 255
+// bytes.
+const oversizeDST = "H2C-OVERSIZE-DST-"
+
+var errLength = errors.New("hash2curve: output too long")
+
+// Expander expands a message, in the domain given by the domain separation
+// tag dst, into n uniform bytes.
+type Expander func(msg, dst []byte, n int) ([]byte, error)
+
+// XOF is an extendable output function, such as SHAKE128.
+type XOF interface {
+	io.Writer
+	io.Reader
+}
+
+// ExpanderXMD returns the expander expand_message_xmd built on the hash
+// function h, such as SHA-256, with outputs of up to 255 hashes.
+func ExpanderXMD(h func() hash.Hash) Expander {
+	return func(msg, dst []byte, n int) ([]byte, error) {
+		return ExpandMessageXMD(h, msg, dst, n)
+	}
+}
+
+// ExpanderXOF returns the expander expand_message_xof built on the
+// extendable output function returned by x, for the security level of k
+// bits.
+func ExpanderXOF(x func() XOF, k int) Expander {
+	return func(msg, dst []byte, n int) ([]byte, error) {
+		return ExpandMessageXOF(x, k, msg, dst, n)
+	}
+}
+
+// ExpandMessageXMD expands msg, in the domain dst, into n uniform bytes
+// with the hash function h, as specified by Section 5.3.1 of RFC 9380.
+func ExpandMessageXMD(h func() hash.Hash, msg, dst []byte, n int) ([]byte, error) {
+	H := h()
+	if len(dst) > 255 {
+		H.Write([]byte(oversizeDST))
+		H.Write(dst)
+		dst = H.Sum(nil)
+		H.Reset()
+	}
+	b := H.Size()
+	ell := (n + b - 1) / b
+	if n < 0 || ell > 255 || n > 65535 {
+		return nil, errLength
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	// b_0 = H(Z_pad || msg || I2OSP(n, 2) || I2OSP(0, 1) || DST_prime)
+	H.Write(make([]byte, H.BlockSize()))
+	H.Write(msg)
+	H.Write([]byte{byte(n >> 8), byte(n), 0})
+	H.Write(dstPrime)
+	b0 := H.Sum(nil)
+
+	// b_i = H(strxor(b_0, b_(i-1)) || I2OSP(i, 1) || DST_prime)
+	out := make([]byte, 0, ell*b)
+	bi := make([]byte, b)
+	for i := 1; i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		H.Reset()
+		H.Write(bi)
+		H.Write([]byte{byte(i)})
+		H.Write(dstPrime)
+		bi = H.Sum(nil)
+		out = append(out, bi...)
+	}
+	return out[:n], nil
+}
+
+// ExpandMessageXOF expands msg, in the domain dst, into n uniform bytes
+// with the extendable output function returned by x, for the security level
+// of k bits, as specified by Section 5.3.2 of RFC 9380.
+func ExpandMessageXOF(x func() XOF, k int, msg, dst []byte, n int) ([]byte, error) {
+	if n < 0 || n > 65535 {
+		return nil, errLength
+	}
+	if len(dst) > 255 {
+		H := x()
+		H.Write([]byte(oversizeDST))
+		H.Write(dst)
+		dst = make([]byte, (2*k+7)/8)
+		if _, err := io.ReadFull(H, dst); err != nil {
+			return nil, err
+		}
+	}
+	H := x()
+	H.Write(msg)
+	H.Write([]byte{byte(n >> 8), byte(n)})
+	H.Write(dst)
+	H.Write([]byte{byte(len(dst))})
+	out := make([]byte, n)
+	if _, err := io.ReadFull(H, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HashToField hashes msg, in the domain dst, to count elements of the
+// extension of degree m of the prime field of order p, each of whose m
+// coordinates is reduced from L uniform bytes, as specified by Section 5.2
+// of RFC 9380. It returns the count*m coordinates in order.
+func HashToField(e Expander, msg, dst []byte, p *big.Int, m, L, count int) ([]*big.Int, error) {
+	uniform, err := e(msg, dst, count*m*L)
+	if err != nil {
+		return nil, err
+	}
+	u := make([]*big.Int, count*m)
+	for i := range u {
+		u[i] = new(big.Int).SetBytes(uniform[i*L : (i+1)*L])
+		u[i].Mod(u[i], p)
+	}
+	return u, nil
+}
@@ -0,0 +1,78 @@
+package hash2curve
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func shake128() XOF {
+	return sha3.NewShake128()
+}
+
+// Test vectors of Appendix K of RFC 9380.
+func TestExpandMessageVectors(t *testing.T) {
+	uniform, err := ExpandMessageXMD(sha256.New, nil, []byte("QUUX-V01-CS02-with-expander-SHA256-128"), 32)
+	require.Nil(t, err)
+	require.Equal(t, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235", hex.EncodeToString(uniform))
+
+	uniform, err = ExpandMessageXMD(sha256.New, []byte("abc"), []byte("QUUX-V01-CS02-with-expander-SHA256-128"), 32)
+	require.Nil(t, err)
+	require.Equal(t, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615", hex.EncodeToString(uniform))
+
+	uniform, err = ExpandMessageXOF(shake128, 128, nil, []byte("QUUX-V01-CS02-with-expander-SHAKE128"), 32)
+	require.Nil(t, err)
+	require.Equal(t, "86518c9cd86581486e9485aa74ab35ba150d1c75c88e26b7043e44e2acd735a2", hex.EncodeToString(uniform))
+
+	uniform, err = ExpandMessageXOF(shake128, 128, []byte("abc"), []byte("QUUX-V01-CS02-with-expander-SHAKE128"), 32)
+	require.Nil(t, err)
+	require.Equal(t, "8696af52a4d862417c0763556073f47bc9b9ba43c99b505305cb1ec04a9ab468", hex.EncodeToString(uniform))
+}
+
+func TestExpandMessage(t *testing.T) {
+	long := []byte("QUUX-V01-CS02-with-expander-SHA256-128-long-DST-" + strings.Repeat("1", 208))
+
+	uniform, err := ExpanderXMD(sha256.New)([]byte("abc"), long, 80)
+	require.Nil(t, err)
+	require.Equal(t, "a14c441a8593f152f906dc5061a6b8745ce1f6aff8e2f968387888a781f201bc"+
+		"87695c094a13f81723f815723e18fc84fd4551521b70c8d18621dba0a87008f87b26451082949efcbd89cf194bdfb28f",
+		hex.EncodeToString(uniform))
+
+	uniform, err = ExpanderXOF(shake128, 128)([]byte("abc"), long, 80)
+	require.Nil(t, err)
+	require.Equal(t, "1a5dee89f1b0b893e16a73bae33f66abe591e6f3ff4575dd0748c190a62da928"+
+		"130e7a85c220ceef489af9cb3c1acf2948ed20f737778f8af3b7803fbb518edd86b239726f85c502e95f9a7b6c2e68ae",
+		hex.EncodeToString(uniform))
+
+	uniform, err = ExpandMessageXMD(sha512.New, []byte("abc"), []byte("QUUX-V01-CS02-with-expander-SHA512-256"), 80)
+	require.Nil(t, err)
+	require.Equal(t, "e97428cd5279cae9822ec6bbdd2f095cf9c6bc955ae7acfc2e2988f451770a13"+
+		"87149f8cf0c9d75632dd92c60f312677d62771043e9933460dc76c1246038806280924385e1f4457cf48b93ea914b872",
+		hex.EncodeToString(uniform))
+
+	_, err = ExpandMessageXMD(sha256.New, nil, nil, 255*32+1)
+	require.Error(t, err)
+	_, err = ExpandMessageXOF(shake128, 128, nil, nil, 65536)
+	require.Error(t, err)
+}
+
+func TestHashToField(t *testing.T) {
+	e := ExpanderXMD(sha256.New)
+	p := big.NewInt(1000003)
+	u, err := HashToField(e, []byte("msg"), []byte("dst"), p, 2, 16, 3)
+	require.Nil(t, err)
+	require.Len(t, u, 6)
+
+	uniform, err := e([]byte("msg"), []byte("dst"), 96)
+	require.Nil(t, err)
+	for i := range u {
+		v := new(big.Int).SetBytes(uniform[16*i : 16*(i+1)])
+		require.Equal(t, 0, u[i].Cmp(v.Mod(v, p)))
+	}
+}
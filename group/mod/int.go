@@ -4,13 +4,16 @@ package mod
 
 import (
 	"crypto/cipher"
+	"crypto/subtle"
 	"encoding/hex"
 	"errors"
 	"io"
 	"math/big"
+	"sync"
+	"sync/atomic"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -45,6 +48,11 @@ const (
 // target objects, and receive the modulus of the first operand.
 // For efficiency the modulus field M is a pointer,
 // whose target is assumed never to change.
+//
+// Add, Sub, Neg and Mul reuse the words of the target's value once it has
+// grown to the size of the modulus, and so allocate nothing in loops that
+// keep updating the same targets, such as the field arithmetic of curve
+// points. Div, Inv, Exp, Sqrt and the encoding methods allocate.
 type Int struct {
 	V  big.Int   // Integer value from 0 through M-1
 	M  *big.Int  // Modulus for finite field arithmetic
@@ -138,9 +146,37 @@ func (i *Int) Cmp(s2 kyber.Scalar) int {
 	return i.V.Cmp(&s2.(*Int).V)
 }
 
-// Equal returns true if the two Ints are equal
+// Equal returns true if the two Ints are equal, comparing their values
+// with ConstantTimeEqual.
 func (i *Int) Equal(s2 kyber.Scalar) bool {
-	return i.V.Cmp(&s2.(*Int).V) == 0
+	return ConstantTimeEqual(&i.V, &s2.(*Int).V)
+}
+
+// ConstantTimeEqual reports whether a and b are equal, comparing their
+// encodings in constant time rather than with the variable-time Cmp of
+// big.Int. The time taken depends only on the length of the longer of
+// them, which for values reduced modulo the same modulus is at most that
+// of the modulus.
+func ConstantTimeEqual(a, b *big.Int) bool {
+	n := (a.BitLen() + 7) / 8
+	if m := (b.BitLen() + 7) / 8; m > n {
+		n = m
+	}
+	ab := a.FillBytes(make([]byte, n))
+	bb := b.FillBytes(make([]byte, n))
+	return subtle.ConstantTimeCompare(ab, bb)&
+		subtle.ConstantTimeEq(int32(a.Sign()), int32(b.Sign())) == 1
+}
+
+// Destroy overwrites the value of the Int, including the words of memory
+// that held it, and sets it to zero, as in kyber.Destroyable.
+func (i *Int) Destroy() {
+	words := i.V.Bits()
+	words = words[:cap(words)]
+	for j := range words {
+		words[j] = 0
+	}
+	i.V.SetInt64(0)
 }
 
 // Nonzero returns true if the integer value is nonzero.
@@ -208,7 +244,8 @@ func (i *Int) Add(a, b kyber.Scalar) kyber.Scalar {
 	ai := a.(*Int)
 	bi := b.(*Int)
 	i.M = ai.M
-	i.V.Add(&ai.V, &bi.V).Mod(&i.V, i.M)
+	i.V.Add(&ai.V, &bi.V)
+	i.reduce()
 	return i
 }
 
@@ -218,10 +255,99 @@ func (i *Int) Sub(a, b kyber.Scalar) kyber.Scalar {
 	ai := a.(*Int)
 	bi := b.(*Int)
 	i.M = ai.M
-	i.V.Sub(&ai.V, &bi.V).Mod(&i.V, i.M)
+	i.V.Sub(&ai.V, &bi.V)
+	i.reduce()
 	return i
 }
 
+// reduce brings the sum or difference of two values in [0, M) back into
+// that range with a single addition or subtraction of M, rather than with
+// Mod, which allocates its quotient. It falls back to Mod for values out
+// of range.
+func (i *Int) reduce() {
+	switch {
+	case i.V.Sign() < 0:
+		i.V.Add(&i.V, i.M)
+	case i.V.Cmp(i.M) >= 0:
+		i.V.Sub(&i.V, i.M)
+	default:
+		return
+	}
+	if i.V.Sign() < 0 || i.V.Cmp(i.M) >= 0 {
+		i.V.Mod(&i.V, i.M)
+	}
+}
+
+// product holds the temporaries of a modular multiplication: the full
+// product, which must not alias the target when the target is also an
+// operand, and two intermediate values of its reduction. They are pooled
+// so that their words are reused across multiplications, and may thus keep
+// traces of past products that Destroy does not reach.
+type product struct {
+	x, q, y big.Int
+}
+
+var products = sync.Pool{New: func() interface{} { return new(product) }}
+
+// mulMod sets the value of i to a * b mod M. It reduces the product with
+// Barrett's method, which needs only multiplications and shifts, because
+// the division of math/big allocates for moduli of up to four words.
+func (i *Int) mulMod(a, b *big.Int) {
+	t := products.Get().(*product)
+	t.x.Mul(a, b)
+	r := reducerOf(i.M)
+	if t.x.Sign() < 0 || uint(t.x.BitLen()) > 2*r.k {
+		t.q.QuoRem(&t.x, i.M, &i.V)
+		if i.V.Sign() < 0 {
+			i.V.Add(&i.V, i.M)
+		}
+	} else {
+		// q = floor(floor(x / 2^(k-1)) * mu / 2^(k+1)) falls short of
+		// floor(x / M) by at most 2.
+		t.q.Rsh(&t.x, r.k-1)
+		t.y.Mul(&t.q, &r.mu)
+		t.q.Rsh(&t.y, r.k+1)
+		t.y.Mul(&t.q, i.M)
+		i.V.Sub(&t.x, &t.y)
+		for i.V.Cmp(i.M) >= 0 {
+			i.V.Sub(&i.V, i.M)
+		}
+	}
+	products.Put(t)
+}
+
+// reducer holds the constant mu = floor(4^k / m) of the Barrett reduction
+// modulo m, for k the bit length of m.
+type reducer struct {
+	m  big.Int
+	k  uint
+	mu big.Int
+}
+
+// reducers caches the reducers of recently used moduli in a table indexed
+// by a hash of their value, rather than of their address, so that the many
+// copies of a modulus, such as those of each instance of a curve, share an
+// entry. A modulus whose slot holds another one gets a new reducer, which
+// replaces it.
+var reducers [64]atomic.Pointer[reducer]
+
+func reducerOf(m *big.Int) *reducer {
+	h := uint64(14695981039346656037)
+	for _, w := range m.Bits() {
+		h = (h ^ uint64(w)) * 1099511628211
+	}
+	slot := &reducers[h>>58]
+	if r := slot.Load(); r != nil && r.m.Cmp(m) == 0 {
+		return r
+	}
+	r := &reducer{k: uint(m.BitLen())}
+	r.m.Set(m)
+	r.mu.Lsh(one, 2*r.k)
+	r.mu.Quo(&r.mu, m)
+	slot.Store(r)
+	return r
+}
+
 // Neg sets the target to -a mod M.
 func (i *Int) Neg(a kyber.Scalar) kyber.Scalar {
 	ai := a.(*Int)
@@ -240,7 +366,7 @@ func (i *Int) Mul(a, b kyber.Scalar) kyber.Scalar {
 	ai := a.(*Int)
 	bi := b.(*Int)
 	i.M = ai.M
-	i.V.Mul(&ai.V, &bi.V).Mod(&i.V, i.M)
+	i.mulMod(&ai.V, &bi.V)
 	return i
 }
 
@@ -250,8 +376,7 @@ func (i *Int) Div(a, b kyber.Scalar) kyber.Scalar {
 	bi := b.(*Int)
 	var t big.Int
 	i.M = ai.M
-	i.V.Mul(&ai.V, t.ModInverse(&bi.V, i.M))
-	i.V.Mod(&i.V, i.M)
+	i.mulMod(&ai.V, t.ModInverse(&bi.V, i.M))
 	return i
 }
 
@@ -385,6 +510,12 @@ func (i *Int) SetBytes(a []byte) kyber.Scalar {
 	return i
 }
 
+// SetBytesWide sets the value to the 64-byte integer b modulo M,
+// in the endianess set in i.
+func (i *Int) SetBytesWide(b [64]byte) kyber.Scalar {
+	return i.SetBytes(b[:])
+}
+
 // LittleEndian encodes the value of this Int into a little-endian byte-slice
 // at least min bytes but no more than max bytes long.
 // Panics if max != 0 and the Int cannot be represented in max bytes.
@@ -6,6 +6,9 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/race"
+	"github.com/dedis/kyber/util/random"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -88,3 +91,116 @@ func TestIntClone(t *testing.T) {
 		t.Error("Should not be equal")
 	}
 }
+
+func TestIntSetBytesWide(t *testing.T) {
+	m := new(big.Int).Lsh(big.NewInt(1), 255)
+	m.Sub(m, big.NewInt(19))
+	var b [64]byte
+	for i := range b {
+		b[i] = byte(i)
+	}
+	want := new(big.Int).SetBytes(b[:])
+	want.Mod(want, m)
+
+	i := NewInt64(0, m)
+	i.SetBytesWide(b)
+	assert.Equal(t, 0, i.V.Cmp(want))
+
+	i.BO = LittleEndian
+	i.SetBytesWide(b)
+	want.SetBytes(reverse(nil, b[:])).Mod(want, m)
+	assert.Equal(t, 0, i.V.Cmp(want))
+}
+
+// narrowScalar hides the SetBytesWide of its Scalar.
+type narrowScalar struct {
+	kyber.Scalar
+}
+
+func TestSetBytesWideFallback(t *testing.T) {
+	m := new(big.Int).Lsh(big.NewInt(1), 255)
+	m.Sub(m, big.NewInt(19))
+	var b [64]byte
+	for i := range b {
+		b[i] = byte(255 - i)
+	}
+	want := NewInt64(0, m).SetBytesWide(b)
+
+	_, ok := kyber.Scalar(narrowScalar{NewInt64(0, m)}).(kyber.WideScalar)
+	assert.False(t, ok)
+	s := narrowScalar{NewInt64(0, m)}
+	kyber.SetBytesWide(s, b)
+	assert.True(t, s.Scalar.Equal(want))
+	assert.True(t, kyber.SetBytesWide(NewInt64(0, m), b).Equal(want))
+}
+
+func TestConstantTimeEqual(t *testing.T) {
+	a := big.NewInt(0x1234)
+	b := new(big.Int).SetBytes([]byte{0x12, 0x34})
+	assert.True(t, ConstantTimeEqual(a, b))
+	assert.False(t, ConstantTimeEqual(a, big.NewInt(0x34)))
+	assert.False(t, ConstantTimeEqual(a, new(big.Int).Neg(a)))
+	assert.True(t, ConstantTimeEqual(new(big.Int), big.NewInt(0)))
+
+	m := big.NewInt(101)
+	assert.True(t, NewInt64(7, m).Equal(NewInt64(108, m)))
+	assert.False(t, NewInt64(7, m).Equal(NewInt64(8, m)))
+}
+
+func TestIntDestroy(t *testing.T) {
+	m := new(big.Int).Lsh(big.NewInt(1), 255)
+	i := NewInt(new(big.Int).Sub(m, big.NewInt(1)), m)
+	words := i.V.Bits()
+	i.Destroy()
+	assert.Equal(t, 0, i.V.Sign())
+	for _, w := range words {
+		assert.Zero(t, w)
+	}
+}
+
+func TestIntMul(t *testing.T) {
+	rand := random.New()
+	for _, bits := range []int{2, 17, 64, 65, 255, 256, 521, 2048} {
+		m := new(big.Int).SetBit(random.Int(new(big.Int).Lsh(one, uint(bits-1)), rand), bits-1, 1)
+		for _, m := range []*big.Int{m, new(big.Int).Lsh(one, uint(bits-1))} {
+			max := new(big.Int).Sub(m, one)
+			for _, v := range [][2]*big.Int{
+				{max, max},
+				{max, one},
+				{random.Int(m, rand), random.Int(m, rand)},
+			} {
+				want := new(big.Int).Mul(v[0], v[1])
+				want.Mod(want, m)
+				i := NewInt(v[0], m)
+				i.Mul(i, NewInt(v[1], m))
+				assert.Equal(t, 0, i.V.Cmp(want), "%v * %v mod %v", v[0], v[1], m)
+			}
+		}
+	}
+
+	// Values out of range are reduced too.
+	m := big.NewInt(101)
+	i := &Int{M: m}
+	i.V.SetInt64(-1000)
+	i.Mul(i, NewInt64(3, m))
+	assert.Equal(t, int64(30), i.Int64())
+}
+
+func TestIntAllocs(t *testing.T) {
+	if race.Enabled {
+		t.Skip("sync.Pool drops values under the race detector")
+	}
+	m := new(big.Int).Lsh(one, 255)
+	m.Sub(m, big.NewInt(19))
+	rand := random.New()
+	a := NewInt(random.Int(m, rand), m)
+	b := NewInt(random.Int(m, rand), m)
+	a.Mul(a, b)
+	allocs := testing.AllocsPerRun(100, func() {
+		a.Add(a, b)
+		a.Mul(a, b)
+		a.Sub(a, b)
+		a.Neg(a)
+	})
+	assert.Zero(t, allocs)
+}
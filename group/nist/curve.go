@@ -10,14 +10,16 @@ import (
 	"math/big"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/blind"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
 )
 
 type curvePoint struct {
-	x, y *big.Int
-	c    *curve
+	x, y  *big.Int
+	c     *curve
+	blind cipher.Stream // randomness of blinded Mul, if set
 }
 
 func (p *curvePoint) String() string {
@@ -35,7 +37,9 @@ func (p *curvePoint) Equal(p2 kyber.Point) bool {
 	cp2.x.Mod(cp2.x, M)
 	cp2.y.Mod(cp2.y, M)
 
-	return p.x.Cmp(cp2.x) == 0 && p.y.Cmp(cp2.y) == 0
+	xeq := mod.ConstantTimeEqual(p.x, cp2.x)
+	yeq := mod.ConstantTimeEqual(p.y, cp2.y)
+	return xeq && yeq
 }
 
 func (p *curvePoint) Null() kyber.Point {
@@ -160,7 +164,16 @@ func (p *curvePoint) Neg(a kyber.Point) kyber.Point {
 	return p.Mul(s, a).(*curvePoint)
 }
 
+// SetBlinding enables the blinding of Mul on p with the randomness of
+// rand, or disables it if rand is nil, as in kyber.BlindablePoint.
+func (p *curvePoint) SetBlinding(rand cipher.Stream) {
+	p.blind = rand
+}
+
 func (p *curvePoint) Mul(s kyber.Scalar, b kyber.Point) kyber.Point {
+	if p.blind != nil {
+		return p.Set(blind.Mul(p.c, s, b, p.blind))
+	}
 	cs := s.(*mod.Int)
 	if b != nil {
 		cb := b.(*curvePoint)
@@ -180,7 +193,14 @@ func (p *curvePoint) MarshalBinary() ([]byte, error) {
 	return elliptic.Marshal(p.c, p.x, p.y), nil
 }
 
+// UnmarshalBinary decodes a point from its uncompressed representation,
+// or the identity from 0x04 followed by zeros. Unless
+// kyber.AllowNonCanonical is set, it rejects the identity with any other
+// first byte or length.
 func (p *curvePoint) UnmarshalBinary(buf []byte) error {
+	if len(buf) == 0 {
+		return errors.New("invalid elliptic curve point")
+	}
 	// Check whether all bytes after first one are 0, so we
 	// just return the initial point. Read everything to
 	// prevent timing-leakage.
@@ -188,6 +208,10 @@ func (p *curvePoint) UnmarshalBinary(buf []byte) error {
 	for _, b := range buf[1:] {
 		c |= b
 	}
+	if c == 0 && !kyber.NonCanonicalAllowed() &&
+		(len(buf) != p.MarshalSize() || buf[0] != 4) {
+		return errors.New("non-canonical elliptic curve point")
+	}
 	if c != 0 {
 		p.x, p.y = elliptic.Unmarshal(p.c, buf)
 		if p.x == nil || !p.Valid() {
@@ -219,7 +243,8 @@ type curveOps interface {
 type curve struct {
 	elliptic.Curve
 	curveOps
-	p *elliptic.CurveParams
+	p   *elliptic.CurveParams
+	h2c sswu
 }
 
 // Return the number of bytes in the encoding of a Scalar for this curve.
@@ -2,4 +2,9 @@
 // based on the NIST standards, using Go's built-in crypto library.
 // Since that package does not implement constant time arithmetic operations
 // yet, it must be compiled with the "vartime" compilation flag.
+//
+// The arithmetic of the curves goes through crypto/elliptic, which returns
+// new coordinates for every addition and multiplication, so that it
+// allocates in every operation, unlike their scalars, whose Add, Sub and
+// Mul reuse the memory of their receiver.
 package nist
@@ -3,8 +3,11 @@
 package nist
 
 import (
+	"bytes"
+	"crypto/cipher"
 	"testing"
 
+	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/util/test"
 )
 
@@ -16,6 +19,16 @@ var testP256 = NewBlakeSHA256P256()
 
 func TestP256(t *testing.T) { test.SuiteTest(testP256) }
 
+var testP256Shake = NewShakeSHA256P256()
+
+func TestP256Shake(t *testing.T) { test.SuiteTest(testP256Shake) }
+
+func TestP256AES(t *testing.T) { test.SuiteTest(NewAESSHA256P256()) }
+
+func TestP384(t *testing.T) { test.SuiteTest(NewBlakeSHA384P384()) }
+
+func TestP521(t *testing.T) { test.SuiteTest(NewBlakeSHA512P521()) }
+
 func TestSetBytesBE(t *testing.T) {
 	s := testP256.Scalar()
 	s.SetBytes([]byte{0, 1, 2, 3})
@@ -26,6 +39,58 @@ func TestSetBytesBE(t *testing.T) {
 	}
 }
 
+func TestNonCanonical(t *testing.T) {
+	P := testP256.Point()
+	if err := P.UnmarshalBinary(nil); err == nil {
+		t.Fatal("empty point accepted")
+	}
+	for _, b := range [][]byte{make([]byte, 65), {4, 0}} {
+		if err := P.UnmarshalBinary(b); err == nil {
+			t.Fatal("non-canonical identity accepted")
+		}
+		kyber.AllowNonCanonical(true)
+		err := P.UnmarshalBinary(b)
+		kyber.AllowNonCanonical(false)
+		if err != nil || !P.Equal(testP256.Point().Null()) {
+			t.Fatal("legacy mode rejected the identity:", err)
+		}
+	}
+	b, _ := testP256.Point().Null().MarshalBinary()
+	if err := P.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+
+	Q := testQR512.Point().Pick(testQR512.RandomStream())
+	b, _ = Q.MarshalBinary()
+	if err := Q.UnmarshalBinary(append([]byte{0}, b...)); err == nil {
+		t.Fatal("non-canonical residue accepted")
+	}
+}
+
+// Points and scalars written by the suites must be read back as they were.
+func TestReadWrite(t *testing.T) {
+	suites := []interface {
+		kyber.Group
+		kyber.Encoding
+		RandomStream() cipher.Stream
+	}{NewBlakeSHA384P384(), NewBlakeSHA512P521()}
+	for _, suite := range suites {
+		x := suite.Scalar().Pick(suite.RandomStream())
+		X := suite.Point().Mul(x, nil)
+		var buf bytes.Buffer
+		if err := suite.Write(&buf, x, X); err != nil {
+			t.Fatal(err)
+		}
+		y, Y := suite.Scalar(), suite.Point()
+		if err := suite.Read(&buf, y, Y); err != nil {
+			t.Fatal(suite, err)
+		}
+		if !x.Equal(y) || !X.Equal(Y) {
+			t.Fatal(suite, "read back other values")
+		}
+	}
+}
+
 var benchP256 = test.NewGroupBench(testP256)
 
 func BenchmarkScalarAdd(b *testing.B)    { benchP256.ScalarAdd(b.N) }
@@ -0,0 +1,109 @@
+// +build vartime
+
+package nist
+
+import (
+	"hash"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/hash2curve"
+)
+
+// sswu holds the parameters of the suites of RFC 9380, Section 8.2, that
+// hash to a NIST curve with the simplified Shallue-van de Woestijne-Ulas
+// map (curve_XMD:hash_SSWU_RO_ and curve_XMD:hash_SSWU_NU_).
+type sswu struct {
+	z    *big.Int         // non-square Z of the map
+	hash func() hash.Hash // hash function of expand_message_xmd
+	l    int              // bytes per field element in hash_to_field
+}
+
+// HashToPoint sets p to the hash of msg in the domain dst, with the
+// hash_to_curve suite of RFC 9380 for the curve, such as
+// P256_XMD:SHA-256_SSWU_RO_.
+func (p *curvePoint) HashToPoint(msg, dst []byte) kyber.Point {
+	return p.hash(msg, dst, 2)
+}
+
+// EncodeToPoint sets p to the encoding of msg in the domain dst, with the
+// encode_to_curve suite of RFC 9380 for the curve, such as
+// P256_XMD:SHA-256_SSWU_NU_.
+func (p *curvePoint) EncodeToPoint(msg, dst []byte) kyber.Point {
+	return p.hash(msg, dst, 1)
+}
+
+// hash sets p to the sum of the maps of count field elements hashed from
+// msg. NIST curves have cofactor 1, so there is no cofactor to clear.
+func (p *curvePoint) hash(msg, dst []byte, count int) kyber.Point {
+	h := p.c.h2c
+	u, err := hash2curve.HashToField(hash2curve.ExpanderXMD(h.hash), msg, dst, p.c.p.P, 1, h.l, count)
+	if err != nil {
+		// The output lengths of the suites are well within the limits
+		// of expand_message_xmd.
+		panic(err)
+	}
+	p.x, p.y = p.c.mapToCurve(u[0])
+	for _, ui := range u[1:] {
+		x, y := p.c.mapToCurve(ui)
+		p.x, p.y = p.c.Add(p.x, p.y, x, y)
+	}
+	return p
+}
+
+// mapToCurve maps the field element u to a point of the curve with the
+// simplified SWU map of RFC 9380, Section 6.6.2, for a = -3.
+func (c *curve) mapToCurve(u *big.Int) (*big.Int, *big.Int) {
+	P := c.p.P
+	A := big.NewInt(-3)
+	B := c.p.B
+	Z := c.h2c.z
+
+	// tv1 = 1 / (Z^2 * u^4 + Z * u^2)
+	zu2 := new(big.Int).Mul(u, u)
+	zu2.Mul(zu2, Z)
+	zu2.Mod(zu2, P)
+	tv1 := new(big.Int).Mul(zu2, zu2)
+	tv1.Add(tv1, zu2)
+	tv1.Mod(tv1, P)
+
+	// x1 = (-B / A) * (1 + tv1), or B / (Z * A) if tv1 = 0
+	x1 := new(big.Int)
+	if tv1.Sign() == 0 {
+		x1.Mul(Z, A)
+		x1.ModInverse(x1.Mod(x1, P), P)
+		x1.Mul(x1, B)
+	} else {
+		tv1.ModInverse(tv1, P)
+		tv1.Add(tv1, big.NewInt(1))
+		x1.ModInverse(new(big.Int).Mod(A, P), P)
+		x1.Mul(x1, B)
+		x1.Neg(x1)
+		x1.Mul(x1, tv1)
+	}
+	x1.Mod(x1, P)
+
+	// x = x1 if g(x1) is square, x2 = Z * u^2 * x1 otherwise
+	x := x1
+	gx := c.g(x)
+	if big.Jacobi(gx, P) < 0 {
+		x = new(big.Int).Mul(zu2, x1)
+		x.Mod(x, P)
+		gx = c.g(x)
+	}
+	y := c.sqrt(gx)
+	y.Mod(y, P)
+	if u.Bit(0) != y.Bit(0) {
+		y.Sub(P, y).Mod(y, P)
+	}
+	return x, y
+}
+
+// g returns x^3 - 3x + B.
+func (c *curve) g(x *big.Int) *big.Int {
+	gx := new(big.Int).Mul(x, x)
+	gx.Sub(gx, big.NewInt(3))
+	gx.Mul(gx, x)
+	gx.Add(gx, c.p.B)
+	return gx.Mod(gx, c.p.P)
+}
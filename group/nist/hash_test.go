@@ -0,0 +1,72 @@
+// +build vartime
+
+package nist
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/stretchr/testify/require"
+)
+
+type h2cVector struct {
+	msg  string
+	x, y string
+}
+
+func testHashToPoint(t *testing.T, g kyber.Group, dst string, encode bool, vectors []h2cVector) {
+	for _, v := range vectors {
+		p := g.Point().(kyber.HashablePoint)
+		var q kyber.Point
+		if encode {
+			q = p.EncodeToPoint([]byte(v.msg), []byte(dst))
+		} else {
+			q = p.HashToPoint([]byte(v.msg), []byte(dst))
+		}
+		cp := q.(*curvePoint)
+		require.True(t, cp.Valid())
+		x, _ := new(big.Int).SetString(v.x, 16)
+		y, _ := new(big.Int).SetString(v.y, 16)
+		require.Equal(t, 0, x.Cmp(cp.x), "msg %q", v.msg)
+		require.Equal(t, 0, y.Cmp(cp.y), "msg %q", v.msg)
+	}
+}
+
+// Test vectors of Appendix J.1 of RFC 9380.
+func TestHashToPointP256(t *testing.T) {
+	testHashToPoint(t, testP256, "QUUX-V01-CS02-with-P256_XMD:SHA-256_SSWU_RO_", false, []h2cVector{
+		{"", "2c15230b26dbc6fc9a37051158c95b79656e17a1a920b11394ca91c44247d3e4", "8a7a74985cc5c776cdfe4b1f19884970453912e9d31528c060be9ab5c43e8415"},
+		{"abc", "0bb8b87485551aa43ed54f009230450b492fead5f1cc91658775dac4a3388a0f", "5c41b3d0731a27a7b14bc0bf0ccded2d8751f83493404c84a88e71ffd424212e"},
+	})
+	testHashToPoint(t, testP256, "QUUX-V01-CS02-with-P256_XMD:SHA-256_SSWU_NU_", true, []h2cVector{
+		{"", "f871caad25ea3b59c16cf87c1894902f7e7b2c822c3d3f73596c5ace8ddd14d1", "87b9ae23335bee057b99bac1e68588b18b5691af476234b8971bc4f011ddc99b"},
+		{"abc", "fc3f5d734e8dce41ddac49f47dd2b8a57257522a865c124ed02b92b5237befa4", "fe4d197ecf5a62645b9690599e1d80e82c500b22ac705a0b421fac7b47157866"},
+	})
+}
+
+// Test vectors of Appendix J.2 of RFC 9380.
+func TestHashToPointP384(t *testing.T) {
+	g := NewBlakeSHA384P384()
+	testHashToPoint(t, g, "QUUX-V01-CS02-with-P384_XMD:SHA-384_SSWU_RO_", false, []h2cVector{
+		{"", "eb9fe1b4f4e14e7140803c1d99d0a93cd823d2b024040f9c067a8eca1f5a2eeac9ad604973527a356f3fa3aeff0e4d83", "0c21708cff382b7f4643c07b105c2eaec2cead93a917d825601e63c8f21f6abd9abc22c93c2bed6f235954b25048bb1a"},
+		{"abc", "e02fc1a5f44a7519419dd314e29863f30df55a514da2d655775a81d413003c4d4e7fd59af0826dfaad4200ac6f60abe1", "01f638d04d98677d65bef99aef1a12a70a4cbb9270ec55248c04530d8bc1f8f90f8a6a859a7c1f1ddccedf8f96d675f6"},
+	})
+	testHashToPoint(t, g, "QUUX-V01-CS02-with-P384_XMD:SHA-384_SSWU_NU_", true, []h2cVector{
+		{"", "de5a893c83061b2d7ce6a0d8b049f0326f2ada4b966dc7e72927256b033ef61058029a3bfb13c1c7ececd6641881ae20", "63f46da6139785674da315c1947e06e9a0867f5608cf24724eb3793a1f5b3809ee28eb21a0c64be3be169afc6cdb38ca"},
+		{"abc", "1f08108b87e703c86c872ab3eb198a19f2b708237ac4be53d7929fb4bd5194583f40d052f32df66afe5249c9915d139b", "1369dc8d5bf038032336b989994874a2270adadb67a7fcc32f0f8824bc5118613f0ac8de04a1041d90ff8a5ad555f96c"},
+	})
+}
+
+// Test vectors of Appendix J.3 of RFC 9380.
+func TestHashToPointP521(t *testing.T) {
+	g := NewBlakeSHA512P521()
+	testHashToPoint(t, g, "QUUX-V01-CS02-with-P521_XMD:SHA-512_SSWU_RO_", false, []h2cVector{
+		{"", "00fd767cebb2452030358d0e9cf907f525f50920c8f607889a6a35680727f64f4d66b161fafeb2654bea0d35086bec0a10b30b14adef3556ed9f7f1bc23cecc9c088", "0169ba78d8d851e930680322596e39c78f4fe31b97e57629ef6460ddd68f8763fd7bd767a4e94a80d3d21a3c2ee98347e024fc73ee1c27166dc3fe5eeef782be411d"},
+		{"abc", "002f89a1677b28054b50d15e1f81ed6669b5a2158211118ebdef8a6efc77f8ccaa528f698214e4340155abc1fa08f8f613ef14a043717503d57e267d57155cf784a4", "010e0be5dc8e753da8ce51091908b72396d3deed14ae166f66d8ebf0a4e7059ead169ea4bead0232e9b700dd380b316e9361cfdba55a08c73545563a80966ecbb86d"},
+	})
+	testHashToPoint(t, g, "QUUX-V01-CS02-with-P521_XMD:SHA-512_SSWU_NU_", true, []h2cVector{
+		{"", "01ec604b4e1e3e4c7449b7a41e366e876655538acf51fd40d08b97be066f7d020634e906b1b6942f9174b417027c953d75fb6ec64b8cee2a3672d4f1987d13974705", "00944fc439b4aad2463e5c9cfa0b0707af3c9a42e37c5a57bb4ecd12fef9fb21508568aedcdd8d2490472df4bbafd79081c81e99f4da3286eddf19be47e9c4cf0e91"},
+		{"abc", "00c720ab56aa5a7a4c07a7732a0a4e1b909e32d063ae1b58db5f0eb5e09f08a9884bff55a2bef4668f715788e692c18c1915cd034a6b998311fcf46924ce66a2be9a", "003570e87f91a4f3c7a56be2cb2a078ffc153862a53d5e03e5dad5bccc6c529b8bab0b7dbb157499e1949e4edab21cf5d10b782bc1e945e13d7421ad8121dbc72b1d"},
+	})
+}
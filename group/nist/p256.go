@@ -4,6 +4,7 @@ package nist
 
 import (
 	"crypto/elliptic"
+	"crypto/sha256"
 	"math/big"
 )
 
@@ -74,5 +75,6 @@ func (c *p256) Init() curve {
 	c.curve.Curve = elliptic.P256()
 	c.p = c.Params()
 	c.curveOps = c
+	c.h2c = sswu{big.NewInt(-10), sha256.New, 48}
 	return c.curve
 }
@@ -0,0 +1,37 @@
+// +build vartime
+
+package nist
+
+import (
+	"crypto/elliptic"
+	"crypto/sha512"
+	"math/big"
+)
+
+// p521 implements the kyber.Group interface
+// for the NIST P-521 elliptic curve,
+// based on Go's native elliptic curve library.
+type p521 struct {
+	curve
+}
+
+func (curve *p521) String() string {
+	return "P521"
+}
+
+// Modular square root for P-521 curve, whose prime is 3 mod 4:
+// sqrt(c) = c^((p+1)/4) mod p
+func (curve *p521) sqrt(c *big.Int) *big.Int {
+	e := new(big.Int).Add(curve.p.P, big.NewInt(1))
+	e.Rsh(e, 2)
+	return new(big.Int).Exp(c, e, curve.p.P)
+}
+
+// Initialize standard Curve instances
+func (c *p521) Init() curve {
+	c.curve.Curve = elliptic.P521()
+	c.p = c.Params()
+	c.curveOps = c
+	c.h2c = sswu{big.NewInt(-4), sha512.New, 98}
+	return c.curve
+}
@@ -13,7 +13,8 @@ import (
 	"github.com/dedis/fixbuf"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/internal/fips"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
 	"github.com/dedis/kyber/xof/blake"
 )
@@ -63,3 +64,12 @@ func NewBlakeSHA256QR512() *QrSuite {
 	suite.SetParams(p, q, r, g)
 	return suite
 }
+
+// NewBlakeSHA256QR512Checked is NewBlakeSHA256QR512, but fails with an error
+// wrapping suites.ErrNotFIPSApproved in FIPS mode.
+func NewBlakeSHA256QR512Checked() (*QrSuite, error) {
+	if err := fips.Check("QR512"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA256QR512(), nil
+}
@@ -12,8 +12,8 @@ import (
 	//"encoding/hex"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
 	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -36,7 +36,7 @@ func isPrime(i *big.Int) bool {
 func (p *residuePoint) String() string { return p.Int.String() }
 
 func (p *residuePoint) Equal(p2 kyber.Point) bool {
-	return p.Int.Cmp(&p2.(*residuePoint).Int) == 0
+	return mod.ConstantTimeEqual(&p.Int, &p2.(*residuePoint).Int)
 }
 
 func (p *residuePoint) Null() kyber.Point {
@@ -153,7 +153,13 @@ func (p *residuePoint) MarshalBinary() ([]byte, error) {
 	return b, nil
 }
 
+// UnmarshalBinary decodes an element of the group. Unless
+// kyber.AllowNonCanonical is set, it rejects encodings whose length is not
+// MarshalSize.
 func (p *residuePoint) UnmarshalBinary(data []byte) error {
+	if len(data) != p.MarshalSize() && !kyber.NonCanonicalAllowed() {
+		return errors.New("non-canonical Residue group element")
+	}
 	p.Int.SetBytes(data)
 	if !p.Valid() {
 		return errors.New("invalid Residue group element")
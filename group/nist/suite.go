@@ -5,20 +5,34 @@ package nist
 import (
 	"crypto/cipher"
 	"crypto/sha256"
+	"crypto/sha512"
 	"hash"
 	"io"
 	"reflect"
+	"sync"
 
 	"github.com/dedis/fixbuf"
 
 	"github.com/dedis/kyber"
-	"github.com/dedis/kyber/group/internal/marshalling"
+	"github.com/dedis/kyber/internal/fips"
+	"github.com/dedis/kyber/internal/marshalling"
 	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/aes"
 	"github.com/dedis/kyber/xof/blake"
+	"github.com/dedis/kyber/xof/keccak"
 )
 
 type Suite128 struct {
 	p256
+	pool     *kyber.Pool
+	poolOnce sync.Once
+}
+
+// Pool returns the pool of temporary Points and Scalars shared by the users
+// of the suite, as in kyber.Pooling.
+func (s *Suite128) Pool() *kyber.Pool {
+	s.poolOnce.Do(func() { s.pool = kyber.NewPool(s) })
+	return s.pool
 }
 
 // SHA256 hash function
@@ -58,3 +72,185 @@ func NewBlakeSHA256P256() *Suite128 {
 	suite.p256.Init()
 	return suite
 }
+
+// NewBlakeSHA256P256Checked is NewBlakeSHA256P256, except that it returns an
+// error wrapping suites.ErrNotFIPSApproved once FIPS mode is required: the
+// curve is approved, but not the Blake2b XOF of the suite.
+func NewBlakeSHA256P256Checked() (*Suite128, error) {
+	if err := fips.Check("P256"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA256P256(), nil
+}
+
+// SuiteShake128 is a P-256 suite that only uses FIPS-approved algorithms:
+// SHA-256 for hashing and SHAKE256 as XOF.
+type SuiteShake128 struct {
+	Suite128
+}
+
+// XOF returns an XOF which is implemented via SHAKE256.
+func (s *SuiteShake128) XOF(key []byte) kyber.XOF {
+	return keccak.New(key)
+}
+
+// String returns the name of the suite, "P256-SHAKE256".
+func (s *SuiteShake128) String() string {
+	return "P256-SHAKE256"
+}
+
+// NewShakeSHA256P256 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/keccak (SHAKE256), SHA-256, and the NIST P-256
+// elliptic curve. It returns random streams from Go's crypto/rand.
+//
+// Unlike NewBlakeSHA256P256, all of its algorithms are FIPS approved.
+func NewShakeSHA256P256() *SuiteShake128 {
+	suite := new(SuiteShake128)
+	suite.p256.Init()
+	return suite
+}
+
+// SuiteAES128 is a P-256 suite whose XOF is composed of AES-CTR and
+// HMAC-SHA-256, for deployments that cannot use SHAKE.
+type SuiteAES128 struct {
+	Suite128
+}
+
+// XOF returns an XOF which is implemented via AES-CTR and HMAC-SHA-256.
+func (s *SuiteAES128) XOF(key []byte) kyber.XOF {
+	return aes.New(key)
+}
+
+// String returns the name of the suite, "P256-AES".
+func (s *SuiteAES128) String() string {
+	return "P256-AES"
+}
+
+// NewAESSHA256P256 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/aes, SHA-256, and the NIST P-256 elliptic
+// curve. It returns random streams from Go's crypto/rand.
+//
+// Like NewShakeSHA256P256, all of its algorithms are FIPS approved.
+func NewAESSHA256P256() *SuiteAES128 {
+	suite := new(SuiteAES128)
+	suite.p256.Init()
+	return suite
+}
+
+// Suite192 is a cipher suite for the NIST P-384 elliptic curve, with
+// SHA-384 for hashing.
+type Suite192 struct {
+	p384
+	pool     *kyber.Pool
+	poolOnce sync.Once
+}
+
+// Pool returns the pool of temporary Points and Scalars shared by the users
+// of the suite, as in kyber.Pooling.
+func (s *Suite192) Pool() *kyber.Pool {
+	s.poolOnce.Do(func() { s.pool = kyber.NewPool(s) })
+	return s.pool
+}
+
+// Hash returns a SHA-384 hash function.
+func (s *Suite192) Hash() hash.Hash {
+	return sha512.New384()
+}
+
+func (s *Suite192) XOF(key []byte) kyber.XOF {
+	return blake.New(key)
+}
+
+func (s *Suite192) RandomStream() cipher.Stream {
+	return random.New()
+}
+
+func (s *Suite192) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *Suite192) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+func (s *Suite192) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// NewBlakeSHA384P384 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake, SHA-384, and the NIST P-384
+// elliptic curve. It returns random streams from Go's crypto/rand.
+// Like those of NewBlakeSHA256P256, its scalars are big-endian integers.
+func NewBlakeSHA384P384() *Suite192 {
+	suite := new(Suite192)
+	suite.p384.Init()
+	return suite
+}
+
+// NewBlakeSHA384P384Checked is NewBlakeSHA384P384 with the FIPS check of
+// NewBlakeSHA256P256Checked.
+func NewBlakeSHA384P384Checked() (*Suite192, error) {
+	if err := fips.Check("P384"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA384P384(), nil
+}
+
+// Suite256 is a cipher suite for the NIST P-521 elliptic curve, with
+// SHA-512 for hashing.
+type Suite256 struct {
+	p521
+	pool     *kyber.Pool
+	poolOnce sync.Once
+}
+
+// Pool returns the pool of temporary Points and Scalars shared by the users
+// of the suite, as in kyber.Pooling.
+func (s *Suite256) Pool() *kyber.Pool {
+	s.poolOnce.Do(func() { s.pool = kyber.NewPool(s) })
+	return s.pool
+}
+
+// Hash returns a SHA-512 hash function.
+func (s *Suite256) Hash() hash.Hash {
+	return sha512.New()
+}
+
+func (s *Suite256) XOF(key []byte) kyber.XOF {
+	return blake.New(key)
+}
+
+func (s *Suite256) RandomStream() cipher.Stream {
+	return random.New()
+}
+
+func (s *Suite256) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *Suite256) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+func (s *Suite256) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// NewBlakeSHA512P521 returns a cipher suite based on package
+// github.com/dedis/kyber/xof/blake, SHA-512, and the NIST P-521
+// elliptic curve. It returns random streams from Go's crypto/rand.
+// Like those of NewBlakeSHA256P256, its scalars are big-endian integers.
+func NewBlakeSHA512P521() *Suite256 {
+	suite := new(Suite256)
+	suite.p521.Init()
+	return suite
+}
+
+// NewBlakeSHA512P521Checked is NewBlakeSHA512P521 with the FIPS check of
+// NewBlakeSHA256P256Checked.
+func NewBlakeSHA512P521Checked() (*Suite256, error) {
+	if err := fips.Check("P521"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA512P521(), nil
+}
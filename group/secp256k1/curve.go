@@ -0,0 +1,190 @@
+// +build vartime
+
+package secp256k1
+
+import (
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+)
+
+// Domain parameters of secp256k1, from SEC 2, Section 2.4.1.
+var (
+	fieldP = fromHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	orderN = fromHex("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+	baseX  = fromHex("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+	baseY  = fromHex("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	curveB = big.NewInt(7)
+
+	// sqrtExp is (p+1)/4, the exponent computing square roots modulo p.
+	sqrtExp = new(big.Int).Rsh(new(big.Int).Add(fieldP, big.NewInt(1)), 2)
+)
+
+func fromHex(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("invalid hex constant " + s)
+	}
+	return v
+}
+
+// Curve implements the kyber.Group interface for the secp256k1 curve.
+// There are no parameters and no initialization is required.
+type Curve struct {
+}
+
+// Return the name of the curve, "secp256k1".
+func (c *Curve) String() string {
+	return "secp256k1"
+}
+
+// ScalarLen returns 32, the size in bytes of an encoded Scalar.
+func (c *Curve) ScalarLen() int {
+	return 32
+}
+
+// Scalar creates a new Scalar modulo the order of the curve. The scalars
+// created by this package implement kyber.Scalar's SetBytes method,
+// interpreting the bytes as a big-endian integer, so as to be compatible
+// with Bitcoin and Ethereum private keys.
+func (c *Curve) Scalar() kyber.Scalar {
+	return mod.NewInt64(0, orderN)
+}
+
+// PointLen returns 33, the size in bytes of a compressed point.
+func (c *Curve) PointLen() int {
+	return 1 + coordLen
+}
+
+// Point creates a new Point on the curve, initialized to the identity.
+func (c *Curve) Point() kyber.Point {
+	P := new(point)
+	P.setInfinity()
+	return P
+}
+
+// Order returns the prime order of the curve.
+func (c *Curve) Order() *big.Int {
+	return orderN
+}
+
+// jacobian represents the point (X/Z^2, Y/Z^3) in Jacobian coordinates.
+// The point at infinity has Z = 0. Values are never modified in place,
+// so that points may safely share them.
+type jacobian struct {
+	x, y, z *big.Int
+}
+
+var zero, one = big.NewInt(0), big.NewInt(1)
+
+func fMul(a, b *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, b)
+	return r.Mod(r, fieldP)
+}
+
+func fSqr(a *big.Int) *big.Int {
+	return fMul(a, a)
+}
+
+func fAdd(a, b *big.Int) *big.Int {
+	r := new(big.Int).Add(a, b)
+	return r.Mod(r, fieldP)
+}
+
+func fSub(a, b *big.Int) *big.Int {
+	r := new(big.Int).Sub(a, b)
+	return r.Mod(r, fieldP)
+}
+
+// fSqrt returns a square root of a modulo p, or nil if a is not a square.
+// Since p = 3 mod 4, a candidate root is a^((p+1)/4).
+func fSqrt(a *big.Int) *big.Int {
+	r := new(big.Int).Exp(a, sqrtExp, fieldP)
+	if fSqr(r).Cmp(new(big.Int).Mod(a, fieldP)) != 0 {
+		return nil
+	}
+	return r
+}
+
+// rhs returns x^3 + 7, the right-hand side of the curve equation.
+func rhs(x *big.Int) *big.Int {
+	return fAdd(fMul(fSqr(x), x), curveB)
+}
+
+func (p *jacobian) isInfinity() bool {
+	return p.z.Sign() == 0
+}
+
+func (p *jacobian) neg() jacobian {
+	return jacobian{p.x, fSub(zero, p.y), p.z}
+}
+
+// affine returns the affine coordinates of a point other than infinity.
+func (p *jacobian) affine() (x, y *big.Int) {
+	zInv := new(big.Int).ModInverse(p.z, fieldP)
+	zInv2 := fSqr(zInv)
+	return fMul(p.x, zInv2), fMul(p.y, fMul(zInv2, zInv))
+}
+
+// double computes 2p using the "dbl-2009-l" formulas for a = 0.
+func (p *jacobian) double() jacobian {
+	if p.isInfinity() || p.y.Sign() == 0 {
+		return jacobian{one, one, zero}
+	}
+	a := fSqr(p.x)
+	b := fSqr(p.y)
+	c := fSqr(b)
+	d := fSub(fSub(fSqr(fAdd(p.x, b)), a), c)
+	d = fAdd(d, d)
+	e := fAdd(fAdd(a, a), a)
+	x3 := fSub(fSqr(e), fAdd(d, d))
+	c8 := new(big.Int).Lsh(c, 3)
+	y3 := fSub(fMul(e, fSub(d, x3)), c8)
+	z3 := fMul(p.y, p.z)
+	z3 = fAdd(z3, z3)
+	return jacobian{x3, y3, z3}
+}
+
+// add computes p + q using the "add-2007-bl" style formulas.
+func (p *jacobian) add(q *jacobian) jacobian {
+	if p.isInfinity() {
+		return *q
+	}
+	if q.isInfinity() {
+		return *p
+	}
+	z1z1 := fSqr(p.z)
+	z2z2 := fSqr(q.z)
+	u1 := fMul(p.x, z2z2)
+	u2 := fMul(q.x, z1z1)
+	s1 := fMul(p.y, fMul(q.z, z2z2))
+	s2 := fMul(q.y, fMul(p.z, z1z1))
+	if u1.Cmp(u2) == 0 {
+		if s1.Cmp(s2) == 0 {
+			return p.double()
+		}
+		return jacobian{one, one, zero}
+	}
+	h := fSub(u2, u1)
+	r := fSub(s2, s1)
+	h2 := fSqr(h)
+	h3 := fMul(h, h2)
+	u1h2 := fMul(u1, h2)
+	x3 := fSub(fSub(fSqr(r), h3), fAdd(u1h2, u1h2))
+	y3 := fSub(fMul(r, fSub(u1h2, x3)), fMul(s1, h3))
+	z3 := fMul(h, fMul(p.z, q.z))
+	return jacobian{x3, y3, z3}
+}
+
+// equal reports whether p and q represent the same point.
+func (p *jacobian) equal(q *jacobian) bool {
+	if p.isInfinity() || q.isInfinity() {
+		return p.isInfinity() && q.isInfinity()
+	}
+	z1z1 := fSqr(p.z)
+	z2z2 := fSqr(q.z)
+	xeq := mod.ConstantTimeEqual(fMul(p.x, z2z2), fMul(q.x, z1z1))
+	yeq := mod.ConstantTimeEqual(fMul(p.y, fMul(q.z, z2z2)), fMul(q.y, fMul(p.z, z1z1)))
+	return xeq && yeq
+}
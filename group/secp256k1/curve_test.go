@@ -0,0 +1,99 @@
+// +build vartime
+
+package secp256k1
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+)
+
+var tSuite = NewBlakeSHA256Secp256k1()
+var groupBench = test.NewGroupBench(tSuite)
+
+func TestSuite(t *testing.T) { test.SuiteTest(tSuite) }
+
+func TestBaseMultiples(t *testing.T) {
+	vectors := []string{
+		"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		"02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+		"02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+	}
+	for i, v := range vectors {
+		P := tSuite.Point().Mul(tSuite.Scalar().SetInt64(int64(i+1)), nil)
+		if P.String() != v {
+			t.Fatalf("%d*G: expected %s, got %s", i+1, v, P.String())
+		}
+	}
+
+	// -1*G has the same x-coordinate as G and an odd y-coordinate.
+	P := tSuite.Point().Mul(tSuite.Scalar().SetInt64(-1), nil)
+	if P.String() != "03"+vectors[0][2:] {
+		t.Fatal("unexpected encoding of -G:", P.String())
+	}
+}
+
+func TestUncompressed(t *testing.T) {
+	b, _ := hex.DecodeString("04" +
+		"79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8")
+	P := tSuite.Point()
+	if err := P.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !P.Equal(tSuite.Point().Base()) {
+		t.Fatal("uncompressed base point decoded incorrectly")
+	}
+
+	b[len(b)-1] ^= 1
+	if err := P.UnmarshalBinary(b); err == nil {
+		t.Fatal("expected invalid point to be rejected")
+	}
+}
+
+func TestGLV(t *testing.T) {
+	// lambda*G must equal phi(G).
+	G := jacobian{baseX, baseY, one}
+	lG := scalarMult(glvLambda, &G)
+	phiG := G.endomorphism()
+	if !lG.equal(&phiG) {
+		t.Fatal("endomorphism does not act as multiplication by lambda")
+	}
+
+	rand := random.New()
+	for i := 0; i < 32; i++ {
+		k := tSuite.Scalar().Pick(rand).(*mod.Int).V
+		k1, k2 := splitScalar(&k)
+		if k1.BitLen() > 129 || k2.BitLen() > 129 {
+			t.Fatal("scalar decomposition is too long")
+		}
+		s := new(big.Int).Mul(k2, glvLambda)
+		s.Add(s, k1).Mod(s, orderN)
+		if s.Cmp(&k) != 0 {
+			t.Fatal("scalar decomposition is incorrect")
+		}
+
+		// Compare against plain double-and-add.
+		r := jacobian{one, one, zero}
+		for j := k.BitLen() - 1; j >= 0; j-- {
+			r = r.double()
+			if k.Bit(j) == 1 {
+				r = r.add(&G)
+			}
+		}
+		kG := scalarMult(&k, &G)
+		if !r.equal(&kG) {
+			t.Fatal("GLV scalar multiplication is incorrect")
+		}
+	}
+}
+
+func BenchmarkPointMul(b *testing.B)     { groupBench.PointMul(b.N) }
+func BenchmarkPointBaseMul(b *testing.B) { groupBench.PointBaseMul(b.N) }
+func BenchmarkPointAdd(b *testing.B)     { groupBench.PointAdd(b.N) }
+func BenchmarkPointEncode(b *testing.B)  { groupBench.PointEncode(b.N) }
+func BenchmarkPointDecode(b *testing.B)  { groupBench.PointDecode(b.N) }
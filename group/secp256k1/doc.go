@@ -0,0 +1,13 @@
+// Package secp256k1 implements the secp256k1 elliptic curve group used by
+// Bitcoin and Ethereum, as specified in SEC 2 (http://www.secg.org/sec2-v2.pdf).
+//
+// Scalar multiplication uses the GLV endomorphism of the curve, which splits
+// a 256-bit scalar into two half-length scalars that are then processed
+// simultaneously. Points are encoded in the 33-byte SEC 1 compressed format
+// used by Bitcoin; the 65-byte uncompressed format used by Ethereum is
+// accepted when decoding. Scalars are big-endian, as in those systems.
+//
+// The arithmetic is based on Go's math/big package, without assembly of its
+// own, and is not constant time, so this package must be compiled with the
+// "vartime" compilation flag.
+package secp256k1
@@ -0,0 +1,90 @@
+// +build vartime
+
+package secp256k1
+
+import (
+	"math/big"
+)
+
+// The secp256k1 curve has an efficiently computable endomorphism
+// phi(x, y) = (beta*x, y), which acts on the group as multiplication
+// by lambda. Following Gallant, Lambert and Vanstone, "Faster Point
+// Multiplication on Elliptic Curves with Efficient Endomorphisms"
+// (CRYPTO 2001), a scalar k is decomposed as k = k1 + k2*lambda mod n
+// with k1, k2 of about 128 bits, and k*P is computed as k1*P + k2*phi(P).
+var (
+	glvBeta   = fromHex("7ae96a2b657c07106e64479eac3434e99cf0497512f58995c1396c28719501ee")
+	glvLambda = fromHex("5363ad4cc05c30e0a5261c028812645a122e22ea20816678df02967c1b23bd72")
+
+	// A short basis {(a1, b1), (a2, b2)} of the lattice of pairs (x, y)
+	// with x + y*lambda = 0 mod n.
+	glvA1 = fromHex("3086d221a7d46bcde86c90e49284eb15")
+	glvB1 = new(big.Int).Neg(fromHex("e4437ed6010e88286f547fa90abfe4c3"))
+	glvA2 = fromHex("114ca50f7a8e2f3f657c1108d9d44cfd8")
+	glvB2 = glvA1
+)
+
+// endomorphism returns phi(p) = lambda*p.
+func (p *jacobian) endomorphism() jacobian {
+	return jacobian{fMul(p.x, glvBeta), p.y, p.z}
+}
+
+// roundDiv returns a/b rounded to the nearest integer, for b > 0.
+func roundDiv(a, b *big.Int) *big.Int {
+	r := new(big.Int).Lsh(a, 1)
+	r.Add(r, b)
+	return r.Div(r, new(big.Int).Lsh(b, 1))
+}
+
+// splitScalar returns k1, k2 such that k = k1 + k2*lambda mod n.
+// Both halves are at most about 128 bits long, but may be negative.
+func splitScalar(k *big.Int) (k1, k2 *big.Int) {
+	c1 := roundDiv(new(big.Int).Mul(glvB2, k), orderN)
+	c2 := roundDiv(new(big.Int).Mul(new(big.Int).Neg(glvB1), k), orderN)
+
+	k1 = new(big.Int).Sub(k, new(big.Int).Mul(c1, glvA1))
+	k1.Sub(k1, new(big.Int).Mul(c2, glvA2))
+	k2 = new(big.Int).Mul(c1, glvB1)
+	k2.Add(k2, new(big.Int).Mul(c2, glvB2))
+	k2.Neg(k2)
+	return k1, k2
+}
+
+// scalarMult computes k*p using the GLV decomposition and
+// Shamir's trick to process both half-length scalars at once.
+func scalarMult(k *big.Int, p *jacobian) jacobian {
+	k1, k2 := splitScalar(new(big.Int).Mod(k, orderN))
+
+	p1 := *p
+	p2 := p.endomorphism()
+	if k1.Sign() < 0 {
+		k1.Neg(k1)
+		p1 = p1.neg()
+	}
+	if k2.Sign() < 0 {
+		k2.Neg(k2)
+		p2 = p2.neg()
+	}
+	p12 := p1.add(&p2)
+
+	r := jacobian{one, one, zero}
+	for i := maxInt(k1.BitLen(), k2.BitLen()) - 1; i >= 0; i-- {
+		r = r.double()
+		switch k1.Bit(i) | k2.Bit(i)<<1 {
+		case 1:
+			r = r.add(&p1)
+		case 2:
+			r = r.add(&p2)
+		case 3:
+			r = r.add(&p12)
+		}
+	}
+	return r
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,236 @@
+// +build vartime
+
+package secp256k1
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/blind"
+	"github.com/dedis/kyber/internal/fixedbase"
+	"github.com/dedis/kyber/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+)
+
+// coordLen is the number of bytes in an encoded coordinate.
+const coordLen = 32
+
+var errInvalidPoint = errors.New("invalid secp256k1 point")
+
+// baseTable holds the multiples of the base point with which Mul computes
+// its products, built on the first of them.
+var (
+	baseTable     *fixedbase.Table
+	baseTableOnce sync.Once
+)
+
+type point struct {
+	jacobian
+	blind cipher.Stream // randomness of blinded Mul, if set
+}
+
+func (P *point) setInfinity() {
+	P.jacobian = jacobian{one, one, zero}
+}
+
+func (P *point) String() string {
+	b, _ := P.MarshalBinary()
+	return hex.EncodeToString(b)
+}
+
+func (P *point) Equal(P2 kyber.Point) bool {
+	return P.equal(&P2.(*point).jacobian)
+}
+
+// Valid reports whether P is on the curve, which has prime order.
+func (P *point) Valid() bool {
+	if P.isInfinity() {
+		return true
+	}
+	x, y := P.affine()
+	return fSqr(y).Cmp(rhs(x)) == 0
+}
+
+func (P *point) Null() kyber.Point {
+	P.setInfinity()
+	return P
+}
+
+func (P *point) Base() kyber.Point {
+	P.jacobian = jacobian{baseX, baseY, one}
+	return P
+}
+
+func (P *point) Set(P2 kyber.Point) kyber.Point {
+	P.jacobian = P2.(*point).jacobian
+	return P
+}
+
+func (P *point) Clone() kyber.Point {
+	return &point{jacobian: P.jacobian}
+}
+
+// setX sets P to a point with the given x-coordinate and the parity of y
+// given by odd, and reports whether such a point exists.
+func (P *point) setX(x *big.Int, odd bool) bool {
+	if x.Cmp(fieldP) >= 0 {
+		return false
+	}
+	y := fSqrt(rhs(x))
+	if y == nil {
+		return false
+	}
+	if (y.Bit(0) == 1) != odd {
+		y = fSub(zero, y)
+	}
+	P.jacobian = jacobian{x, y, one}
+	return true
+}
+
+func (P *point) EmbedLen() int {
+	// Reserve the most-significant 8 bits for pseudo-randomness.
+	// Reserve the least-significant 8 bits for embedded data length.
+	return (256 - 8 - 8) / 8
+}
+
+func (P *point) Pick(rand cipher.Stream) kyber.Point {
+	return P.Embed(nil, rand)
+}
+
+// Embed picks a curve point containing a variable amount of embedded data
+// in its x-coordinate. Remaining bits comprising the point are chosen randomly.
+// Since secp256k1 has prime order, every such point is a group element.
+func (P *point) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	dl := P.EmbedLen()
+	if dl > len(data) {
+		dl = len(data)
+	}
+
+	for {
+		var b [coordLen + 1]byte
+		random.Bytes(b[:], rand)
+		if data != nil {
+			b[coordLen-1] = byte(dl)                // Encode length in low 8 bits
+			copy(b[coordLen-dl-1:coordLen-1], data) // Copy in data to embed
+		}
+		if P.setX(new(big.Int).SetBytes(b[:coordLen]), b[coordLen]&1 == 1) {
+			return P
+		}
+	}
+}
+
+// Data extracts embedded data from a curve point.
+func (P *point) Data() ([]byte, error) {
+	b, _ := P.MarshalBinary()
+	x := b[1:]
+	dl := int(x[coordLen-1])
+	if dl > P.EmbedLen() {
+		return nil, errors.New("invalid embedded data length")
+	}
+	return x[coordLen-dl-1 : coordLen-1], nil
+}
+
+func (P *point) Add(A, B kyber.Point) kyber.Point {
+	P.jacobian = A.(*point).add(&B.(*point).jacobian)
+	return P
+}
+
+func (P *point) Sub(A, B kyber.Point) kyber.Point {
+	nb := B.(*point).neg()
+	P.jacobian = A.(*point).add(&nb)
+	return P
+}
+
+func (P *point) Neg(A kyber.Point) kyber.Point {
+	P.jacobian = A.(*point).neg()
+	return P
+}
+
+// SetBlinding enables the blinding of Mul on P with the randomness of
+// rand, or disables it if rand is nil, as in kyber.BlindablePoint.
+func (P *point) SetBlinding(rand cipher.Stream) {
+	P.blind = rand
+}
+
+// Mul multiplies point A by scalar s, or the base point if A is nil.
+func (P *point) Mul(s kyber.Scalar, A kyber.Point) kyber.Point {
+	if P.blind != nil {
+		return P.Set(blind.Mul(new(Curve), s, A, P.blind))
+	}
+	if A == nil {
+		baseTableOnce.Do(func() { baseTable = fixedbase.New(new(Curve), nil) })
+		return baseTable.MulTo(P, s)
+	}
+	P.jacobian = scalarMult(&s.(*mod.Int).V, &A.(*point).jacobian)
+	return P
+}
+
+// Precompute returns a kyber.FixedBase for P, like the table with which Mul
+// multiplies the base point.
+func (P *point) Precompute() kyber.FixedBase {
+	return fixedbase.New(new(Curve), P)
+}
+
+func (P *point) MarshalSize() int {
+	return 1 + coordLen
+}
+
+// MarshalBinary returns the SEC 1 compressed encoding of P.
+// The point at infinity is encoded as all zero bytes.
+func (P *point) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 1+coordLen)
+	if P.isInfinity() {
+		return b, nil
+	}
+	x, y := P.affine()
+	b[0] = 2 | byte(y.Bit(0))
+	xb := x.Bytes()
+	copy(b[1+coordLen-len(xb):], xb)
+	return b, nil
+}
+
+// UnmarshalBinary decodes a point in SEC 1 compressed or uncompressed form.
+func (P *point) UnmarshalBinary(b []byte) error {
+	switch {
+	case len(b) == 1+coordLen && (b[0] == 2 || b[0] == 3):
+		if !P.setX(new(big.Int).SetBytes(b[1:]), b[0] == 3) {
+			return errInvalidPoint
+		}
+	case len(b) == 1+2*coordLen && b[0] == 4:
+		x := new(big.Int).SetBytes(b[1 : 1+coordLen])
+		y := new(big.Int).SetBytes(b[1+coordLen:])
+		if x.Cmp(fieldP) >= 0 || y.Cmp(fieldP) >= 0 ||
+			fSqr(y).Cmp(rhs(x)) != 0 {
+			return errInvalidPoint
+		}
+		P.jacobian = jacobian{x, y, one}
+	case len(b) == 1+coordLen && isZero(b):
+		P.setInfinity()
+	default:
+		return errInvalidPoint
+	}
+	return nil
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (P *point) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(P, w)
+}
+
+func (P *point) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(P, r)
+}
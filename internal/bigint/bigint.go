@@ -0,0 +1,42 @@
+// +build vartime
+
+// Package bigint provides the checks and sampling of integers shared by the
+// packages built on math/big, such as encrypt/paillier and sign/tecdsa.
+//
+// The package uses math/big and is not constant time, so it must be
+// compiled with the "vartime" compilation flag.
+package bigint
+
+import (
+	"crypto/cipher"
+	"math/big"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+var one = big.NewInt(1)
+
+// IsUnit reports whether x is set and a unit modulo n.
+func IsUnit(x, n *big.Int) bool {
+	return x != nil && x.Sign() > 0 && x.Cmp(n) < 0 &&
+		new(big.Int).GCD(nil, nil, x, n).Cmp(one) == 0
+}
+
+// RandomUnit returns a uniform unit modulo n.
+func RandomUnit(n *big.Int, rand cipher.Stream) *big.Int {
+	for {
+		if r := random.Int(n, rand); IsUnit(r, n) {
+			return r
+		}
+	}
+}
+
+// InRange reports whether x is set and in [0, max].
+func InRange(x, max *big.Int) bool {
+	return x != nil && x.Sign() >= 0 && x.Cmp(max) <= 0
+}
+
+// NonNegative reports whether x is set and not negative.
+func NonNegative(x *big.Int) bool {
+	return x != nil && x.Sign() >= 0
+}
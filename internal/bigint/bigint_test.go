@@ -0,0 +1,39 @@
+// +build vartime
+
+package bigint
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecks(t *testing.T) {
+	n := big.NewInt(15)
+	require.True(t, IsUnit(big.NewInt(7), n))
+	require.False(t, IsUnit(big.NewInt(5), n))
+	require.False(t, IsUnit(big.NewInt(0), n))
+	require.False(t, IsUnit(n, n))
+	require.False(t, IsUnit(nil, n))
+
+	max := big.NewInt(10)
+	require.True(t, InRange(big.NewInt(0), max))
+	require.True(t, InRange(max, max))
+	require.False(t, InRange(big.NewInt(11), max))
+	require.False(t, InRange(big.NewInt(-1), max))
+	require.False(t, InRange(nil, max))
+
+	require.True(t, NonNegative(big.NewInt(0)))
+	require.False(t, NonNegative(big.NewInt(-1)))
+	require.False(t, NonNegative(nil))
+}
+
+func TestRandomUnit(t *testing.T) {
+	n := big.NewInt(15)
+	rand := random.New()
+	for i := 0; i < 100; i++ {
+		require.True(t, IsUnit(RandomUnit(n, rand), n))
+	}
+}
@@ -0,0 +1,35 @@
+// Package blind implements the blinded scalar multiplication of the
+// kyber.BlindablePoint interface for any group, with its own arithmetic.
+package blind
+
+import (
+	"crypto/cipher"
+
+	"github.com/dedis/kyber"
+)
+
+// Group creates the scalars and the unblinded points of a group, which is
+// all Mul needs of a kyber.Group.
+type Group interface {
+	Scalar() kyber.Scalar
+	Point() kyber.Point
+}
+
+// Mul returns s*G in the group g, or s times the standard base point if G
+// is nil, computed as s*(G+R) - s*R for a random point R, where every
+// product by s is the sum of the products by a random share r and by the
+// share s-r. The points that g creates must not be blinded themselves.
+func Mul(g Group, s kyber.Scalar, G kyber.Point, rand cipher.Stream) kyber.Point {
+	if G == nil {
+		G = g.Point().Base()
+	}
+	r := g.Scalar().Pick(rand)
+	t := g.Scalar().Sub(s, r)
+	R := g.Point().Pick(rand)
+	Q := g.Point().Add(G, R)
+
+	mul := func(P kyber.Point) kyber.Point {
+		return g.Point().Add(g.Point().Mul(r, P), g.Point().Mul(t, P))
+	}
+	return g.Point().Sub(mul(Q), mul(R))
+}
@@ -0,0 +1,26 @@
+package blind
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+)
+
+func TestMul(t *testing.T) {
+	g := edwards25519.NewBlakeSHA256Ed25519()
+	rand := random.New()
+	for i := 0; i < 10; i++ {
+		s := g.Scalar().Pick(rand)
+		P := g.Point().Pick(rand)
+		if !Mul(g, s, P, rand).Equal(g.Point().Mul(s, P)) {
+			t.Fatal("wrong blinded product")
+		}
+		if !Mul(g, s, nil, rand).Equal(g.Point().Mul(s, nil)) {
+			t.Fatal("wrong blinded product of the base point")
+		}
+	}
+	if !Mul(g, g.Scalar().Zero(), nil, rand).Equal(g.Point().Null()) {
+		t.Fatal("blinded product by zero is not the identity")
+	}
+}
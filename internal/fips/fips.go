@@ -0,0 +1,26 @@
+// Package fips holds the FIPS mode set by suites.RequireFIPS, so that the
+// constructors of the suites built from algorithms that are not FIPS
+// approved, which package suites imports, can refuse to run in it.
+package fips
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// ErrNotApproved is the error of the suites that are not FIPS approved.
+var ErrNotApproved = errors.New("suite is not FIPS approved")
+
+// Required is set by suites.RequireFIPS.
+var Required atomic.Bool
+
+// Check returns an error wrapping ErrNotApproved if FIPS mode is required,
+// and nil otherwise. The error-returning constructors of the suites named
+// name that are not FIPS approved call it.
+func Check(name string) error {
+	if Required.Load() {
+		return fmt.Errorf("%s: %w", name, ErrNotApproved)
+	}
+	return nil
+}
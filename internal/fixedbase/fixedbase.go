@@ -0,0 +1,110 @@
+// Package fixedbase multiplies fixed points, such as the base points of
+// groups, by scalars with precomputed tables of their multiples, for the
+// groups that have no such tables of their own.
+package fixedbase
+
+import (
+	"math/bits"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/msm"
+)
+
+// Table is a kyber.FixedBase for any group: for every nibble of the
+// scalars, it holds the multiples by 1 to 8 of the point shifted to that
+// nibble, so that Mul adds one multiple per signed nibble of the scalar,
+// without any doubling. For scalars of 256 bits, that is 65 additions, for
+// a table of 520 points. Its lookups depend on the scalar, which suits the
+// groups that are not constant time anyway. For groups whose scalars msm
+// cannot decompose, Mul falls back to Point.Mul.
+type Table struct {
+	g    kyber.Group
+	P    kyber.Point
+	rows [][8]kyber.Point
+}
+
+// New returns the Table of the point P of g, or of its standard base point
+// if P is nil. Later changes of P do not affect the Table.
+func New(g kyber.Group, P kyber.Point) *Table {
+	t := &Table{g: g, P: g.Point()}
+	if P == nil {
+		t.P.Base()
+	} else {
+		t.P.Set(P)
+	}
+	one, ok := msm.ScalarBytes(g, []kyber.Scalar{g.Scalar().One()})
+	if !ok {
+		return t
+	}
+
+	// rows[i][k] is (k+1) * 16^i * P, with a row for the last carry.
+	B := t.P.Clone()
+	t.rows = make([][8]kyber.Point, 2*len(one[0])+1)
+	for i := range t.rows {
+		row := &t.rows[i]
+		row[0] = B.Clone()
+		for k := 1; k < len(row); k++ {
+			row[k] = g.Point().Add(row[k-1], B)
+		}
+		for j := 0; j < 4; j++ {
+			B.Add(B, B)
+		}
+	}
+	return t
+}
+
+// Mul returns s times the point of the table.
+func (t *Table) Mul(s kyber.Scalar) kyber.Point {
+	return t.MulTo(t.g.Point(), s)
+}
+
+// MulTo sets Q to s times the point of the table, and returns it. It does
+// not allocate for scalars of group/mod, such as those of the curves built
+// on math/big.
+func (t *Table) MulTo(Q kyber.Point, s kyber.Scalar) kyber.Point {
+	if t.rows == nil {
+		return Q.Mul(s, t.P)
+	}
+	if m, ok := s.(*mod.Int); ok && m.V.Sign() >= 0 {
+		words := m.V.Bits()
+		return t.mul(Q, func(i int) int32 {
+			w, shift := 4*i/bits.UintSize, uint(4*i%bits.UintSize)
+			if w >= len(words) {
+				return 0
+			}
+			return int32(words[w] >> shift & 15)
+		}, m.V.BitLen())
+	}
+	b, ok := msm.ScalarBytes(t.g, []kyber.Scalar{s})
+	if !ok {
+		return Q.Mul(s, t.P)
+	}
+	return t.mul(Q, func(i int) int32 {
+		return int32(b[0][i/2] >> (4 * uint(i%2)) & 15)
+	}, 8*len(b[0]))
+}
+
+// mul sets Q to the product of the point by the integer of n bits whose
+// i-th nibble is nibble(i), recoded into signed nibbles between -8 and 7.
+func (t *Table) mul(Q kyber.Point, nibble func(i int) int32, n int) kyber.Point {
+	if n > 4*(len(t.rows)-1) {
+		panic("fixedbase: scalar larger than the table")
+	}
+	Q.Null()
+	var carry int32
+	for i := range t.rows {
+		d := carry
+		if i < len(t.rows)-1 {
+			d += nibble(i)
+		}
+		carry = (d + 8) >> 4
+		switch d -= carry << 4; {
+		case d > 0:
+			Q.Add(Q, t.rows[i][d-1])
+		case d < 0:
+			Q.Sub(Q, t.rows[i][-d-1])
+		}
+	}
+	return Q
+}
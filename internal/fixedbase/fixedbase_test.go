@@ -0,0 +1,45 @@
+package fixedbase
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+)
+
+// The scalars of edwards25519 are not those of group/mod, so that Table
+// decomposes their encodings.
+func TestTable(t *testing.T) {
+	g := edwards25519.NewBlakeSHA256Ed25519()
+	rand := random.New()
+	for _, P := range []kyber.Point{g.Point().Pick(rand), nil} {
+		tab := New(g, P)
+		if len(tab.rows) != 65 {
+			t.Fatalf("table of %d rows", len(tab.rows))
+		}
+		for j := 0; j < 5; j++ {
+			s := g.Scalar().Pick(rand)
+			if j == 0 {
+				s.SetInt64(-1)
+			}
+			if !tab.Mul(s).Equal(g.Point().Mul(s, P)) {
+				t.Fatal("wrong product")
+			}
+		}
+	}
+}
+
+func TestTableMulTo(t *testing.T) {
+	g := edwards25519.NewBlakeSHA256Ed25519()
+	rand := random.New()
+	tab := New(g, nil)
+	s := g.Scalar().Pick(rand)
+	Q := g.Point().Pick(rand)
+	if tab.MulTo(Q, s) != Q || !Q.Equal(g.Point().Mul(s, nil)) {
+		t.Fatal("MulTo does not set its point to the product")
+	}
+	if !tab.MulTo(Q, g.Scalar().Zero()).Equal(g.Point().Null()) {
+		t.Fatal("product by zero is not the identity")
+	}
+}
@@ -0,0 +1,207 @@
+// Package msm computes multi-scalar multiplications, that is sums of the
+// form s_1*P_1 + ... + s_n*P_n, faster than by computing every product on
+// its own.
+package msm
+
+import (
+	"github.com/dedis/kyber"
+)
+
+// window is the number of scalar bits processed at a time by Straus'
+// method, such that a byte holds two digits.
+const window = 4
+
+// Mul returns the sum of scalars[i]*points[i] in the group g. A nil point
+// stands for the standard base point.
+//
+// If the points of g implement kyber.MultiScalarMultiplier, Mul leaves the
+// computation to them. Otherwise it uses Straus' interleaving method, in
+// which all the products share the same sequence of doublings, or, for
+// long sums, Pippenger's bucket method, as chosen by Window. Both work
+// with groups whose scalars are encoded as fixed-length integers, either
+// little- or big-endian; for other groups, Mul computes every product
+// separately. It is not constant time.
+func Mul(g kyber.Group, scalars []kyber.Scalar, points []kyber.Point) kyber.Point {
+	if len(scalars) != len(points) {
+		panic("msm: scalars and points of different lengths")
+	}
+	sum := g.Point()
+	if m, ok := sum.(kyber.MultiScalarMultiplier); ok {
+		return m.MultiScalarMult(scalars, points)
+	}
+	sum.Null()
+	if len(scalars) == 0 {
+		return sum
+	}
+	bytes, ok := ScalarBytes(g, scalars)
+	if !ok {
+		for i := range scalars {
+			sum.Add(sum, g.Point().Mul(scalars[i], points[i]))
+		}
+		return sum
+	}
+	if c := Window(len(points), 8*len(bytes[0])); c != 0 {
+		return pippenger(g, c, bytes, points)
+	}
+
+	// tables[i][j] is j*points[i].
+	tables := make([][1 << window]kyber.Point, len(points))
+	for i, P := range points {
+		t := &tables[i]
+		t[0] = g.Point().Null()
+		t[1] = g.Point()
+		if P == nil {
+			t[1].Base()
+		} else {
+			t[1].Set(P)
+		}
+		for j := 2; j < len(t); j++ {
+			t[j] = g.Point().Add(t[j-1], t[1])
+		}
+	}
+
+	started := false
+	for d := 2*len(bytes[0]) - 1; d >= 0; d-- {
+		if started {
+			for j := 0; j < window; j++ {
+				sum.Add(sum, sum)
+			}
+		}
+		for i := range tables {
+			if k := bytes[i][d/2] >> (window * uint(d%2)) & 0xf; k != 0 {
+				sum.Add(sum, tables[i][k])
+				started = true
+			}
+		}
+	}
+	return sum
+}
+
+// pippenger returns the sum of the products of the little-endian scalars
+// and points with Pippenger's method on signed digits of c bits: for every
+// digit position, from the most significant down, it adds each point into
+// the bucket of its digit, and then all the buckets weighted by their
+// digits with two running sums.
+func pippenger(g kyber.Group, c uint, scalars [][]byte, points []kyber.Point) kyber.Point {
+	digits := make([][]int32, len(scalars))
+	for i, b := range scalars {
+		digits[i] = Digits(b, c)
+	}
+	base := g.Point().Base()
+
+	// buckets[k] is the sum of the points of digit ±(k+1).
+	buckets := make([]kyber.Point, 1<<(c-1))
+	for k := range buckets {
+		buckets[k] = g.Point()
+	}
+	sum := g.Point().Null()
+	running, seg := g.Point(), g.Point()
+	for d := len(digits[0]) - 1; d >= 0; d-- {
+		for j := uint(0); j < c; j++ {
+			sum.Add(sum, sum)
+		}
+		for _, B := range buckets {
+			B.Null()
+		}
+		for i, P := range points {
+			if P == nil {
+				P = base
+			}
+			switch k := digits[i][d]; {
+			case k > 0:
+				buckets[k-1].Add(buckets[k-1], P)
+			case k < 0:
+				buckets[-k-1].Sub(buckets[-k-1], P)
+			}
+		}
+		running.Null()
+		seg.Null()
+		for k := len(buckets) - 1; k >= 0; k-- {
+			running.Add(running, buckets[k])
+			seg.Add(seg, running)
+		}
+		sum.Add(sum, seg)
+	}
+	return sum
+}
+
+// Window returns the width of the signed digits with which Pippenger's
+// method computes a sum of n products of scalars of the given bit length
+// in the fewest point additions, or 0 if Straus' method takes fewer.
+// Pippenger's method with digits of c bits takes about n + 2^c additions
+// per digit, and Straus' about n per 4-bit digit plus 14 per point for
+// its tables, so that Pippenger's wins from a few hundred products on.
+func Window(n, bits int) uint {
+	best, cost := uint(0), n*(bits/window+(1<<window)-2)
+	for c := uint(2); c <= 16; c++ {
+		if k := ((bits+int(c)-1)/int(c) + 1) * (n + 1<<c); k < cost {
+			best, cost = c, k
+		}
+	}
+	return best
+}
+
+// Digits returns the digits of the little-endian integer b in radix 2^c,
+// least significant first, in [-2^(c-1), 2^(c-1)), so that a method
+// adding the multiples of its points by these digits needs only those by
+// 1 to 2^(c-1), in which it subtracts points for negative digits. There
+// is one more digit than the 8*len(b) bits take, for the final carry. The
+// width c must be between 2 and 24.
+func Digits(b []byte, c uint) []int32 {
+	n := (8*uint(len(b))+c-1)/c + 1
+	d := make([]int32, n)
+	var carry int32
+	for j := uint(0); j < n; j++ {
+		pos := j * c
+		var v uint32
+		for k := uint(0); k < 4 && pos/8+k < uint(len(b)); k++ {
+			v |= uint32(b[pos/8+k]) << (8 * k)
+		}
+		digit := int32(v>>(pos%8)&(1<<c-1)) + carry
+		carry = (digit + 1<<(c-1)) >> c
+		d[j] = digit - carry<<c
+	}
+	return d
+}
+
+// ScalarBytes returns the little-endian encodings of the scalars, and
+// whether the encoding of the group's scalars is understood.
+func ScalarBytes(g kyber.Group, scalars []kyber.Scalar) ([][]byte, bool) {
+	one, err := g.Scalar().One().MarshalBinary()
+	if err != nil || len(one) < 2 {
+		return nil, false
+	}
+	var littleEndian bool
+	switch {
+	case one[0] == 1 && isZero(one[1:]):
+		littleEndian = true
+	case one[len(one)-1] == 1 && isZero(one[:len(one)-1]):
+		littleEndian = false
+	default:
+		return nil, false
+	}
+
+	bytes := make([][]byte, len(scalars))
+	for i, s := range scalars {
+		b, err := s.MarshalBinary()
+		if err != nil || len(b) != len(one) {
+			return nil, false
+		}
+		if !littleEndian {
+			for j, k := 0, len(b)-1; j < k; j, k = j+1, k-1 {
+				b[j], b[k] = b[k], b[j]
+			}
+		}
+		bytes[i] = b
+	}
+	return bytes, true
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
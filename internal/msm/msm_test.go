@@ -0,0 +1,96 @@
+package msm_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/internal/msm"
+	"github.com/dedis/kyber/util/random"
+)
+
+func TestMul(t *testing.T) {
+	testMul(t, edwards25519.NewBlakeSHA256Ed25519(), []int{0, 1, 2, 7, 32, 100})
+}
+
+// testMul checks msm.Mul on sums of n products, with a zero scalar and
+// some base points among them.
+func testMul(t *testing.T, g kyber.Group, ns []int) {
+	rand := random.New()
+	for _, n := range ns {
+		scalars := make([]kyber.Scalar, n)
+		points := make([]kyber.Point, n)
+		want := g.Point().Null()
+		for i := range scalars {
+			scalars[i] = g.Scalar().Pick(rand)
+			if i == 2 {
+				scalars[i].Zero()
+			}
+			if i%3 != 1 {
+				points[i] = g.Point().Pick(rand)
+			}
+			want.Add(want, g.Point().Mul(scalars[i], points[i]))
+		}
+		if got := msm.Mul(g, scalars, points); !got.Equal(want) {
+			t.Fatalf("%s: wrong sum of %d products", g, n)
+		}
+	}
+}
+
+func TestDigits(t *testing.T) {
+	rand := random.New()
+	b := random.Bits(256, false, rand)
+	b[31] = 0xff // all ones at the top, to carry into the extra digit
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	x := new(big.Int).SetBytes(be)
+	for _, c := range []uint{2, 3, 4, 5, 8, 11, 16} {
+		d := msm.Digits(b, c)
+		y := new(big.Int)
+		for j := len(d) - 1; j >= 0; j-- {
+			if d[j] < -1<<(c-1) || d[j] >= 1<<(c-1) {
+				t.Fatalf("digit %d of width %d out of range", d[j], c)
+			}
+			y.Lsh(y, c)
+			y.Add(y, big.NewInt(int64(d[j])))
+		}
+		if y.Cmp(x) != 0 {
+			t.Fatalf("digits of width %d do not add up to the integer", c)
+		}
+	}
+}
+
+func TestWindow(t *testing.T) {
+	if c := msm.Window(1, 256); c != 0 {
+		t.Fatalf("window %d for a single product", c)
+	}
+	prev := msm.Window(1000, 256)
+	if prev == 0 {
+		t.Fatal("no window for a thousand products")
+	}
+	for _, n := range []int{10000, 100000} {
+		c := msm.Window(n, 256)
+		if c < prev {
+			t.Fatalf("window shrinks from %d to %d for %d products", prev, c, n)
+		}
+		prev = c
+	}
+}
+
+func BenchmarkMul(b *testing.B) {
+	g := edwards25519.NewBlakeSHA256Ed25519()
+	rand := random.New()
+	scalars := make([]kyber.Scalar, 64)
+	points := make([]kyber.Point, 64)
+	for i := range scalars {
+		scalars[i] = g.Scalar().Pick(rand)
+		points[i] = g.Point().Pick(rand)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		msm.Mul(g, scalars, points)
+	}
+}
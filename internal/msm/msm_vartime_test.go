@@ -0,0 +1,18 @@
+// +build vartime
+
+package msm_test
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/curve25519"
+	"github.com/dedis/kyber/group/nist"
+)
+
+// The points of these groups have no multi-scalar multiplication of their
+// own, so that msm.Mul takes Straus' method, and Pippenger's for 100
+// products, on their scalars of either endianness.
+func TestMulGeneric(t *testing.T) {
+	testMul(t, nist.NewBlakeSHA256P256(), []int{0, 1, 7, 100})
+	testMul(t, curve25519.NewBlakeSHA256Curve25519(false), []int{1, 100})
+}
@@ -0,0 +1,6 @@
+// +build !race
+
+package race
+
+// Enabled is true when built with -race.
+const Enabled = false
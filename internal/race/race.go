@@ -0,0 +1,9 @@
+// +build race
+
+// Package race reports whether the race detector is enabled, under which
+// sync.Pool drops some of the values put into it, so that the counts of
+// allocations that tests expect of pooled code do not hold.
+package race
+
+// Enabled is true when built with -race.
+const Enabled = true
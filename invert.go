@@ -0,0 +1,38 @@
+package kyber
+
+// BatchInvert sets every non-zero scalar of scalars to its inverse, and
+// leaves the zero ones, with Montgomery's trick: it multiplies them all
+// together, inverts the product once and recovers every inverse with two
+// more multiplications, so that n inversions cost one, plus 3(n-1)
+// multiplications. The scalars must be distinct objects of the same group.
+// Apart from which scalars are zero, it takes the time of the Mul and Inv
+// of the group.
+func BatchInvert(scalars []Scalar) {
+	if len(scalars) == 0 {
+		return
+	}
+	zero := scalars[0].Clone().Zero()
+	nonZero := make([]bool, len(scalars))
+
+	// prefix[i] is the product of the non-zero scalars before i.
+	prefix := make([]Scalar, len(scalars))
+	acc := zero.Clone().One()
+	for i, s := range scalars {
+		prefix[i] = acc.Clone()
+		if nonZero[i] = !s.Equal(zero); nonZero[i] {
+			acc.Mul(acc, s)
+		}
+	}
+
+	// From the last to the first, acc is the inverse of prefix[i+1].
+	acc.Inv(acc)
+	for i := len(scalars) - 1; i >= 0; i-- {
+		if !nonZero[i] {
+			continue
+		}
+		s := scalars[i]
+		prefix[i].Mul(prefix[i], acc)
+		acc.Mul(acc, s)
+		s.Set(prefix[i])
+	}
+}
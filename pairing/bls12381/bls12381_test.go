@@ -0,0 +1,180 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/util/test"
+)
+
+var tSuite = NewBlakeSHA256BLS12381()
+
+// Ensure Suite implements the pairing.Suite interface.
+var _ pairing.Suite = tSuite
+
+func TestSuite(t *testing.T) { test.SuiteTest(tSuite) }
+func TestG1(t *testing.T)    { test.GroupTest(tSuite.G1()) }
+func TestG2(t *testing.T)    { test.GroupTest(tSuite.G2()) }
+func TestGT(t *testing.T)    { test.GroupTest(tSuite.GT()) }
+
+func TestGenerators(t *testing.T) {
+	g1 := "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb"
+	g2 := "93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e" +
+		"024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8"
+	if s := tSuite.G1().Point().Base().String(); s != g1 {
+		t.Fatal("unexpected G1 generator:", s)
+	}
+	if s := tSuite.G2().Point().Base().String(); s != g2 {
+		t.Fatal("unexpected G2 generator:", s)
+	}
+}
+
+func TestInfinity(t *testing.T) {
+	for _, g := range []kyber.Group{tSuite.G1(), tSuite.G2()} {
+		P := g.Point().Null()
+		b, err := P.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b[0] != 0xc0 {
+			t.Fatalf("%s: unexpected infinity encoding %x", g, b)
+		}
+		Q := g.Point().Base()
+		if err := Q.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+		if !Q.Equal(P) {
+			t.Fatalf("%s: infinity does not round-trip", g)
+		}
+		b[len(b)-1] = 1
+		if err := Q.UnmarshalBinary(b); err == nil {
+			t.Fatalf("%s: accepted non-canonical infinity", g)
+		}
+	}
+}
+
+func TestInvalidEncoding(t *testing.T) {
+	// Clearing the compression flag must be rejected.
+	b, _ := tSuite.G1().Point().Base().MarshalBinary()
+	b[0] &^= 0x80
+	if err := tSuite.G1().Point().UnmarshalBinary(b); err == nil {
+		t.Fatal("accepted G1 encoding without compression flag")
+	}
+
+	// A valid GT element must lie in the order-r subgroup.
+	one, _ := tSuite.GT().Point().Null().MarshalBinary()
+	one[len(one)-1] = 2
+	if err := tSuite.GT().Point().UnmarshalBinary(one); err == nil {
+		t.Fatal("accepted GT element outside the subgroup")
+	}
+}
+
+func TestBilinearity(t *testing.T) {
+	rand := random.New()
+	a := tSuite.G1().Scalar().Pick(rand)
+	b := tSuite.G2().Scalar().Pick(rand)
+	ab := tSuite.GT().Scalar().Mul(a, b)
+
+	P := tSuite.G1().Point().Mul(a, nil)
+	Q := tSuite.G2().Point().Mul(b, nil)
+	left := tSuite.Pair(P, Q)
+	right := tSuite.GT().Point().Mul(ab, nil)
+	if !left.Equal(right) {
+		t.Fatal("e(aP, bQ) != e(P, Q)^(ab)")
+	}
+
+	// e(P1 + P2, Q) = e(P1, Q) * e(P2, Q)
+	P2 := tSuite.G1().Point().Pick(rand)
+	sum := tSuite.G1().Point().Add(P, P2)
+	left = tSuite.Pair(sum, Q)
+	right = tSuite.GT().Point().Add(tSuite.Pair(P, Q), tSuite.Pair(P2, Q))
+	if !left.Equal(right) {
+		t.Fatal("pairing is not linear in G1")
+	}
+}
+
+func TestNonDegeneracy(t *testing.T) {
+	g1 := tSuite.G1().Point().Base()
+	g2 := tSuite.G2().Point().Base()
+	one := tSuite.GT().Point().Null()
+	if tSuite.Pair(g1, g2).Equal(one) {
+		t.Fatal("pairing of generators is degenerate")
+	}
+	if !tSuite.Pair(tSuite.G1().Point().Null(), g2).Equal(one) {
+		t.Fatal("e(0, Q) != 1")
+	}
+	if !tSuite.Pair(g1, tSuite.G2().Point().Null()).Equal(one) {
+		t.Fatal("e(P, 0) != 1")
+	}
+}
+
+func TestPairingCheck(t *testing.T) {
+	rand := random.New()
+	a := tSuite.G1().Scalar().Pick(rand)
+	P := tSuite.G1().Point().Pick(rand)
+	Q := tSuite.G2().Point().Pick(rand)
+
+	// e(aP, Q) * e(-P, aQ) = 1
+	aP := tSuite.G1().Point().Mul(a, P)
+	nP := tSuite.G1().Point().Neg(P)
+	aQ := tSuite.G2().Point().Mul(a, Q)
+	if !tSuite.PairingCheck([]kyber.Point{aP, nP}, []kyber.Point{Q, aQ}) {
+		t.Fatal("valid pairing product rejected")
+	}
+	if tSuite.PairingCheck([]kyber.Point{aP, P}, []kyber.Point{Q, aQ}) {
+		t.Fatal("invalid pairing product accepted")
+	}
+
+	// Pairs involving infinity contribute the identity.
+	null1 := tSuite.G1().Point().Null()
+	null2 := tSuite.G2().Point().Null()
+	if !tSuite.PairingCheck([]kyber.Point{aP, null1, nP}, []kyber.Point{Q, Q, aQ}) {
+		t.Fatal("infinity in G1 not ignored")
+	}
+	if !tSuite.PairingCheck([]kyber.Point{P}, []kyber.Point{null2}) {
+		t.Fatal("e(P, 0) != 1")
+	}
+	if !tSuite.PairingCheck(nil, nil) {
+		t.Fatal("empty product != 1")
+	}
+	if tSuite.PairingCheck([]kyber.Point{P}, nil) {
+		t.Fatal("accepted inputs of different lengths")
+	}
+}
+
+func BenchmarkPair(b *testing.B) {
+	P := tSuite.G1().Point().Base()
+	Q := tSuite.G2().Point().Base()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tSuite.Pair(P, Q)
+	}
+}
+
+func BenchmarkPairingCheck(b *testing.B) {
+	P := tSuite.G1().Point().Base()
+	nP := tSuite.G1().Point().Neg(P)
+	Q := tSuite.G2().Point().Base()
+	p1 := []kyber.Point{P, nP}
+	p2 := []kyber.Point{Q, Q}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tSuite.PairingCheck(p1, p2)
+	}
+}
+
+func BenchmarkG1Mul(b *testing.B) {
+	test.NewGroupBench(tSuite.G1()).PointMul(b.N)
+}
+
+func BenchmarkG2Mul(b *testing.B) {
+	test.NewGroupBench(tSuite.G2()).PointMul(b.N)
+}
+
+func BenchmarkGTMul(b *testing.B) {
+	test.NewGroupBench(tSuite.GT()).PointMul(b.N)
+}
@@ -0,0 +1,21 @@
+// Package bls12381 implements the BLS12-381 pairing-friendly elliptic curve,
+// providing the groups G1, G2 and GT as kyber.Group implementations and the
+// optimal ate pairing between them.
+//
+// BLS12-381 was introduced by Sean Bowe for Zcash and targets about 128 bits
+// of security. Points of G1 and G2 are encoded in the compressed format used
+// by Zcash and most other implementations: 48 bytes for G1 and 96 bytes for
+// G2, with the three most-significant bits of the first byte used as flags.
+// Scalars are big-endian integers modulo the 255-bit group order.
+//
+// Field arithmetic uses 64-bit limbs in Montgomery form, but point
+// multiplication and other operations are not constant time, so this
+// package must be compiled with the "vartime" compilation flag. The
+// Montgomery multiplication of the base field is written in assembly for
+// amd64 processors with the BMI2 and ADX extensions, which makes pairings
+// about twice as fast; the other operations of the fields, and the
+// arithmetic of scalars, are written in Go. Its arm64 assembly has not been
+// run on arm64 hardware yet, and is only built with the opt-in "arm64asm"
+// compilation flag. The "purego" flag selects the Go code on all
+// architectures.
+package bls12381
@@ -0,0 +1,270 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+)
+
+// fe is an element of the base field GF(p), represented by six 64-bit
+// little-endian limbs in Montgomery form, that is, as x*R mod p for
+// R = 2^384. Elements are always kept fully reduced modulo p.
+type fe [6]uint64
+
+const feBytes = 48
+
+var (
+	// pBig is the characteristic of the base field.
+	pBig = fromHex("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab")
+
+	pLimbs = limbsFromBig(pBig)    // p as plain limbs
+	pInv   = negInverse(pLimbs[0]) // -p^-1 mod 2^64
+
+	montR = new(big.Int).Lsh(big.NewInt(1), 384)
+	// R^2 mod p as plain limbs, used to convert into Montgomery form.
+	rSq = limbsFromBig(new(big.Int).Mod(new(big.Int).Mul(montR, montR), pBig))
+
+	feZero fe
+	feOne  = limbsFromBig(new(big.Int).Mod(montR, pBig)) // R mod p
+
+	// Exponents used for square roots and inversion.
+	pMinus2      = new(big.Int).Sub(pBig, big.NewInt(2))
+	pPlus1Div4   = new(big.Int).Rsh(new(big.Int).Add(pBig, big.NewInt(1)), 2)
+	pMinus1Div2  = new(big.Int).Rsh(new(big.Int).Sub(pBig, big.NewInt(1)), 1)
+	errNonCanonF = errors.New("bls12381: non-canonical field element")
+)
+
+// negInverse returns -x^-1 mod 2^64 for odd x.
+func negInverse(x uint64) uint64 {
+	// Newton iteration, doubling the number of correct bits each time.
+	inv := uint64(1)
+	for i := 0; i < 6; i++ {
+		inv *= 2 - x*inv
+	}
+	return -inv
+}
+
+func fromHex(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("bls12381: invalid hex constant " + s)
+	}
+	return v
+}
+
+// limbsFromBig returns the plain limbs of 0 <= v < 2^384.
+func limbsFromBig(v *big.Int) fe {
+	var b [feBytes]byte
+	v.FillBytes(b[:])
+	var z fe
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 8; j++ {
+			z[i] |= uint64(b[feBytes-1-8*i-j]) << (8 * uint(j))
+		}
+	}
+	return z
+}
+
+// setBig sets z to v mod p.
+func (z *fe) setBig(v *big.Int) *fe {
+	m := new(big.Int).Mod(v, pBig)
+	l := limbsFromBig(m)
+	return z.mul(&l, &rSq)
+}
+
+// big returns the integer represented by z.
+func (z *fe) big() *big.Int {
+	var b [feBytes]byte
+	z.bytes(b[:])
+	return new(big.Int).SetBytes(b[:])
+}
+
+// bytes writes the 48-byte big-endian encoding of z into b.
+func (z *fe) bytes(b []byte) {
+	var one fe
+	one[0] = 1
+	var t fe
+	t.mul(z, &one) // leave Montgomery form
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 8; j++ {
+			b[feBytes-1-8*i-j] = byte(t[i] >> (8 * uint(j)))
+		}
+	}
+}
+
+// setBytes decodes a 48-byte big-endian integer, which must be smaller than p.
+func (z *fe) setBytes(b []byte) error {
+	v := new(big.Int).SetBytes(b[:feBytes])
+	if v.Cmp(pBig) >= 0 {
+		return errNonCanonF
+	}
+	z.setBig(v)
+	return nil
+}
+
+func (z *fe) setOne() *fe {
+	*z = feOne
+	return z
+}
+
+func (z *fe) isZero() bool {
+	return z.diff(&feZero) == 0
+}
+
+func (z *fe) equal(x *fe) bool {
+	return z.diff(x) == 0
+}
+
+// diff returns a value that is zero if and only if z = x, in constant time.
+func (z *fe) diff(x *fe) uint64 {
+	var d uint64
+	for i := range z {
+		d |= z[i] ^ x[i]
+	}
+	return d
+}
+
+// nonZero returns 1 if d is not zero and 0 otherwise, in constant time.
+func nonZero(d uint64) uint64 {
+	return (d | -d) >> 63
+}
+
+// reduce sets z to t - p if t >= p, for t < 2p given by the limbs t and the
+// carry c.
+func (z *fe) reduce(t *fe, c uint64) {
+	var r fe
+	var b uint64
+	for i := 0; i < 6; i++ {
+		r[i], b = bits.Sub64(t[i], pLimbs[i], b)
+	}
+	_, b = bits.Sub64(c, 0, b)
+	// b == 1 iff t < p, in which case t is kept.
+	mask := -b
+	for i := 0; i < 6; i++ {
+		z[i] = (t[i] & mask) | (r[i] &^ mask)
+	}
+}
+
+func (z *fe) add(x, y *fe) *fe {
+	var t fe
+	var c uint64
+	for i := 0; i < 6; i++ {
+		t[i], c = bits.Add64(x[i], y[i], c)
+	}
+	z.reduce(&t, c)
+	return z
+}
+
+func (z *fe) double(x *fe) *fe {
+	return z.add(x, x)
+}
+
+func (z *fe) sub(x, y *fe) *fe {
+	var t fe
+	var b uint64
+	for i := 0; i < 6; i++ {
+		t[i], b = bits.Sub64(x[i], y[i], b)
+	}
+	// Add p back if the subtraction borrowed.
+	mask := -b
+	var c uint64
+	for i := 0; i < 6; i++ {
+		z[i], c = bits.Add64(t[i], pLimbs[i]&mask, c)
+	}
+	return z
+}
+
+func (z *fe) neg(x *fe) *fe {
+	return z.sub(&feZero, x)
+}
+
+// mul sets z to x*y*R^-1 mod p, with the assembly of feMul where the
+// architecture has it.
+func (z *fe) mul(x, y *fe) *fe {
+	feMul(z, x, y)
+	return z
+}
+
+// mulGeneric sets z to x*y*R^-1 mod p, using the CIOS Montgomery
+// multiplication.
+func mulGeneric(z, x, y *fe) {
+	var t [8]uint64
+	for i := 0; i < 6; i++ {
+		var c, hi, lo, cc uint64
+		for j := 0; j < 6; j++ {
+			hi, lo = bits.Mul64(x[j], y[i])
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j] = lo
+			c = hi
+		}
+		t[6], cc = bits.Add64(t[6], c, 0)
+		t[7] = cc
+
+		m := t[0] * pInv
+		hi, lo = bits.Mul64(m, pLimbs[0])
+		_, cc = bits.Add64(lo, t[0], 0)
+		c = hi + cc
+		for j := 1; j < 6; j++ {
+			hi, lo = bits.Mul64(m, pLimbs[j])
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j-1] = lo
+			c = hi
+		}
+		t[5], cc = bits.Add64(t[6], c, 0)
+		t[6] = t[7] + cc
+	}
+	var r fe
+	copy(r[:], t[:6])
+	z.reduce(&r, t[6])
+}
+
+func (z *fe) square(x *fe) *fe {
+	return z.mul(x, x)
+}
+
+// exp sets z to x^e for a non-negative public exponent e.
+func (z *fe) exp(x *fe, e *big.Int) *fe {
+	var r fe
+	r.setOne()
+	b := *x
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r.square(&r)
+		if e.Bit(i) == 1 {
+			r.mul(&r, &b)
+		}
+	}
+	*z = r
+	return z
+}
+
+// inv sets z to x^-1, or to zero if x is zero.
+func (z *fe) inv(x *fe) *fe {
+	return z.exp(x, pMinus2)
+}
+
+// sqrt sets z to a square root of x and reports whether x is a square.
+// Since p = 3 mod 4, a candidate root is x^((p+1)/4).
+func (z *fe) sqrt(x *fe) bool {
+	var r, c fe
+	r.exp(x, pPlus1Div4)
+	c.square(&r)
+	if !c.equal(x) {
+		return false
+	}
+	*z = r
+	return true
+}
+
+// isLexLargest reports whether z > (p-1)/2, that is, whether z is the
+// larger of z and -z when both are seen as integers in [0, p).
+func (z *fe) isLexLargest() bool {
+	return z.big().Cmp(pMinus1Div2) > 0
+}
@@ -0,0 +1,167 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"math/big"
+)
+
+// fe12 is an element c0 + c1*w of GF(p^12) = GF(p^6)[w]/(w^2 - v).
+// Equivalently, it is the polynomial in w of degree 5 with coefficients
+// in GF(p^2) given by c0.c0 + c1.c0*w + c0.c1*w^2 + c1.c1*w^3 + c0.c2*w^4
+// + c1.c2*w^5, where w^6 = 1+u.
+type fe12 struct {
+	c0, c1 fe6
+}
+
+const fe12Bytes = 12 * feBytes
+
+var (
+	fe12One = fe12{c0: fe6One}
+
+	// frob2Coeffs[k] is gamma^k for gamma = w^(p^2-1) = (1+u)^((p^2-1)/6),
+	// so that (a*w^k)^(p^2) = a*gamma^k*w^k for a in GF(p^2).
+	frob2Coeffs = frobeniusCoeffs(new(big.Int).Mul(pBig, pBig))
+)
+
+// frobeniusCoeffs returns (1+u)^(k*(q-1)/6) for k = 0...5.
+func frobeniusCoeffs(q *big.Int) [6]fe2 {
+	var xi, gamma fe2
+	xi.c0.setOne()
+	xi.c1.setOne()
+	e := new(big.Int).Sub(q, big.NewInt(1))
+	e.Div(e, big.NewInt(6))
+	gamma.exp(&xi, e)
+
+	var c [6]fe2
+	c[0].setOne()
+	for k := 1; k < 6; k++ {
+		c[k].mul(&c[k-1], &gamma)
+	}
+	return c
+}
+
+// coeffs returns pointers to the coefficients of w^0, ..., w^5.
+func (z *fe12) coeffs() [6]*fe2 {
+	return [6]*fe2{&z.c0.c0, &z.c1.c0, &z.c0.c1, &z.c1.c1, &z.c0.c2, &z.c1.c2}
+}
+
+func (z *fe12) setOne() *fe12 {
+	*z = fe12One
+	return z
+}
+
+func (z *fe12) isOne() bool {
+	return z.equal(&fe12One)
+}
+
+func (z *fe12) equal(x *fe12) bool {
+	return z.c0.diff(&x.c0)|z.c1.diff(&x.c1) == 0
+}
+
+func (z *fe12) mul(x, y *fe12) *fe12 {
+	var t0, t1, s, u fe6
+	t0.mul(&x.c0, &y.c0)
+	t1.mul(&x.c1, &y.c1)
+	s.add(&x.c0, &x.c1)
+	u.add(&y.c0, &y.c1)
+	s.mul(&s, &u)
+	s.sub(&s, &t0)
+	z.c1.sub(&s, &t1)
+	t1.mulByV(&t1)
+	z.c0.add(&t0, &t1)
+	return z
+}
+
+// square uses the complex squaring formula, costing two multiplications
+// in GF(p^6).
+func (z *fe12) square(x *fe12) *fe12 {
+	var t, s, u fe6
+	t.mul(&x.c0, &x.c1)
+	s.add(&x.c0, &x.c1)
+	u.mulByV(&x.c1)
+	u.add(&u, &x.c0)
+	s.mul(&s, &u)
+	s.sub(&s, &t)
+	u.mulByV(&t)
+	z.c0.sub(&s, &u)
+	z.c1.double(&t)
+	return z
+}
+
+// conj sets z to x^(p^6), which is the inverse of x for elements of GT.
+func (z *fe12) conj(x *fe12) *fe12 {
+	z.c0 = x.c0
+	z.c1.neg(&x.c1)
+	return z
+}
+
+func (z *fe12) inv(x *fe12) *fe12 {
+	var t0, t1 fe6
+	t0.square(&x.c0)
+	t1.square(&x.c1)
+	t1.mulByV(&t1)
+	t0.sub(&t0, &t1)
+	t0.inv(&t0)
+	z.c0.mul(&x.c0, &t0)
+	t0.neg(&t0)
+	z.c1.mul(&x.c1, &t0)
+	return z
+}
+
+// frob2 sets z to x^(p^2).
+func (z *fe12) frob2(x *fe12) *fe12 {
+	r := *x
+	c := r.coeffs()
+	for k := 1; k < 6; k++ {
+		c[k].mul(c[k], &frob2Coeffs[k])
+	}
+	*z = r
+	return z
+}
+
+// exp sets z to x^e for a non-negative public exponent e.
+func (z *fe12) exp(x *fe12, e *big.Int) *fe12 {
+	r := fe12One
+	b := *x
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r.square(&r)
+		if e.Bit(i) == 1 {
+			r.mul(&r, &b)
+		}
+	}
+	*z = r
+	return z
+}
+
+// bytes writes the 576-byte encoding of z into b: the twelve coefficients
+// in GF(p) as big-endian integers, in the order c0.c0.c0, c0.c0.c1,
+// c0.c1.c0, ..., c1.c2.c1.
+func (z *fe12) bytes(b []byte) {
+	for i, c := range z.fpCoeffs() {
+		c.bytes(b[i*feBytes : (i+1)*feBytes])
+	}
+}
+
+// setBytes decodes the encoding produced by bytes.
+func (z *fe12) setBytes(b []byte) error {
+	var r fe12
+	for i, c := range r.fpCoeffs() {
+		if err := c.setBytes(b[i*feBytes : (i+1)*feBytes]); err != nil {
+			return err
+		}
+	}
+	*z = r
+	return nil
+}
+
+func (z *fe12) fpCoeffs() [12]*fe {
+	var c [12]*fe
+	for i, x := range [2]*fe6{&z.c0, &z.c1} {
+		for j, y := range [3]*fe2{&x.c0, &x.c1, &x.c2} {
+			c[6*i+2*j] = &y.c0
+			c[6*i+2*j+1] = &y.c1
+		}
+	}
+	return c
+}
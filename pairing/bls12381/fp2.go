@@ -0,0 +1,189 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"math/big"
+)
+
+// fe2 is an element c0 + c1*u of GF(p^2) = GF(p)[u]/(u^2 + 1).
+type fe2 struct {
+	c0, c1 fe
+}
+
+var (
+	fe2Zero fe2
+	fe2One  = fe2{c0: feOne}
+)
+
+func (z *fe2) setOne() *fe2 {
+	*z = fe2One
+	return z
+}
+
+func (z *fe2) isZero() bool {
+	return z.diff(&fe2Zero) == 0
+}
+
+func (z *fe2) equal(x *fe2) bool {
+	return z.diff(x) == 0
+}
+
+func (z *fe2) diff(x *fe2) uint64 {
+	return z.c0.diff(&x.c0) | z.c1.diff(&x.c1)
+}
+
+func (z *fe2) add(x, y *fe2) *fe2 {
+	z.c0.add(&x.c0, &y.c0)
+	z.c1.add(&x.c1, &y.c1)
+	return z
+}
+
+func (z *fe2) double(x *fe2) *fe2 {
+	return z.add(x, x)
+}
+
+func (z *fe2) sub(x, y *fe2) *fe2 {
+	z.c0.sub(&x.c0, &y.c0)
+	z.c1.sub(&x.c1, &y.c1)
+	return z
+}
+
+func (z *fe2) neg(x *fe2) *fe2 {
+	z.c0.neg(&x.c0)
+	z.c1.neg(&x.c1)
+	return z
+}
+
+// conj sets z to the conjugate c0 - c1*u of x, which is also x^p.
+func (z *fe2) conj(x *fe2) *fe2 {
+	z.c0 = x.c0
+	z.c1.neg(&x.c1)
+	return z
+}
+
+func (z *fe2) mul(x, y *fe2) *fe2 {
+	var t0, t1, t2, t3 fe
+	t0.mul(&x.c0, &y.c0)
+	t1.mul(&x.c1, &y.c1)
+	t2.add(&x.c0, &x.c1)
+	t3.add(&y.c0, &y.c1)
+	t2.mul(&t2, &t3)
+	t2.sub(&t2, &t0)
+	z.c1.sub(&t2, &t1)
+	z.c0.sub(&t0, &t1)
+	return z
+}
+
+// mulFe sets z to x*y for y in the base field.
+func (z *fe2) mulFe(x *fe2, y *fe) *fe2 {
+	z.c0.mul(&x.c0, y)
+	z.c1.mul(&x.c1, y)
+	return z
+}
+
+func (z *fe2) square(x *fe2) *fe2 {
+	var t0, t1, t2 fe
+	t0.add(&x.c0, &x.c1)
+	t1.sub(&x.c0, &x.c1)
+	t2.mul(&x.c0, &x.c1)
+	z.c0.mul(&t0, &t1)
+	z.c1.double(&t2)
+	return z
+}
+
+// mulByXi sets z to x*(1+u), where 1+u is the non-residue defining GF(p^6).
+func (z *fe2) mulByXi(x *fe2) *fe2 {
+	var t fe
+	t.sub(&x.c0, &x.c1)
+	z.c1.add(&x.c0, &x.c1)
+	z.c0 = t
+	return z
+}
+
+func (z *fe2) inv(x *fe2) *fe2 {
+	var t0, t1 fe
+	t0.square(&x.c0)
+	t1.square(&x.c1)
+	t0.add(&t0, &t1)
+	t0.inv(&t0)
+	z.c0.mul(&x.c0, &t0)
+	t0.neg(&t0)
+	z.c1.mul(&x.c1, &t0)
+	return z
+}
+
+// exp sets z to x^e for a non-negative public exponent e.
+func (z *fe2) exp(x *fe2, e *big.Int) *fe2 {
+	r := fe2One
+	b := *x
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		r.square(&r)
+		if e.Bit(i) == 1 {
+			r.mul(&r, &b)
+		}
+	}
+	*z = r
+	return z
+}
+
+// sqrt sets z to a square root of x and reports whether x is a square.
+// It uses the norm map: if z = z0 + z1*u with z^2 = x, then z0^2 + z1^2 is a
+// square root of the norm of x, and z0^2 = (x0 +/- sqrt(norm))/2.
+func (z *fe2) sqrt(x *fe2) bool {
+	var r fe2
+	if x.c1.isZero() {
+		// x lies in the base field: its root is either real or imaginary.
+		if r.c0.sqrt(&x.c0) {
+			r.c1 = feZero
+		} else {
+			var t fe
+			t.neg(&x.c0)
+			if !r.c1.sqrt(&t) {
+				return false
+			}
+			r.c0 = feZero
+		}
+		*z = r
+		return true
+	}
+
+	var n, s, t, half fe
+	n.square(&x.c0)
+	t.square(&x.c1)
+	n.add(&n, &t)
+	if !s.sqrt(&n) {
+		return false
+	}
+	half.double(&feOne)
+	half.inv(&half)
+
+	t.add(&x.c0, &s)
+	t.mul(&t, &half)
+	if !r.c0.sqrt(&t) {
+		t.sub(&x.c0, &s)
+		t.mul(&t, &half)
+		if !r.c0.sqrt(&t) {
+			return false
+		}
+	}
+	t.double(&r.c0)
+	t.inv(&t)
+	r.c1.mul(&x.c1, &t)
+
+	var check fe2
+	if !check.square(&r).equal(x) {
+		return false
+	}
+	*z = r
+	return true
+}
+
+// isLexLargest reports whether z is the lexicographically larger of z and -z,
+// comparing the c1 coordinates first, as in the Zcash encoding.
+func (z *fe2) isLexLargest() bool {
+	if !z.c1.isZero() {
+		return z.c1.isLexLargest()
+	}
+	return z.c0.isLexLargest()
+}
@@ -0,0 +1,142 @@
+// +build vartime
+
+package bls12381
+
+// fe6 is an element c0 + c1*v + c2*v^2 of GF(p^6) = GF(p^2)[v]/(v^3 - (1+u)).
+type fe6 struct {
+	c0, c1, c2 fe2
+}
+
+var (
+	fe6Zero fe6
+	fe6One  = fe6{c0: fe2One}
+)
+
+func (z *fe6) isZero() bool {
+	return z.diff(&fe6Zero) == 0
+}
+
+func (z *fe6) diff(x *fe6) uint64 {
+	return z.c0.diff(&x.c0) | z.c1.diff(&x.c1) | z.c2.diff(&x.c2)
+}
+
+func (z *fe6) add(x, y *fe6) *fe6 {
+	z.c0.add(&x.c0, &y.c0)
+	z.c1.add(&x.c1, &y.c1)
+	z.c2.add(&x.c2, &y.c2)
+	return z
+}
+
+func (z *fe6) double(x *fe6) *fe6 {
+	return z.add(x, x)
+}
+
+func (z *fe6) sub(x, y *fe6) *fe6 {
+	z.c0.sub(&x.c0, &y.c0)
+	z.c1.sub(&x.c1, &y.c1)
+	z.c2.sub(&x.c2, &y.c2)
+	return z
+}
+
+func (z *fe6) neg(x *fe6) *fe6 {
+	z.c0.neg(&x.c0)
+	z.c1.neg(&x.c1)
+	z.c2.neg(&x.c2)
+	return z
+}
+
+// mul computes x*y with the Karatsuba-like method, using six
+// multiplications in GF(p^2).
+func (z *fe6) mul(x, y *fe6) *fe6 {
+	var t0, t1, t2, s, u fe2
+	var r fe6
+	t0.mul(&x.c0, &y.c0)
+	t1.mul(&x.c1, &y.c1)
+	t2.mul(&x.c2, &y.c2)
+
+	// c0 = t0 + xi*((x1+x2)(y1+y2) - t1 - t2)
+	s.add(&x.c1, &x.c2)
+	u.add(&y.c1, &y.c2)
+	s.mul(&s, &u)
+	s.sub(&s, &t1)
+	s.sub(&s, &t2)
+	s.mulByXi(&s)
+	r.c0.add(&s, &t0)
+
+	// c1 = (x0+x1)(y0+y1) - t0 - t1 + xi*t2
+	s.add(&x.c0, &x.c1)
+	u.add(&y.c0, &y.c1)
+	s.mul(&s, &u)
+	s.sub(&s, &t0)
+	s.sub(&s, &t1)
+	u.mulByXi(&t2)
+	r.c1.add(&s, &u)
+
+	// c2 = (x0+x2)(y0+y2) - t0 - t2 + t1
+	s.add(&x.c0, &x.c2)
+	u.add(&y.c0, &y.c2)
+	s.mul(&s, &u)
+	s.sub(&s, &t0)
+	s.sub(&s, &t2)
+	r.c2.add(&s, &t1)
+
+	*z = r
+	return z
+}
+
+// mulFe2 sets z to x*y for y in GF(p^2).
+func (z *fe6) mulFe2(x *fe6, y *fe2) *fe6 {
+	z.c0.mul(&x.c0, y)
+	z.c1.mul(&x.c1, y)
+	z.c2.mul(&x.c2, y)
+	return z
+}
+
+func (z *fe6) square(x *fe6) *fe6 {
+	return z.mul(x, x)
+}
+
+// mulByV sets z to x*v.
+func (z *fe6) mulByV(x *fe6) *fe6 {
+	var t fe2
+	t.mulByXi(&x.c2)
+	z.c2 = x.c1
+	z.c1 = x.c0
+	z.c0 = t
+	return z
+}
+
+func (z *fe6) inv(x *fe6) *fe6 {
+	var a, b, c, t, f fe2
+
+	// a = x0^2 - xi*x1*x2
+	a.square(&x.c0)
+	t.mul(&x.c1, &x.c2)
+	t.mulByXi(&t)
+	a.sub(&a, &t)
+
+	// b = xi*x2^2 - x0*x1
+	b.square(&x.c2)
+	b.mulByXi(&b)
+	t.mul(&x.c0, &x.c1)
+	b.sub(&b, &t)
+
+	// c = x1^2 - x0*x2
+	c.square(&x.c1)
+	t.mul(&x.c0, &x.c2)
+	c.sub(&c, &t)
+
+	// f = x0*a + xi*(x2*b + x1*c)
+	f.mul(&x.c2, &b)
+	t.mul(&x.c1, &c)
+	f.add(&f, &t)
+	f.mulByXi(&f)
+	t.mul(&x.c0, &a)
+	f.add(&f, &t)
+	f.inv(&f)
+
+	z.c0.mul(&a, &f)
+	z.c1.mul(&b, &f)
+	z.c2.mul(&c, &f)
+	return z
+}
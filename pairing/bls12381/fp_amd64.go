@@ -0,0 +1,22 @@
+// +build vartime,!purego
+
+package bls12381
+
+import "golang.org/x/sys/cpu"
+
+// useADX tells whether the processor has the MULX instruction of BMI2 and
+// the ADCX and ADOX of ADX, which mulADX needs.
+var useADX = cpu.X86.HasBMI2 && cpu.X86.HasADX
+
+// mulADX is mulGeneric in assembly.
+//
+//go:noescape
+func mulADX(z, x, y *fe)
+
+func feMul(z, x, y *fe) {
+	if useADX {
+		mulADX(z, x, y)
+		return
+	}
+	mulGeneric(z, x, y)
+}
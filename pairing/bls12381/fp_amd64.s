@@ -0,0 +1,332 @@
+// +build vartime,!purego
+
+#include "textflag.h"
+
+// func mulADX(z, x, y *fe)
+//
+// mulADX is the CIOS Montgomery multiplication of mulGeneric, with two
+// interleaved carry chains on CF and OF, and CX held at zero. The
+// accumulator t lives in seven registers, whose names rotate by one limb
+// at the end of every round, as its lowest limb is then zero. Since
+// p < 2^381, t always fits in them.
+TEXT ·mulADX(SB), NOSPLIT, $0-24
+	MOVQ x+8(FP), SI
+	MOVQ y+16(FP), DI
+	XORQ CX, CX
+	XORQ R8, R8
+	XORQ R9, R9
+	XORQ R10, R10
+	XORQ R11, R11
+	XORQ R12, R12
+	XORQ R13, R13
+	XORQ R14, R14
+
+	// t += x*y[0]
+	MOVQ 0(DI), DX
+	XORQ AX, AX
+	MULXQ 0(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	ADOXQ CX, R14
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MOVQ R8, DX
+	IMULQ ·pInv(SB), DX
+	XORQ AX, AX
+	MULXQ ·pLimbs+0(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ ·pLimbs+8(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ ·pLimbs+16(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ ·pLimbs+24(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ ·pLimbs+32(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ ·pLimbs+40(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	ADOXQ CX, R14
+
+	// t += x*y[1]
+	MOVQ 8(DI), DX
+	XORQ AX, AX
+	MULXQ 0(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	ADOXQ CX, R8
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MOVQ R9, DX
+	IMULQ ·pInv(SB), DX
+	XORQ AX, AX
+	MULXQ ·pLimbs+0(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ ·pLimbs+8(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ ·pLimbs+16(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ ·pLimbs+24(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ ·pLimbs+32(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ ·pLimbs+40(SB), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	ADOXQ CX, R8
+
+	// t += x*y[2]
+	MOVQ 16(DI), DX
+	XORQ AX, AX
+	MULXQ 0(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	ADOXQ CX, R9
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MOVQ R10, DX
+	IMULQ ·pInv(SB), DX
+	XORQ AX, AX
+	MULXQ ·pLimbs+0(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ ·pLimbs+8(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ ·pLimbs+16(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ ·pLimbs+24(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ ·pLimbs+32(SB), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ ·pLimbs+40(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	ADOXQ CX, R9
+
+	// t += x*y[3]
+	MOVQ 24(DI), DX
+	XORQ AX, AX
+	MULXQ 0(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	ADOXQ CX, R10
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MOVQ R11, DX
+	IMULQ ·pInv(SB), DX
+	XORQ AX, AX
+	MULXQ ·pLimbs+0(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	MULXQ ·pLimbs+8(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ ·pLimbs+16(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ ·pLimbs+24(SB), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ ·pLimbs+32(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ ·pLimbs+40(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	ADOXQ CX, R10
+
+	// t += x*y[4]
+	MOVQ 32(DI), DX
+	XORQ AX, AX
+	MULXQ 0(SI), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	ADOXQ CX, R11
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MOVQ R12, DX
+	IMULQ ·pInv(SB), DX
+	XORQ AX, AX
+	MULXQ ·pLimbs+0(SB), AX, BX
+	ADOXQ AX, R12
+	ADCXQ BX, R13
+	MULXQ ·pLimbs+8(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ ·pLimbs+16(SB), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ ·pLimbs+24(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ ·pLimbs+32(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ ·pLimbs+40(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	ADOXQ CX, R11
+
+	// t += x*y[5]
+	MOVQ 40(DI), DX
+	XORQ AX, AX
+	MULXQ 0(SI), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ 8(SI), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ 16(SI), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ 24(SI), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ 32(SI), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ 40(SI), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	ADOXQ CX, R12
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MOVQ R13, DX
+	IMULQ ·pInv(SB), DX
+	XORQ AX, AX
+	MULXQ ·pLimbs+0(SB), AX, BX
+	ADOXQ AX, R13
+	ADCXQ BX, R14
+	MULXQ ·pLimbs+8(SB), AX, BX
+	ADOXQ AX, R14
+	ADCXQ BX, R8
+	MULXQ ·pLimbs+16(SB), AX, BX
+	ADOXQ AX, R8
+	ADCXQ BX, R9
+	MULXQ ·pLimbs+24(SB), AX, BX
+	ADOXQ AX, R9
+	ADCXQ BX, R10
+	MULXQ ·pLimbs+32(SB), AX, BX
+	ADOXQ AX, R10
+	ADCXQ BX, R11
+	MULXQ ·pLimbs+40(SB), AX, BX
+	ADOXQ AX, R11
+	ADCXQ BX, R12
+	ADOXQ CX, R12
+
+	// z = t - p if t >= p, and t otherwise
+	MOVQ R14, AX
+	MOVQ R8, BX
+	MOVQ R9, CX
+	MOVQ R10, DX
+	MOVQ R11, SI
+	MOVQ R12, DI
+	SUBQ ·pLimbs+0(SB), AX
+	SBBQ ·pLimbs+8(SB), BX
+	SBBQ ·pLimbs+16(SB), CX
+	SBBQ ·pLimbs+24(SB), DX
+	SBBQ ·pLimbs+32(SB), SI
+	SBBQ ·pLimbs+40(SB), DI
+	CMOVQCS R14, AX
+	CMOVQCS R8, BX
+	CMOVQCS R9, CX
+	CMOVQCS R10, DX
+	CMOVQCS R11, SI
+	CMOVQCS R12, DI
+	MOVQ z+0(FP), R14
+	MOVQ AX, 0(R14)
+	MOVQ BX, 8(R14)
+	MOVQ CX, 16(R14)
+	MOVQ DX, 24(R14)
+	MOVQ SI, 32(R14)
+	MOVQ DI, 40(R14)
+	RET
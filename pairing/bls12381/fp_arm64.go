@@ -0,0 +1,8 @@
+// +build vartime,arm64asm,!purego
+
+package bls12381
+
+// feMul is mulGeneric in assembly.
+//
+//go:noescape
+func feMul(z, x, y *fe)
@@ -0,0 +1,385 @@
+// +build vartime,arm64asm,!purego
+
+#include "textflag.h"
+
+// func feMul(z, x, y *fe)
+//
+// feMul is the CIOS Montgomery multiplication of mulGeneric, with x, p and
+// the accumulator t in registers. MUL and UMULH leave the carry flag alone,
+// so that every round adds the low halves of its products in one carry
+// chain, and the high halves in another. The names of the seven registers
+// of t rotate by one limb at the end of every round, as its lowest limb is
+// then zero; since p < 2^381, t always fits in them.
+TEXT ·feMul(SB), NOSPLIT, $0-24
+	MOVD x+8(FP), R1
+	LDP 0(R1), (R3, R4)
+	LDP 16(R1), (R5, R6)
+	LDP 32(R1), (R7, R8)
+	MOVD $·pLimbs(SB), R1
+	LDP 0(R1), (R9, R10)
+	LDP 16(R1), (R11, R12)
+	LDP 32(R1), (R13, R14)
+	MOVD ·pInv(SB), R15
+	MOVD y+16(FP), R0
+	MOVD ZR, R16
+	MOVD ZR, R17
+	MOVD ZR, R19
+	MOVD ZR, R20
+	MOVD ZR, R21
+	MOVD ZR, R22
+	MOVD ZR, R23
+
+	// t += x*y[0]
+	MOVD 0(R0), R25
+	MUL R3, R25, R24
+	ADDS R24, R16
+	MUL R4, R25, R24
+	ADCS R24, R17
+	MUL R5, R25, R24
+	ADCS R24, R19
+	MUL R6, R25, R24
+	ADCS R24, R20
+	MUL R7, R25, R24
+	ADCS R24, R21
+	MUL R8, R25, R24
+	ADCS R24, R22
+	ADC ZR, R23
+	UMULH R3, R25, R24
+	ADDS R24, R17
+	UMULH R4, R25, R24
+	ADCS R24, R19
+	UMULH R5, R25, R24
+	ADCS R24, R20
+	UMULH R6, R25, R24
+	ADCS R24, R21
+	UMULH R7, R25, R24
+	ADCS R24, R22
+	UMULH R8, R25, R24
+	ADC R24, R23
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MUL R15, R16, R25
+	MUL R9, R25, R24
+	ADDS R24, R16
+	MUL R10, R25, R24
+	ADCS R24, R17
+	MUL R11, R25, R24
+	ADCS R24, R19
+	MUL R12, R25, R24
+	ADCS R24, R20
+	MUL R13, R25, R24
+	ADCS R24, R21
+	MUL R14, R25, R24
+	ADCS R24, R22
+	ADC ZR, R23
+	UMULH R9, R25, R24
+	ADDS R24, R17
+	UMULH R10, R25, R24
+	ADCS R24, R19
+	UMULH R11, R25, R24
+	ADCS R24, R20
+	UMULH R12, R25, R24
+	ADCS R24, R21
+	UMULH R13, R25, R24
+	ADCS R24, R22
+	UMULH R14, R25, R24
+	ADC R24, R23
+
+	// t += x*y[1]
+	MOVD 8(R0), R25
+	MUL R3, R25, R24
+	ADDS R24, R17
+	MUL R4, R25, R24
+	ADCS R24, R19
+	MUL R5, R25, R24
+	ADCS R24, R20
+	MUL R6, R25, R24
+	ADCS R24, R21
+	MUL R7, R25, R24
+	ADCS R24, R22
+	MUL R8, R25, R24
+	ADCS R24, R23
+	ADC ZR, R16
+	UMULH R3, R25, R24
+	ADDS R24, R19
+	UMULH R4, R25, R24
+	ADCS R24, R20
+	UMULH R5, R25, R24
+	ADCS R24, R21
+	UMULH R6, R25, R24
+	ADCS R24, R22
+	UMULH R7, R25, R24
+	ADCS R24, R23
+	UMULH R8, R25, R24
+	ADC R24, R16
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MUL R15, R17, R25
+	MUL R9, R25, R24
+	ADDS R24, R17
+	MUL R10, R25, R24
+	ADCS R24, R19
+	MUL R11, R25, R24
+	ADCS R24, R20
+	MUL R12, R25, R24
+	ADCS R24, R21
+	MUL R13, R25, R24
+	ADCS R24, R22
+	MUL R14, R25, R24
+	ADCS R24, R23
+	ADC ZR, R16
+	UMULH R9, R25, R24
+	ADDS R24, R19
+	UMULH R10, R25, R24
+	ADCS R24, R20
+	UMULH R11, R25, R24
+	ADCS R24, R21
+	UMULH R12, R25, R24
+	ADCS R24, R22
+	UMULH R13, R25, R24
+	ADCS R24, R23
+	UMULH R14, R25, R24
+	ADC R24, R16
+
+	// t += x*y[2]
+	MOVD 16(R0), R25
+	MUL R3, R25, R24
+	ADDS R24, R19
+	MUL R4, R25, R24
+	ADCS R24, R20
+	MUL R5, R25, R24
+	ADCS R24, R21
+	MUL R6, R25, R24
+	ADCS R24, R22
+	MUL R7, R25, R24
+	ADCS R24, R23
+	MUL R8, R25, R24
+	ADCS R24, R16
+	ADC ZR, R17
+	UMULH R3, R25, R24
+	ADDS R24, R20
+	UMULH R4, R25, R24
+	ADCS R24, R21
+	UMULH R5, R25, R24
+	ADCS R24, R22
+	UMULH R6, R25, R24
+	ADCS R24, R23
+	UMULH R7, R25, R24
+	ADCS R24, R16
+	UMULH R8, R25, R24
+	ADC R24, R17
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MUL R15, R19, R25
+	MUL R9, R25, R24
+	ADDS R24, R19
+	MUL R10, R25, R24
+	ADCS R24, R20
+	MUL R11, R25, R24
+	ADCS R24, R21
+	MUL R12, R25, R24
+	ADCS R24, R22
+	MUL R13, R25, R24
+	ADCS R24, R23
+	MUL R14, R25, R24
+	ADCS R24, R16
+	ADC ZR, R17
+	UMULH R9, R25, R24
+	ADDS R24, R20
+	UMULH R10, R25, R24
+	ADCS R24, R21
+	UMULH R11, R25, R24
+	ADCS R24, R22
+	UMULH R12, R25, R24
+	ADCS R24, R23
+	UMULH R13, R25, R24
+	ADCS R24, R16
+	UMULH R14, R25, R24
+	ADC R24, R17
+
+	// t += x*y[3]
+	MOVD 24(R0), R25
+	MUL R3, R25, R24
+	ADDS R24, R20
+	MUL R4, R25, R24
+	ADCS R24, R21
+	MUL R5, R25, R24
+	ADCS R24, R22
+	MUL R6, R25, R24
+	ADCS R24, R23
+	MUL R7, R25, R24
+	ADCS R24, R16
+	MUL R8, R25, R24
+	ADCS R24, R17
+	ADC ZR, R19
+	UMULH R3, R25, R24
+	ADDS R24, R21
+	UMULH R4, R25, R24
+	ADCS R24, R22
+	UMULH R5, R25, R24
+	ADCS R24, R23
+	UMULH R6, R25, R24
+	ADCS R24, R16
+	UMULH R7, R25, R24
+	ADCS R24, R17
+	UMULH R8, R25, R24
+	ADC R24, R19
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MUL R15, R20, R25
+	MUL R9, R25, R24
+	ADDS R24, R20
+	MUL R10, R25, R24
+	ADCS R24, R21
+	MUL R11, R25, R24
+	ADCS R24, R22
+	MUL R12, R25, R24
+	ADCS R24, R23
+	MUL R13, R25, R24
+	ADCS R24, R16
+	MUL R14, R25, R24
+	ADCS R24, R17
+	ADC ZR, R19
+	UMULH R9, R25, R24
+	ADDS R24, R21
+	UMULH R10, R25, R24
+	ADCS R24, R22
+	UMULH R11, R25, R24
+	ADCS R24, R23
+	UMULH R12, R25, R24
+	ADCS R24, R16
+	UMULH R13, R25, R24
+	ADCS R24, R17
+	UMULH R14, R25, R24
+	ADC R24, R19
+
+	// t += x*y[4]
+	MOVD 32(R0), R25
+	MUL R3, R25, R24
+	ADDS R24, R21
+	MUL R4, R25, R24
+	ADCS R24, R22
+	MUL R5, R25, R24
+	ADCS R24, R23
+	MUL R6, R25, R24
+	ADCS R24, R16
+	MUL R7, R25, R24
+	ADCS R24, R17
+	MUL R8, R25, R24
+	ADCS R24, R19
+	ADC ZR, R20
+	UMULH R3, R25, R24
+	ADDS R24, R22
+	UMULH R4, R25, R24
+	ADCS R24, R23
+	UMULH R5, R25, R24
+	ADCS R24, R16
+	UMULH R6, R25, R24
+	ADCS R24, R17
+	UMULH R7, R25, R24
+	ADCS R24, R19
+	UMULH R8, R25, R24
+	ADC R24, R20
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MUL R15, R21, R25
+	MUL R9, R25, R24
+	ADDS R24, R21
+	MUL R10, R25, R24
+	ADCS R24, R22
+	MUL R11, R25, R24
+	ADCS R24, R23
+	MUL R12, R25, R24
+	ADCS R24, R16
+	MUL R13, R25, R24
+	ADCS R24, R17
+	MUL R14, R25, R24
+	ADCS R24, R19
+	ADC ZR, R20
+	UMULH R9, R25, R24
+	ADDS R24, R22
+	UMULH R10, R25, R24
+	ADCS R24, R23
+	UMULH R11, R25, R24
+	ADCS R24, R16
+	UMULH R12, R25, R24
+	ADCS R24, R17
+	UMULH R13, R25, R24
+	ADCS R24, R19
+	UMULH R14, R25, R24
+	ADC R24, R20
+
+	// t += x*y[5]
+	MOVD 40(R0), R25
+	MUL R3, R25, R24
+	ADDS R24, R22
+	MUL R4, R25, R24
+	ADCS R24, R23
+	MUL R5, R25, R24
+	ADCS R24, R16
+	MUL R6, R25, R24
+	ADCS R24, R17
+	MUL R7, R25, R24
+	ADCS R24, R19
+	MUL R8, R25, R24
+	ADCS R24, R20
+	ADC ZR, R21
+	UMULH R3, R25, R24
+	ADDS R24, R23
+	UMULH R4, R25, R24
+	ADCS R24, R16
+	UMULH R5, R25, R24
+	ADCS R24, R17
+	UMULH R6, R25, R24
+	ADCS R24, R19
+	UMULH R7, R25, R24
+	ADCS R24, R20
+	UMULH R8, R25, R24
+	ADC R24, R21
+
+	// t = (t + m*p) / 2^64, with m = t[0] * -p^-1 mod 2^64
+	MUL R15, R22, R25
+	MUL R9, R25, R24
+	ADDS R24, R22
+	MUL R10, R25, R24
+	ADCS R24, R23
+	MUL R11, R25, R24
+	ADCS R24, R16
+	MUL R12, R25, R24
+	ADCS R24, R17
+	MUL R13, R25, R24
+	ADCS R24, R19
+	MUL R14, R25, R24
+	ADCS R24, R20
+	ADC ZR, R21
+	UMULH R9, R25, R24
+	ADDS R24, R23
+	UMULH R10, R25, R24
+	ADCS R24, R16
+	UMULH R11, R25, R24
+	ADCS R24, R17
+	UMULH R12, R25, R24
+	ADCS R24, R19
+	UMULH R13, R25, R24
+	ADCS R24, R20
+	UMULH R14, R25, R24
+	ADC R24, R21
+
+	// z = t - p if t >= p, and t otherwise
+	SUBS R9, R23, R3
+	SBCS R10, R16, R4
+	SBCS R11, R17, R5
+	SBCS R12, R19, R6
+	SBCS R13, R20, R7
+	SBCS R14, R21, R8
+	CSEL CC, R23, R3, R3
+	CSEL CC, R16, R4, R4
+	CSEL CC, R17, R5, R5
+	CSEL CC, R19, R6, R6
+	CSEL CC, R20, R7, R7
+	CSEL CC, R21, R8, R8
+	MOVD z+0(FP), R1
+	STP (R3, R4), 0(R1)
+	STP (R5, R6), 16(R1)
+	STP (R7, R8), 32(R1)
+	RET
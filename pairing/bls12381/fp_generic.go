@@ -0,0 +1,8 @@
+// +build vartime
+// +build !amd64,!arm64 arm64,!arm64asm purego
+
+package bls12381
+
+func feMul(z, x, y *fe) {
+	mulGeneric(z, x, y)
+}
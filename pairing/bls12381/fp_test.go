@@ -0,0 +1,46 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+func TestFeMul(t *testing.T) {
+	rand := random.New()
+	pMinus1 := new(big.Int).Sub(pBig, big.NewInt(1))
+	values := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), pMinus1}
+	for i := 0; i < 100; i++ {
+		values = append(values, random.Int(pBig, rand))
+	}
+	rInv := new(big.Int).ModInverse(montR, pBig)
+	for i, a := range values {
+		b := values[(i+1)%len(values)]
+		x := limbsFromBig(a)
+		y := limbsFromBig(b)
+		var z, w fe
+		feMul(&z, &x, &y)
+		mulGeneric(&w, &x, &y)
+		if z != w {
+			t.Fatalf("feMul(%x, %x) = %x, not %x", a, b, z, w)
+		}
+		want := new(big.Int).Mul(a, b)
+		want.Mul(want, rInv).Mod(want, pBig)
+		if z != limbsFromBig(want) {
+			t.Fatalf("feMul(%x, %x) is not a Montgomery product", a, b)
+		}
+	}
+}
+
+func BenchmarkFeMul(b *testing.B) {
+	rand := random.New()
+	var z fe
+	z.setBig(random.Int(pBig, rand))
+	x := z
+	for i := 0; i < b.N; i++ {
+		z.mul(&z, &x)
+	}
+}
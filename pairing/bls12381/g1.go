@@ -0,0 +1,434 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/fixedbase"
+	"github.com/dedis/kyber/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+)
+
+// g1Point is a point of E(GF(p)): y^2 = x^3 + 4 in Jacobian coordinates,
+// representing (x/z^2, y/z^3). The point at infinity has z = 0.
+type g1Point struct {
+	x, y, z fe
+}
+
+const g1Bytes = feBytes
+
+// Flags in the most-significant bits of compressed encodings.
+const (
+	flagCompressed = 0x80
+	flagInfinity   = 0x40
+	flagSign       = 0x20
+)
+
+var (
+	g1B = *new(fe).setBig(big.NewInt(4))
+
+	g1Gen = g1Point{
+		x: *new(fe).setBig(fromHex("17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb")),
+		y: *new(fe).setBig(fromHex("08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1")),
+		z: feOne,
+	}
+
+	// g1Cofactor is the effective cofactor 1 - x of G1, where x is the
+	// BLS parameter -0xd201000000010000. Multiplying by it maps any point
+	// of the curve into the prime-order subgroup.
+	g1Cofactor = fromHex("d201000000010001")
+
+	errInvalidG1 = errors.New("bls12381: invalid G1 point")
+)
+
+func (p *g1Point) setInfinity() *g1Point {
+	p.x.setOne()
+	p.y.setOne()
+	p.z = feZero
+	return p
+}
+
+func (p *g1Point) isInfinity() bool {
+	return p.z.isZero()
+}
+
+// setAffine sets p to (x, y) without checking that it lies on the curve.
+func (p *g1Point) setAffine(x, y *fe) *g1Point {
+	p.x, p.y = *x, *y
+	p.z.setOne()
+	return p
+}
+
+// affine returns the affine coordinates of p, which must not be infinity.
+func (p *g1Point) affine() (x, y fe) {
+	var zInv, zInv2 fe
+	zInv.inv(&p.z)
+	zInv2.square(&zInv)
+	x.mul(&p.x, &zInv2)
+	y.mul(&p.y, &zInv2)
+	y.mul(&y, &zInv)
+	return x, y
+}
+
+func (p *g1Point) neg(a *g1Point) *g1Point {
+	p.x = a.x
+	p.y.neg(&a.y)
+	p.z = a.z
+	return p
+}
+
+// double sets p to 2a, using the "dbl-2009-l" formulas for a = 0.
+func (p *g1Point) double(a *g1Point) *g1Point {
+	if a.isInfinity() {
+		*p = *a
+		return p
+	}
+	var A, B, C, D, E, F, t fe
+	A.square(&a.x)
+	B.square(&a.y)
+	C.square(&B)
+	D.add(&a.x, &B)
+	D.square(&D)
+	D.sub(&D, &A)
+	D.sub(&D, &C)
+	D.double(&D)
+	E.double(&A)
+	E.add(&E, &A)
+	F.square(&E)
+
+	var r g1Point
+	r.x.sub(&F, t.double(&D))
+	r.y.sub(&D, &r.x)
+	r.y.mul(&r.y, &E)
+	t.double(&C)
+	t.double(&t)
+	t.double(&t)
+	r.y.sub(&r.y, &t)
+	r.z.mul(&a.y, &a.z)
+	r.z.double(&r.z)
+	*p = r
+	return p
+}
+
+// add sets p to a+b, using the "add-2007-bl" formulas.
+func (p *g1Point) add(a, b *g1Point) *g1Point {
+	if a.isInfinity() {
+		*p = *b
+		return p
+	}
+	if b.isInfinity() {
+		*p = *a
+		return p
+	}
+	var z1z1, z2z2, u1, u2, s1, s2, h, i, j, rr, v, t fe
+	z1z1.square(&a.z)
+	z2z2.square(&b.z)
+	u1.mul(&a.x, &z2z2)
+	u2.mul(&b.x, &z1z1)
+	s1.mul(&a.y, &b.z)
+	s1.mul(&s1, &z2z2)
+	s2.mul(&b.y, &a.z)
+	s2.mul(&s2, &z1z1)
+	if u1.equal(&u2) {
+		if s1.equal(&s2) {
+			return p.double(a)
+		}
+		return p.setInfinity()
+	}
+	h.sub(&u2, &u1)
+	i.double(&h)
+	i.square(&i)
+	j.mul(&h, &i)
+	rr.sub(&s2, &s1)
+	rr.double(&rr)
+	v.mul(&u1, &i)
+
+	var r g1Point
+	r.x.square(&rr)
+	r.x.sub(&r.x, &j)
+	r.x.sub(&r.x, t.double(&v))
+	r.y.sub(&v, &r.x)
+	r.y.mul(&r.y, &rr)
+	t.mul(&s1, &j)
+	t.double(&t)
+	r.y.sub(&r.y, &t)
+	r.z.add(&a.z, &b.z)
+	r.z.square(&r.z)
+	r.z.sub(&r.z, &z1z1)
+	r.z.sub(&r.z, &z2z2)
+	r.z.mul(&r.z, &h)
+	*p = r
+	return p
+}
+
+// mul sets p to k*a for a non-negative integer k.
+func (p *g1Point) mul(a *g1Point, k *big.Int) *g1Point {
+	var r g1Point
+	r.setInfinity()
+	b := *a
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		r.double(&r)
+		if k.Bit(i) == 1 {
+			r.add(&r, &b)
+		}
+	}
+	*p = r
+	return p
+}
+
+// equal reports whether p and b are the same point, in constant time. Two
+// points at infinity pass the comparison of the coordinates, whose terms
+// are all zero, so only one point at infinity needs to be told apart.
+func (p *g1Point) equal(b *g1Point) bool {
+	var z1z1, z2z2, t0, t1 fe
+	z1z1.square(&p.z)
+	z2z2.square(&b.z)
+	t0.mul(&p.x, &z2z2)
+	t1.mul(&b.x, &z1z1)
+	d := t0.diff(&t1)
+	t0.mul(&p.y, &b.z)
+	t0.mul(&t0, &z2z2)
+	t1.mul(&b.y, &p.z)
+	t1.mul(&t1, &z1z1)
+	d |= t0.diff(&t1)
+	d |= nonZero(p.z.diff(&feZero)) ^ nonZero(b.z.diff(&feZero))
+	return d == 0
+}
+
+// g1Rhs returns x^3 + 4.
+func g1Rhs(x *fe) fe {
+	var t fe
+	t.square(x)
+	t.mul(&t, x)
+	t.add(&t, &g1B)
+	return t
+}
+
+// setX sets p to the point with x-coordinate x whose y-coordinate is the
+// lexicographically larger root if largest is set, and reports whether
+// such a point exists.
+func (p *g1Point) setX(x *fe, largest bool) bool {
+	var y fe
+	rhs := g1Rhs(x)
+	if !y.sqrt(&rhs) {
+		return false
+	}
+	if y.isLexLargest() != largest {
+		y.neg(&y)
+	}
+	p.setAffine(x, &y)
+	return true
+}
+
+func (p *g1Point) onCurve() bool {
+	if p.isInfinity() {
+		return true
+	}
+	x, y := p.affine()
+	rhs := g1Rhs(&x)
+	return y.square(&y).equal(&rhs)
+}
+
+func (p *g1Point) inSubgroup() bool {
+	var t g1Point
+	return t.mul(p, orderBig).isInfinity()
+}
+
+// bytes returns the compressed encoding of p.
+func (p *g1Point) bytes() []byte {
+	b := make([]byte, g1Bytes)
+	if p.isInfinity() {
+		b[0] = flagCompressed | flagInfinity
+		return b
+	}
+	x, y := p.affine()
+	x.bytes(b)
+	b[0] |= flagCompressed
+	if y.isLexLargest() {
+		b[0] |= flagSign
+	}
+	return b
+}
+
+// setBytes decodes a compressed point, checking that it belongs to G1.
+func (p *g1Point) setBytes(buf []byte) error {
+	if len(buf) != g1Bytes || buf[0]&flagCompressed == 0 {
+		return errInvalidG1
+	}
+	if buf[0]&flagInfinity != 0 {
+		if buf[0] != flagCompressed|flagInfinity || !isZero(buf[1:]) {
+			return errInvalidG1
+		}
+		p.setInfinity()
+		return nil
+	}
+
+	b := make([]byte, g1Bytes)
+	copy(b, buf)
+	b[0] &^= flagCompressed | flagInfinity | flagSign
+	var x fe
+	if err := x.setBytes(b); err != nil {
+		return err
+	}
+	var r g1Point
+	if !r.setX(&x, buf[0]&flagSign != 0) || !r.inSubgroup() {
+		return errInvalidG1
+	}
+	*p = r
+	return nil
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// g1Table holds the multiples of the generator, built on its first use.
+var (
+	g1Table     *fixedbase.Table
+	g1TableOnce sync.Once
+)
+
+type pointG1 struct {
+	g g1Point
+}
+
+func newPointG1() *pointG1 {
+	p := new(pointG1)
+	p.g.setInfinity()
+	return p
+}
+
+func (p *pointG1) String() string {
+	return hex.EncodeToString(p.g.bytes())
+}
+
+func (p *pointG1) Equal(q kyber.Point) bool {
+	return p.g.equal(&q.(*pointG1).g)
+}
+
+func (p *pointG1) Null() kyber.Point {
+	p.g.setInfinity()
+	return p
+}
+
+func (p *pointG1) Base() kyber.Point {
+	p.g = g1Gen
+	return p
+}
+
+// Pick maps random bytes from rand to a curve point, and multiplies it by
+// the cofactor. When rand is an XOF seeded with a message, this hashes the
+// message to a point of unknown discrete logarithm.
+func (p *pointG1) Pick(rand cipher.Stream) kyber.Point {
+	var b [feBytes + 16 + 1]byte
+	for {
+		random.Bytes(b[:], rand)
+		var x fe
+		x.setBig(new(big.Int).SetBytes(b[1:]))
+		var r g1Point
+		if !r.setX(&x, b[0]&1 == 1) {
+			continue
+		}
+		r.mul(&r, g1Cofactor)
+		if !r.isInfinity() {
+			p.g = r
+			return p
+		}
+	}
+}
+
+func (p *pointG1) Set(q kyber.Point) kyber.Point {
+	p.g = q.(*pointG1).g
+	return p
+}
+
+func (p *pointG1) Clone() kyber.Point {
+	return &pointG1{g: p.g}
+}
+
+// EmbedLen returns 0: because of the cofactor of the curve, data cannot be
+// embedded in the coordinates of points of G1.
+func (p *pointG1) EmbedLen() int {
+	return 0
+}
+
+// Embed ignores data, which cannot be embedded, and picks a random point.
+func (p *pointG1) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	return p.Pick(rand)
+}
+
+// Data always returns an empty slice since no data is embedded in points.
+func (p *pointG1) Data() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (p *pointG1) Add(a, b kyber.Point) kyber.Point {
+	p.g.add(&a.(*pointG1).g, &b.(*pointG1).g)
+	return p
+}
+
+func (p *pointG1) Sub(a, b kyber.Point) kyber.Point {
+	var nb g1Point
+	nb.neg(&b.(*pointG1).g)
+	p.g.add(&a.(*pointG1).g, &nb)
+	return p
+}
+
+func (p *pointG1) Neg(a kyber.Point) kyber.Point {
+	p.g.neg(&a.(*pointG1).g)
+	return p
+}
+
+// Mul multiplies point a by scalar s, or the generator if a is nil, which
+// it does with the table of multiples of g1Table.
+func (p *pointG1) Mul(s kyber.Scalar, a kyber.Point) kyber.Point {
+	if a == nil {
+		g1TableOnce.Do(func() { g1Table = fixedbase.New(new(groupG1), nil) })
+		return g1Table.MulTo(p, s)
+	}
+	p.g.mul(&a.(*pointG1).g, &s.(*mod.Int).V)
+	return p
+}
+
+// Precompute returns a kyber.FixedBase for p.
+func (p *pointG1) Precompute() kyber.FixedBase {
+	return fixedbase.New(new(groupG1), p)
+}
+
+// Valid reports whether p is on the curve and in the subgroup G1.
+func (p *pointG1) Valid() bool {
+	return p.g.onCurve() && p.g.inSubgroup()
+}
+
+func (p *pointG1) MarshalSize() int {
+	return g1Bytes
+}
+
+func (p *pointG1) MarshalBinary() ([]byte, error) {
+	return p.g.bytes(), nil
+}
+
+func (p *pointG1) UnmarshalBinary(buf []byte) error {
+	return p.g.setBytes(buf)
+}
+
+func (p *pointG1) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(p, w)
+}
+
+func (p *pointG1) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(p, r)
+}
@@ -0,0 +1,473 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/fixedbase"
+	"github.com/dedis/kyber/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+)
+
+// g2Point is a point of the sextic twist E'(GF(p^2)): y^2 = x^3 + 4(1+u)
+// in Jacobian coordinates. The point at infinity has z = 0.
+type g2Point struct {
+	x, y, z fe2
+}
+
+const g2Bytes = 2 * feBytes
+
+var (
+	g2B = fe2{c0: g1B, c1: g1B}
+
+	g2Gen = g2Point{
+		x: fe2{
+			c0: *new(fe).setBig(fromHex("024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8")),
+			c1: *new(fe).setBig(fromHex("13e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e")),
+		},
+		y: fe2{
+			c0: *new(fe).setBig(fromHex("0ce5d527727d6e118cc9cdc6da2e351aadfd9baa8cbdd3a76d429a695160d12c923ac9cc3baca289e193548608b82801")),
+			c1: *new(fe).setBig(fromHex("0606c4a02ea734cc32acd2b02bc28b99cb3e287e85a763af267492ab572e99ab3f370d275cec1da1aaa9075ff05f79be")),
+		},
+		z: fe2One,
+	}
+
+	// blsX is the absolute value of the (negative) BLS parameter x.
+	blsX = fromHex("d201000000010000")
+
+	// psiX and psiY are 1/(1+u)^((p-1)/3) and 1/(1+u)^((p-1)/2), the
+	// constants of the untwist-Frobenius-twist endomorphism psi.
+	psiX, psiY = psiCoeffs()
+
+	errInvalidG2 = errors.New("bls12381: invalid G2 point")
+)
+
+func psiCoeffs() (fe2, fe2) {
+	var xi, cx, cy fe2
+	xi.c0.setOne()
+	xi.c1.setOne()
+	e := new(big.Int).Sub(pBig, big.NewInt(1))
+	cx.exp(&xi, new(big.Int).Div(e, big.NewInt(3)))
+	cy.exp(&xi, new(big.Int).Div(e, big.NewInt(2)))
+	cx.inv(&cx)
+	cy.inv(&cy)
+	return cx, cy
+}
+
+func (p *g2Point) setInfinity() *g2Point {
+	p.x.setOne()
+	p.y.setOne()
+	p.z = fe2Zero
+	return p
+}
+
+func (p *g2Point) isInfinity() bool {
+	return p.z.isZero()
+}
+
+func (p *g2Point) setAffine(x, y *fe2) *g2Point {
+	p.x, p.y = *x, *y
+	p.z.setOne()
+	return p
+}
+
+// affine returns the affine coordinates of p, which must not be infinity.
+func (p *g2Point) affine() (x, y fe2) {
+	var zInv, zInv2 fe2
+	zInv.inv(&p.z)
+	zInv2.square(&zInv)
+	x.mul(&p.x, &zInv2)
+	y.mul(&p.y, &zInv2)
+	y.mul(&y, &zInv)
+	return x, y
+}
+
+func (p *g2Point) neg(a *g2Point) *g2Point {
+	p.x = a.x
+	p.y.neg(&a.y)
+	p.z = a.z
+	return p
+}
+
+// double sets p to 2a, using the "dbl-2009-l" formulas for a = 0.
+func (p *g2Point) double(a *g2Point) *g2Point {
+	if a.isInfinity() {
+		*p = *a
+		return p
+	}
+	var A, B, C, D, E, F, t fe2
+	A.square(&a.x)
+	B.square(&a.y)
+	C.square(&B)
+	D.add(&a.x, &B)
+	D.square(&D)
+	D.sub(&D, &A)
+	D.sub(&D, &C)
+	D.double(&D)
+	E.double(&A)
+	E.add(&E, &A)
+	F.square(&E)
+
+	var r g2Point
+	r.x.sub(&F, t.double(&D))
+	r.y.sub(&D, &r.x)
+	r.y.mul(&r.y, &E)
+	t.double(&C)
+	t.double(&t)
+	t.double(&t)
+	r.y.sub(&r.y, &t)
+	r.z.mul(&a.y, &a.z)
+	r.z.double(&r.z)
+	*p = r
+	return p
+}
+
+// add sets p to a+b, using the "add-2007-bl" formulas.
+func (p *g2Point) add(a, b *g2Point) *g2Point {
+	if a.isInfinity() {
+		*p = *b
+		return p
+	}
+	if b.isInfinity() {
+		*p = *a
+		return p
+	}
+	var z1z1, z2z2, u1, u2, s1, s2, h, i, j, rr, v, t fe2
+	z1z1.square(&a.z)
+	z2z2.square(&b.z)
+	u1.mul(&a.x, &z2z2)
+	u2.mul(&b.x, &z1z1)
+	s1.mul(&a.y, &b.z)
+	s1.mul(&s1, &z2z2)
+	s2.mul(&b.y, &a.z)
+	s2.mul(&s2, &z1z1)
+	if u1.equal(&u2) {
+		if s1.equal(&s2) {
+			return p.double(a)
+		}
+		return p.setInfinity()
+	}
+	h.sub(&u2, &u1)
+	i.double(&h)
+	i.square(&i)
+	j.mul(&h, &i)
+	rr.sub(&s2, &s1)
+	rr.double(&rr)
+	v.mul(&u1, &i)
+
+	var r g2Point
+	r.x.square(&rr)
+	r.x.sub(&r.x, &j)
+	r.x.sub(&r.x, t.double(&v))
+	r.y.sub(&v, &r.x)
+	r.y.mul(&r.y, &rr)
+	t.mul(&s1, &j)
+	t.double(&t)
+	r.y.sub(&r.y, &t)
+	r.z.add(&a.z, &b.z)
+	r.z.square(&r.z)
+	r.z.sub(&r.z, &z1z1)
+	r.z.sub(&r.z, &z2z2)
+	r.z.mul(&r.z, &h)
+	*p = r
+	return p
+}
+
+// mul sets p to k*a for a non-negative integer k.
+func (p *g2Point) mul(a *g2Point, k *big.Int) *g2Point {
+	var r g2Point
+	r.setInfinity()
+	b := *a
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		r.double(&r)
+		if k.Bit(i) == 1 {
+			r.add(&r, &b)
+		}
+	}
+	*p = r
+	return p
+}
+
+// equal reports whether p and b are the same point, in constant time. Two
+// points at infinity pass the comparison of the coordinates, whose terms
+// are all zero, so only one point at infinity needs to be told apart.
+func (p *g2Point) equal(b *g2Point) bool {
+	var z1z1, z2z2, t0, t1 fe2
+	z1z1.square(&p.z)
+	z2z2.square(&b.z)
+	t0.mul(&p.x, &z2z2)
+	t1.mul(&b.x, &z1z1)
+	d := t0.diff(&t1)
+	t0.mul(&p.y, &b.z)
+	t0.mul(&t0, &z2z2)
+	t1.mul(&b.y, &p.z)
+	t1.mul(&t1, &z1z1)
+	d |= t0.diff(&t1)
+	d |= nonZero(p.z.diff(&fe2Zero)) ^ nonZero(b.z.diff(&fe2Zero))
+	return d == 0
+}
+
+// psi sets p to psi(a), the endomorphism obtained by mapping a to the curve
+// over GF(p^12), applying the Frobenius map, and mapping back to the twist.
+// In Jacobian coordinates, conjugating z preserves the represented point.
+func (p *g2Point) psi(a *g2Point) *g2Point {
+	p.x.conj(&a.x)
+	p.x.mul(&p.x, &psiX)
+	p.y.conj(&a.y)
+	p.y.mul(&p.y, &psiY)
+	p.z.conj(&a.z)
+	return p
+}
+
+// clearCofactor sets p to h_eff*a, which lies in G2, using the method of
+// Budroni and Pintore as specified in RFC 9380, Appendix G.3.
+func (p *g2Point) clearCofactor(a *g2Point) *g2Point {
+	var t1, t2, t3, n g2Point
+	t1.mul(a, blsX)
+	t1.neg(&t1) // t1 = x*a
+	t2.psi(a)
+	t3.double(a)
+	t3.psi(&t3)
+	t3.psi(&t3)
+	t3.add(&t3, n.neg(&t2))
+	t2.add(&t1, &t2)
+	t2.mul(&t2, blsX)
+	t2.neg(&t2)
+	t3.add(&t3, &t2)
+	t3.add(&t3, n.neg(&t1))
+	return p.add(&t3, n.neg(a))
+}
+
+// g2Rhs returns x^3 + 4(1+u).
+func g2Rhs(x *fe2) fe2 {
+	var t fe2
+	t.square(x)
+	t.mul(&t, x)
+	t.add(&t, &g2B)
+	return t
+}
+
+// setX sets p to the point with x-coordinate x whose y-coordinate is the
+// lexicographically larger root if largest is set, and reports whether
+// such a point exists.
+func (p *g2Point) setX(x *fe2, largest bool) bool {
+	var y fe2
+	rhs := g2Rhs(x)
+	if !y.sqrt(&rhs) {
+		return false
+	}
+	if y.isLexLargest() != largest {
+		y.neg(&y)
+	}
+	p.setAffine(x, &y)
+	return true
+}
+
+func (p *g2Point) onCurve() bool {
+	if p.isInfinity() {
+		return true
+	}
+	x, y := p.affine()
+	rhs := g2Rhs(&x)
+	return y.square(&y).equal(&rhs)
+}
+
+func (p *g2Point) inSubgroup() bool {
+	var t g2Point
+	return t.mul(p, orderBig).isInfinity()
+}
+
+// bytes returns the compressed encoding of p: x.c1 followed by x.c0.
+func (p *g2Point) bytes() []byte {
+	b := make([]byte, g2Bytes)
+	if p.isInfinity() {
+		b[0] = flagCompressed | flagInfinity
+		return b
+	}
+	x, y := p.affine()
+	x.c1.bytes(b[:feBytes])
+	x.c0.bytes(b[feBytes:])
+	b[0] |= flagCompressed
+	if y.isLexLargest() {
+		b[0] |= flagSign
+	}
+	return b
+}
+
+// setBytes decodes a compressed point, checking that it belongs to G2.
+func (p *g2Point) setBytes(buf []byte) error {
+	if len(buf) != g2Bytes || buf[0]&flagCompressed == 0 {
+		return errInvalidG2
+	}
+	if buf[0]&flagInfinity != 0 {
+		if buf[0] != flagCompressed|flagInfinity || !isZero(buf[1:]) {
+			return errInvalidG2
+		}
+		p.setInfinity()
+		return nil
+	}
+
+	b := make([]byte, g2Bytes)
+	copy(b, buf)
+	b[0] &^= flagCompressed | flagInfinity | flagSign
+	var x fe2
+	if err := x.c1.setBytes(b[:feBytes]); err != nil {
+		return err
+	}
+	if err := x.c0.setBytes(b[feBytes:]); err != nil {
+		return err
+	}
+	var r g2Point
+	if !r.setX(&x, buf[0]&flagSign != 0) || !r.inSubgroup() {
+		return errInvalidG2
+	}
+	*p = r
+	return nil
+}
+
+// g2Table holds the multiples of the generator, built on its first use.
+var (
+	g2Table     *fixedbase.Table
+	g2TableOnce sync.Once
+)
+
+type pointG2 struct {
+	g g2Point
+}
+
+func newPointG2() *pointG2 {
+	p := new(pointG2)
+	p.g.setInfinity()
+	return p
+}
+
+func (p *pointG2) String() string {
+	return hex.EncodeToString(p.g.bytes())
+}
+
+func (p *pointG2) Equal(q kyber.Point) bool {
+	return p.g.equal(&q.(*pointG2).g)
+}
+
+func (p *pointG2) Null() kyber.Point {
+	p.g.setInfinity()
+	return p
+}
+
+func (p *pointG2) Base() kyber.Point {
+	p.g = g2Gen
+	return p
+}
+
+// Pick maps random bytes from rand to a point of the twist, and clears its
+// cofactor. When rand is an XOF seeded with a message, this hashes the
+// message to a point of unknown discrete logarithm.
+func (p *pointG2) Pick(rand cipher.Stream) kyber.Point {
+	var b [2*(feBytes+16) + 1]byte
+	for {
+		random.Bytes(b[:], rand)
+		var x fe2
+		x.c0.setBig(new(big.Int).SetBytes(b[1 : 1+feBytes+16]))
+		x.c1.setBig(new(big.Int).SetBytes(b[1+feBytes+16:]))
+		var r g2Point
+		if !r.setX(&x, b[0]&1 == 1) {
+			continue
+		}
+		r.clearCofactor(&r)
+		if !r.isInfinity() {
+			p.g = r
+			return p
+		}
+	}
+}
+
+func (p *pointG2) Set(q kyber.Point) kyber.Point {
+	p.g = q.(*pointG2).g
+	return p
+}
+
+func (p *pointG2) Clone() kyber.Point {
+	return &pointG2{g: p.g}
+}
+
+// EmbedLen returns 0: because of the cofactor of the twist, data cannot be
+// embedded in the coordinates of points of G2.
+func (p *pointG2) EmbedLen() int {
+	return 0
+}
+
+// Embed ignores data, which cannot be embedded, and picks a random point.
+func (p *pointG2) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	return p.Pick(rand)
+}
+
+// Data always returns an empty slice since no data is embedded in points.
+func (p *pointG2) Data() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (p *pointG2) Add(a, b kyber.Point) kyber.Point {
+	p.g.add(&a.(*pointG2).g, &b.(*pointG2).g)
+	return p
+}
+
+func (p *pointG2) Sub(a, b kyber.Point) kyber.Point {
+	var nb g2Point
+	nb.neg(&b.(*pointG2).g)
+	p.g.add(&a.(*pointG2).g, &nb)
+	return p
+}
+
+func (p *pointG2) Neg(a kyber.Point) kyber.Point {
+	p.g.neg(&a.(*pointG2).g)
+	return p
+}
+
+// Mul multiplies point a by scalar s, or the generator if a is nil, which
+// it does with the table of multiples of g2Table.
+func (p *pointG2) Mul(s kyber.Scalar, a kyber.Point) kyber.Point {
+	if a == nil {
+		g2TableOnce.Do(func() { g2Table = fixedbase.New(new(groupG2), nil) })
+		return g2Table.MulTo(p, s)
+	}
+	p.g.mul(&a.(*pointG2).g, &s.(*mod.Int).V)
+	return p
+}
+
+// Precompute returns a kyber.FixedBase for p.
+func (p *pointG2) Precompute() kyber.FixedBase {
+	return fixedbase.New(new(groupG2), p)
+}
+
+// Valid reports whether p is on the twist and in the subgroup G2.
+func (p *pointG2) Valid() bool {
+	return p.g.onCurve() && p.g.inSubgroup()
+}
+
+func (p *pointG2) MarshalSize() int {
+	return g2Bytes
+}
+
+func (p *pointG2) MarshalBinary() ([]byte, error) {
+	return p.g.bytes(), nil
+}
+
+func (p *pointG2) UnmarshalBinary(buf []byte) error {
+	return p.g.setBytes(buf)
+}
+
+func (p *pointG2) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(p, w)
+}
+
+func (p *pointG2) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(p, r)
+}
@@ -0,0 +1,160 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"hash"
+	"io"
+	"reflect"
+
+	"github.com/dedis/fixbuf"
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/fips"
+	"github.com/dedis/kyber/internal/marshalling"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+// orderBig is the prime order r of G1, G2 and GT.
+var orderBig = fromHex("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001")
+
+// commonGroup implements the scalar-related methods shared by all groups.
+type commonGroup struct{}
+
+// ScalarLen returns 32, the size in bytes of an encoded Scalar.
+func (g *commonGroup) ScalarLen() int {
+	return 32
+}
+
+// Scalar creates a new Scalar modulo the group order. The scalars
+// implement kyber.Scalar's SetBytes method, interpreting the bytes as a
+// big-endian integer, as is customary for this curve.
+func (g *commonGroup) Scalar() kyber.Scalar {
+	return mod.NewInt64(0, orderBig)
+}
+
+type groupG1 struct{ commonGroup }
+
+func (g *groupG1) String() string     { return "BLS12-381.G1" }
+func (g *groupG1) PointLen() int      { return g1Bytes }
+func (g *groupG1) Point() kyber.Point { return newPointG1() }
+
+type groupG2 struct{ commonGroup }
+
+func (g *groupG2) String() string     { return "BLS12-381.G2" }
+func (g *groupG2) PointLen() int      { return g2Bytes }
+func (g *groupG2) Point() kyber.Point { return newPointG2() }
+
+type groupGT struct{ commonGroup }
+
+func (g *groupGT) String() string     { return "BLS12-381.GT" }
+func (g *groupGT) PointLen() int      { return fe12Bytes }
+func (g *groupGT) Point() kyber.Point { return newPointGT() }
+
+// Suite implements the pairing.Suite interface for the BLS12-381 curve.
+// Used as a kyber.Group itself, a Suite behaves as G1.
+type Suite struct {
+	groupG1
+	r cipher.Stream
+}
+
+// NewBlakeSHA256BLS12381 returns a pairing suite based on package
+// github.com/dedis/kyber/xof/blake, SHA-256, and the BLS12-381 curve.
+// It produces cryptographically random numbers via package crypto/rand.
+func NewBlakeSHA256BLS12381() *Suite {
+	return new(Suite)
+}
+
+// NewBlakeSHA256BLS12381Checked is NewBlakeSHA256BLS12381 for the programs
+// that may run in FIPS mode: there, it returns an error wrapping
+// suites.ErrNotFIPSApproved instead of the suite.
+func NewBlakeSHA256BLS12381Checked() (*Suite, error) {
+	if err := fips.Check("BLS12-381"); err != nil {
+		return nil, err
+	}
+	return NewBlakeSHA256BLS12381(), nil
+}
+
+// NewBlakeSHA256BLS12381WithRand returns a pairing suite based on package
+// github.com/dedis/kyber/xof/blake, SHA-256, and the BLS12-381 curve.
+// It produces cryptographically random numbers via the provided stream r.
+func NewBlakeSHA256BLS12381WithRand(r cipher.Stream) *Suite {
+	return &Suite{r: r}
+}
+
+// G1 returns the group G1 of points on the base curve.
+func (s *Suite) G1() kyber.Group {
+	return &groupG1{}
+}
+
+// G2 returns the group G2 of points on the sextic twist.
+func (s *Suite) G2() kyber.Group {
+	return &groupG2{}
+}
+
+// GT returns the target group of the pairing.
+func (s *Suite) GT() kyber.Group {
+	return &groupGT{}
+}
+
+// Pair computes the optimal ate pairing e(p1, p2)
+// of a point p1 in G1 and a point p2 in G2.
+func (s *Suite) Pair(p1, p2 kyber.Point) kyber.Point {
+	return &pointGT{f: pair(&p1.(*pointG1).g, &p2.(*pointG2).g)}
+}
+
+// PairingCheck reports whether the product of the pairings e(p1[i], p2[i])
+// of points p1[i] in G1 and p2[i] in G2 is the identity of GT, using a
+// single final exponentiation.
+func (s *Suite) PairingCheck(p1, p2 []kyber.Point) bool {
+	if len(p1) != len(p2) {
+		return false
+	}
+	ps := make([]*g1Point, len(p1))
+	qs := make([]*g2Point, len(p2))
+	for i := range p1 {
+		ps[i] = &p1[i].(*pointG1).g
+		qs[i] = &p2[i].(*pointG2).g
+	}
+	return pairingCheck(ps, qs)
+}
+
+// String returns the name of the suite, "BLS12-381".
+func (s *Suite) String() string {
+	return "BLS12-381"
+}
+
+// Hash returns a newly instanciated sha256 hash function.
+func (s *Suite) Hash() hash.Hash {
+	return sha256.New()
+}
+
+// XOF returns an XOF which is implemented via the Blake2b hash.
+func (s *Suite) XOF(key []byte) kyber.XOF {
+	return blake.New(key)
+}
+
+func (s *Suite) Read(r io.Reader, objs ...interface{}) error {
+	return fixbuf.Read(r, s, objs...)
+}
+
+func (s *Suite) Write(w io.Writer, objs ...interface{}) error {
+	return fixbuf.Write(w, objs)
+}
+
+// New implements the kyber.Encoding interface. Points are created in G1.
+func (s *Suite) New(t reflect.Type) interface{} {
+	return marshalling.GroupNew(s, t)
+}
+
+// RandomStream returns a cipher.Stream that returns a key stream
+// from crypto/rand.
+func (s *Suite) RandomStream() cipher.Stream {
+	if s.r != nil {
+		return s.r
+	}
+	return random.New()
+}
@@ -0,0 +1,158 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/mod"
+	"github.com/dedis/kyber/internal/marshalling"
+)
+
+var (
+	gtGen     fe12
+	gtGenOnce sync.Once
+
+	errInvalidGT = errors.New("bls12381: invalid GT element")
+)
+
+// gtBase returns the generator e(g1, g2) of GT.
+func gtBase() *fe12 {
+	gtGenOnce.Do(func() {
+		gtGen = pair(&g1Gen, &g2Gen)
+	})
+	return &gtGen
+}
+
+// pointGT is an element of GT, the order-r subgroup of the multiplicative
+// group of GF(p^12). Following kyber's additive notation, the group
+// operation Add is multiplication in GF(p^12), and Mul is exponentiation.
+type pointGT struct {
+	f fe12
+}
+
+func newPointGT() *pointGT {
+	return &pointGT{f: fe12One}
+}
+
+func (p *pointGT) String() string {
+	b, _ := p.MarshalBinary()
+	return hex.EncodeToString(b)
+}
+
+func (p *pointGT) Equal(q kyber.Point) bool {
+	return p.f.equal(&q.(*pointGT).f)
+}
+
+func (p *pointGT) Null() kyber.Point {
+	p.f.setOne()
+	return p
+}
+
+func (p *pointGT) Base() kyber.Point {
+	p.f = *gtBase()
+	return p
+}
+
+// Pick returns a random element of GT, as a random power of the generator.
+func (p *pointGT) Pick(rand cipher.Stream) kyber.Point {
+	s := mod.NewInt64(0, orderBig).Pick(rand)
+	return p.Mul(s, nil)
+}
+
+func (p *pointGT) Set(q kyber.Point) kyber.Point {
+	p.f = q.(*pointGT).f
+	return p
+}
+
+func (p *pointGT) Clone() kyber.Point {
+	return &pointGT{f: p.f}
+}
+
+// EmbedLen returns 0: data cannot be embedded in elements of GT.
+func (p *pointGT) EmbedLen() int {
+	return 0
+}
+
+// Embed ignores data, which cannot be embedded, and picks a random element.
+func (p *pointGT) Embed(data []byte, rand cipher.Stream) kyber.Point {
+	return p.Pick(rand)
+}
+
+// Data always returns an empty slice since no data is embedded in elements.
+func (p *pointGT) Data() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (p *pointGT) Add(a, b kyber.Point) kyber.Point {
+	p.f.mul(&a.(*pointGT).f, &b.(*pointGT).f)
+	return p
+}
+
+func (p *pointGT) Sub(a, b kyber.Point) kyber.Point {
+	var t fe12
+	t.conj(&b.(*pointGT).f)
+	p.f.mul(&a.(*pointGT).f, &t)
+	return p
+}
+
+// Neg computes the inverse of a, which for elements of GT is its conjugate.
+func (p *pointGT) Neg(a kyber.Point) kyber.Point {
+	p.f.conj(&a.(*pointGT).f)
+	return p
+}
+
+// Mul raises a to the power s, or the generator if a is nil.
+func (p *pointGT) Mul(s kyber.Scalar, a kyber.Point) kyber.Point {
+	base := gtBase()
+	if a != nil {
+		base = &a.(*pointGT).f
+	}
+	p.f.exp(base, &s.(*mod.Int).V)
+	return p
+}
+
+// Valid reports whether p is in the subgroup GT of the order of G1 and G2.
+func (p *pointGT) Valid() bool {
+	var t fe12
+	return t.exp(&p.f, orderBig).isOne()
+}
+
+func (p *pointGT) MarshalSize() int {
+	return fe12Bytes
+}
+
+func (p *pointGT) MarshalBinary() ([]byte, error) {
+	b := make([]byte, fe12Bytes)
+	p.f.bytes(b)
+	return b, nil
+}
+
+// UnmarshalBinary decodes an element, checking that it belongs to GT.
+func (p *pointGT) UnmarshalBinary(buf []byte) error {
+	if len(buf) != fe12Bytes {
+		return errInvalidGT
+	}
+	var f, t fe12
+	if err := f.setBytes(buf); err != nil {
+		return err
+	}
+	if !t.exp(&f, orderBig).isOne() {
+		return errInvalidGT
+	}
+	p.f = f
+	return nil
+}
+
+func (p *pointGT) MarshalTo(w io.Writer) (int, error) {
+	return marshalling.PointMarshalTo(p, w)
+}
+
+func (p *pointGT) UnmarshalFrom(r io.Reader) (int, error) {
+	return marshalling.PointUnmarshalFrom(p, r)
+}
@@ -0,0 +1,131 @@
+// +build vartime
+
+package bls12381
+
+import (
+	"math/big"
+)
+
+// hardExp is (p^4 - p^2 + 1)/r, the hard part of the final exponentiation.
+var hardExp = func() *big.Int {
+	p2 := new(big.Int).Mul(pBig, pBig)
+	e := new(big.Int).Mul(p2, p2)
+	e.Sub(e, p2)
+	e.Add(e, big.NewInt(1))
+	return e.Div(e, orderBig)
+}()
+
+// lineEval returns the line through the twist point (x, y) with slope
+// lambda, evaluated at the G1 point (xP, yP) after untwisting. The line is
+// scaled by w^6 = 1+u, which the final exponentiation eliminates, so that
+// only the coefficients of w^0, w^3 and w^5 are non-zero.
+func lineEval(lambda, x, y *fe2, xP, yP *fe) fe12 {
+	var l fe12
+	c := l.coeffs()
+	c[0].c0 = *yP // (1+u)*yP
+	c[0].c1 = *yP
+	c[3].mul(lambda, x)
+	c[3].sub(c[3], y)
+	c[5].mulFe(lambda, xP)
+	c[5].neg(c[5])
+	return l
+}
+
+// lineStep multiplies f by the line through (tx, ty) with slope lambda,
+// evaluated at P, and replaces (tx, ty) by the third intersection of the
+// line with the curve, negated; x2 is the x-coordinate of the second point
+// on the line, equal to tx for the tangent.
+func lineStep(f *fe12, lambda, tx, ty, x2 *fe2, xP, yP *fe) {
+	l := lineEval(lambda, tx, ty, xP, yP)
+	f.mul(f, &l)
+
+	var nx, t fe2
+	nx.square(lambda)
+	nx.sub(&nx, tx)
+	nx.sub(&nx, x2)
+	t.sub(tx, &nx)
+	t.mul(&t, lambda)
+	ty.sub(&t, ty)
+	*tx = nx
+}
+
+// millerLoop computes the Miller loop of the optimal ate pairing of the
+// affine points P of G1 and Q of G2, neither of which may be infinity.
+// The accumulated point T is kept in affine coordinates on the twist.
+func millerLoop(xP, yP *fe, xQ, yQ *fe2) fe12 {
+	f := fe12One
+	tx, ty := *xQ, *yQ
+
+	var lambda, t fe2
+	for i := blsX.BitLen() - 2; i >= 0; i-- {
+		// Doubling step: lambda = 3*tx^2 / (2*ty).
+		lambda.square(&tx)
+		t.double(&lambda)
+		lambda.add(&lambda, &t)
+		t.double(&ty)
+		t.inv(&t)
+		lambda.mul(&lambda, &t)
+		f.square(&f)
+		x2 := tx
+		lineStep(&f, &lambda, &tx, &ty, &x2, xP, yP)
+
+		if blsX.Bit(i) == 1 {
+			// Addition step: lambda = (yQ - ty) / (xQ - tx).
+			lambda.sub(yQ, &ty)
+			t.sub(xQ, &tx)
+			t.inv(&t)
+			lambda.mul(&lambda, &t)
+			lineStep(&f, &lambda, &tx, &ty, xQ, xP, yP)
+		}
+	}
+
+	// The BLS parameter x is negative.
+	f.conj(&f)
+	return f
+}
+
+// finalExp raises f to the power (p^12 - 1)/r.
+func finalExp(f *fe12) fe12 {
+	var t, r fe12
+	// Easy part: f^((p^6 - 1)(p^2 + 1)).
+	t.inv(f)
+	r.conj(f)
+	r.mul(&r, &t)
+	t.frob2(&r)
+	r.mul(&r, &t)
+	// Hard part.
+	r.exp(&r, hardExp)
+	return r
+}
+
+// pair computes the optimal ate pairing e(p, q).
+func pair(p *g1Point, q *g2Point) fe12 {
+	if p.isInfinity() || q.isInfinity() {
+		return fe12One
+	}
+	xP, yP := p.affine()
+	xQ, yQ := q.affine()
+	f := millerLoop(&xP, &yP, &xQ, &yQ)
+	return finalExp(&f)
+}
+
+// pairingCheck reports whether the product of the pairings e(ps[i], qs[i])
+// is the identity of GT. The Miller loops are multiplied together so that
+// a single final exponentiation is needed.
+func pairingCheck(ps []*g1Point, qs []*g2Point) bool {
+	if len(ps) != len(qs) {
+		return false
+	}
+	f := fe12One
+	for i := range ps {
+		if ps[i].isInfinity() || qs[i].isInfinity() {
+			continue
+		}
+		xP, yP := ps[i].affine()
+		xQ, yQ := qs[i].affine()
+		m := millerLoop(&xP, &yP, &xQ, &yQ)
+		f.mul(&f, &m)
+	}
+	r := finalExp(&f)
+	return r.isOne()
+}
@@ -0,0 +1,44 @@
+// Package pairing defines the interface implemented by pairing-friendly
+// cryptographic suites, which provide three groups G1, G2 and GT together
+// with a bilinear map e: G1 x G2 -> GT.
+package pairing
+
+import (
+	"github.com/dedis/kyber"
+)
+
+// Suite interface represents a pairing-friendly suite. Points of G1 and G2
+// are paired into points of GT; the three groups share the same prime order,
+// and hence the same scalars.
+type Suite interface {
+	G1() kyber.Group
+	G2() kyber.Group
+	GT() kyber.Group
+	// Pair computes the pairing e(p1, p2) of a point p1 in G1
+	// and a point p2 in G2, returning a point in GT.
+	Pair(p1, p2 kyber.Point) kyber.Point
+	// PairingCheck reports whether the product of the pairings
+	// e(p1[i], p2[i]) is the identity of GT. It is faster than computing
+	// each pairing separately, and returns false if the lengths differ.
+	PairingCheck(p1, p2 []kyber.Point) bool
+	kyber.Encoding
+	kyber.HashFactory
+	kyber.XOFFactory
+	kyber.Random
+}
+
+// HashToPoint hashes msg to a point of g, one of the groups of suite, in
+// the domain separated by dst. It uses the hash_to_curve of RFC 9380 if the
+// points of g implement kyber.HashablePoint, and otherwise picks the point
+// from the XOF of suite keyed with dst and msg.
+func HashToPoint(suite Suite, g kyber.Group, dst, msg []byte) kyber.Point {
+	P := g.Point()
+	if hp, ok := P.(kyber.HashablePoint); ok {
+		return hp.HashToPoint(msg, dst)
+	}
+	h := suite.XOF(nil)
+	h.Write([]byte{byte(len(dst))})
+	h.Write(dst)
+	h.Write(msg)
+	return P.Pick(h)
+}
@@ -0,0 +1,96 @@
+package kyber
+
+import "sync"
+
+// Pool hands out Points and Scalars of a Group for temporary use, and takes
+// them back with Release to hand them out again, so that computations going
+// through many short-lived values, such as shuffles and proofs, reuse them
+// rather than leave them to the garbage collector. A Pool is safe for
+// concurrent use.
+//
+// As with Group.Point and Group.Scalar, the objects handed out have no
+// particular value and must be set before use. Once released, an object
+// must not be used, nor released again, by the code that released it, since
+// the Pool may already have handed it out elsewhere.
+type Pool struct {
+	points  sync.Pool
+	scalars sync.Pool
+}
+
+// Pooling is implemented by the suites that keep a Pool of their Points and
+// Scalars, shared by all the code using the suite.
+type Pooling interface {
+	// Pool returns the Pool of the suite, which is always the same.
+	Pool() *Pool
+}
+
+// NewPool returns a Pool handing out Points and Scalars of g.
+func NewPool(g Group) *Pool {
+	p := new(Pool)
+	p.points.New = func() interface{} { return g.Point() }
+	p.scalars.New = func() interface{} { return g.Scalar() }
+	return p
+}
+
+// PoolOf returns the Pool of g if g implements Pooling, or else a new Pool
+// of g, which then only reuses the objects released into it.
+func PoolOf(g Group) *Pool {
+	if p, ok := g.(Pooling); ok {
+		return p.Pool()
+	}
+	return NewPool(g)
+}
+
+// Point returns a Point of the Pool's group.
+func (p *Pool) Point() Point {
+	return p.points.Get().(Point)
+}
+
+// Scalar returns a Scalar of the Pool's group.
+func (p *Pool) Scalar() Scalar {
+	return p.scalars.Get().(Scalar)
+}
+
+// Release gives Points and Scalars of the Pool's group back to the Pool,
+// whether on their own or in slices, and ignores nil ones. It sets Scalars
+// to zero, so that the secrets they may hold are not handed out again, and
+// disables the blinding of BlindablePoints. It panics on any other object.
+func (p *Pool) Release(objs ...interface{}) {
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case Point:
+			p.releasePoint(o)
+		case Scalar:
+			p.releaseScalar(o)
+		case []Point:
+			for _, P := range o {
+				p.releasePoint(P)
+			}
+		case []Scalar:
+			for _, s := range o {
+				p.releaseScalar(s)
+			}
+		case nil:
+		default:
+			panic("kyber: cannot release a value of a type other than Point or Scalar")
+		}
+	}
+}
+
+func (p *Pool) releasePoint(P Point) {
+	if P == nil {
+		return
+	}
+	if b, ok := P.(BlindablePoint); ok {
+		b.SetBlinding(nil)
+	}
+	p.points.Put(P)
+}
+
+func (p *Pool) releaseScalar(s Scalar) {
+	if s == nil {
+		return
+	}
+	s.Zero()
+	p.scalars.Put(s)
+}
@@ -0,0 +1,371 @@
+// Package bulletproof implements the range proofs of Bulletproofs ("Bulletproofs:
+// Short Proofs for Confidential Transactions and More", Bünz et al., IEEE S&P
+// 2018) over any group.
+//
+// A range proof shows that Pedersen commitments V = v*G + gamma*H, where G
+// is the base point of the group and H a second generator, commit to values
+// v in [0, 2^n) without revealing them, for n up to 64 bits. Its size is
+// logarithmic in n, and m values can be proven at once by an aggregated
+// proof only 2*log2(m) points larger than a single one. The proofs need no
+// trusted setup: all the generators are hashed to the group, so that no one
+// knows discrete logarithms between them.
+//
+// Proofs are made non-interactive with a transcript, see package
+// proof/transcript, which binds them to the protocol that uses them, and end
+// with an inner product argument, see package proof/innerproduct.
+package bulletproof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/innerproduct"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+// Suite wraps the functionalities needed by the bulletproof package.
+type Suite interface {
+	kyber.Group
+	kyber.XOFFactory
+	kyber.Random
+}
+
+var errInvalidProof = errors.New("bulletproof: invalid proof")
+
+// Proof is an aggregated range proof.
+type Proof struct {
+	// Commitments to the bits of the values and to the blinding vectors
+	A, S kyber.Point
+	// Commitments to the coefficients of t(X)
+	T1, T2 kyber.Point
+	// Blinding factor of t(x), blinding factor of A and S, and t(x)
+	TauX, Mu, T kyber.Scalar
+	// Inner product argument for <l(x), r(x)> = t(x)
+	IPP *innerproduct.Proof
+}
+
+// Commit returns the Pedersen commitment v*G + gamma*H to the value v with
+// the blinding factor gamma, as proven by Prove.
+func Commit(suite Suite, v uint64, gamma kyber.Scalar) kyber.Point {
+	V := suite.Point().Mul(uintScalar(suite, v), nil)
+	return V.Add(V, suite.Point().Mul(gamma, blindingBase(suite)))
+}
+
+// Prove returns the commitments to the values with their blinding factors,
+// and a proof that each value is in [0, 2^n). n must be a power of 2 up to
+// 64, and the number of values a power of 2. The transcript t of the
+// protocol that uses the proof is appended to, and the verifier must have
+// built it identically.
+func Prove(suite Suite, t *transcript.Transcript, values []uint64, blindings []kyber.Scalar, n int) (*Proof, []kyber.Point, error) {
+	m := len(values)
+	if err := checkSizes(n, m); err != nil {
+		return nil, nil, err
+	}
+	if len(blindings) != m {
+		return nil, nil, errors.New("bulletproof: one blinding factor per value is needed")
+	}
+	for _, v := range values {
+		if n < 64 && v>>uint(n) != 0 {
+			return nil, nil, errors.New("bulletproof: value out of range")
+		}
+	}
+	nm := n * m
+	g, h := generators(suite, nm)
+	B, Bt := suite.Point().Base(), blindingBase(suite)
+	rand := suite.RandomStream()
+
+	V := make([]kyber.Point, m)
+	for j := range values {
+		V[j] = Commit(suite, values[j], blindings[j])
+	}
+	start(t, n, V)
+
+	// bits of the values, and blinding vectors
+	one := suite.Scalar().One()
+	aL, aR := make([]kyber.Scalar, nm), make([]kyber.Scalar, nm)
+	sL, sR := make([]kyber.Scalar, nm), make([]kyber.Scalar, nm)
+	for j, v := range values {
+		for i := 0; i < n; i++ {
+			k := j*n + i
+			aL[k] = suite.Scalar().SetInt64(int64(v >> uint(i) & 1))
+			aR[k] = suite.Scalar().Sub(aL[k], one)
+			sL[k] = suite.Scalar().Pick(rand)
+			sR[k] = suite.Scalar().Pick(rand)
+		}
+	}
+	alpha, rho := suite.Scalar().Pick(rand), suite.Scalar().Pick(rand)
+	A := suite.Point().Mul(alpha, Bt)
+	A.Add(A, multiMul(suite, aL, g)).Add(A, multiMul(suite, aR, h))
+	S := suite.Point().Mul(rho, Bt)
+	S.Add(S, multiMul(suite, sL, g)).Add(S, multiMul(suite, sR, h))
+	t.AppendPoints("A", A)
+	t.AppendPoints("S", S)
+	y := t.Challenge("y")
+	z := t.Challenge("z")
+
+	// l(X) = l0 + l1*X and r(X) = r0 + r1*X
+	yn := powers(suite, y, nm)
+	zs := zTerms(suite, z, n, m)
+	l0, l1 := make([]kyber.Scalar, nm), sL
+	r0, r1 := make([]kyber.Scalar, nm), make([]kyber.Scalar, nm)
+	for i := 0; i < nm; i++ {
+		l0[i] = suite.Scalar().Sub(aL[i], z)
+		r0[i] = suite.Scalar().Add(aR[i], z)
+		r0[i].Mul(r0[i], yn[i]).Add(r0[i], zs[i])
+		r1[i] = suite.Scalar().Mul(yn[i], sR[i])
+	}
+	t1 := suite.Scalar().Add(innerProduct(suite, l0, r1), innerProduct(suite, l1, r0))
+	t2 := innerProduct(suite, l1, r1)
+	tau1, tau2 := suite.Scalar().Pick(rand), suite.Scalar().Pick(rand)
+	T1 := suite.Point().Mul(t1, B)
+	T1.Add(T1, suite.Point().Mul(tau1, Bt))
+	T2 := suite.Point().Mul(t2, B)
+	T2.Add(T2, suite.Point().Mul(tau2, Bt))
+	t.AppendPoints("T1", T1)
+	t.AppendPoints("T2", T2)
+	x := t.Challenge("x")
+
+	l, r := make([]kyber.Scalar, nm), make([]kyber.Scalar, nm)
+	for i := 0; i < nm; i++ {
+		l[i] = suite.Scalar().Mul(l1[i], x)
+		l[i].Add(l[i], l0[i])
+		r[i] = suite.Scalar().Mul(r1[i], x)
+		r[i].Add(r[i], r0[i])
+	}
+	taux := suite.Scalar().Mul(tau2, x)
+	taux.Add(taux, tau1).Mul(taux, x)
+	zj := suite.Scalar().Mul(z, z)
+	for _, gamma := range blindings {
+		taux.Add(taux, suite.Scalar().Mul(zj, gamma))
+		zj.Mul(zj, z)
+	}
+	mu := suite.Scalar().Mul(rho, x)
+	mu.Add(mu, alpha)
+	that := innerProduct(suite, l, r)
+	t.AppendScalars("taux", taux)
+	t.AppendScalars("mu", mu)
+	t.AppendScalars("t", that)
+	Q := suite.Point().Mul(t.Challenge("w"), B)
+
+	// inner product argument for <l, r> = t, with generators g and h' where
+	// h'_i = y^-i * h_i
+	yInv := suite.Scalar().Inv(y)
+	hp := make([]kyber.Point, nm)
+	for i, yi := range powers(suite, yInv, nm) {
+		hp[i] = suite.Point().Mul(yi, h[i])
+	}
+	ipp, err := innerproduct.Prove(suite, t, g, hp, Q, l, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	p := &Proof{A: A, S: S, T1: T1, T2: T2, TauX: taux, Mu: mu, T: that, IPP: ipp}
+	return p, V, nil
+}
+
+// Verify checks that p proves that each of the commitments V commits to a
+// value in [0, 2^n), with the transcript t in the same state as when the
+// proof was created.
+func (p *Proof) Verify(suite Suite, t *transcript.Transcript, V []kyber.Point, n int) error {
+	m := len(V)
+	if err := checkSizes(n, m); err != nil {
+		return err
+	}
+	nm := n * m
+	if p.A == nil || p.S == nil || p.T1 == nil || p.T2 == nil || p.TauX == nil ||
+		p.Mu == nil || p.T == nil || p.IPP == nil {
+		return errInvalidProof
+	}
+	g, h := generators(suite, nm)
+	B, Bt := suite.Point().Base(), blindingBase(suite)
+
+	start(t, n, V)
+	t.AppendPoints("A", p.A)
+	t.AppendPoints("S", p.S)
+	y := t.Challenge("y")
+	z := t.Challenge("z")
+	t.AppendPoints("T1", p.T1)
+	t.AppendPoints("T2", p.T2)
+	x := t.Challenge("x")
+	t.AppendScalars("taux", p.TauX)
+	t.AppendScalars("mu", p.Mu)
+	t.AppendScalars("t", p.T)
+	Q := suite.Point().Mul(t.Challenge("w"), B)
+
+	// t*B + taux*Bt = sum(z^(2+j) V_j) + delta(y, z)*B + x*T1 + x^2*T2
+	yn := powers(suite, y, nm)
+	z2 := suite.Scalar().Mul(z, z)
+	delta := suite.Scalar().Sub(z, z2)
+	delta.Mul(delta, sum(suite, yn))
+	sum2n := sum(suite, powers(suite, suite.Scalar().SetInt64(2), n))
+	lhs := suite.Point().Mul(p.T, B)
+	lhs.Add(lhs, suite.Point().Mul(p.TauX, Bt))
+	rhs := suite.Point().Mul(x, p.T1)
+	rhs.Add(rhs, suite.Point().Mul(suite.Scalar().Mul(x, x), p.T2))
+	zj := suite.Scalar().Set(z2)
+	for j := range V {
+		rhs.Add(rhs, suite.Point().Mul(zj, V[j]))
+		zj.Mul(zj, z)
+		delta.Sub(delta, suite.Scalar().Mul(zj, sum2n))
+	}
+	rhs.Add(rhs, suite.Point().Mul(delta, B))
+	if !lhs.Equal(rhs) {
+		return errInvalidProof
+	}
+
+	// P = A + x*S - z*<1, g> + <z*y^nm + zs, h'> - mu*Bt + t*Q, where
+	// h'_i = y^-i * h_i, has to be <l, g> + <r, h'> + <l, r>*Q
+	zs := zTerms(suite, z, n, m)
+	yInvn := powers(suite, suite.Scalar().Inv(y), nm)
+	hp := make([]kyber.Point, nm)
+	gs, hs := make([]kyber.Scalar, nm), make([]kyber.Scalar, nm)
+	for i := 0; i < nm; i++ {
+		hp[i] = suite.Point().Mul(yInvn[i], h[i])
+		gs[i] = suite.Scalar().Neg(z)
+		hs[i] = suite.Scalar().Mul(z, yn[i])
+		hs[i].Add(hs[i], zs[i])
+	}
+	P := multiMul(suite, gs, g)
+	P.Add(P, multiMul(suite, hs, hp)).Add(P, p.A).Add(P, suite.Point().Mul(x, p.S))
+	P.Sub(P, suite.Point().Mul(p.Mu, Bt)).Add(P, suite.Point().Mul(p.T, Q))
+	if err := p.IPP.Verify(suite, t, g, hp, Q, P); err != nil {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// MarshalBinary returns the binary representation of the proof: A, S, T1,
+// T2, TauX, Mu and T, followed by the inner product argument.
+func (p *Proof) MarshalBinary() ([]byte, error) {
+	var b bytes.Buffer
+	for _, m := range []kyber.Marshaling{p.A, p.S, p.T1, p.T2, p.TauX, p.Mu, p.T} {
+		if _, err := m.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.IPP.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalBinary reads the proof from its binary representation.
+func (p *Proof) UnmarshalBinary(suite Suite, buff []byte) error {
+	fixed := 4*suite.PointLen() + 3*suite.ScalarLen()
+	if len(buff) < fixed {
+		return errors.New("bulletproof: invalid proof length")
+	}
+	q := &Proof{
+		A: suite.Point(), S: suite.Point(), T1: suite.Point(), T2: suite.Point(),
+		TauX: suite.Scalar(), Mu: suite.Scalar(), T: suite.Scalar(),
+		IPP: new(innerproduct.Proof),
+	}
+	r := bytes.NewReader(buff[:fixed])
+	for _, m := range []kyber.Marshaling{q.A, q.S, q.T1, q.T2, q.TauX, q.Mu, q.T} {
+		if _, err := m.UnmarshalFrom(r); err != nil {
+			return err
+		}
+	}
+	if err := q.IPP.UnmarshalBinary(suite, buff[fixed:]); err != nil {
+		return err
+	}
+	*p = *q
+	return nil
+}
+
+// checkSizes checks that the number of bits n and the number of values m
+// are powers of 2, with n at most 64.
+func checkSizes(n, m int) error {
+	if n <= 0 || n > 64 || n&(n-1) != 0 {
+		return errors.New("bulletproof: invalid number of bits")
+	}
+	if m <= 0 || m&(m-1) != 0 {
+		return errors.New("bulletproof: the number of values must be a power of 2")
+	}
+	return nil
+}
+
+// start appends the statement of a proof to t.
+func start(t *transcript.Transcript, n int, V []kyber.Point) {
+	t.Append("dom-sep", []byte("bulletproof range proof"))
+	var b [8]byte
+	binary.BigEndian.PutUint32(b[:4], uint32(n))
+	binary.BigEndian.PutUint32(b[4:], uint32(len(V)))
+	t.Append("sizes", b[:])
+	t.AppendPoints("V", V...)
+}
+
+// blindingBase returns the generator H of the blinding factors of
+// commitments.
+func blindingBase(suite Suite) kyber.Point {
+	return suite.Point().Pick(suite.XOF([]byte("bulletproof H")))
+}
+
+// generators returns the vectors of generators g and h of size nm.
+func generators(suite Suite, nm int) ([]kyber.Point, []kyber.Point) {
+	g, h := make([]kyber.Point, nm), make([]kyber.Point, nm)
+	for i := 0; i < nm; i++ {
+		var b [4]byte
+		binary.BigEndian.PutUint32(b[:], uint32(i))
+		g[i] = suite.Point().Pick(suite.XOF(append([]byte("bulletproof G"), b[:]...)))
+		h[i] = suite.Point().Pick(suite.XOF(append([]byte("bulletproof H"), b[:]...)))
+	}
+	return g, h
+}
+
+// uintScalar returns v as a scalar.
+func uintScalar(suite Suite, v uint64) kyber.Scalar {
+	s := suite.Scalar().SetInt64(int64(v >> 32))
+	s.Mul(s, suite.Scalar().SetInt64(1<<32))
+	return s.Add(s, suite.Scalar().SetInt64(int64(v&0xffffffff)))
+}
+
+// powers returns the vector (1, x, x^2, ..., x^(n-1)).
+func powers(suite Suite, x kyber.Scalar, n int) []kyber.Scalar {
+	p := make([]kyber.Scalar, n)
+	p[0] = suite.Scalar().One()
+	for i := 1; i < n; i++ {
+		p[i] = suite.Scalar().Mul(p[i-1], x)
+	}
+	return p
+}
+
+// zTerms returns the vector whose block j of n scalars is z^(2+j) * 2^n.
+func zTerms(suite Suite, z kyber.Scalar, n, m int) []kyber.Scalar {
+	twos := powers(suite, suite.Scalar().SetInt64(2), n)
+	zs := make([]kyber.Scalar, 0, n*m)
+	zj := suite.Scalar().Mul(z, z)
+	for j := 0; j < m; j++ {
+		for i := 0; i < n; i++ {
+			zs = append(zs, suite.Scalar().Mul(zj, twos[i]))
+		}
+		zj = suite.Scalar().Mul(zj, z)
+	}
+	return zs
+}
+
+func sum(suite Suite, a []kyber.Scalar) kyber.Scalar {
+	s := suite.Scalar().Zero()
+	for _, x := range a {
+		s.Add(s, x)
+	}
+	return s
+}
+
+func innerProduct(suite Suite, a, b []kyber.Scalar) kyber.Scalar {
+	s := suite.Scalar().Zero()
+	for i := range a {
+		s.Add(s, suite.Scalar().Mul(a[i], b[i]))
+	}
+	return s
+}
+
+// multiMul returns the sum of the a_i * P_i.
+func multiMul(suite Suite, a []kyber.Scalar, P []kyber.Point) kyber.Point {
+	s := suite.Point().Null()
+	for i := range a {
+		s.Add(s, suite.Point().Mul(a[i], P[i]))
+	}
+	return s
+}
@@ -0,0 +1,88 @@
+package bulletproof
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func blindings(m int) []kyber.Scalar {
+	gammas := make([]kyber.Scalar, m)
+	for i := range gammas {
+		gammas[i] = suite.Scalar().Pick(suite.RandomStream())
+	}
+	return gammas
+}
+
+func TestRangeProof(t *testing.T) {
+	for _, v := range []uint64{0, 1, 42, 1<<32 - 1, 1<<64 - 1} {
+		gammas := blindings(1)
+		p, V, err := Prove(suite, transcript.New(suite, "test"), []uint64{v}, gammas, 64)
+		require.Nil(t, err)
+		require.True(t, V[0].Equal(Commit(suite, v, gammas[0])))
+		require.Len(t, p.IPP.L, 6)
+		require.Nil(t, p.Verify(suite, transcript.New(suite, "test"), V, 64))
+
+		require.Error(t, p.Verify(suite, transcript.New(suite, "other"), V, 64))
+		require.Error(t, p.Verify(suite, transcript.New(suite, "test"), V, 32))
+		other := Commit(suite, v+1, gammas[0])
+		require.Error(t, p.Verify(suite, transcript.New(suite, "test"), []kyber.Point{other}, 64))
+	}
+
+	_, _, err := Prove(suite, transcript.New(suite, "test"), []uint64{256}, blindings(1), 8)
+	require.Error(t, err)
+	_, _, err = Prove(suite, transcript.New(suite, "test"), []uint64{1}, blindings(1), 12)
+	require.Error(t, err)
+	_, _, err = Prove(suite, transcript.New(suite, "test"), []uint64{1}, blindings(2), 8)
+	require.Error(t, err)
+}
+
+func TestAggregatedRangeProof(t *testing.T) {
+	values := []uint64{7, 0, 65535, 1000}
+	p, V, err := Prove(suite, transcript.New(suite, "test"), values, blindings(4), 16)
+	require.Nil(t, err)
+	require.Len(t, p.IPP.L, 6)
+	require.Nil(t, p.Verify(suite, transcript.New(suite, "test"), V, 16))
+
+	// The commitments are bound to the proof, in order.
+	require.Error(t, p.Verify(suite, transcript.New(suite, "test"), V[:2], 16))
+	swapped := []kyber.Point{V[1], V[0], V[2], V[3]}
+	require.Error(t, p.Verify(suite, transcript.New(suite, "test"), swapped, 16))
+
+	_, _, err = Prove(suite, transcript.New(suite, "test"), values[:3], blindings(3), 16)
+	require.Error(t, err)
+}
+
+func TestRangeProofOutOfRange(t *testing.T) {
+	// A value of 2^n committed to as if it were in range: the prover's bits
+	// do not add up to it, and the proof fails.
+	gammas := blindings(1)
+	p, _, err := Prove(suite, transcript.New(suite, "test"), []uint64{0}, gammas, 8)
+	require.Nil(t, err)
+	V := Commit(suite, 256, gammas[0])
+	require.Error(t, p.Verify(suite, transcript.New(suite, "test"), []kyber.Point{V}, 8))
+}
+
+func TestRangeProofMarshal(t *testing.T) {
+	p, V, err := Prove(suite, transcript.New(suite, "test"), []uint64{3, 5}, blindings(2), 32)
+	require.Nil(t, err)
+	buff, err := p.MarshalBinary()
+	require.Nil(t, err)
+	require.Len(t, buff, (4+2*6)*suite.PointLen()+5*suite.ScalarLen())
+
+	decoded := new(Proof)
+	require.Nil(t, decoded.UnmarshalBinary(suite, buff))
+	require.Nil(t, decoded.Verify(suite, transcript.New(suite, "test"), V, 32))
+
+	require.Error(t, decoded.UnmarshalBinary(suite, buff[1:]))
+	buff[suite.PointLen()*4] ^= 1
+	if decoded.UnmarshalBinary(suite, buff) == nil {
+		require.Error(t, decoded.Verify(suite, transcript.New(suite, "test"), V, 32))
+	}
+	require.Error(t, new(Proof).Verify(suite, transcript.New(suite, "test"), V, 32))
+}
@@ -0,0 +1,70 @@
+// +build experimental
+
+package proof
+
+import (
+	"github.com/dedis/kyber"
+)
+
+// Names of the variables of the designated verifier in its predicates.
+const (
+	designatedKey    = "designated verifier key"
+	designatedSecret = "designated verifier secret"
+	designatedBase   = "designated verifier base"
+)
+
+// designated returns the predicate pred || Y=y*B, where Y is the public key
+// of the designated verifier, together with the points it refers to.
+func designated(suite Suite, pred Predicate, points map[string]kyber.Point,
+	Y kyber.Point) (Predicate, map[string]kyber.Point) {
+	dp := Or(pred, Rep(designatedKey, designatedSecret, designatedBase))
+	pval := make(map[string]kyber.Point, len(points)+2)
+	for name, P := range points {
+		pval[name] = P
+	}
+	pval[designatedKey] = Y
+	pval[designatedBase] = suite.Point().Base()
+	return dp, pval
+}
+
+// DesignatedProver returns a prover of the statement pred whose proofs only
+// convince the designated verifier of public key Y. The proofs state that
+// either pred holds or the prover knows the private key of Y: the designated
+// verifier, who knows that it did not make the proof itself, is convinced
+// that pred holds, but anyone else sees that the verifier could have made
+// the proof, see DesignatedSimulator. The claims of the prover are thus
+// deniable, and the verifier cannot transfer them to third parties, as in
+// receipt-free voting.
+//
+// The secrets, points and choices are those that pred.Prover takes, and the
+// prover is run with HashProve or interactively like any other.
+func DesignatedProver(suite Suite, pred Predicate, secrets map[string]kyber.Scalar,
+	points map[string]kyber.Point, choice map[Predicate]int, Y kyber.Point) Prover {
+	dp, pval := designated(suite, pred, points, Y)
+	ch := make(map[Predicate]int, len(choice)+1)
+	for p, c := range choice {
+		ch[p] = c
+	}
+	ch[dp] = 0
+	return dp.Prover(suite, secrets, pval, ch)
+}
+
+// DesignatedSimulator returns a prover of the statement pred designated to
+// the verifier of private key y, which needs no secret of pred and proves
+// it even if it does not hold. Its proofs are indistinguishable from those
+// of DesignatedProver, which is why they do not convince anyone but the
+// designated verifier.
+func DesignatedSimulator(suite Suite, pred Predicate, points map[string]kyber.Point,
+	y kyber.Scalar) Prover {
+	dp, pval := designated(suite, pred, points, suite.Point().Mul(y, nil))
+	secrets := map[string]kyber.Scalar{designatedSecret: y}
+	return dp.Prover(suite, secrets, pval, map[Predicate]int{dp: 1})
+}
+
+// DesignatedVerifier returns a verifier of the proofs of the statement pred
+// designated to the verifier of public key Y.
+func DesignatedVerifier(suite Suite, pred Predicate, points map[string]kyber.Point,
+	Y kyber.Point) Verifier {
+	dp, pval := designated(suite, pred, points, Y)
+	return dp.Verifier(suite, pval)
+}
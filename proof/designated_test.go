@@ -0,0 +1,65 @@
+// +build experimental
+
+package proof
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+func TestDesignated(t *testing.T) {
+	rand := blake.New([]byte("seed"))
+	suite := edwards25519.NewBlakeSHA256Ed25519WithRand(rand)
+
+	x := suite.Scalar().Pick(rand)
+	y := suite.Scalar().Pick(rand)
+	X := suite.Point().Mul(x, nil)
+	Y := suite.Point().Mul(y, nil)
+	Z := suite.Point().Mul(suite.Scalar().Pick(rand), nil)
+	pval := map[string]kyber.Point{"B": suite.Point().Base(), "X": X}
+	pred := Rep("X", "x", "B")
+
+	// The prover convinces the designated verifier.
+	sval := map[string]kyber.Scalar{"x": x}
+	prover := DesignatedProver(suite, pred, sval, pval, nil, Y)
+	proof, err := HashProve(suite, "TEST", prover)
+	if err != nil {
+		t.Fatal("prover: " + err.Error())
+	}
+	verifier := DesignatedVerifier(suite, pred, pval, Y)
+	if err := HashVerify(suite, "TEST", verifier, proof); err != nil {
+		t.Fatal("verify: " + err.Error())
+	}
+
+	// The proof is bound to the designated verifier, and is not a proof of
+	// pred alone.
+	if HashVerify(suite, "TEST", DesignatedVerifier(suite, pred, pval, Z), proof) == nil {
+		t.Fatal("proof verified for another verifier")
+	}
+	if HashVerify(suite, "TEST", pred.Verifier(suite, pval), proof) == nil {
+		t.Fatal("designated proof verified as a plain proof")
+	}
+
+	// The designated verifier proves false statements with its private key,
+	// so that its proofs convince no one else.
+	fval := map[string]kyber.Point{"B": suite.Point().Base(), "X": Z}
+	proof, err = HashProve(suite, "TEST", DesignatedSimulator(suite, pred, fval, y))
+	if err != nil {
+		t.Fatal("simulator: " + err.Error())
+	}
+	if err := HashVerify(suite, "TEST", DesignatedVerifier(suite, pred, fval, Y), proof); err != nil {
+		t.Fatal("verify simulated: " + err.Error())
+	}
+
+	// Without the secret of pred, the prover's proofs are rejected.
+	proof, err = HashProve(suite, "TEST", DesignatedProver(suite, pred, sval, fval, nil, Y))
+	if err != nil {
+		t.Fatal("prover: " + err.Error())
+	}
+	if HashVerify(suite, "TEST", DesignatedVerifier(suite, pred, fval, Y), proof) == nil {
+		t.Fatal("proof of a false statement verified")
+	}
+}
@@ -3,12 +3,23 @@
 // This means, for two values xG and xH one can check that
 //   log_{G}(xG) == log_{H}(xH)
 // without revealing the secret value x.
+//
+// Such proofs show that the same secret was used with two bases, for example
+// that a decryption share or an evaluation of an oblivious PRF was computed
+// with the private key of a known public key. A Proof can be sent in its
+// binary representation, from MarshalBinary, and bound to the protocol that
+// uses it with a transcript, see NewDLEQProofTranscript. A batch proof,
+// from NewBatchProof, shows with a single proof of constant size that the
+// same secret relates one base point to many others, as when a server
+// evaluates an oblivious PRF on many inputs at once.
 package dleq
 
 import (
+	"bytes"
 	"errors"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/transcript"
 )
 
 // Suite wraps the functionalities needed by the dleq package.
@@ -19,6 +30,9 @@ type Suite interface {
 	kyber.Random
 }
 
+// protocol is the label of the proofs in transcripts.
+const protocol = "dleq"
+
 var errorDifferentLengths = errors.New("inputs of different lengths")
 var errorInvalidProof = errors.New("invalid proof")
 
@@ -32,10 +46,19 @@ type Proof struct {
 
 // NewDLEQProof computes a new NIZK dlog-equality proof for the scalar x with
 // respect to base points G and H. It therefore randomly selects a commitment v
-// and then computes the challenge c = H(xG,xH,vG,vH) and response r = v - cx.
-// Besides the proof, this function also returns the encrypted base points xG
-// and xH.
+// and then computes the challenge c = H(G,H,xG,xH,vG,vH) and response r = v -
+// cx. Besides the proof, this function also returns the encrypted base points
+// xG and xH. The challenge is derived from a transcript of its own, see
+// NewDLEQProofTranscript to bind the proof to a protocol.
 func NewDLEQProof(suite Suite, G kyber.Point, H kyber.Point, x kyber.Scalar) (proof *Proof, xG kyber.Point, xH kyber.Point, err error) {
+	return NewDLEQProofTranscript(suite, transcript.New(suite, protocol), G, H, x)
+}
+
+// NewDLEQProofTranscript computes a NIZK dlog-equality proof like
+// NewDLEQProof, with its challenge derived from the transcript t of the
+// protocol that uses it. The statement and the proof are appended to t,
+// which the verifier must have built identically.
+func NewDLEQProofTranscript(suite Suite, t *transcript.Transcript, G kyber.Point, H kyber.Point, x kyber.Scalar) (proof *Proof, xG kyber.Point, xH kyber.Point, err error) {
 	// Encrypt base points with secret
 	xG = suite.Point().Mul(x, G)
 	xH = suite.Point().Mul(x, H)
@@ -46,13 +69,7 @@ func NewDLEQProof(suite Suite, G kyber.Point, H kyber.Point, x kyber.Scalar) (pr
 	vH := suite.Point().Mul(v, H)
 
 	// Challenge
-	h := suite.Hash()
-	xG.MarshalTo(h)
-	xH.MarshalTo(h)
-	vG.MarshalTo(h)
-	vH.MarshalTo(h)
-	cb := h.Sum(nil)
-	c := suite.Scalar().Pick(suite.XOF(cb))
+	c := challenge(t, G, H, xG, xH, vG, vH)
 
 	// Response
 	r := suite.Scalar()
@@ -62,65 +79,79 @@ func NewDLEQProof(suite Suite, G kyber.Point, H kyber.Point, x kyber.Scalar) (pr
 }
 
 // NewDLEQProofBatch computes lists of NIZK dlog-equality proofs and of
-// encrypted base points xG and xH. Note that the challenge is computed over all
-// input values.
+// encrypted base points xG and xH. Each proof is verified on its own, like
+// those of NewDLEQProof.
 func NewDLEQProofBatch(suite Suite, G []kyber.Point, H []kyber.Point, secrets []kyber.Scalar) (proof []*Proof, xG []kyber.Point, xH []kyber.Point, err error) {
+	return NewDLEQProofBatchTranscript(suite, transcript.New(suite, protocol), G, H, secrets)
+}
+
+// NewDLEQProofBatchTranscript computes lists of NIZK dlog-equality proofs
+// like NewDLEQProofBatch, with the challenge of each proof derived from a
+// copy of the transcript t, which is left unchanged. Each proof is verified
+// on its own with a copy of the same transcript.
+func NewDLEQProofBatchTranscript(suite Suite, t *transcript.Transcript, G []kyber.Point, H []kyber.Point, secrets []kyber.Scalar) (proof []*Proof, xG []kyber.Point, xH []kyber.Point, err error) {
 	if len(G) != len(H) || len(H) != len(secrets) {
 		return nil, nil, nil, errorDifferentLengths
 	}
 
 	n := len(secrets)
 	proofs := make([]*Proof, n)
-	v := make([]kyber.Scalar, n)
 	xG = make([]kyber.Point, n)
 	xH = make([]kyber.Point, n)
-	vG := make([]kyber.Point, n)
-	vH := make([]kyber.Point, n)
-
 	for i, x := range secrets {
-		// Encrypt base points with secrets
-		xG[i] = suite.Point().Mul(x, G[i])
-		xH[i] = suite.Point().Mul(x, H[i])
-
-		// Commitments
-		v[i] = suite.Scalar().Pick(suite.RandomStream())
-		vG[i] = suite.Point().Mul(v[i], G[i])
-		vH[i] = suite.Point().Mul(v[i], H[i])
+		proofs[i], xG[i], xH[i], err = NewDLEQProofTranscript(suite, t.Clone(), G[i], H[i], x)
+		if err != nil {
+			return nil, nil, nil, err
+		}
 	}
+	return proofs, xG, xH, nil
+}
 
-	// Collective challenge
-	h := suite.Hash()
-	for _, x := range xG {
-		x.MarshalTo(h)
-	}
-	for _, x := range xH {
-		x.MarshalTo(h)
+// NewBatchProof computes a single NIZK proof that the same scalar x relates
+// the base point G to xG and each base point H[i] to xH[i], whatever the
+// number of base points, and also returns xG and the xH[i]. The statements
+// are combined with random coefficients d_i derived from all of them, and
+// the proof is a dlog-equality proof for G and the sum of the d_i H[i].
+func NewBatchProof(suite Suite, G kyber.Point, H []kyber.Point, x kyber.Scalar) (proof *Proof, xG kyber.Point, xH []kyber.Point, err error) {
+	return NewBatchProofTranscript(suite, transcript.New(suite, protocol), G, H, x)
+}
+
+// NewBatchProofTranscript computes a batch proof like NewBatchProof, with
+// its coefficients and challenge derived from the transcript t of the
+// protocol that uses it.
+func NewBatchProofTranscript(suite Suite, t *transcript.Transcript, G kyber.Point, H []kyber.Point, x kyber.Scalar) (proof *Proof, xG kyber.Point, xH []kyber.Point, err error) {
+	if len(H) == 0 {
+		return nil, nil, nil, errorDifferentLengths
 	}
-	for _, x := range vG {
-		x.MarshalTo(h)
+	xG = suite.Point().Mul(x, G)
+	xH = make([]kyber.Point, len(H))
+	for i := range H {
+		xH[i] = suite.Point().Mul(x, H[i])
 	}
-	for _, x := range vH {
-		x.MarshalTo(h)
+	M, _ := combine(suite, t, G, H, xG, xH)
+	proof, _, _, err = NewDLEQProofTranscript(suite, t, G, M, x)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	cb := h.Sum(nil)
-
-	c := suite.Scalar().Pick(suite.XOF(cb))
-
-	// Responses
-	for i, x := range secrets {
-		r := suite.Scalar()
-		r.Mul(x, c).Sub(v[i], r)
-		proofs[i] = &Proof{c, r, vG[i], vH[i]}
-	}
-
-	return proofs, xG, xH, nil
+	return proof, xG, xH, nil
 }
 
 // Verify examines the validity of the NIZK dlog-equality proof.
-// The proof is valid if the following two conditions hold:
+// The proof is valid if its challenge is the one derived from the statement
+// and the commitments, and the following two conditions hold:
 //   vG == rG + c(xG)
 //   vH == rH + c(xH)
 func (p *Proof) Verify(suite Suite, G kyber.Point, H kyber.Point, xG kyber.Point, xH kyber.Point) error {
+	return p.VerifyTranscript(suite, transcript.New(suite, protocol), G, H, xG, xH)
+}
+
+// VerifyTranscript examines the validity of a NIZK dlog-equality proof
+// created with NewDLEQProofTranscript, with the transcript t of the protocol
+// that uses it in the same state as when the proof was created.
+func (p *Proof) VerifyTranscript(suite Suite, t *transcript.Transcript, G kyber.Point, H kyber.Point, xG kyber.Point, xH kyber.Point) error {
+	if !challenge(t, G, H, xG, xH, p.VG, p.VH).Equal(p.C) {
+		return errorInvalidProof
+	}
 	rG := suite.Point().Mul(p.R, G)
 	rH := suite.Point().Mul(p.R, H)
 	cxG := suite.Point().Mul(p.C, xG)
@@ -132,3 +163,78 @@ func (p *Proof) Verify(suite Suite, G kyber.Point, H kyber.Point, xG kyber.Point
 	}
 	return nil
 }
+
+// VerifyBatch examines the validity of a batch proof created with
+// NewBatchProof, that log_G(xG) == log_H[i](xH[i]) for every i.
+func (p *Proof) VerifyBatch(suite Suite, G kyber.Point, H []kyber.Point, xG kyber.Point, xH []kyber.Point) error {
+	return p.VerifyBatchTranscript(suite, transcript.New(suite, protocol), G, H, xG, xH)
+}
+
+// VerifyBatchTranscript examines the validity of a batch proof created with
+// NewBatchProofTranscript, with the transcript t in the same state as when
+// the proof was created.
+func (p *Proof) VerifyBatchTranscript(suite Suite, t *transcript.Transcript, G kyber.Point, H []kyber.Point, xG kyber.Point, xH []kyber.Point) error {
+	if len(H) == 0 || len(H) != len(xH) {
+		return errorDifferentLengths
+	}
+	M, Z := combine(suite, t, G, H, xG, xH)
+	return p.VerifyTranscript(suite, t, G, M, xG, Z)
+}
+
+// combine appends the statements of a batch proof to t, and returns their
+// combinations M = sum(d_i H[i]) and Z = sum(d_i xH[i]) with coefficients
+// d_i derived from t.
+func combine(suite Suite, t *transcript.Transcript, G kyber.Point, H []kyber.Point, xG kyber.Point, xH []kyber.Point) (kyber.Point, kyber.Point) {
+	t.Append("dom-sep", []byte(protocol+" batch"))
+	t.AppendPoints("base", G)
+	t.AppendPoints("statement", xG)
+	t.AppendPoints("bases", H...)
+	t.AppendPoints("statements", xH...)
+	M, Z := suite.Point().Null(), suite.Point().Null()
+	for i := range H {
+		d := t.Challenge("coefficient")
+		M.Add(M, suite.Point().Mul(d, H[i]))
+		Z.Add(Z, suite.Point().Mul(d, xH[i]))
+	}
+	return M, Z
+}
+
+// challenge appends the statement and the commitments of a proof to t, and
+// derives its challenge.
+func challenge(t *transcript.Transcript, G, H, xG, xH, vG, vH kyber.Point) kyber.Scalar {
+	t.Append("dom-sep", []byte(protocol))
+	t.AppendPoints("bases", G, H)
+	t.AppendPoints("statement", xG, xH)
+	t.AppendPoints("commitments", vG, vH)
+	return t.Challenge("challenge")
+}
+
+// MarshalBinary returns the binary representation of the proof: its
+// challenge, response and commitments, in this order.
+func (p *Proof) MarshalBinary() ([]byte, error) {
+	var b bytes.Buffer
+	for _, m := range []kyber.Marshaling{p.C, p.R, p.VG, p.VH} {
+		if _, err := m.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// UnmarshalBinary reads the proof from its binary representation.
+func (p *Proof) UnmarshalBinary(s Suite, buff []byte) error {
+	c, r := s.Scalar(), s.Scalar()
+	vG, vH := s.Point(), s.Point()
+	size := c.MarshalSize() + r.MarshalSize() + vG.MarshalSize() + vH.MarshalSize()
+	if len(buff) != size {
+		return errors.New("invalid proof length")
+	}
+	b := bytes.NewReader(buff)
+	for _, m := range []kyber.Marshaling{c, r, vG, vH} {
+		if _, err := m.UnmarshalFrom(b); err != nil {
+			return err
+		}
+	}
+	p.C, p.R, p.VG, p.VH = c, r, vG, vH
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/transcript"
 	"github.com/dedis/kyber/util/random"
 	"github.com/stretchr/testify/require"
 )
@@ -59,3 +60,84 @@ func TestDLEQLengths(t *testing.T) {
 	_, _, _, err := NewDLEQProofBatch(suite, g, h, x)
 	require.Equal(t, err, errorDifferentLengths)
 }
+
+func TestDLEQProofTranscript(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	x := suite.Scalar().Pick(rng)
+	g := suite.Point().Pick(rng)
+	h := suite.Point().Pick(rng)
+	proof, xG, xH, err := NewDLEQProofTranscript(suite, transcript.New(suite, "test"), g, h, x)
+	require.Nil(t, err)
+	require.Nil(t, proof.VerifyTranscript(suite, transcript.New(suite, "test"), g, h, xG, xH))
+
+	// A proof is bound to its protocol and to its statement.
+	require.Error(t, proof.VerifyTranscript(suite, transcript.New(suite, "other"), g, h, xG, xH))
+	require.Error(t, proof.Verify(suite, g, h, xG, xH))
+	require.Error(t, proof.VerifyTranscript(suite, transcript.New(suite, "test"), h, g, xH, xG))
+
+	// Proofs with a challenge of the prover's choice are rejected, even if
+	// they satisfy the verification equations.
+	y := suite.Scalar().Pick(rng)
+	yH := suite.Point().Mul(y, h)
+	c, r := suite.Scalar().Pick(rng), suite.Scalar().Pick(rng)
+	forged := &Proof{
+		C:  c,
+		R:  r,
+		VG: suite.Point().Add(suite.Point().Mul(r, g), suite.Point().Mul(c, xG)),
+		VH: suite.Point().Add(suite.Point().Mul(r, h), suite.Point().Mul(c, yH)),
+	}
+	require.Error(t, forged.Verify(suite, g, h, xG, yH))
+}
+
+func TestDLEQProofMarshal(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	x := suite.Scalar().Pick(rng)
+	g := suite.Point().Pick(rng)
+	h := suite.Point().Pick(rng)
+	proof, xG, xH, err := NewDLEQProof(suite, g, h, x)
+	require.Nil(t, err)
+
+	buff, err := proof.MarshalBinary()
+	require.Nil(t, err)
+	require.Len(t, buff, 2*suite.ScalarLen()+2*suite.PointLen())
+	decoded := new(Proof)
+	require.Nil(t, decoded.UnmarshalBinary(suite, buff))
+	require.Nil(t, decoded.Verify(suite, g, h, xG, xH))
+
+	require.Error(t, decoded.UnmarshalBinary(suite, buff[1:]))
+	buff[len(buff)-1] ^= 1
+	if decoded.UnmarshalBinary(suite, buff) == nil {
+		require.Error(t, decoded.Verify(suite, g, h, xG, xH))
+	}
+}
+
+func TestBatchProof(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	n := 10
+	x := suite.Scalar().Pick(rng)
+	g := suite.Point().Pick(rng)
+	h := make([]kyber.Point, n)
+	for i := range h {
+		h[i] = suite.Point().Pick(rng)
+	}
+	proof, xG, xH, err := NewBatchProof(suite, g, h, x)
+	require.Nil(t, err)
+	require.Nil(t, proof.VerifyBatch(suite, g, h, xG, xH))
+	require.Error(t, proof.Verify(suite, g, h[0], xG, xH[0]))
+
+	// A single statement with another secret makes the proof invalid.
+	for i := range h {
+		wrong := append([]kyber.Point{}, xH...)
+		wrong[i] = suite.Point().Mul(suite.Scalar().Pick(rng), h[i])
+		require.Error(t, proof.VerifyBatch(suite, g, h, xG, wrong))
+	}
+	swapped := append([]kyber.Point{}, xH...)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	require.Error(t, proof.VerifyBatch(suite, g, h, xG, swapped))
+	require.Error(t, proof.VerifyBatch(suite, g, h[1:], xG, xH[1:]))
+	require.Error(t, proof.VerifyBatchTranscript(suite, transcript.New(suite, "other"), g, h, xG, xH))
+
+	require.Equal(t, errorDifferentLengths, proof.VerifyBatch(suite, g, h, xG, xH[1:]))
+	_, _, _, err = NewBatchProof(suite, g, nil, x)
+	require.Equal(t, errorDifferentLengths, err)
+}
@@ -7,21 +7,23 @@ import (
 	"fmt"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/transcript"
 )
 
-// Hash-based noninteractive Sigma-protocol prover context
+// Hash-based noninteractive Sigma-protocol prover context, whose public
+// randomness is derived from a transcript of the messages of the proof.
 type hashProver struct {
 	suite   Suite
 	proof   bytes.Buffer
 	msg     bytes.Buffer
-	pubrand kyber.XOF
+	t       *transcript.Transcript
 	prirand kyber.XOF
 }
 
 func newHashProver(suite Suite, protoName string) *hashProver {
 	var sc hashProver
 	sc.suite = suite
-	sc.pubrand = suite.XOF([]byte(protoName))
+	sc.t = transcript.New(suite, protoName)
 	sc.prirand = suite.RandomStream().(kyber.XOF)
 	return &sc
 }
@@ -33,10 +35,9 @@ func (c *hashProver) Put(message interface{}) error {
 func (c *hashProver) consumeMsg() {
 	if c.msg.Len() > 0 {
 
-		// Stir the message into the public randomness pool
+		// Append the message to the transcript
 		buf := c.msg.Bytes()
-		c.pubrand.Reseed()
-		c.pubrand.Write(buf)
+		c.t.Append("message", buf)
 
 		// Append the current message data to the proof
 		c.proof.Write(buf)
@@ -47,7 +48,7 @@ func (c *hashProver) consumeMsg() {
 // Get public randomness that depends on every bit in the proof so far.
 func (c *hashProver) PubRand(data ...interface{}) error {
 	c.consumeMsg()
-	return c.suite.Read(c.pubrand, data...)
+	return pubRand(c.suite, c.t, data...)
 }
 
 // Get private randomness
@@ -66,10 +67,10 @@ func (c *hashProver) Proof() []byte {
 
 // Noninteractive Sigma-protocol verifier context
 type hashVerifier struct {
-	suite   Suite
-	proof   bytes.Buffer // Buffer with which to read the proof
-	prbuf   []byte       // Byte-slice underlying proof buffer
-	pubrand kyber.XOF
+	suite Suite
+	proof bytes.Buffer // Buffer with which to read the proof
+	prbuf []byte       // Byte-slice underlying proof buffer
+	t     *transcript.Transcript
 }
 
 func newHashVerifier(suite Suite, protoName string,
@@ -80,17 +81,15 @@ func newHashVerifier(suite Suite, protoName string,
 	}
 	c.suite = suite
 	c.prbuf = c.proof.Bytes()
-	c.pubrand = suite.XOF([]byte(protoName))
+	c.t = transcript.New(suite, protoName)
 	return &c, nil
 }
 
 func (c *hashVerifier) consumeMsg() {
 	l := len(c.prbuf) - c.proof.Len() // How many bytes read?
 	if l > 0 {
-		// Stir consumed bytes into the public randomness pool
-		buf := c.prbuf[:l]
-		c.pubrand.Reseed()
-		c.pubrand.Write(buf)
+		// Append the consumed bytes to the transcript
+		c.t.Append("message", c.prbuf[:l])
 
 		c.prbuf = c.proof.Bytes() // Reset to remaining bytes
 	}
@@ -104,7 +103,14 @@ func (c *hashVerifier) Get(message interface{}) error {
 // Get public randomness that depends on every bit in the proof so far.
 func (c *hashVerifier) PubRand(data ...interface{}) error {
 	c.consumeMsg() // Stir in newly-read data
-	return c.suite.Read(c.pubrand, data...)
+	return pubRand(c.suite, c.t, data...)
+}
+
+// pubRand picks the public random objects data from a challenge seed of
+// the transcript t, which is appended to it.
+func pubRand(suite Suite, t *transcript.Transcript, data ...interface{}) error {
+	seed := t.ChallengeBytes("challenge", 32)
+	return suite.Read(suite.XOF(seed), data...)
 }
 
 // HashProve runs a given Sigma-protocol prover with a ProverContext
@@ -112,8 +118,9 @@ func (c *hashVerifier) PubRand(data ...interface{}) error {
 // Returns a byte-slice containing the noninteractive proof on success,
 // or an error in the case of failure.
 //
-// The optional protocolName is fed into the hash function used in the proof,
-// so that a proof generated for a particular protocolName
+// The challenges are derived from a transcript of the messages of the
+// proof, as kept by package proof/transcript, labelled with the optional
+// protocolName, so that a proof generated for a particular protocolName
 // will verify successfully only if the verifier uses the same protocolName.
 //
 // The caller must provide a source of random entropy for the proof;
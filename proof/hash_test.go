@@ -52,8 +52,8 @@ func Example_hashProve1() {
 	// Signature:
 	// 00000000  e9 a2 da f4 9d 7c e2 25  35 be 0a 15 78 9c ea ca  |.....|.%5...x...|
 	// 00000010  a7 1e 6e d6 26 c3 40 ed  0d 3d 71 d4 a9 ef 55 3b  |..n.&.@..=q...U;|
-	// 00000020  64 76 55 7b 3c 63 20 d8  4b 29 3a 1c 7f 44 59 ad  |dvU{<c .K):..DY.|
-	// 00000030  ff 5d c1 ff 06 1d 97 0c  59 06 3c 4b aa 7b 7c 0c  |.]......Y.<K.{|.|
+	// 00000020  a9 a7 dc 60 94 93 68 c8  fc 4d 50 e8 30 ae c0 88  |...`..h..MP.0...|
+	// 00000030  35 cb 56 13 1e fe 79 69  f3 a0 6f 7b dc 73 13 01  |5.V...yi..o{.s..|
 	// Signature verified against correct message M.
 	// Signature verify against wrong message: invalid proof: commit mismatch
 }
@@ -157,13 +157,13 @@ func Example_hashProve2() {
 	// 000000d0  4d 97 a9 bf 1a 28 27 6d  3b 71 04 e1 c0 86 96 08  |M....('m;q......|
 	// 000000e0  8d 0e c0 14 e3 eb 8b e9  16 40 29 60 ab bd e6 1a  |.........@)`....|
 	// 000000f0  68 54 5e 29 c8 85 05 bc  4a 27 83 d9 32 cc 74 0f  |hT^)....J'..2.t.|
-	// 00000100  5e 16 30 25 e2 d6 35 2a  d4 3e b5 07 1f d4 0a eb  |^.0%..5*.>......|
-	// 00000110  5d ef 3b 84 35 39 90 0c  3a 02 bb ee c7 9a e7 09  |].;.59..:.......|
+	// 00000100  d9 51 d5 b3 28 08 fa c4  64 fd c2 cb f6 18 f6 50  |.Q..(...d......P|
+	// 00000110  32 e4 5d b9 d2 7a a2 94  0f 71 71 f9 18 77 72 02  |2.]..z...qq..wr.|
 	// 00000120  d1 cc 1e e1 f4 3b 88 52  e5 99 ed 50 d7 66 b5 76  |.....;.R...P.f.v|
 	// 00000130  59 6c c1 66 98 07 e5 73  e7 b8 fe 48 43 a0 74 09  |Yl.f...s...HC.t.|
 	// 00000140  84 9a 7b ec 21 aa ff c7  fc 79 c6 8f f4 23 82 e7  |..{.!....y...#..|
 	// 00000150  d3 71 69 20 d6 94 27 ef  11 0b 4c a5 79 54 1f 09  |.qi ..'...L.yT..|
-	// 00000160  6b ec 50 c2 1f 98 38 ea  a7 02 da ca aa 1b 6b 39  |k.P...8.......k9|
-	// 00000170  70 b8 35 6c fe 03 1f b0  08 42 e0 5d b2 5e 40 04  |p.5l.....B.].^@.|
+	// 00000160  9d c1 5b 71 9b ab 8c 33  38 3c 07 8a 65 d7 09 17  |..[q...38<..e...|
+	// 00000170  01 19 6e ba 48 bf 32 8b  01 be 20 17 56 dd a9 0d  |..n.H.2... .V...|
 	// Linkable Ring Signature verified.
 }
@@ -0,0 +1,226 @@
+// Package innerproduct implements the inner product argument of Bulletproofs
+// ("Bulletproofs: Short Proofs for Confidential Transactions and More", Bünz
+// et al., IEEE S&P 2018) over any group.
+//
+// Given vectors of generators G and H of size n, and a generator Q, an
+// inner product proof shows knowledge of vectors of scalars a and b such
+// that P = <a, G> + <b, H> + <a, b>*Q, with 2*log2(n) points and two
+// scalars. It is the building block of range proofs, see package
+// proof/bulletproof, and of other arguments on committed vectors. The
+// argument is not zero-knowledge by itself: it reveals information on a and
+// b, which protocols must blind beforehand.
+//
+// The discrete logarithms between the generators must be unknown, for
+// example because they are hashed to the group. Proofs are made
+// non-interactive with a transcript, see package proof/transcript.
+package innerproduct
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+// Suite wraps the functionalities needed by the innerproduct package.
+type Suite interface {
+	kyber.Group
+	kyber.XOFFactory
+}
+
+var errInvalidProof = errors.New("innerproduct: invalid proof")
+
+// Proof is an inner product proof.
+type Proof struct {
+	// Points of each round
+	L, R []kyber.Point
+	// Final scalars
+	A, B kyber.Scalar
+}
+
+// Prove returns a proof that P = <a, G> + <b, H> + <a, b>*Q, whose size n
+// must be a power of 2. The transcript t is appended to, and must be in the
+// same state for the verifier, who has to bind P to it beforehand unless it
+// is already.
+func Prove(suite Suite, t *transcript.Transcript, G, H []kyber.Point, Q kyber.Point, a, b []kyber.Scalar) (*Proof, error) {
+	n := len(G)
+	if len(H) != n || len(a) != n || len(b) != n {
+		return nil, errors.New("innerproduct: vectors of different lengths")
+	}
+	if n == 0 || n&(n-1) != 0 {
+		return nil, errors.New("innerproduct: the size must be a power of 2")
+	}
+	// fold copies, and leave the vectors of the caller as they are
+	G, H = append([]kyber.Point{}, G...), append([]kyber.Point{}, H...)
+	a, b = append([]kyber.Scalar{}, a...), append([]kyber.Scalar{}, b...)
+	start(t, n)
+	p := new(Proof)
+	for n > 1 {
+		k := n / 2
+		cL := innerProduct(suite, a[:k], b[k:])
+		cR := innerProduct(suite, a[k:], b[:k])
+		L := multiMul(suite, a[:k], G[k:])
+		L.Add(L, multiMul(suite, b[k:], H[:k])).Add(L, suite.Point().Mul(cL, Q))
+		R := multiMul(suite, a[k:], G[:k])
+		R.Add(R, multiMul(suite, b[:k], H[k:])).Add(R, suite.Point().Mul(cR, Q))
+		t.AppendPoints("L", L)
+		t.AppendPoints("R", R)
+		p.L, p.R = append(p.L, L), append(p.R, R)
+		u := t.Challenge("u")
+		uInv := suite.Scalar().Inv(u)
+		for i := 0; i < k; i++ {
+			a[i] = suite.Scalar().Add(suite.Scalar().Mul(a[i], u), suite.Scalar().Mul(a[k+i], uInv))
+			b[i] = suite.Scalar().Add(suite.Scalar().Mul(b[i], uInv), suite.Scalar().Mul(b[k+i], u))
+			G[i] = suite.Point().Add(suite.Point().Mul(uInv, G[i]), suite.Point().Mul(u, G[k+i]))
+			H[i] = suite.Point().Add(suite.Point().Mul(u, H[i]), suite.Point().Mul(uInv, H[k+i]))
+		}
+		a, b, G, H, n = a[:k], b[:k], G[:k], H[:k], k
+	}
+	p.A, p.B = a[0], b[0]
+	return p, nil
+}
+
+// Verify checks that p proves that P = <a, G> + <b, H> + <a, b>*Q for some
+// vectors a and b known to the prover, with the transcript t in the same
+// state as when the proof was created.
+func (p *Proof) Verify(suite Suite, t *transcript.Transcript, G, H []kyber.Point, Q, P kyber.Point) error {
+	n := len(G)
+	if len(H) != n {
+		return errors.New("innerproduct: vectors of different lengths")
+	}
+	if n == 0 || n&(n-1) != 0 {
+		return errors.New("innerproduct: the size must be a power of 2")
+	}
+	rounds := 0
+	for 1<<uint(rounds) < n {
+		rounds++
+	}
+	if p.A == nil || p.B == nil || len(p.L) != rounds || len(p.R) != rounds {
+		return errInvalidProof
+	}
+	start(t, n)
+	u := make([]kyber.Scalar, rounds)
+	for j := range u {
+		t.AppendPoints("L", p.L[j])
+		t.AppendPoints("R", p.R[j])
+		u[j] = t.Challenge("u")
+	}
+
+	// The folded generators are sum(s_i G_i) and sum(s_i^-1 H_i), where s_i
+	// is the product of the u_j or of their inverses given by the bits of i,
+	// and the folded P is P + sum(u_j^2 L_j + u_j^-2 R_j).
+	uInv := make([]kyber.Scalar, rounds)
+	for j := range u {
+		uInv[j] = u[j].Clone()
+	}
+	kyber.BatchInvert(uInv)
+	gs, hs := make([]kyber.Scalar, n), make([]kyber.Scalar, n)
+	for i := range gs {
+		s, sInv := suite.Scalar().One(), suite.Scalar().One()
+		for j := 0; j < rounds; j++ {
+			if i>>uint(rounds-1-j)&1 == 1 {
+				s.Mul(s, u[j])
+				sInv.Mul(sInv, uInv[j])
+			} else {
+				s.Mul(s, uInv[j])
+				sInv.Mul(sInv, u[j])
+			}
+		}
+		gs[i] = s.Mul(s, p.A)
+		hs[i] = sInv.Mul(sInv, p.B)
+	}
+	lhs := multiMul(suite, gs, G)
+	lhs.Add(lhs, multiMul(suite, hs, H))
+	lhs.Add(lhs, suite.Point().Mul(suite.Scalar().Mul(p.A, p.B), Q))
+	rhs := suite.Point().Set(P)
+	for j := range u {
+		u2 := suite.Scalar().Mul(u[j], u[j])
+		rhs.Add(rhs, suite.Point().Mul(u2, p.L[j]))
+		rhs.Add(rhs, suite.Point().Mul(u2.Mul(uInv[j], uInv[j]), p.R[j]))
+	}
+	if !lhs.Equal(rhs) {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// MarshalBinary returns the binary representation of the proof: A, B, and
+// the points L and R of each round.
+func (p *Proof) MarshalBinary() ([]byte, error) {
+	var b bytes.Buffer
+	if _, err := p.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// MarshalTo writes the binary representation of the proof to w.
+func (p *Proof) MarshalTo(w io.Writer) (int, error) {
+	ms := []kyber.Marshaling{p.A, p.B}
+	for j := range p.L {
+		ms = append(ms, p.L[j], p.R[j])
+	}
+	var n int
+	for _, m := range ms {
+		k, err := m.MarshalTo(w)
+		n += k
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// UnmarshalBinary reads the proof from its binary representation.
+func (p *Proof) UnmarshalBinary(suite Suite, buff []byte) error {
+	pl, sl := suite.PointLen(), suite.ScalarLen()
+	if len(buff) < 2*sl || (len(buff)-2*sl)%(2*pl) != 0 {
+		return errors.New("innerproduct: invalid proof length")
+	}
+	rounds := (len(buff) - 2*sl) / (2 * pl)
+	q := &Proof{
+		A: suite.Scalar(), B: suite.Scalar(),
+		L: make([]kyber.Point, rounds), R: make([]kyber.Point, rounds),
+	}
+	ms := []kyber.Marshaling{q.A, q.B}
+	for j := 0; j < rounds; j++ {
+		q.L[j], q.R[j] = suite.Point(), suite.Point()
+		ms = append(ms, q.L[j], q.R[j])
+	}
+	r := bytes.NewReader(buff)
+	for _, m := range ms {
+		if _, err := m.UnmarshalFrom(r); err != nil {
+			return err
+		}
+	}
+	*p = *q
+	return nil
+}
+
+// start appends the statement of a proof of size n to t.
+func start(t *transcript.Transcript, n int) {
+	t.Append("dom-sep", []byte("inner product"))
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], uint32(n))
+	t.Append("n", b[:])
+}
+
+func innerProduct(suite Suite, a, b []kyber.Scalar) kyber.Scalar {
+	s := suite.Scalar().Zero()
+	for i := range a {
+		s.Add(s, suite.Scalar().Mul(a[i], b[i]))
+	}
+	return s
+}
+
+// multiMul returns the sum of the a_i * P_i.
+func multiMul(suite Suite, a []kyber.Scalar, P []kyber.Point) kyber.Point {
+	s := suite.Point().Null()
+	for i := range a {
+		s.Add(s, suite.Point().Mul(a[i], P[i]))
+	}
+	return s
+}
@@ -0,0 +1,70 @@
+package innerproduct
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+// statement returns random generators and vectors of size n, and the
+// commitment P to them.
+func statement(n int) ([]kyber.Point, []kyber.Point, kyber.Point, []kyber.Scalar, []kyber.Scalar, kyber.Point) {
+	G, H := make([]kyber.Point, n), make([]kyber.Point, n)
+	a, b := make([]kyber.Scalar, n), make([]kyber.Scalar, n)
+	for i := 0; i < n; i++ {
+		G[i] = suite.Point().Pick(random.New())
+		H[i] = suite.Point().Pick(random.New())
+		a[i] = suite.Scalar().Pick(random.New())
+		b[i] = suite.Scalar().Pick(random.New())
+	}
+	Q := suite.Point().Pick(random.New())
+	P := suite.Point().Add(multiMul(suite, a, G), multiMul(suite, b, H))
+	P.Add(P, suite.Point().Mul(innerProduct(suite, a, b), Q))
+	return G, H, Q, a, b, P
+}
+
+func TestInnerProduct(t *testing.T) {
+	for _, n := range []int{1, 2, 16} {
+		G, H, Q, a, b, P := statement(n)
+		a0 := suite.Scalar().Set(a[0])
+		p, err := Prove(suite, transcript.New(suite, "test"), G, H, Q, a, b)
+		require.Nil(t, err)
+		require.True(t, a0.Equal(a[0]))
+		require.Nil(t, p.Verify(suite, transcript.New(suite, "test"), G, H, Q, P))
+
+		require.Error(t, p.Verify(suite, transcript.New(suite, "test"), G, H, Q, suite.Point().Add(P, Q)))
+		require.Error(t, p.Verify(suite, transcript.New(suite, "test"), H, G, Q, P))
+		// Without rounds, there is no challenge to bind to the transcript.
+		if n > 1 {
+			require.Error(t, p.Verify(suite, transcript.New(suite, "other"), G, H, Q, P))
+			require.Error(t, p.Verify(suite, transcript.New(suite, "test"), G[:n/2], H[:n/2], Q, P))
+		}
+	}
+
+	G, H, Q, a, b, _ := statement(3)
+	_, err := Prove(suite, transcript.New(suite, "test"), G, H, Q, a, b)
+	require.Error(t, err)
+	_, err = Prove(suite, transcript.New(suite, "test"), G[:2], H[:2], Q, a[:2], b[:1])
+	require.Error(t, err)
+}
+
+func TestInnerProductMarshal(t *testing.T) {
+	G, H, Q, a, b, P := statement(8)
+	p, err := Prove(suite, transcript.New(suite, "test"), G, H, Q, a, b)
+	require.Nil(t, err)
+	buff, err := p.MarshalBinary()
+	require.Nil(t, err)
+	require.Len(t, buff, 2*suite.ScalarLen()+6*suite.PointLen())
+
+	decoded := new(Proof)
+	require.Nil(t, decoded.UnmarshalBinary(suite, buff))
+	require.Nil(t, decoded.Verify(suite, transcript.New(suite, "test"), G, H, Q, P))
+	require.Error(t, decoded.UnmarshalBinary(suite, buff[1:]))
+	require.Error(t, new(Proof).Verify(suite, transcript.New(suite, "test"), G, H, Q, P))
+}
@@ -0,0 +1,209 @@
+// +build experimental
+
+package proof
+
+import (
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+)
+
+////////// Pairing predicate //////////
+
+// A pairingTerm describes a term of a pairing-product expression.
+type pairingTerm struct {
+	S string // Scalar multiplier for this term
+	A string // Point of G1 for this term
+	B string // Point of G2 for this term
+}
+
+type pairingPred struct {
+	suite pairing.Suite
+	P     string        // Point of GT of which a representation is known
+	T     []pairingTerm // Terms comprising the known representation
+}
+
+// PairingRep creates a predicate stating that the prover knows
+// secrets x1,...,xn such that the point P of the target group GT
+// of a pairing suite is the sum x1*e(A1,B1)+...+xn*e(An,Bn),
+// for public points A1,...,An of G1 and B1,...,Bn of G2:
+//
+//	PairingRep(suite,P,x1,A1,B1,...,xn,An,Bn)
+//
+// Since the groups of the suite share their scalars, secrets are shared
+// with the other predicates on the same variables when the proof suite is
+// built on a group of the pairing suite. For example,
+//
+//	And(Rep(X,x,B),PairingRep(suite,T,x,A,Q))
+//
+// proves that T=e(x*A,Q) for the private key x of X=x*B in G1.
+func PairingRep(suite pairing.Suite, P string, SAB ...string) Predicate {
+	if len(SAB)%3 != 0 {
+		panic("mismatched Scalar")
+	}
+	t := make([]pairingTerm, len(SAB)/3)
+	for i := range t {
+		t[i].S = SAB[i*3]
+		t[i].A = SAB[i*3+1]
+		t[i].B = SAB[i*3+2]
+	}
+	return &pairingPred{suite, P, t}
+}
+
+// Return a string representation of this pairing predicate,
+// mainly for debugging.
+func (pp *pairingPred) String() string {
+	return pp.precString(precNone)
+}
+
+func (pp *pairingPred) precString(prec int) string {
+	s := pp.P + "="
+	for i := range pp.T {
+		if i > 0 {
+			s += "+"
+		}
+		t := &pp.T[i]
+		s += t.S + "*e(" + t.A + "," + t.B + ")"
+	}
+	return s
+}
+
+func (pp *pairingPred) enumVars(prf *proof) {
+	prf.enumPointVar(pp.P)
+	for i := range pp.T {
+		prf.enumScalarVar(pp.T[i].S)
+		prf.enumPointVar(pp.T[i].A)
+		prf.enumPointVar(pp.T[i].B)
+	}
+}
+
+// base returns the base e(A,B) of the i-th term.
+func (pp *pairingPred) base(prf *proof, i int) kyber.Point {
+	return pp.suite.Pair(prf.pval[pp.T[i].A], prf.pval[pp.T[i].B])
+}
+
+func (pp *pairingPred) commit(prf *proof, w kyber.Scalar, pv []kyber.Scalar) error {
+
+	// Create per-predicate prover state
+	v := prf.makeScalars(pv)
+	prf.pp[pp] = &proverPred{w, v, nil}
+
+	// Compute commit V=wP+v1*e(A1,B1)+...+vk*e(Ak,Bk) in GT
+	V := pp.suite.GT().Point()
+	if w != nil { // We're on a non-obligated branch
+		V.Mul(w, prf.pval[pp.P])
+	} else { // We're on a proof-obligated branch, so w=0
+		V.Null()
+	}
+	P := pp.suite.GT().Point()
+	for i := range pp.T {
+		s := prf.sidx[pp.T[i].S]
+
+		// Choose a blinding secret the first time
+		// we encounter each variable
+		if v[s] == nil {
+			v[s] = prf.s.Scalar()
+			prf.pc.PriRand(v[s])
+		}
+		P.Mul(v[s], pp.base(prf, i))
+		V.Add(V, P)
+	}
+
+	// Encode and send the commitment to the verifier
+	return prf.pc.Put(V)
+}
+
+func (pp *pairingPred) respond(prf *proof, c kyber.Scalar,
+	pr []kyber.Scalar) error {
+	st := prf.pp[pp]
+
+	// Create a response array for this OR-domain if not done already
+	r := prf.makeScalars(pr)
+
+	for i := range pp.T {
+		t := pp.T[i] // current term
+		s := prf.sidx[t.S]
+
+		// Produce a correct response for each variable
+		// the first time we encounter that variable.
+		if r[s] == nil {
+			if st.w != nil {
+				// We're on a non-proof-obligated branch:
+				// w was our challenge, v[s] is our response.
+				r[s] = st.v[s]
+				continue
+			}
+
+			// We're on a proof-obligated branch,
+			// so we need to calculate the correct response
+			// as r = v-cx where x is the secret variable
+			ri := prf.s.Scalar()
+			ri.Mul(c, prf.sval[t.S])
+			ri.Sub(st.v[s], ri)
+			r[s] = ri
+		}
+	}
+
+	// Send our responses if we created the array (i.e., if pr == nil)
+	return prf.sendResponses(pr, r)
+}
+
+func (pp *pairingPred) getCommits(prf *proof, pr []kyber.Scalar) error {
+
+	// Create per-predicate verifier state
+	V := pp.suite.GT().Point()
+	r := prf.makeScalars(pr)
+	vp := &verifierPred{V, r}
+	prf.vp[pp] = vp
+
+	// Get the commitment for this representation
+	if e := prf.vc.Get(vp.V); e != nil {
+		return e
+	}
+
+	// Fill in the r vector with the responses we'll need.
+	for i := range pp.T {
+		s := prf.sidx[pp.T[i].S]
+		if r[s] == nil {
+			r[s] = prf.s.Scalar()
+		}
+	}
+	return nil
+}
+
+func (pp *pairingPred) verify(prf *proof, c kyber.Scalar, pr []kyber.Scalar) error {
+	vp := prf.vp[pp]
+	r := vp.r
+
+	// Get the needed responses if a parent didn't already
+	if e := prf.getResponses(pr, r); e != nil {
+		return e
+	}
+
+	// Recompute commit V=cP+r1*e(A1,B1)+...+rk*e(Ak,Bk)
+	V := pp.suite.GT().Point()
+	V.Mul(c, prf.pval[pp.P])
+	P := pp.suite.GT().Point()
+	for i := range pp.T {
+		s := prf.sidx[pp.T[i].S]
+		P.Mul(r[s], pp.base(prf, i))
+		V.Add(V, P)
+	}
+	if !V.Equal(vp.V) {
+		return errors.New("invalid proof: commit mismatch")
+	}
+
+	return nil
+}
+
+func (pp *pairingPred) Prover(suite Suite, secrets map[string]kyber.Scalar,
+	points map[string]kyber.Point,
+	choice map[Predicate]int) Prover {
+	return proof{}.init(suite, pp).prover(pp, secrets, points, choice)
+}
+
+func (pp *pairingPred) Verifier(suite Suite,
+	points map[string]kyber.Point) Verifier {
+	return proof{}.init(suite, pp).verifier(pp, points)
+}
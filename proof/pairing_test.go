@@ -0,0 +1,69 @@
+// +build experimental,vartime
+
+package proof
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+// pairingSuite is a proof suite on G1 of a pairing suite.
+type pairingSuite struct {
+	kyber.Group
+	pairing.Suite
+}
+
+func TestPairingRep(t *testing.T) {
+	rand := blake.New([]byte("seed"))
+	ps := bls12381.NewBlakeSHA256BLS12381WithRand(rand)
+	suite := &pairingSuite{ps.G1(), ps}
+
+	x := ps.G1().Scalar().Pick(rand)
+	y := ps.G1().Scalar().Pick(rand)
+	A := ps.G1().Point().Pick(rand)
+	Q := ps.G2().Point().Pick(rand)
+	R := ps.G2().Point().Pick(rand)
+	T := ps.GT().Point().Add(ps.Pair(ps.G1().Point().Mul(x, A), Q),
+		ps.Pair(ps.G1().Point().Mul(y, A), R))
+	pval := map[string]kyber.Point{
+		"B": ps.G1().Point().Base(),
+		"X": ps.G1().Point().Mul(x, nil),
+		"A": A, "Q": Q, "R": R, "T": T,
+	}
+	sval := map[string]kyber.Scalar{"x": x, "y": y}
+
+	pred := And(Rep("X", "x", "B"), PairingRep(ps, "T", "x", "A", "Q", "y", "A", "R"))
+	if s := pred.String(); s != "X=x*B && T=x*e(A,Q)+y*e(A,R)" {
+		t.Fatal("unexpected string " + s)
+	}
+	proof, err := HashProve(suite, "TEST", pred.Prover(suite, sval, pval, nil))
+	if err != nil {
+		t.Fatal("prover: " + err.Error())
+	}
+	if err := HashVerify(suite, "TEST", pred.Verifier(suite, pval), proof); err != nil {
+		t.Fatal("verify: " + err.Error())
+	}
+
+	// The secrets are shared with the predicate on X.
+	wrong := map[string]kyber.Point{"X": ps.G1().Point().Mul(y, nil)}
+	for _, name := range []string{"B", "A", "Q", "R", "T"} {
+		wrong[name] = pval[name]
+	}
+	if HashVerify(suite, "TEST", pred.Verifier(suite, wrong), proof) == nil {
+		t.Fatal("proof verified for another point")
+	}
+
+	// A pairing predicate on a non-obligated branch is simulated.
+	or := Or(PairingRep(ps, "T", "y", "A", "Q"), Rep("X", "x", "B"))
+	proof, err = HashProve(suite, "TEST", or.Prover(suite, sval, pval, map[Predicate]int{or: 1}))
+	if err != nil {
+		t.Fatal("prover: " + err.Error())
+	}
+	if err := HashVerify(suite, "TEST", or.Verifier(suite, pval), proof); err != nil {
+		t.Fatal("verify: " + err.Error())
+	}
+}
@@ -6,6 +6,9 @@
 // "Proof Systems for General Statements about Discrete Logarithms" at
 // ftp://ftp.inf.ethz.ch/pub/crypto/publications/CamSta97b.pdf.
 //
+// Proofs may also be designated to a single verifier, whom alone they convince,
+// with DesignatedProver and DesignatedVerifier.
+//
 // Package shuffle requires build tag "experimental".
 package proof
 
@@ -33,7 +36,10 @@ Atomic predicates in this system are statements of the form P=x1*B1+...+xn+Bn,
 indicating the prover knows secrets x1,...,xn that make the statement true,
 where P and B1,...,Bn are public points known to the verifier.
 These atomic Rep (representation) predicates may be combined
-with logical And and Or combinators to form composite statements.
+with logical And and Or combinators to form composite statements,
+as may the atomic Range predicates, stating that a secret is in [0, 2^n),
+and PairingRep predicates, stating representations in the target group
+of a pairing.
 Predicate objects, once created, are immutable and safe to share
 or reuse for any number of proofs and verifications.
 
@@ -105,7 +111,8 @@ const (
 
 // Internal prover/verifier state
 type proof struct {
-	s Suite
+	s    Suite
+	pool *kyber.Pool // temporary Points and Scalars
 
 	nsvars     int            // number of Scalar variables
 	npvars     int            // number of Point variables
@@ -119,10 +126,12 @@ type proof struct {
 	sval   map[string]kyber.Scalar   // values of private Scalar variables
 	choice map[Predicate]int         // OR branch choices set by caller
 	pp     map[Predicate]*proverPred // per-predicate prover state
+	rpp    map[Predicate]*rangeProverPred
 
 	// verifier-specific state
-	vc VerifierContext
-	vp map[Predicate]*verifierPred // per-predicate verifier state
+	vc  VerifierContext
+	vp  map[Predicate]*verifierPred // per-predicate verifier state
+	rvp map[Predicate]*rangeVerifierPred
 }
 type proverPred struct {
 	w  kyber.Scalar   // secret pre-challenge
@@ -216,7 +225,8 @@ func (rp *repPred) commit(prf *proof, w kyber.Scalar, pv []kyber.Scalar) error {
 	} else { // We're on a proof-obligated branch, so w=0
 		V.Null()
 	}
-	P := prf.s.Point()
+	P := prf.pool.Point()
+	defer prf.pool.Release(P)
 	for i := 0; i < len(rp.T); i++ {
 		t := rp.T[i] // current term
 		s := prf.sidx[t.S]
@@ -304,9 +314,10 @@ func (rp *repPred) verify(prf *proof, c kyber.Scalar, pr []kyber.Scalar) error {
 	}
 
 	// Recompute commit V=cY+r1G1+...+rkGk
-	V := prf.s.Point()
+	V := prf.pool.Point()
 	V.Mul(c, prf.pval[rp.P])
-	P := prf.s.Point()
+	P := prf.pool.Point()
+	defer prf.pool.Release(V, P)
 	for i := 0; i < len(rp.T); i++ {
 		t := rp.T[i] // current term
 		s := prf.sidx[t.S]
@@ -641,6 +652,7 @@ func (p *Prover) Linear(a1,a2,b kyber.Scalar, x1,x2 PriVar) {
 
 func (prf proof) init(suite Suite, pred Predicate) *proof {
 	prf.s = suite
+	prf.pool = kyber.PoolOf(suite)
 
 	// Enumerate all the variables in a consistent order.
 	// Reserve variable index 0 for convenience.
@@ -716,6 +728,7 @@ func (prf *proof) prove(p Predicate, sval map[string]kyber.Scalar,
 	prf.pval = pval
 	prf.choice = choice
 	prf.pp = make(map[Predicate]*proverPred)
+	prf.rpp = make(map[Predicate]*rangeProverPred)
 
 	// Generate all commitments
 	if e := p.commit(prf, nil, nil); e != nil {
@@ -737,6 +750,7 @@ func (prf *proof) verify(p Predicate, pval map[string]kyber.Point,
 	prf.vc = vc
 	prf.pval = pval
 	prf.vp = make(map[Predicate]*verifierPred)
+	prf.rvp = make(map[Predicate]*rangeVerifierPred)
 
 	// Get the commitments from the verifier,
 	// and calculate the sets of responses we'll need for each OR-domain.
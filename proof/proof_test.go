@@ -117,8 +117,8 @@ func Example_rep2() {
 	// Proof:
 	// 00000000  e9 a2 da f4 9d 7c e2 25  35 be 0a 15 78 9c ea ca  |.....|.%5...x...|
 	// 00000010  a7 1e 6e d6 26 c3 40 ed  0d 3d 71 d4 a9 ef 55 3b  |..n.&.@..=q...U;|
-	// 00000020  c1 84 20 a6 b7 79 86 9c  f8 dd 09 82 1e 48 a9 00  |.. ..y.......H..|
-	// 00000030  3e f3 68 66 3f a0 58 f9  88 df b4 35 1b 2f 72 0d  |>.hf?.X....5./r.|
+	// 00000020  a9 ec de 69 4e 94 ee f6  66 c7 c3 f2 f1 1d 80 12  |...iN...f.......|
+	// 00000030  70 e8 00 75 a0 1a c3 d6  f2 9a 6e ac a8 68 27 08  |p..u......n..h'.|
 	// Proof verified.
 }
 
@@ -238,12 +238,12 @@ func Example_or2() {
 	// 00000010  4c c8 15 ed b1 eb 50 d3  d9 d2 9b 31 6c d3 0f 6b  |L.....P....1l..k|
 	// 00000020  a2 a9 bc d2 8c 6d d0 5e  9a 8e d1 8e 04 fb 88 af  |.....m.^........|
 	// 00000030  fb 90 8a 2a 71 ac 34 08  f9 bc 07 78 08 44 40 07  |...*q.4....x.D@.|
-	// 00000040  ab 1f 36 7e 7b db 50 7d  49 38 34 75 69 07 67 4b  |..6~{.P}I84ui.gK|
-	// 00000050  55 cb 28 f2 50 ad d1 4b  24 d2 d1 44 fe 44 b0 0e  |U.(.P..K$..D.D..|
+	// 00000040  ce b4 1c be b5 81 0c b8  91 10 be 41 5d 8e 3e 03  |...........A].>.|
+	// 00000050  e4 8d 44 7b 51 ed 3e 18  93 76 45 2b b0 c3 ae 00  |..D{Q.>..vE+....|
 	// 00000060  00 e8 d3 8b 37 76 4f 47  d1 4a 93 0c cd df 20 08  |....7vOG.J.... .|
 	// 00000070  fc 0f ad f9 01 6c 30 c0  02 d4 fa 1b 1f 1c fa 04  |.....l0.........|
-	// 00000080  6d 2a a7 d8 8e 67 72 87  51 0e 16 72 51 87 99 83  |m*...gr.Q..rQ...|
-	// 00000090  2e c9 4e a1 ca 20 7d 64  33 04 f5 66 9b d3 74 03  |..N.. }d3..f..t.|
+	// 00000080  71 62 0f 13 e5 47 1e 6f  38 ea 9d 77 56 a6 72 f1  |qb...G.o8..wV.r.|
+	// 00000090  6f 62 50 68 4e 87 bb 30  8c b1 d5 b7 bd 4b 3c 0a  |obPhN..0.....K<.|
 	// 000000a0  2b e0 be 8d 56 55 1a d1  6e 11 21 fc 20 3e 0f 5f  |+...VU..n.!. >._|
 	// 000000b0  4d 97 a9 bf 1a 28 27 6d  3b 71 04 e1 c0 86 96 08  |M....('m;q......|
 	// Proof verified.
@@ -0,0 +1,383 @@
+// +build experimental
+
+package proof
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+)
+
+////////// Range predicate //////////
+
+type rangePred struct {
+	S    string // Secret whose range is proven
+	N    int    // Number of bits of the range
+	G, H string // Generators of the commitments to the bits
+}
+
+// Per-predicate prover state of a range predicate
+type rangeProverPred struct {
+	w    kyber.Scalar   // secret pre-challenge
+	v    []kyber.Scalar // secret blinding factor for each variable
+	b    []int          // bits of the secret
+	r    []kyber.Scalar // blinding factors of the bit commitments
+	t    []kyber.Scalar // blinding secrets of the bit proofs
+	e, s []kyber.Scalar // challenges and responses of the simulated branches
+	s0   []kyber.Scalar // non-obligated branch: responses of the 0 branches
+	vrho kyber.Scalar   // blinding secret of the sum of the r
+}
+
+// Per-predicate verifier state of a range predicate
+type rangeVerifierPred struct {
+	C      []kyber.Point // bit commitments
+	V      kyber.Point   // commitment of the link to the secret
+	V0, V1 []kyber.Point // commitments of the bit proofs
+	r      []kyber.Scalar
+}
+
+// Range creates a predicate stating that the secret x is in [0, 2^n),
+// where 2^n is less than the order of the group.
+//
+// The prover commits to each bit of x with a Pedersen commitment
+// Ci=bi*G+ri*H, shows in an OR-proof that it commits to 0 or 1,
+// and that the sum of the 2^i*Ci commits to x, whose response
+// is shared with the other predicates on x. The discrete logarithm of H
+// with respect to G must be unknown to the prover, for example because
+// both are hashed to the group. Since x is otherwise unconstrained, the
+// predicate is meant to be combined with others binding x, such as in
+//
+//	And(Rep(X,x,B),Range(x,32,G,H))
+//
+// which proves knowledge of the private key x of X, in [0, 2^32).
+func Range(x string, n int, G, H string) Predicate {
+	if n <= 0 {
+		panic("invalid number of bits")
+	}
+	return &rangePred{x, n, G, H}
+}
+
+// Return a string representation of this range predicate,
+// mainly for debugging.
+func (rp *rangePred) String() string {
+	return rp.precString(precNone)
+}
+
+func (rp *rangePred) precString(prec int) string {
+	return fmt.Sprintf("Range(%s,%d,%s,%s)", rp.S, rp.N, rp.G, rp.H)
+}
+
+// slot returns the name of the i-th internal variable of the given kind.
+// The responses of the bit proofs are carried as internal variables of the
+// predicate, so that they are sent along with the responses of the
+// enclosing OR-domain.
+func (rp *rangePred) slot(kind string, i int) string {
+	return fmt.Sprintf("%s.%s%d", rp.String(), kind, i)
+}
+
+func (rp *rangePred) enumVars(prf *proof) {
+	prf.enumScalarVar(rp.S)
+	prf.enumPointVar(rp.G)
+	prf.enumPointVar(rp.H)
+	prf.enumScalarVar(rp.slot("rho", 0))
+	for i := 0; i < rp.N; i++ {
+		prf.enumScalarVar(rp.slot("e", i))
+		prf.enumScalarVar(rp.slot("s0", i))
+		prf.enumScalarVar(rp.slot("s1", i))
+	}
+}
+
+// bits returns the n least significant bits of x, or an error if x is not
+// in [0, 2^n).
+func (rp *rangePred) bits(prf *proof, x kyber.Scalar) ([]int, error) {
+	buf, err := x.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	// Scalars are marshaled in little- or big-endian order,
+	// depending on the group.
+	one, err := prf.s.Scalar().One().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if one[0] != 1 {
+		for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+			buf[i], buf[j] = buf[j], buf[i]
+		}
+	}
+	b := make([]int, rp.N)
+	for i := 0; i < 8*len(buf); i++ {
+		bit := int(buf[i/8] >> uint(i%8) & 1)
+		if i < rp.N {
+			b[i] = bit
+		} else if bit != 0 {
+			return nil, errors.New("secret " + rp.S + " out of range")
+		}
+	}
+	return b, nil
+}
+
+// sum returns the sum of the 2^i*Pi.
+func (rp *rangePred) sum(prf *proof, P []kyber.Point) kyber.Point {
+	S := prf.s.Point().Null()
+	two := prf.pool.Scalar().One()
+	T := prf.pool.Point()
+	defer prf.pool.Release(two, T)
+	for i := range P {
+		S.Add(S, T.Mul(two, P[i]))
+		two.Add(two, two)
+	}
+	return S
+}
+
+func (rp *rangePred) commit(prf *proof, w kyber.Scalar, pv []kyber.Scalar) error {
+	v := prf.makeScalars(pv)
+	rho := prf.sidx[rp.slot("rho", 0)]
+	if v[rho] != nil {
+		// The same range was already proven in this AND-domain.
+		return nil
+	}
+
+	// Create per-predicate prover state
+	pp := &rangeProverPred{w: w, v: v, r: make([]kyber.Scalar, rp.N),
+		t: make([]kyber.Scalar, rp.N), e: make([]kyber.Scalar, rp.N),
+		s: make([]kyber.Scalar, rp.N), s0: make([]kyber.Scalar, rp.N)}
+	prf.rpp[rp] = pp
+	if w == nil {
+		x, ok := prf.sval[rp.S]
+		if !ok {
+			return errors.New("no value for secret " + rp.S)
+		}
+		b, err := rp.bits(prf, x)
+		if err != nil {
+			return err
+		}
+		pp.b = b
+	} else {
+		// On a non-obligated branch, commit to zero bits.
+		pp.b = make([]int, rp.N)
+	}
+
+	// Commit to the bits
+	G, H := prf.pval[rp.G], prf.pval[rp.H]
+	C := make([]kyber.Point, rp.N)
+	for i := range C {
+		pp.r[i] = prf.s.Scalar()
+		prf.pc.PriRand(pp.r[i])
+		C[i] = prf.s.Point().Mul(pp.r[i], H)
+		if pp.b[i] == 1 {
+			C[i].Add(C[i], G)
+		}
+		if e := prf.pc.Put(C[i]); e != nil {
+			return e
+		}
+	}
+
+	// Commit to the link V=v*G+vrho*H between the secret and the sum of the
+	// bit commitments, plus w times the sum on a non-obligated branch.
+	s := prf.sidx[rp.S]
+	if v[s] == nil {
+		v[s] = prf.s.Scalar()
+		prf.pc.PriRand(v[s])
+	}
+	pp.vrho = prf.s.Scalar()
+	prf.pc.PriRand(pp.vrho)
+	v[rho] = pp.vrho
+	V := prf.s.Point().Add(prf.s.Point().Mul(v[s], G), prf.s.Point().Mul(pp.vrho, H))
+	if w != nil {
+		V.Add(V, prf.s.Point().Mul(w, rp.sum(prf, C)))
+	}
+	if e := prf.pc.Put(V); e != nil {
+		return e
+	}
+
+	// Commit to the proofs that Ci-k*G=ri*H for k=0 or k=1
+	for i := range C {
+		Ck := [2]kyber.Point{C[i], prf.s.Point().Sub(C[i], G)}
+		var Vk [2]kyber.Point
+		pp.e[i] = prf.s.Scalar()
+		prf.pc.PriRand(pp.e[i])
+		pp.s[i] = prf.s.Scalar()
+		prf.pc.PriRand(pp.s[i])
+		if w == nil {
+			// Prove the branch of the bit, and simulate the other one
+			// with the challenge e and the response s.
+			b := pp.b[i]
+			pp.t[i] = prf.s.Scalar()
+			prf.pc.PriRand(pp.t[i])
+			Vk[b] = prf.s.Point().Mul(pp.t[i], H)
+			Vk[1-b] = prf.s.Point().Mul(pp.e[i], Ck[1-b])
+			Vk[1-b].Add(Vk[1-b], prf.s.Point().Mul(pp.s[i], H))
+		} else {
+			// Simulate both branches, with the challenges e and w-e.
+			pp.s0[i] = prf.s.Scalar()
+			prf.pc.PriRand(pp.s0[i])
+			Vk[0] = prf.s.Point().Mul(pp.e[i], Ck[0])
+			Vk[0].Add(Vk[0], prf.s.Point().Mul(pp.s0[i], H))
+			Vk[1] = prf.s.Point().Mul(prf.s.Scalar().Sub(w, pp.e[i]), Ck[1])
+			Vk[1].Add(Vk[1], prf.s.Point().Mul(pp.s[i], H))
+		}
+		if e := prf.pc.Put(Vk[0]); e != nil {
+			return e
+		}
+		if e := prf.pc.Put(Vk[1]); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func (rp *rangePred) respond(prf *proof, c kyber.Scalar, pr []kyber.Scalar) error {
+	// Create a response array for this OR-domain if not done already
+	r := prf.makeScalars(pr)
+	rho := prf.sidx[rp.slot("rho", 0)]
+	if r[rho] != nil {
+		// The same range was already proven in this AND-domain.
+		return nil
+	}
+	pp := prf.rpp[rp]
+
+	// Response for the secret, if no other predicate produced it
+	s := prf.sidx[rp.S]
+	if r[s] == nil {
+		if pp.w != nil {
+			r[s] = pp.v[s]
+		} else {
+			ri := prf.s.Scalar().Mul(c, prf.sval[rp.S])
+			r[s] = ri.Sub(pp.v[s], ri)
+		}
+	}
+
+	// Responses for the sum of the blinding factors and the bit proofs,
+	// sent as internal variables: the challenge of the 0 branch
+	// and the responses of both branches.
+	if pp.w != nil {
+		r[rho] = pp.vrho
+		for i := 0; i < rp.N; i++ {
+			r[prf.sidx[rp.slot("e", i)]] = pp.e[i]
+			r[prf.sidx[rp.slot("s0", i)]] = pp.s0[i]
+			r[prf.sidx[rp.slot("s1", i)]] = pp.s[i]
+		}
+		return prf.sendResponses(pr, r)
+	}
+	sum := prf.s.Scalar().Zero()
+	two := prf.s.Scalar().One()
+	for i := 0; i < rp.N; i++ {
+		sum.Add(sum, prf.s.Scalar().Mul(two, pp.r[i]))
+		two.Add(two, two)
+	}
+	r[rho] = sum.Sub(pp.vrho, sum.Mul(c, sum))
+	for i := 0; i < rp.N; i++ {
+		b := pp.b[i]
+		var ck, sk [2]kyber.Scalar
+		ck[1-b], sk[1-b] = pp.e[i], pp.s[i]
+		ck[b] = prf.s.Scalar().Sub(c, pp.e[i])
+		sk[b] = prf.s.Scalar().Mul(ck[b], pp.r[i])
+		sk[b].Sub(pp.t[i], sk[b])
+		r[prf.sidx[rp.slot("e", i)]] = ck[0]
+		r[prf.sidx[rp.slot("s0", i)]] = sk[0]
+		r[prf.sidx[rp.slot("s1", i)]] = sk[1]
+	}
+	return prf.sendResponses(pr, r)
+}
+
+func (rp *rangePred) getCommits(prf *proof, pr []kyber.Scalar) error {
+	r := prf.makeScalars(pr)
+	rho := prf.sidx[rp.slot("rho", 0)]
+	if r[rho] != nil {
+		// The same range was already proven in this AND-domain.
+		return nil
+	}
+
+	// Create per-predicate verifier state
+	vp := &rangeVerifierPred{C: make([]kyber.Point, rp.N), V: prf.s.Point(),
+		V0: make([]kyber.Point, rp.N), V1: make([]kyber.Point, rp.N), r: r}
+	prf.rvp[rp] = vp
+
+	// Get the commitments, in the order of the prover
+	for i := range vp.C {
+		vp.C[i] = prf.s.Point()
+		if e := prf.vc.Get(vp.C[i]); e != nil {
+			return e
+		}
+	}
+	if e := prf.vc.Get(vp.V); e != nil {
+		return e
+	}
+	for i := range vp.C {
+		vp.V0[i], vp.V1[i] = prf.s.Point(), prf.s.Point()
+		if e := prf.vc.Get(vp.V0[i]); e != nil {
+			return e
+		}
+		if e := prf.vc.Get(vp.V1[i]); e != nil {
+			return e
+		}
+	}
+
+	// Fill in the r vector with the responses we'll need.
+	s := prf.sidx[rp.S]
+	if r[s] == nil {
+		r[s] = prf.s.Scalar()
+	}
+	r[rho] = prf.s.Scalar()
+	for i := 0; i < rp.N; i++ {
+		r[prf.sidx[rp.slot("e", i)]] = prf.s.Scalar()
+		r[prf.sidx[rp.slot("s0", i)]] = prf.s.Scalar()
+		r[prf.sidx[rp.slot("s1", i)]] = prf.s.Scalar()
+	}
+	return nil
+}
+
+func (rp *rangePred) verify(prf *proof, c kyber.Scalar, pr []kyber.Scalar) error {
+	vp, ok := prf.rvp[rp]
+	if !ok {
+		// The same range was already verified in this AND-domain.
+		return nil
+	}
+	r := vp.r
+
+	// Get the needed responses if a parent didn't already
+	if e := prf.getResponses(pr, r); e != nil {
+		return e
+	}
+
+	// Check the link V=c*C+r*G+rrho*H, where C is the sum of the 2^i*Ci
+	G, H := prf.pval[rp.G], prf.pval[rp.H]
+	pool := prf.pool
+	V, V0, V1 := pool.Point(), pool.Point(), pool.Point()
+	T, D := pool.Point(), pool.Point()
+	c1 := pool.Scalar()
+	defer pool.Release(V, V0, V1, T, D, c1)
+	V.Mul(c, rp.sum(prf, vp.C))
+	V.Add(V, T.Mul(r[prf.sidx[rp.S]], G))
+	V.Add(V, T.Mul(r[prf.sidx[rp.slot("rho", 0)]], H))
+	if !V.Equal(vp.V) {
+		return errors.New("invalid proof: commit mismatch")
+	}
+
+	// Check the bit proofs Vk=ck*(Ci-k*G)+sk*H, with c0+c1=c
+	for i, C := range vp.C {
+		c0 := r[prf.sidx[rp.slot("e", i)]]
+		c1.Sub(c, c0)
+		V0.Mul(c0, C)
+		V0.Add(V0, T.Mul(r[prf.sidx[rp.slot("s0", i)]], H))
+		V1.Mul(c1, D.Sub(C, G))
+		V1.Add(V1, T.Mul(r[prf.sidx[rp.slot("s1", i)]], H))
+		if !V0.Equal(vp.V0[i]) || !V1.Equal(vp.V1[i]) {
+			return errors.New("invalid proof: bit commit mismatch")
+		}
+	}
+	return nil
+}
+
+func (rp *rangePred) Prover(suite Suite, secrets map[string]kyber.Scalar,
+	points map[string]kyber.Point,
+	choice map[Predicate]int) Prover {
+	return proof{}.init(suite, rp).prover(rp, secrets, points, choice)
+}
+
+func (rp *rangePred) Verifier(suite Suite,
+	points map[string]kyber.Point) Verifier {
+	return proof{}.init(suite, rp).verifier(rp, points)
+}
@@ -0,0 +1,86 @@
+// +build experimental
+
+package proof
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+func TestRange(t *testing.T) {
+	rand := blake.New([]byte("seed"))
+	suite := edwards25519.NewBlakeSHA256Ed25519WithRand(rand)
+
+	x := suite.Scalar().SetInt64(123456)
+	y := suite.Scalar().Pick(rand)
+	pval := map[string]kyber.Point{
+		"B": suite.Point().Base(),
+		"G": suite.Point().Pick(suite.XOF([]byte("G"))),
+		"H": suite.Point().Pick(suite.XOF([]byte("H"))),
+		"X": suite.Point().Mul(x, nil),
+		"Y": suite.Point().Mul(y, nil),
+	}
+	sval := map[string]kyber.Scalar{"x": x, "y": y}
+
+	pred := And(Rep("X", "x", "B"), Range("x", 32, "G", "H"))
+	if s := pred.String(); s != "X=x*B && Range(x,32,G,H)" {
+		t.Fatal("unexpected string " + s)
+	}
+	proof, err := HashProve(suite, "TEST", pred.Prover(suite, sval, pval, nil))
+	if err != nil {
+		t.Fatal("prover: " + err.Error())
+	}
+	if err := HashVerify(suite, "TEST", pred.Verifier(suite, pval), proof); err != nil {
+		t.Fatal("verify: " + err.Error())
+	}
+
+	// The proof is bound to the secret of X.
+	wval := map[string]kyber.Point{"X": pval["Y"]}
+	for _, name := range []string{"B", "G", "H"} {
+		wval[name] = pval[name]
+	}
+	if HashVerify(suite, "TEST", pred.Verifier(suite, wval), proof) == nil {
+		t.Fatal("proof verified for another point")
+	}
+	proof[len(proof)-1] ^= 1
+	if HashVerify(suite, "TEST", pred.Verifier(suite, pval), proof) == nil {
+		t.Fatal("tampered proof verified")
+	}
+
+	// Secrets out of range are rejected by the prover.
+	short := And(Rep("X", "x", "B"), Range("x", 16, "G", "H"))
+	_, err = HashProve(suite, "TEST", short.Prover(suite, sval, pval, nil))
+	if err == nil {
+		t.Fatal("proved a secret out of range")
+	}
+
+	// A range on a non-obligated branch is simulated.
+	or := Or(short, Rep("Y", "y", "B"))
+	choice := map[Predicate]int{or: 1}
+	proof, err = HashProve(suite, "TEST", or.Prover(suite, sval, pval, choice))
+	if err != nil {
+		t.Fatal("prover: " + err.Error())
+	}
+	if err := HashVerify(suite, "TEST", or.Verifier(suite, pval), proof); err != nil {
+		t.Fatal("verify: " + err.Error())
+	}
+	choice[or] = 0
+	if _, err := HashProve(suite, "TEST", or.Prover(suite, sval, pval, choice)); err == nil {
+		t.Fatal("proved a secret out of range")
+	}
+
+	// A range on its own, and repeated in an AND-domain.
+	for _, pred := range []Predicate{Range("x", 32, "G", "H"),
+		And(Range("x", 17, "G", "H"), Rep("X", "x", "B"), Range("x", 17, "G", "H"))} {
+		proof, err = HashProve(suite, "TEST", pred.Prover(suite, sval, pval, nil))
+		if err != nil {
+			t.Fatal("prover: " + err.Error())
+		}
+		if err := HashVerify(suite, "TEST", pred.Verifier(suite, pval), proof); err != nil {
+			t.Fatal("verify: " + err.Error())
+		}
+	}
+}
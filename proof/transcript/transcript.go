@@ -0,0 +1,137 @@
+// Package transcript provides transcripts for the Fiat-Shamir transform of
+// interactive proofs into non-interactive ones, in the style of Merlin.
+//
+// A transcript records all the messages of a protocol, each under a label,
+// and derives the challenges of the verifier from all of them. Both the
+// prover and the verifier build the same transcript, starting from the label
+// of the protocol: challenges are thus bound to the protocol, to every
+// statement and commitment appended before them, and to each other, so that
+// a proof made for one protocol or statement is not valid for another.
+// Protocols compose by passing their transcript to the proofs they use,
+// which append to it with their own labels.
+//
+// Transcripts are backed by the XOF of the suite. Every message and
+// challenge is framed by its kind and the lengths of its label and data, and
+// every challenge is appended to the transcript once derived.
+package transcript
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
+)
+
+// Suite wraps the functionalities needed by the transcript package.
+type Suite interface {
+	kyber.Group
+	kyber.XOFFactory
+}
+
+// protocol is the label of the transcripts of this package, which sets them
+// apart from other uses of the XOF.
+const protocol = "kyber transcript v1"
+
+// Operations on transcripts, which prefix their labels in the XOF.
+const (
+	opMessage   = 'm'
+	opChallenge = 'c'
+)
+
+// Transcript is the transcript of a protocol.
+type Transcript struct {
+	suite Suite
+	xof   kyber.XOF
+}
+
+// New returns the transcript of the protocol of the given label.
+func New(suite Suite, label string) *Transcript {
+	t := &Transcript{suite: suite, xof: suite.XOF([]byte(protocol))}
+	t.Append("dom-sep", []byte(label))
+	return t
+}
+
+// Append appends the message data to the transcript, under the given label.
+func (t *Transcript) Append(label string, data []byte) {
+	frame(t.xof, opMessage, label)
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(data)))
+	t.xof.Write(l[:])
+	t.xof.Write(data)
+}
+
+// AppendPoints appends the encodings of the points to the transcript, under
+// the given label.
+func (t *Transcript) AppendPoints(label string, points ...kyber.Point) {
+	for _, p := range points {
+		buf, _ := p.MarshalBinary()
+		t.Append(label, buf)
+	}
+}
+
+// AppendScalars appends the encodings of the scalars to the transcript,
+// under the given label.
+func (t *Transcript) AppendScalars(label string, scalars ...kyber.Scalar) {
+	for _, s := range scalars {
+		buf, _ := s.MarshalBinary()
+		t.Append(label, buf)
+	}
+}
+
+// AppendInts appends the big-endian encodings of the non-negative integers
+// to the transcript, under the given label, for proofs about integers
+// rather than group elements.
+func (t *Transcript) AppendInts(label string, ints ...*big.Int) {
+	for _, i := range ints {
+		t.Append(label, i.Bytes())
+	}
+}
+
+// ChallengeBytes returns n bytes of challenge derived from the transcript,
+// which are appended to it under the given label.
+func (t *Transcript) ChallengeBytes(label string, n int) []byte {
+	out := make([]byte, n)
+	t.stream(label).XORKeyStream(out, out)
+	t.Append(label, out)
+	return out
+}
+
+// Challenge returns a scalar challenge derived from the transcript, which is
+// appended to it under the given label.
+func (t *Transcript) Challenge(label string) kyber.Scalar {
+	c := t.suite.Scalar().Pick(t.stream(label))
+	t.AppendScalars(label, c)
+	return c
+}
+
+// ChallengeInt returns an integer challenge uniform in [1, max), derived
+// from the transcript, which is appended to it under the given label.
+func (t *Transcript) ChallengeInt(label string, max *big.Int) *big.Int {
+	c := random.Int(max, t.stream(label))
+	t.AppendInts(label, c)
+	return c
+}
+
+// stream returns the output stream of the challenge of the given label,
+// read from a copy of the XOF so that the transcript can be appended to.
+func (t *Transcript) stream(label string) kyber.XOF {
+	x := t.xof.Clone()
+	frame(x, opChallenge, label)
+	return x
+}
+
+// frame writes the operation op and its label to the XOF.
+func frame(x kyber.XOF, op byte, label string) {
+	var l [5]byte
+	l[0] = op
+	binary.BigEndian.PutUint32(l[1:], uint32(len(label)))
+	x.Write(l[:])
+	x.Write([]byte(label))
+}
+
+// Clone returns a copy of the transcript in its current state, for example
+// to derive independent proofs from a common statement.
+func (t *Transcript) Clone() *Transcript {
+	return &Transcript{suite: t.suite, xof: t.xof.Clone()}
+}
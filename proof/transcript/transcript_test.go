@@ -0,0 +1,66 @@
+package transcript
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func TestTranscript(t *testing.T) {
+	P := suite.Point().Pick(suite.RandomStream())
+	s := suite.Scalar().Pick(suite.RandomStream())
+	build := func(protocol string, msg []byte) *Transcript {
+		tr := New(suite, protocol)
+		tr.Append("msg", msg)
+		tr.AppendPoints("points", P, P)
+		tr.AppendScalars("scalar", s)
+		return tr
+	}
+
+	// Both sides derive the same challenges.
+	a, b := build("proto", []byte("hello")), build("proto", []byte("hello"))
+	c := a.Challenge("c")
+	require.True(t, c.Equal(b.Challenge("c")))
+	require.Equal(t, a.ChallengeBytes("bytes", 32), b.ChallengeBytes("bytes", 32))
+
+	// Challenges depend on the protocol, the messages, and their framing.
+	for _, other := range []*Transcript{
+		build("other", []byte("hello")),
+		build("proto", []byte("hell")),
+		New(suite, "proto"),
+	} {
+		require.False(t, c.Equal(other.Challenge("c")))
+	}
+	x, y := New(suite, "proto"), New(suite, "proto")
+	x.Append("ab", []byte("c"))
+	y.Append("a", []byte("bc"))
+	require.False(t, x.Challenge("c").Equal(y.Challenge("c")))
+
+	// Challenges depend on their label and on the previous challenges.
+	a, b = build("proto", []byte("hello")), build("proto", []byte("hello"))
+	require.False(t, a.Challenge("c").Equal(b.Challenge("d")))
+	require.False(t, a.Challenge("c").Equal(c))
+
+	// Integers are appended and derived like the other messages.
+	q := big.NewInt(1000003)
+	a, b = build("proto", []byte("hello")), build("proto", []byte("hello"))
+	a.AppendInts("ints", big.NewInt(1), q)
+	b.AppendInts("ints", big.NewInt(1), q)
+	e := a.ChallengeInt("e", q)
+	require.True(t, e.Sign() > 0 && e.Cmp(q) < 0)
+	require.Equal(t, e, b.ChallengeInt("e", q))
+	b = build("proto", []byte("hello"))
+	b.AppendInts("ints", big.NewInt(2), q)
+	require.NotEqual(t, e, b.ChallengeInt("e", q))
+
+	// Clones evolve independently.
+	a = build("proto", []byte("hello"))
+	clone := a.Clone()
+	clone.Append("more", nil)
+	require.False(t, clone.Challenge("c").Equal(c))
+	require.True(t, a.Challenge("c").Equal(c))
+}
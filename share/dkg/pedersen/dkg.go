@@ -1,14 +1,53 @@
 // Package dkg implements the protocol described in "A threshold cryptosystem without a trusted party"
 // by Torben Pryds Pedersen. https://dl.acm.org/citation.cfm?id=1754929.
+//
+// The protocol is driven by messages: every participant sends its Deals,
+// answers those it receives with Responses, and answers the complaints
+// against its own deal with Justifications, until the key is Certified and
+// DistKeyShare returns its share. The messages are independent of the
+// network layer, and can be sent in their binary representation with
+// MarshalBinary and UnmarshalBinary.
+//
+// Dealers that misbehave are excluded from the QUALIFIED set, so that once
+// SetTimeout has been called the protocol completes with the other dealers,
+// as long as at least t of them remain. A dealer caught by a wrong
+// justification is identified by evidence that anyone can check with
+// VerifyEvidence.
+//
+// The protocol does not rely on the order in which messages are delivered:
+// responses and justifications received before the deal they are about are
+// kept until it arrives, so that participants on a network without bounded
+// delays, such as a WAN, can process messages as they come. The
+// participants end each Phase on their own timeouts with NextPhase, after
+// which the late messages of the phase are rejected, and compute their
+// DistKeyShare in the FinishPhase. Participants that do not receive the same
+// messages in time may still disagree on the QUALIFIED set: there is no
+// agreement protocol on it.
+//
+// The threshold t can be anything from 2 up to n, although vss.MinimumT(n)
+// is the lowest one that is proven secure. A threshold above n/2 makes the
+// key harder to steal, at the cost of liveness: the protocol only completes
+// if at least t participants send their deals, and a participant that does
+// not send its responses counts as a complaint against every deal.
+//
+// Long-lived keys can be refreshed periodically, which makes the shares that
+// an attacker slowly collects useless once the number of participants that
+// are compromised between two refreshes stays below t: every participant
+// runs the protocol again with NewDistKeyRefresher and renews its share with
+// DistKeyShare.Renew, which leaves the distributed key unchanged. A key can
+// also be handed over to a new committee, with a different threshold, by a
+// Resharer.
 package dkg
 
 import (
 	"errors"
+	"reflect"
 
 	"github.com/dedis/kyber"
 
 	"github.com/dedis/kyber/share"
 	vss "github.com/dedis/kyber/share/vss/pedersen"
+	"github.com/dedis/protobuf"
 )
 
 // Suite wraps the functionalities needed by the dkg package
@@ -27,6 +66,33 @@ func (d *DistKeyShare) Public() kyber.Point {
 	return d.Commits[0]
 }
 
+// Renew adds the distributed key share g, a sharing of zero generated with
+// NewDistKeyRefresher, to the distributed key share d. It returns the new
+// share of the same distributed key, or an error if g does not share zero or
+// does not belong to the same participant and threshold as d.
+func (d *DistKeyShare) Renew(suite Suite, g *DistKeyShare) (*DistKeyShare, error) {
+	if d.Share.I != g.Share.I {
+		return nil, errors.New("dkg: renewal share of a different participant")
+	}
+	if len(d.Commits) != len(g.Commits) {
+		return nil, errors.New("dkg: renewal share of a different threshold")
+	}
+	if !g.Public().Equal(suite.Point().Null()) {
+		return nil, errors.New("dkg: renewal share does not share zero")
+	}
+	commits := make([]kyber.Point, len(d.Commits))
+	for i := range commits {
+		commits[i] = suite.Point().Add(d.Commits[i], g.Commits[i])
+	}
+	return &DistKeyShare{
+		Commits: commits,
+		Share: &share.PriShare{
+			I: d.Share.I,
+			V: suite.Scalar().Add(d.Share.V, g.Share.V),
+		},
+	}, nil
+}
+
 // PriShare implements the dss.DistKeyShare interface so either pedersen or
 // rabin dkg can be used with dss.
 func (d *DistKeyShare) PriShare() *share.PriShare {
@@ -39,6 +105,12 @@ func (d *DistKeyShare) Commitments() []kyber.Point {
 	return d.Commits
 }
 
+// Destroy overwrites the share of the distributed secret once it is no
+// longer needed, such as after a renewal. The commitments are kept.
+func (d *DistKeyShare) Destroy() {
+	d.Share.Destroy()
+}
+
 // Deal holds the Deal for one participant as well as the index of the issuing
 // Dealer.
 type Deal struct {
@@ -80,13 +152,48 @@ type DistKeyGenerator struct {
 
 	dealer    *vss.Dealer
 	verifiers map[uint32]*vss.Verifier
+	// evidence of misbehavior of the dealers, by index of dealer
+	evidence map[uint32]*Justification
+	timeout  bool
+	phase    Phase
+
+	// responses and justifications received before the deal they are
+	// about
+	pending *pending
+
+	// whether only deals of a zero secret are accepted
+	refresh bool
 }
 
 // NewDistKeyGenerator returns a DistKeyGenerator out of the suite,
 // the longterm secret key, the list of participants, and the
-// threshold t parameter. It returns an error if the secret key's
-// commitment can't be found in the list of participants.
+// threshold t parameter, which can be as high as the number of
+// participants. It returns an error if the secret key's commitment can't be
+// found in the list of participants.
 func NewDistKeyGenerator(suite Suite, longterm kyber.Scalar, participants []kyber.Point, t int) (*DistKeyGenerator, error) {
+	ownSec := suite.Scalar().Pick(suite.RandomStream())
+	return newDistKeyGenerator(suite, longterm, ownSec, participants, t)
+}
+
+// NewDistKeyRefresher returns a DistKeyGenerator that refreshes the shares of
+// an existing distributed key, shared among the same participants with the
+// same threshold t. It runs like a regular DistKeyGenerator, except that
+// every participant deals a secret of zero and that the deals of other
+// secrets are left out of the QUALIFIED set. The resulting DistKeyShare is a
+// sharing of zero, which each participant adds to its existing share with
+// DistKeyShare.Renew: the distributed key is unchanged, but the new shares
+// cannot be combined with the old ones, so that an attacker who stole fewer
+// than t shares before the refresh learns nothing from them afterwards.
+func NewDistKeyRefresher(suite Suite, longterm kyber.Scalar, participants []kyber.Point, t int) (*DistKeyGenerator, error) {
+	d, err := newDistKeyGenerator(suite, longterm, suite.Scalar().Zero(), participants, t)
+	if err != nil {
+		return nil, err
+	}
+	d.refresh = true
+	return d, nil
+}
+
+func newDistKeyGenerator(suite Suite, longterm, ownSec kyber.Scalar, participants []kyber.Point, t int) (*DistKeyGenerator, error) {
 	pub := suite.Point().Mul(longterm, nil)
 	// find our index
 	var found bool
@@ -101,9 +208,7 @@ func NewDistKeyGenerator(suite Suite, longterm kyber.Scalar, participants []kybe
 	if !found {
 		return nil, errors.New("dkg: own public key not found in list of participants")
 	}
-	var err error
 	// generate our dealer / deal
-	ownSec := suite.Scalar().Pick(suite.RandomStream())
 	dealer, err := vss.NewDealer(suite, longterm, ownSec, participants, t)
 	if err != nil {
 		return nil, err
@@ -112,6 +217,8 @@ func NewDistKeyGenerator(suite Suite, longterm kyber.Scalar, participants []kybe
 	return &DistKeyGenerator{
 		dealer:       dealer,
 		verifiers:    make(map[uint32]*vss.Verifier),
+		evidence:     make(map[uint32]*Justification),
+		pending:      newPending(),
 		t:            t,
 		suite:        suite,
 		long:         longterm,
@@ -167,6 +274,9 @@ func (d *DistKeyGenerator) Deals() (map[int]*Deal, error) {
 // error in case the deal has already been stored, or if the deal is incorrect
 // (see vss.Verifier.ProcessEncryptedDeal).
 func (d *DistKeyGenerator) ProcessDeal(dd *Deal) (*Response, error) {
+	if d.phase > DealPhase {
+		return nil, errors.New("dkg: deal received after the deal phase")
+	}
 	// public key of the dealer
 	pub, ok := findPub(d.participants, dd.Index)
 	if !ok {
@@ -193,6 +303,16 @@ func (d *DistKeyGenerator) ProcessDeal(dd *Deal) (*Response, error) {
 	// that distibuted the Deal
 	d.verifiers[dd.Index].UnsafeSetResponseDKG(dd.Index, vss.StatusApproval)
 
+	// Process the messages about the deal that arrived before it. Invalid
+	// ones are dropped, as they would have been if they had arrived after.
+	resps, justs := d.pending.take(dd.Index)
+	for _, r := range resps {
+		_, _ = d.ProcessResponse(r)
+	}
+	for _, j := range justs {
+		_ = d.ProcessJustification(j)
+	}
+
 	return &Response{
 		Index:    dd.Index,
 		Response: resp,
@@ -203,11 +323,19 @@ func (d *DistKeyGenerator) ProcessDeal(dd *Deal) (*Response, error) {
 // designates the deal of another participant than this dkg, this dkg stores it
 // and returns nil with a possible error regarding the validity of the response.
 // If the response designates a deal this dkg has issued, then the dkg will process
-// the response, and returns a justification.
+// the response, and returns a justification. Messages can be delivered in any
+// order: a response to a deal that this dkg has not received yet is kept, if
+// it is signed by its verifier, and processed once the deal arrives.
 func (d *DistKeyGenerator) ProcessResponse(resp *Response) (*Justification, error) {
+	if d.phase > ResponsePhase {
+		return nil, errors.New("dkg: response received after the response phase")
+	}
 	v, ok := d.verifiers[resp.Index]
 	if !ok {
-		return nil, errors.New("dkg: complaint received but no deal for it")
+		if resp.Index == d.index || resp.Index >= uint32(len(d.participants)) || d.phase > DealPhase {
+			return nil, errors.New("dkg: complaint received but no deal for it")
+		}
+		return nil, d.pending.addResponse(d.suite, d.participants, resp)
 	}
 
 	if err := v.ProcessResponse(resp.Response); err != nil {
@@ -237,28 +365,90 @@ func (d *DistKeyGenerator) ProcessResponse(resp *Response) (*Justification, erro
 }
 
 // ProcessJustification takes a justification and validates it. It returns an
-// error in case the justification is wrong.
+// error in case the justification is wrong. A justification of a deal that
+// this dkg has not received yet is kept, if it is signed by its dealer, and
+// processed once the deal arrives, with those of the other complaints.
+// A wrong justification signed by
+// its dealer disqualifies the dealer, and is kept as evidence of its
+// misbehavior, see Evidence.
 func (d *DistKeyGenerator) ProcessJustification(j *Justification) error {
+	if d.phase > JustificationPhase {
+		return errors.New("dkg: justification received after the justification phase")
+	}
 	v, ok := d.verifiers[j.Index]
 	if !ok {
-		return errors.New("dkg: Justification received but no deal for it")
+		if j.Index == d.index || j.Index >= uint32(len(d.participants)) || d.phase > DealPhase {
+			return errors.New("dkg: Justification received but no deal for it")
+		}
+		// kept until the deal arrives, like early responses
+		return d.pending.addJustification(d.suite, d.participants, j)
+	}
+	err := v.ProcessJustification(j.Justification)
+	if err != nil && VerifyEvidence(d.suite, d.participants, v.SessionID(), j) == nil {
+		d.evidence[j.Index] = j
+	}
+	return err
+}
+
+// Evidence returns the justifications that prove the misbehavior of the
+// dealers excluded from the QUALIFIED set, sorted by index of dealer. They
+// can be checked by anyone with VerifyEvidence, against the SessionID of
+// the deal of their dealer.
+func (d *DistKeyGenerator) Evidence() []*Justification {
+	var evidence []*Justification
+	for i := range d.participants {
+		if j, ok := d.evidence[uint32(i)]; ok {
+			evidence = append(evidence, j)
+		}
+	}
+	return evidence
+}
+
+// SessionID returns the session ID of the deal of the given dealer, as
+// received by this participant, or nil if it has not received it.
+func (d *DistKeyGenerator) SessionID(dealer uint32) []byte {
+	v, ok := d.verifiers[dealer]
+	if !ok {
+		return nil
+	}
+	return v.SessionID()
+}
+
+// VerifyEvidence checks whether the justification j proves that its dealer,
+// one of the participants, misbehaved in the session sid of its deal. It
+// returns nil if j is signed by the dealer and reveals an invalid deal of
+// that session, and an error otherwise: a justification replayed from an
+// earlier run of the protocol is not evidence against the current one.
+func VerifyEvidence(suite Suite, participants []kyber.Point, sid []byte, j *Justification) error {
+	if j == nil {
+		return errors.New("dkg: nil justification")
+	}
+	pub, ok := findPub(participants, j.Index)
+	if !ok {
+		return errors.New("dkg: justification out of bounds index")
 	}
-	return v.ProcessJustification(j.Justification)
+	return vss.VerifyEvidence(suite, pub, participants, sid, j.Justification)
 }
 
 // SetTimeout triggers the timeout on all verifiers, and thus makes sure
 // all verifiers have either responded, or have a StatusComplaint response.
+// From then on, the protocol completes with the QUALIFIED set of dealers
+// whose deals are certified, excluding those that did not send a deal, that
+// received too many complaints or that sent a wrong justification.
 func (d *DistKeyGenerator) SetTimeout() {
+	d.timeout = true
 	for _, v := range d.verifiers {
 		v.SetTimeout()
 	}
 }
 
-// Certified returns true if at least t deals are certified (see
-// vss.Verifier.DealCertified()). If the distribution is certified, the protocol
-// can continue using d.SecretCommits().
+// Certified returns true if all the deals are certified (see
+// vss.Verifier.DealCertified()) or, once SetTimeout has been called, if at
+// least t of them are. If the distribution is certified, the protocol
+// can continue using d.DistKeyShare().
 func (d *DistKeyGenerator) Certified() bool {
-	return len(d.QUAL()) >= len(d.participants)
+	qual := len(d.QUAL())
+	return qual >= len(d.participants) || (d.timeout && qual >= d.t)
 }
 
 // QUAL returns the index in the list of participants that forms the QUALIFIED
@@ -290,6 +480,9 @@ func (d *DistKeyGenerator) isInQUAL(idx uint32) bool {
 func (d *DistKeyGenerator) qualIter(fn func(idx uint32, v *vss.Verifier) bool) {
 	for i, v := range d.verifiers {
 		if v.DealCertified() {
+			if d.refresh && !d.isZeroDeal(v) {
+				continue
+			}
 			if !fn(i, v) {
 				break
 			}
@@ -297,6 +490,13 @@ func (d *DistKeyGenerator) qualIter(fn func(idx uint32, v *vss.Verifier) bool) {
 	}
 }
 
+// isZeroDeal returns true if the certified deal of v shares a zero secret.
+func (d *DistKeyGenerator) isZeroDeal(v *vss.Verifier) bool {
+	deal := v.Deal()
+	return deal != nil && len(deal.Commitments) > 0 &&
+		deal.Commitments[0].Equal(d.suite.Point().Null())
+}
+
 // DistKeyShare generates the distributed key relative to this receiver.
 // It throws an error if something is wrong such as not enough deals received.
 // The shared secret can be computed when all deals have been sent and
@@ -352,3 +552,47 @@ func findPub(list []kyber.Point, i uint32) (kyber.Point, bool) {
 	}
 	return list[i], true
 }
+
+// MarshalBinary returns the binary representation of a Deal, to be sent over
+// the network.
+func (d *Deal) MarshalBinary() ([]byte, error) {
+	return protobuf.Encode(d)
+}
+
+// UnmarshalBinary reads the Deal from its binary representation.
+func (d *Deal) UnmarshalBinary(s Suite, buff []byte) error {
+	return protobuf.DecodeWithConstructors(buff, d, constructors(s))
+}
+
+// MarshalBinary returns the binary representation of a Response, to be sent
+// over the network.
+func (r *Response) MarshalBinary() ([]byte, error) {
+	return protobuf.Encode(r)
+}
+
+// UnmarshalBinary reads the Response from its binary representation.
+func (r *Response) UnmarshalBinary(s Suite, buff []byte) error {
+	return protobuf.DecodeWithConstructors(buff, r, constructors(s))
+}
+
+// MarshalBinary returns the binary representation of a Justification, to be
+// sent over the network.
+func (j *Justification) MarshalBinary() ([]byte, error) {
+	return protobuf.Encode(j)
+}
+
+// UnmarshalBinary reads the Justification from its binary representation.
+func (j *Justification) UnmarshalBinary(s Suite, buff []byte) error {
+	return protobuf.DecodeWithConstructors(buff, j, constructors(s))
+}
+
+// constructors returns the constructors of the points and scalars of the
+// suite, needed to decode messages.
+func constructors(s Suite) protobuf.Constructors {
+	cons := make(protobuf.Constructors)
+	var point kyber.Point
+	var secret kyber.Scalar
+	cons[reflect.TypeOf(&point).Elem()] = func() interface{} { return s.Point() }
+	cons[reflect.TypeOf(&secret).Elem()] = func() interface{} { return s.Scalar() }
+	return cons
+}
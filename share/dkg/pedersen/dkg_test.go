@@ -2,12 +2,14 @@ package dkg
 
 import (
 	"crypto/rand"
+	"sort"
 	"testing"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
 	"github.com/dedis/kyber/share"
 	vss "github.com/dedis/kyber/share/vss/pedersen"
+	"github.com/dedis/kyber/sign/schnorr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -210,13 +212,24 @@ func TestDKGProcessResponse(t *testing.T) {
 	err = dkg.ProcessJustification(j)
 	assert.Nil(t, err)
 
-	// remove verifiers
+	// a justification without a deal is kept until the deal arrives
 	v = dkg.verifiers[j.Index]
 	delete(dkg.verifiers, j.Index)
 	err = dkg.ProcessJustification(j)
-	assert.Error(t, err)
+	assert.Nil(t, err)
+	_, justs := dkg.pending.take(j.Index)
+	assert.Equal(t, []*Justification{j}, justs)
 	dkg.verifiers[j.Index] = v
 
+	// but not one of our own deal, nor of an unknown dealer
+	j.Index = dkg.index
+	v = dkg.verifiers[j.Index]
+	delete(dkg.verifiers, j.Index)
+	assert.Error(t, dkg.ProcessJustification(j))
+	dkg.verifiers[j.Index] = v
+	j.Index = uint32(len(dkg.participants))
+	assert.Error(t, dkg.ProcessJustification(j))
+
 }
 
 func TestSetTimeout(t *testing.T) {
@@ -298,6 +311,495 @@ func TestDistKeyShare(t *testing.T) {
 	assert.Equal(t, dkss[0].Public().String(), commitSecret.String())
 }
 
+func TestDistKeyShareDestroy(t *testing.T) {
+	dks := &DistKeyShare{
+		Commits: []kyber.Point{suite.Point().Pick(suite.RandomStream())},
+		Share:   &share.PriShare{I: 1, V: suite.Scalar().Pick(suite.RandomStream())},
+	}
+	public := dks.Public().Clone()
+	dks.Destroy()
+	assert.True(t, dks.Share.V.Equal(suite.Scalar().Zero()))
+	assert.True(t, dks.Public().Equal(public))
+}
+
+func TestDKGMarshal(t *testing.T) {
+	// Run the whole protocol with every message sent in its binary
+	// representation.
+	dkgs = dkgGen()
+	var resps []*Response
+	for _, dkg := range dkgs {
+		deals, err := dkg.Deals()
+		require.Nil(t, err)
+		for i, d := range deals {
+			buff, err := d.MarshalBinary()
+			require.Nil(t, err)
+			decoded := new(Deal)
+			require.Nil(t, decoded.UnmarshalBinary(suite, buff))
+			resp, err := dkgs[i].ProcessDeal(decoded)
+			require.Nil(t, err)
+			resps = append(resps, resp)
+		}
+	}
+	for _, resp := range resps {
+		buff, err := resp.MarshalBinary()
+		require.Nil(t, err)
+		decoded := new(Response)
+		require.Nil(t, decoded.UnmarshalBinary(suite, buff))
+		for _, dkg := range dkgs {
+			if resp.Response.Index == dkg.index {
+				continue
+			}
+			j, err := dkg.ProcessResponse(decoded)
+			require.Nil(t, err)
+			require.Nil(t, j)
+		}
+	}
+	var publics []kyber.Point
+	for _, dkg := range dkgs {
+		require.True(t, dkg.Certified())
+		dks, err := dkg.DistKeyShare()
+		require.Nil(t, err)
+		publics = append(publics, dks.Public())
+	}
+	for _, p := range publics {
+		require.True(t, publics[0].Equal(p))
+	}
+
+	// Justifications carry the plaintext deal of the complainer.
+	dkgs = dkgGen()
+	deal, err := dkgs[0].dealer.PlaintextDeal(1)
+	require.Nil(t, err)
+	goodSecret := deal.SecShare.V
+	deal.SecShare.V = suite.Scalar().Zero()
+	deals, err := dkgs[0].Deals()
+	require.Nil(t, err)
+	resp, err := dkgs[1].ProcessDeal(deals[1])
+	require.Nil(t, err)
+	require.Equal(t, vss.StatusComplaint, resp.Response.Status)
+	deal.SecShare.V = goodSecret
+	_, err = dkgs[2].ProcessDeal(deals[2])
+	require.Nil(t, err)
+	buff, err := resp.MarshalBinary()
+	require.Nil(t, err)
+	received := new(Response)
+	require.Nil(t, received.UnmarshalBinary(suite, buff))
+	_, err = dkgs[2].ProcessResponse(received)
+	require.Nil(t, err)
+	j, err := dkgs[0].ProcessResponse(resp)
+	require.Nil(t, err)
+	require.NotNil(t, j)
+	buff, err = j.MarshalBinary()
+	require.Nil(t, err)
+	decoded := new(Justification)
+	require.Nil(t, decoded.UnmarshalBinary(suite, buff))
+	require.Nil(t, dkgs[2].ProcessJustification(decoded))
+}
+
+func TestDKGMisbehavingDealers(t *testing.T) {
+	// Dealer 0 deals a wrong share to participant 1 and sends a wrong
+	// justification, and dealer 6 does not deal at all: the others complete
+	// the protocol without them.
+	dkgs = dkgGen()
+	honest := dkgs[1 : nbParticipants-1]
+	bad, err := dkgs[0].dealer.PlaintextDeal(1)
+	require.Nil(t, err)
+	bad.SecShare.V = suite.Scalar().Zero()
+
+	var resps []*Response
+	for _, dkg := range dkgs[:nbParticipants-1] {
+		deals, err := dkg.Deals()
+		require.Nil(t, err)
+		for i, d := range deals {
+			resp, err := dkgs[i].ProcessDeal(d)
+			require.Nil(t, err)
+			resps = append(resps, resp)
+		}
+	}
+	var complaint *Response
+	for _, resp := range resps {
+		if resp.Response.Status == vss.StatusComplaint {
+			complaint = resp
+			continue
+		}
+		for _, dkg := range dkgs {
+			if resp.Response.Index != dkg.index {
+				_, err := dkg.ProcessResponse(resp)
+				require.Nil(t, err)
+			}
+		}
+	}
+	require.NotNil(t, complaint)
+	require.Equal(t, uint32(0), complaint.Index)
+	for _, dkg := range honest[1:] {
+		j, err := dkg.ProcessResponse(complaint)
+		require.Nil(t, err)
+		require.Nil(t, j)
+	}
+	vj, err := dkgs[0].dealer.ProcessResponse(complaint.Response)
+	require.Nil(t, err)
+	j := &Justification{Index: 0, Justification: vj}
+	for _, dkg := range honest {
+		require.Error(t, dkg.ProcessJustification(j))
+	}
+
+	for _, dkg := range honest {
+		require.False(t, dkg.Certified())
+		dkg.SetTimeout()
+		require.True(t, dkg.Certified())
+		require.Equal(t, []int{1, 2, 3, 4, 5}, sortedQUAL(dkg))
+		evidence := dkg.Evidence()
+		require.Len(t, evidence, 1)
+		require.Nil(t, VerifyEvidence(suite, partPubs, dkg.SessionID(0), evidence[0]))
+	}
+
+	// The evidence is not replayable against the next run of dealer 0.
+	next := dkgGen()
+	deals, err := next[0].Deals()
+	require.Nil(t, err)
+	_, err = next[2].ProcessDeal(deals[2])
+	require.Nil(t, err)
+	require.Error(t, VerifyEvidence(suite, partPubs, next[2].SessionID(0), j))
+	require.Error(t, next[2].ProcessJustification(j))
+	require.Empty(t, next[2].Evidence())
+	require.Nil(t, next[2].SessionID(1))
+
+	var shares []*share.PriShare
+	var dkss []*DistKeyShare
+	for _, dkg := range honest {
+		dks, err := dkg.DistKeyShare()
+		require.Nil(t, err)
+		dkss = append(dkss, dks)
+		shares = append(shares, dks.Share)
+	}
+	for _, dks := range dkss {
+		require.True(t, checkDks(dks, dkss[0]))
+	}
+	secret, err := share.RecoverSecret(suite, shares, len(shares), nbParticipants)
+	require.Nil(t, err)
+	require.True(t, suite.Point().Mul(secret, nil).Equal(dkss[0].Public()))
+}
+
+func TestDKGAsynchronous(t *testing.T) {
+	// With a threshold of n-1 and participant 6 silent, each response is
+	// broadcast as soon as it is made, so that most participants get it
+	// before the deal it is about.
+	th := nbParticipants - 1
+	dkgs := make([]*DistKeyGenerator, nbParticipants)
+	for i := range dkgs {
+		dkg, err := NewDistKeyGenerator(suite, partSec[i], partPubs, th)
+		require.Nil(t, err)
+		dkgs[i] = dkg
+	}
+	active := dkgs[:th]
+	for _, dealer := range active {
+		deals, err := dealer.Deals()
+		require.Nil(t, err)
+		for i, d := range deals {
+			if i >= th {
+				continue
+			}
+			resp, err := dkgs[i].ProcessDeal(d)
+			require.Nil(t, err)
+			require.Equal(t, vss.StatusApproval, resp.Response.Status)
+			for _, dkg := range active {
+				if dkg.index == resp.Response.Index {
+					continue
+				}
+				j, err := dkg.ProcessResponse(resp)
+				require.Nil(t, err)
+				require.Nil(t, j)
+			}
+		}
+	}
+
+	for _, dkg := range active {
+		require.Empty(t, dkg.pending.resps)
+		require.False(t, dkg.Certified())
+		dkg.SetTimeout()
+		require.True(t, dkg.Certified())
+		require.Equal(t, []int{0, 1, 2, 3, 4, 5}, sortedQUAL(dkg))
+	}
+
+	var shares []*share.PriShare
+	var dkss []*DistKeyShare
+	for _, dkg := range active {
+		dks, err := dkg.DistKeyShare()
+		require.Nil(t, err)
+		dkss = append(dkss, dks)
+		shares = append(shares, dks.Share)
+	}
+	for _, dks := range dkss {
+		require.True(t, checkDks(dks, dkss[0]))
+	}
+	secret, err := share.RecoverSecret(suite, shares, th, nbParticipants)
+	require.Nil(t, err)
+	require.True(t, suite.Point().Mul(secret, nil).Equal(dkss[0].Public()))
+	_, err = share.RecoverSecret(suite, shares[:th-1], th, nbParticipants)
+	require.Error(t, err)
+}
+
+func TestDKGEarlyMessages(t *testing.T) {
+	// With a threshold of n, the deal of dealer 0 is only certified by
+	// participant 3 if both complaints against it are justified, although
+	// the complaints and justifications reach 3 before the deal.
+	n := nbParticipants
+	dkgs := make([]*DistKeyGenerator, n)
+	for i := range dkgs {
+		dkg, err := NewDistKeyGenerator(suite, partSec[i], partPubs, n)
+		require.Nil(t, err)
+		dkgs[i] = dkg
+	}
+	dealer, rec := dkgs[0], dkgs[3]
+
+	// The dealer gives wrong shares to participants 1 and 2, and then
+	// justifies them with the right ones.
+	wrong := []int{1, 2}
+	good := make([]kyber.Scalar, len(wrong))
+	for k, i := range wrong {
+		deal, err := dealer.dealer.PlaintextDeal(i)
+		require.Nil(t, err)
+		good[k] = deal.SecShare.V
+		deal.SecShare.V = suite.Scalar().Zero()
+	}
+	deals, err := dealer.Deals()
+	require.Nil(t, err)
+	for k, i := range wrong {
+		deal, err := dealer.dealer.PlaintextDeal(i)
+		require.Nil(t, err)
+		deal.SecShare.V = good[k]
+	}
+
+	var resps []*Response
+	var justs []*Justification
+	for i, d := range deals {
+		if i == int(rec.index) {
+			continue
+		}
+		resp, err := dkgs[i].ProcessDeal(d)
+		require.Nil(t, err)
+		// the dealer changes the status of the responses it justifies
+		c := *resp.Response
+		resps = append(resps, &Response{Index: resp.Index, Response: &c})
+		j, err := dealer.ProcessResponse(resp)
+		require.Nil(t, err)
+		if c.Status == vss.StatusComplaint {
+			require.NotNil(t, j)
+			justs = append(justs, j)
+		}
+	}
+	require.Len(t, justs, len(wrong))
+
+	// Messages not signed by their author are rejected without taking the
+	// place of the others, however many there are.
+	for k := 0; k < 2*n; k++ {
+		forged := *resps[k%len(resps)].Response
+		forged.Signature = randomBytes(len(forged.Signature))
+		_, err := rec.ProcessResponse(&Response{Index: dealer.index, Response: &forged})
+		require.Error(t, err)
+	}
+	forged := *justs[0].Justification
+	forged.Signature = randomBytes(len(forged.Signature))
+	require.Error(t, rec.ProcessJustification(&Justification{Index: dealer.index, Justification: &forged}))
+
+	for _, resp := range resps {
+		j, err := rec.ProcessResponse(resp)
+		require.Nil(t, err)
+		require.Nil(t, j)
+	}
+	for _, j := range justs {
+		require.Nil(t, rec.ProcessJustification(j))
+	}
+	resp, err := rec.ProcessDeal(deals[int(rec.index)])
+	require.Nil(t, err)
+	require.Equal(t, vss.StatusApproval, resp.Response.Status)
+	require.Empty(t, rec.pending.resps)
+	require.Empty(t, rec.pending.justs)
+	require.True(t, rec.verifiers[dealer.index].DealCertified())
+}
+
+func TestDKGPendingCap(t *testing.T) {
+	// A participant signing responses to a deal not received yet for many
+	// sessions only takes one slot, which keeps its latest response.
+	dkgs := dkgGen()
+	dealer, author, rec := dkgs[0], dkgs[1], dkgs[2]
+	deals, err := dealer.Deals()
+	require.Nil(t, err)
+	resp, err := author.ProcessDeal(deals[int(author.index)])
+	require.Nil(t, err)
+
+	var last *Response
+	for k := 0; k < 4*nbParticipants; k++ {
+		r := *resp.Response
+		r.SessionID = randomBytes(len(r.SessionID))
+		r.Signature, err = schnorr.Sign(suite, partSec[author.index], r.Hash(suite))
+		require.Nil(t, err)
+		last = &Response{Index: resp.Index, Response: &r}
+		j, err := rec.ProcessResponse(last)
+		require.Nil(t, err)
+		require.Nil(t, j)
+	}
+	require.Len(t, rec.pending.resps[dealer.index], 1)
+	require.Equal(t, last, rec.pending.resps[dealer.index][author.index])
+
+	// The genuine response replaces them, and is processed with the deal.
+	j, err := rec.ProcessResponse(resp)
+	require.Nil(t, err)
+	require.Nil(t, j)
+	require.Len(t, rec.pending.resps[dealer.index], 1)
+	_, err = rec.ProcessDeal(deals[int(rec.index)])
+	require.Nil(t, err)
+	require.Empty(t, rec.pending.resps)
+	// it was recorded, so that it cannot be processed again
+	require.Error(t, rec.verifiers[dealer.index].ProcessResponse(resp.Response))
+}
+
+func TestDKGPhases(t *testing.T) {
+	// The deal of participant 6 and the response of participant 5 to the
+	// deal of 0 come too late.
+	dkgs := dkgGen()
+	late := dkgs[nbParticipants-1]
+	var lateDeals map[int]*Deal
+	var lateResp *Response
+	for _, dealer := range dkgs {
+		require.Equal(t, DealPhase, dealer.Phase())
+		deals, err := dealer.Deals()
+		require.Nil(t, err)
+		if dealer == late {
+			lateDeals = deals
+			continue
+		}
+		for i, d := range deals {
+			resp, err := dkgs[i].ProcessDeal(d)
+			require.Nil(t, err)
+			if dealer.index == 0 && i == 5 {
+				lateResp = resp
+				continue
+			}
+			for _, dkg := range dkgs {
+				if dkg.index == resp.Response.Index {
+					continue
+				}
+				_, err := dkg.ProcessResponse(resp)
+				require.Nil(t, err)
+			}
+		}
+	}
+
+	for _, dkg := range dkgs {
+		require.Equal(t, ResponsePhase, dkg.NextPhase())
+		if dkg != late {
+			_, err := dkg.ProcessDeal(lateDeals[int(dkg.index)])
+			require.Error(t, err)
+		}
+	}
+	for _, dkg := range dkgs {
+		require.Equal(t, JustificationPhase, dkg.NextPhase())
+		if dkg.index != lateResp.Response.Index {
+			_, err := dkg.ProcessResponse(lateResp)
+			require.Error(t, err)
+		}
+	}
+
+	var dkss []*DistKeyShare
+	for _, dkg := range dkgs {
+		require.Equal(t, FinishPhase, dkg.NextPhase())
+		require.Equal(t, FinishPhase, dkg.NextPhase())
+		require.Error(t, dkg.ProcessJustification(&Justification{}))
+		require.True(t, dkg.Certified())
+		require.Equal(t, []int{0, 1, 2, 3, 4, 5}, sortedQUAL(dkg))
+		dks, err := dkg.DistKeyShare()
+		require.Nil(t, err)
+		dkss = append(dkss, dks)
+	}
+	for _, dks := range dkss {
+		require.True(t, checkDks(dks, dkss[0]))
+	}
+}
+
+func TestDKGRefresh(t *testing.T) {
+	th := nbParticipants/2 + 1
+	fullExchange(t)
+	old := make([]*DistKeyShare, nbParticipants)
+	for i, dkg := range dkgs {
+		dks, err := dkg.DistKeyShare()
+		require.Nil(t, err)
+		old[i] = dks
+	}
+
+	// Dealer 0 deals a secret other than zero, which would change the key:
+	// the others leave its deal out.
+	refreshers := make([]*DistKeyGenerator, nbParticipants)
+	for i := range refreshers {
+		var err error
+		refreshers[i], err = NewDistKeyRefresher(suite, partSec[i], partPubs, th)
+		require.Nil(t, err)
+	}
+	bad, err := NewDistKeyGenerator(suite, partSec[0], partPubs, th)
+	require.Nil(t, err)
+	refreshers[0].dealer = bad.dealer
+
+	var resps []*Response
+	for _, dkg := range refreshers {
+		deals, err := dkg.Deals()
+		require.Nil(t, err)
+		for i, d := range deals {
+			resp, err := refreshers[i].ProcessDeal(d)
+			require.Nil(t, err)
+			resps = append(resps, resp)
+		}
+	}
+	for _, resp := range resps {
+		for _, dkg := range refreshers {
+			if resp.Response.Index != dkg.index {
+				_, err := dkg.ProcessResponse(resp)
+				require.Nil(t, err)
+			}
+		}
+	}
+
+	renewed := make([]*DistKeyShare, nbParticipants)
+	for i, dkg := range refreshers {
+		require.Equal(t, []int{1, 2, 3, 4, 5, 6}, sortedQUAL(dkg))
+		dkg.SetTimeout()
+		dks, err := dkg.DistKeyShare()
+		require.Nil(t, err)
+		renewed[i], err = old[i].Renew(suite, dks)
+		require.Nil(t, err)
+		require.True(t, renewed[i].Public().Equal(old[i].Public()))
+		require.False(t, renewed[i].Share.V.Equal(old[i].Share.V))
+
+		_, err = old[i].Renew(suite, old[i])
+		require.Error(t, err)
+		_, err = old[i].Renew(suite, old[(i+1)%nbParticipants])
+		require.Error(t, err)
+	}
+	for _, dks := range renewed {
+		require.True(t, checkDks(dks, renewed[0]))
+	}
+
+	pub := old[0].Public()
+	var shares []*share.PriShare
+	for _, dks := range renewed {
+		shares = append(shares, dks.Share)
+	}
+	secret, err := share.RecoverSecret(suite, shares[:th], th, nbParticipants)
+	require.Nil(t, err)
+	require.True(t, suite.Point().Mul(secret, nil).Equal(pub))
+
+	// old and new shares do not combine
+	mixed := []*share.PriShare{old[0].Share}
+	mixed = append(mixed, shares[1:th]...)
+	secret, err = share.RecoverSecret(suite, mixed, th, nbParticipants)
+	require.Nil(t, err)
+	require.False(t, suite.Point().Mul(secret, nil).Equal(pub))
+}
+
+func sortedQUAL(dkg *DistKeyGenerator) []int {
+	qual := dkg.QUAL()
+	sort.Ints(qual)
+	return qual
+}
+
 func dkgGen() []*DistKeyGenerator {
 	dkgs := make([]*DistKeyGenerator, nbParticipants)
 	for i := 0; i < nbParticipants; i++ {
@@ -0,0 +1,98 @@
+package dkg
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/sign/schnorr"
+)
+
+// pending holds the responses and justifications received before the deal
+// they are about, by index of dealer, until the deal arrives. A message is
+// only kept if it is signed by its author, the verifier of a response or
+// the dealer of a justification, and in a slot of its own for every
+// verifier: no peer can make room for its messages by evicting those of
+// others. Several complaints against a dealer are thus all answered, with
+// one justification for each complainer.
+//
+// The session of a message is only known once its deal arrives, and a slot
+// keeps the latest message, whatever its session: an author resending its
+// message, or a replayed message of an earlier session, replaces the one
+// kept, so that there are at most n messages pending for each dealer.
+type pending struct {
+	resps map[uint32]map[uint32]*Response
+	justs map[uint32]map[uint32]*Justification
+}
+
+func newPending() *pending {
+	return &pending{
+		resps: make(map[uint32]map[uint32]*Response),
+		justs: make(map[uint32]map[uint32]*Justification),
+	}
+}
+
+// addResponse keeps resp, after checking that it is signed by its verifier,
+// whose public key is in verifiers.
+func (p *pending) addResponse(suite Suite, verifiers []kyber.Point, resp *Response) error {
+	r := resp.Response
+	if r == nil {
+		return errors.New("dkg: malformed response")
+	}
+	pub, ok := findPub(verifiers, r.Index)
+	if !ok {
+		return errors.New("dkg: response out of bounds index")
+	}
+	if err := schnorr.Verify(suite, pub, r.Hash(suite), r.Signature); err != nil {
+		return err
+	}
+	if p.resps[resp.Index] == nil {
+		p.resps[resp.Index] = make(map[uint32]*Response)
+	}
+	p.resps[resp.Index][r.Index] = resp
+	return nil
+}
+
+// addJustification keeps j, after checking that it is signed by its dealer,
+// whose public key is in dealers.
+func (p *pending) addJustification(suite Suite, dealers []kyber.Point, j *Justification) error {
+	jj := j.Justification
+	if jj == nil {
+		return errors.New("dkg: malformed justification")
+	}
+	pub, ok := findPub(dealers, j.Index)
+	if !ok {
+		return errors.New("dkg: justification out of bounds index")
+	}
+	if err := schnorr.Verify(suite, pub, jj.Hash(suite), jj.Signature); err != nil {
+		return err
+	}
+	if p.justs[j.Index] == nil {
+		p.justs[j.Index] = make(map[uint32]*Justification)
+	}
+	p.justs[j.Index][jj.Index] = j
+	return nil
+}
+
+// take removes and returns the messages kept about the deal of dealer,
+// sorted by index of verifier, so that they are processed in the same order
+// by everyone.
+func (p *pending) take(dealer uint32) ([]*Response, []*Justification) {
+	resps := make([]*Response, 0, len(p.resps[dealer]))
+	for _, r := range p.resps[dealer] {
+		resps = append(resps, r)
+	}
+	sort.Slice(resps, func(i, j int) bool {
+		return resps[i].Response.Index < resps[j].Response.Index
+	})
+	justs := make([]*Justification, 0, len(p.justs[dealer]))
+	for _, j := range p.justs[dealer] {
+		justs = append(justs, j)
+	}
+	sort.Slice(justs, func(i, j int) bool {
+		return justs[i].Justification.Index < justs[j].Justification.Index
+	})
+	delete(p.resps, dealer)
+	delete(p.justs, dealer)
+	return resps, justs
+}
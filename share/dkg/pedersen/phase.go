@@ -0,0 +1,69 @@
+package dkg
+
+// Phase is a step of the protocol, which the participants end on their own
+// timeouts with DistKeyGenerator.NextPhase.
+type Phase int
+
+const (
+	// DealPhase is the first phase, in which the dealers send their deals
+	// and the participants answer them with responses.
+	DealPhase Phase = iota
+	// ResponsePhase starts once the deals are no longer awaited: the deals
+	// that did not arrive are missing from the QUALIFIED set, and the
+	// participants only wait for the remaining responses.
+	ResponsePhase
+	// JustificationPhase starts once the responses are no longer awaited:
+	// the missing ones count as complaints, as with SetTimeout, and the
+	// dealers answer the complaints against their deals.
+	JustificationPhase
+	// FinishPhase is the last phase, in which no message is accepted
+	// anymore, so that the QUALIFIED set is settled and DistKeyShare can be
+	// called.
+	FinishPhase
+)
+
+func (p Phase) String() string {
+	switch p {
+	case DealPhase:
+		return "deal"
+	case ResponsePhase:
+		return "response"
+	case JustificationPhase:
+		return "justification"
+	case FinishPhase:
+		return "finish"
+	}
+	return "unknown"
+}
+
+// Phase returns the current phase of the protocol, DealPhase until
+// NextPhase is called.
+func (d *DistKeyGenerator) Phase() Phase {
+	return d.phase
+}
+
+// NextPhase ends the current phase, typically once its timeout expires, and
+// returns the phase that starts. From then on, the messages of the phases
+// that ended are rejected with an error: deals after the DealPhase,
+// responses after the ResponsePhase and justifications after the
+// JustificationPhase. Entering the JustificationPhase calls SetTimeout. In
+// the FinishPhase, NextPhase does nothing.
+//
+// Participants that wait for the FinishPhase before calling DistKeyShare,
+// even if Certified returns true earlier, compute it from the messages
+// received in time only: those that receive the same messages by the end of
+// each phase agree on the QUALIFIED set. Agreeing on it when messages are
+// lost or late would take an agreement protocol on top of this one.
+func (d *DistKeyGenerator) NextPhase() Phase {
+	switch d.phase {
+	case DealPhase:
+		// the messages about deals that never came are of no use
+		d.pending = newPending()
+	case ResponsePhase:
+		d.SetTimeout()
+	case FinishPhase:
+		return d.phase
+	}
+	d.phase++
+	return d.phase
+}
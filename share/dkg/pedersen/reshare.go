@@ -0,0 +1,334 @@
+package dkg
+
+import (
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	vss "github.com/dedis/kyber/share/vss/pedersen"
+)
+
+// Resharer runs the resharing protocol, which transfers a distributed key
+// shared with threshold t among an old committee to a new committee with a
+// threshold t', without ever reconstructing the private key. Each member of
+// the old committee deals its share to the new committee, with the same
+// Deal, Response and Justification messages as the DistKeyGenerator, and
+// each member of the new committee combines the deals of the QUALIFIED old
+// members into its new share. A participant can be a member of both
+// committees.
+//
+// The deal of an old member must share the share of that member, which the
+// new members check against the public polynomial of the distributed key:
+// deals of any other secret are left out of the QUALIFIED set. The
+// distributed key is thus unchanged, and the old shares cannot be combined
+// with the new ones.
+type Resharer struct {
+	suite Suite
+
+	long kyber.Scalar
+	pub  kyber.Point
+
+	oldParticipants []kyber.Point
+	newParticipants []kyber.Point
+	// public polynomial of the distributed key
+	oldPub *share.PubPoly
+	// index in each committee, -1 if not a member
+	oldIndex int
+	newIndex int
+
+	t int
+
+	// nil if not a member of the old committee
+	dealer *vss.Dealer
+	// deals received as a member of the new committee, by index of dealer
+	verifiers map[uint32]*vss.Verifier
+	timeout   bool
+
+	// responses and justifications received before the deal they are
+	// about, as with the DistKeyGenerator
+	pending *pending
+}
+
+// NewResharer returns a Resharer out of the suite, the longterm secret key,
+// the distributed key share dks of a member of the old committee, or nil for
+// a participant that is only a member of the new committee, the coefficients
+// of the public polynomial of the distributed key, the lists of participants
+// of the old and the new committees, and the threshold t of the new
+// committee. It returns an error if the longterm key is in neither committee,
+// or if dks does not match the public polynomial.
+func NewResharer(suite Suite, longterm kyber.Scalar, dks *DistKeyShare, commits []kyber.Point, oldParticipants, newParticipants []kyber.Point, t int) (*Resharer, error) {
+	pub := suite.Point().Mul(longterm, nil)
+	oldIndex, newIndex := indexOf(oldParticipants, pub), indexOf(newParticipants, pub)
+	if oldIndex < 0 && newIndex < 0 {
+		return nil, errors.New("dkg: own public key not found in either committee")
+	}
+	if len(commits) < 2 || len(commits) > len(oldParticipants) {
+		return nil, errors.New("dkg: invalid threshold of old committee")
+	}
+	r := &Resharer{
+		suite:           suite,
+		long:            longterm,
+		pub:             pub,
+		oldParticipants: oldParticipants,
+		newParticipants: newParticipants,
+		oldPub:          share.NewPubPoly(suite, suite.Point().Base(), commits),
+		oldIndex:        oldIndex,
+		newIndex:        newIndex,
+		t:               t,
+		verifiers:       make(map[uint32]*vss.Verifier),
+		pending:         newPending(),
+	}
+	if newIndex >= 0 && (t < 2 || t > len(newParticipants)) {
+		return nil, errors.New("dkg: invalid threshold of new committee")
+	}
+	if oldIndex < 0 {
+		if dks != nil {
+			return nil, errors.New("dkg: distributed key share of a non member of the old committee")
+		}
+		return r, nil
+	}
+	if dks == nil || dks.Share.I != oldIndex || !r.oldPub.Check(dks.Share) {
+		return nil, errors.New("dkg: distributed key share does not match the public polynomial")
+	}
+	var err error
+	r.dealer, err = vss.NewDealer(suite, longterm, dks.Share.V, newParticipants, t)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Deals returns the deals of the share of this old member to the members of
+// the new committee, by index in the list of new participants. If this
+// participant is also a member of the new committee, its own deal is
+// processed and omitted from the returned map, like with
+// DistKeyGenerator.Deals. It returns an error if this participant is not a
+// member of the old committee.
+func (r *Resharer) Deals() (map[int]*Deal, error) {
+	if r.dealer == nil {
+		return nil, errors.New("dkg: not a member of the old committee")
+	}
+	deals, err := r.dealer.EncryptedDeals()
+	if err != nil {
+		return nil, err
+	}
+	dd := make(map[int]*Deal)
+	for i := range r.newParticipants {
+		distd := &Deal{
+			Index: uint32(r.oldIndex),
+			Deal:  deals[i],
+		}
+		if i == r.newIndex {
+			if _, ok := r.verifiers[distd.Index]; ok {
+				// already processed our own deal
+				continue
+			}
+			if resp, err := r.ProcessDeal(distd); err != nil {
+				panic("dkg: cannot process own deal: " + err.Error())
+			} else if resp.Response.Status != vss.StatusApproval {
+				panic("dkg: own deal gave a complaint")
+			}
+			continue
+		}
+		dd[i] = distd
+	}
+	return dd, nil
+}
+
+// ProcessDeal takes a Deal of a member of the old committee, and stores and
+// verifies it. It returns a Response to broadcast to every other participant
+// of both committees. It returns an error if this participant is not a
+// member of the new committee, in case the deal has already been stored, or
+// if the deal is incorrect (see vss.Verifier.ProcessEncryptedDeal).
+func (r *Resharer) ProcessDeal(dd *Deal) (*Response, error) {
+	if r.newIndex < 0 {
+		return nil, errors.New("dkg: not a member of the new committee")
+	}
+	pub, ok := findPub(r.oldParticipants, dd.Index)
+	if !ok {
+		return nil, errors.New("dkg: dist deal out of bounds index")
+	}
+	if _, ok := r.verifiers[dd.Index]; ok {
+		return nil, errors.New("dkg: already received dist deal from same index")
+	}
+
+	ver, err := vss.NewVerifier(r.suite, r.long, pub, r.newParticipants)
+	if err != nil {
+		return nil, err
+	}
+	r.verifiers[dd.Index] = ver
+	resp, err := ver.ProcessEncryptedDeal(dd.Deal)
+	if err != nil {
+		return nil, err
+	}
+
+	// A dealer that is also a new member does not respond to its own deal.
+	if i := indexOf(r.newParticipants, pub); i >= 0 {
+		ver.UnsafeSetResponseDKG(uint32(i), vss.StatusApproval)
+	}
+
+	resps, justs := r.pending.take(dd.Index)
+	for _, p := range resps {
+		_, _ = r.ProcessResponse(p)
+	}
+	for _, j := range justs {
+		_ = r.ProcessJustification(j)
+	}
+
+	return &Response{
+		Index:    dd.Index,
+		Response: resp,
+	}, nil
+}
+
+// ProcessResponse takes a response from a member of the new committee. Like
+// DistKeyGenerator.ProcessResponse, it returns a justification if the
+// response is a complaint against the deal of this participant, and keeps
+// the signed responses that arrive before their deal.
+func (r *Resharer) ProcessResponse(resp *Response) (*Justification, error) {
+	if resp.Index >= uint32(len(r.oldParticipants)) {
+		return nil, errors.New("dkg: complaint received but no deal for it")
+	}
+	if r.newIndex >= 0 {
+		v, ok := r.verifiers[resp.Index]
+		if !ok {
+			if int(resp.Index) == r.oldIndex {
+				return nil, errors.New("dkg: complaint received but no deal for it")
+			}
+			return nil, r.pending.addResponse(r.suite, r.newParticipants, resp)
+		}
+		if err := v.ProcessResponse(resp.Response); err != nil {
+			return nil, err
+		}
+	}
+
+	if int(resp.Index) != r.oldIndex {
+		return nil, nil
+	}
+	j, err := r.dealer.ProcessResponse(resp.Response)
+	if err != nil || j == nil {
+		return nil, err
+	}
+	if v, ok := r.verifiers[resp.Index]; ok {
+		if err := v.ProcessJustification(j); err != nil {
+			return nil, err
+		}
+	}
+	return &Justification{
+		Index:         uint32(r.oldIndex),
+		Justification: j,
+	}, nil
+}
+
+// ProcessJustification takes a justification of a member of the old
+// committee and validates it. It returns an error in case the justification
+// is wrong. Members of the old committee only need to broadcast their own
+// justifications, and ignore the others.
+func (r *Resharer) ProcessJustification(j *Justification) error {
+	if r.newIndex < 0 {
+		return nil
+	}
+	v, ok := r.verifiers[j.Index]
+	if !ok {
+		if int(j.Index) == r.oldIndex || j.Index >= uint32(len(r.oldParticipants)) {
+			return errors.New("dkg: Justification received but no deal for it")
+		}
+		return r.pending.addJustification(r.suite, r.oldParticipants, j)
+	}
+	return v.ProcessJustification(j.Justification)
+}
+
+// SetTimeout triggers the timeout on all verifiers, see
+// DistKeyGenerator.SetTimeout.
+func (r *Resharer) SetTimeout() {
+	r.timeout = true
+	for _, v := range r.verifiers {
+		v.SetTimeout()
+	}
+}
+
+// Certified returns true if the deals of all the members of the old
+// committee are certified or, once SetTimeout has been called, if the deals
+// of at least t of them are, where t is the threshold of the old committee.
+// It always returns false for a participant that is not a member of the new
+// committee.
+func (r *Resharer) Certified() bool {
+	qual := len(r.QUAL())
+	return qual >= len(r.oldParticipants) || (r.timeout && qual >= r.oldPub.Threshold())
+}
+
+// QUAL returns the indices in the list of old participants of the members
+// whose deals are certified and share their share of the distributed key.
+func (r *Resharer) QUAL() []int {
+	var good []int
+	r.qualIter(func(i uint32, deal *vss.Deal) {
+		good = append(good, int(i))
+	})
+	return good
+}
+
+func (r *Resharer) qualIter(fn func(idx uint32, deal *vss.Deal)) {
+	for i := range r.oldParticipants {
+		v, ok := r.verifiers[uint32(i)]
+		if !ok || !v.DealCertified() {
+			continue
+		}
+		deal := v.Deal()
+		if deal == nil || len(deal.Commitments) != r.t ||
+			!deal.Commitments[0].Equal(r.oldPub.Eval(i).V) {
+			continue
+		}
+		fn(uint32(i), deal)
+	}
+}
+
+// DistKeyShare returns the new distributed key share of this member of the
+// new committee. Its share is the interpolation at 0 of the shares dealt by
+// the QUALIFIED old members, and its public polynomial, with which the other
+// new members check their shares, is the interpolation of their public
+// polynomials, so that its public key is the one of the old committee.
+func (r *Resharer) DistKeyShare() (*DistKeyShare, error) {
+	if r.newIndex < 0 {
+		return nil, errors.New("dkg: not a member of the new committee")
+	}
+	if !r.Certified() {
+		return nil, errors.New("dkg: distributed key not certified")
+	}
+
+	var shares []*share.PriShare
+	commits := make([][]*share.PubShare, r.t)
+	r.qualIter(func(i uint32, deal *vss.Deal) {
+		shares = append(shares, &share.PriShare{I: int(i), V: deal.SecShare.V})
+		for k, c := range deal.Commitments {
+			commits[k] = append(commits[k], &share.PubShare{I: int(i), V: c})
+		}
+	})
+
+	n := len(r.oldParticipants)
+	sh, err := share.RecoverSecret(r.suite, shares, len(shares), n)
+	if err != nil {
+		return nil, err
+	}
+	coeffs := make([]kyber.Point, r.t)
+	for k := range coeffs {
+		if coeffs[k], err = share.RecoverCommit(r.suite, commits[k], len(shares), n); err != nil {
+			return nil, err
+		}
+	}
+	return &DistKeyShare{
+		Commits: coeffs,
+		Share: &share.PriShare{
+			I: r.newIndex,
+			V: sh,
+		},
+	}, nil
+}
+
+func indexOf(list []kyber.Point, p kyber.Point) int {
+	for i, q := range list {
+		if q.Equal(p) {
+			return i
+		}
+	}
+	return -1
+}
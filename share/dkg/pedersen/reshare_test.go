@@ -0,0 +1,229 @@
+package dkg
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	vss "github.com/dedis/kyber/share/vss/pedersen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReshare(t *testing.T) {
+	// The key of participants 0 to 4 with threshold 3 is reshared to
+	// participants 3 to 8 with threshold 4. Old member 0 deals a secret
+	// other than its share, and old member 1 does not deal at all.
+	oldN, oldT, newT := 5, 3, 4
+	oldPubs, oldSecs := partPubs[:oldN], partSec[:oldN]
+	old := make([]*DistKeyGenerator, oldN)
+	for i := range old {
+		var err error
+		old[i], err = NewDistKeyGenerator(suite, oldSecs[i], oldPubs, oldT)
+		require.Nil(t, err)
+	}
+	var resps []*Response
+	for _, dkg := range old {
+		deals, err := dkg.Deals()
+		require.Nil(t, err)
+		for i, d := range deals {
+			resp, err := old[i].ProcessDeal(d)
+			require.Nil(t, err)
+			resps = append(resps, resp)
+		}
+	}
+	for _, resp := range resps {
+		for _, dkg := range old {
+			if resp.Response.Index != dkg.index {
+				_, err := dkg.ProcessResponse(resp)
+				require.Nil(t, err)
+			}
+		}
+	}
+	oldDks := make([]*DistKeyShare, oldN)
+	for i, dkg := range old {
+		var err error
+		oldDks[i], err = dkg.DistKeyShare()
+		require.Nil(t, err)
+	}
+	commits := oldDks[0].Commits
+
+	newPubs, newSecs := append([]kyber.Point{}, partPubs[3:]...), append([]kyber.Scalar{}, partSec[3:]...)
+	for i := 0; i < 2; i++ {
+		sec, pub := genPair()
+		newPubs, newSecs = append(newPubs, pub), append(newSecs, sec)
+	}
+	secs := append(append([]kyber.Scalar{}, oldSecs[:3]...), newSecs...)
+	var resharers, newMembers []*Resharer
+	for _, sec := range secs {
+		var dks *DistKeyShare
+		for i, s := range oldSecs {
+			if s.Equal(sec) {
+				dks = oldDks[i]
+			}
+		}
+		r, err := NewResharer(suite, sec, dks, commits, oldPubs, newPubs, newT)
+		require.Nil(t, err)
+		resharers = append(resharers, r)
+		if r.newIndex >= 0 {
+			newMembers = append(newMembers, r)
+		}
+	}
+	require.Len(t, newMembers, len(newPubs))
+	bad, err := vss.NewDealer(suite, oldSecs[0], suite.Scalar().Pick(suite.RandomStream()), newPubs, newT)
+	require.Nil(t, err)
+	resharers[0].dealer = bad
+
+	resps = nil
+	for _, r := range resharers {
+		if r.oldIndex < 0 || r.oldIndex == 1 {
+			_, err := r.Deals()
+			if r.oldIndex < 0 {
+				require.Error(t, err)
+			}
+			continue
+		}
+		deals, err := r.Deals()
+		require.Nil(t, err)
+		for i, d := range deals {
+			resp, err := newMembers[i].ProcessDeal(d)
+			require.Nil(t, err)
+			resps = append(resps, resp)
+		}
+	}
+	for _, resp := range resps {
+		for _, r := range resharers {
+			if r.newIndex == int(resp.Response.Index) {
+				continue
+			}
+			j, err := r.ProcessResponse(resp)
+			require.Nil(t, err)
+			require.Nil(t, j)
+		}
+	}
+
+	newDks := make([]*DistKeyShare, len(newMembers))
+	for i, r := range newMembers {
+		require.False(t, r.Certified())
+		r.SetTimeout()
+		require.True(t, r.Certified())
+		require.Equal(t, []int{2, 3, 4}, r.QUAL())
+		newDks[i], err = r.DistKeyShare()
+		require.Nil(t, err)
+		require.Equal(t, i, newDks[i].Share.I)
+	}
+	_, err = resharers[0].DistKeyShare()
+	require.Error(t, err)
+
+	pubPoly := share.NewPubPoly(suite, nil, newDks[0].Commits)
+	require.Len(t, newDks[0].Commits, newT)
+	require.True(t, pubPoly.Commit().Equal(oldDks[0].Public()))
+	var shares []*share.PriShare
+	for _, dks := range newDks {
+		require.True(t, checkDks(dks, newDks[0]))
+		require.True(t, pubPoly.Check(dks.Share))
+		shares = append(shares, dks.Share)
+	}
+	n := len(newPubs)
+	secret, err := share.RecoverSecret(suite, shares, newT, n)
+	require.Nil(t, err)
+	require.True(t, suite.Point().Mul(secret, nil).Equal(oldDks[0].Public()))
+	_, err = share.RecoverSecret(suite, shares[:newT-1], newT, n)
+	require.Error(t, err)
+}
+
+func TestNewResharer(t *testing.T) {
+	poly := share.NewPriPoly(suite, 3, nil)
+	_, commits := poly.Commit(nil).Info()
+	dks := &DistKeyShare{Commits: commits, Share: poly.Eval(0)}
+	oldPubs, newPubs := partPubs[:4], partPubs[2:]
+
+	_, err := NewResharer(suite, partSec[0], dks, commits, oldPubs, newPubs, 3)
+	require.Nil(t, err)
+	_, err = NewResharer(suite, partSec[1], dks, commits, oldPubs, newPubs, 3)
+	require.Error(t, err)
+	_, err = NewResharer(suite, partSec[0], nil, commits, oldPubs, newPubs, 3)
+	require.Error(t, err)
+	_, err = NewResharer(suite, partSec[6], dks, commits, oldPubs, newPubs, 3)
+	require.Error(t, err)
+	_, err = NewResharer(suite, partSec[6], nil, commits, oldPubs, newPubs, 6)
+	require.Error(t, err)
+	_, err = NewResharer(suite, partSec[6], nil, commits[:1], oldPubs, newPubs, 3)
+	require.Error(t, err)
+	_, err = NewResharer(suite, partSec[6], nil, commits, oldPubs, newPubs, 3)
+	require.Nil(t, err)
+}
+
+func TestReshareEarlyMessages(t *testing.T) {
+	// Old member 0 gives wrong shares to new members 0 and 1, and new
+	// member 3 gets their complaints and the justifications before the
+	// deal. With a new threshold of n', the deal is only certified if both
+	// complaints are justified.
+	poly := share.NewPriPoly(suite, 2, nil)
+	_, commits := poly.Commit(nil).Info()
+	oldPubs, newPubs := partPubs[:3], partPubs[3:]
+	newT := len(newPubs)
+	dealer, err := NewResharer(suite, partSec[0], &DistKeyShare{Commits: commits, Share: poly.Eval(0)}, commits, oldPubs, newPubs, newT)
+	require.Nil(t, err)
+	newMembers := make([]*Resharer, len(newPubs))
+	for i := range newMembers {
+		newMembers[i], err = NewResharer(suite, partSec[3+i], nil, commits, oldPubs, newPubs, newT)
+		require.Nil(t, err)
+	}
+	rec := newMembers[3]
+
+	wrong := []int{0, 1}
+	good := make([]kyber.Scalar, len(wrong))
+	for k, i := range wrong {
+		deal, err := dealer.dealer.PlaintextDeal(i)
+		require.Nil(t, err)
+		good[k] = deal.SecShare.V
+		deal.SecShare.V = suite.Scalar().Zero()
+	}
+	deals, err := dealer.Deals()
+	require.Nil(t, err)
+	for k, i := range wrong {
+		deal, err := dealer.dealer.PlaintextDeal(i)
+		require.Nil(t, err)
+		deal.SecShare.V = good[k]
+	}
+
+	var resps []*Response
+	var justs []*Justification
+	for i, r := range newMembers[:3] {
+		resp, err := r.ProcessDeal(deals[i])
+		require.Nil(t, err)
+		resps = append(resps, resp)
+		j, err := dealer.ProcessResponse(resp)
+		require.Nil(t, err)
+		if resp.Response.Status == vss.StatusComplaint {
+			require.NotNil(t, j)
+			justs = append(justs, j)
+		}
+	}
+	require.Len(t, justs, len(wrong))
+
+	for k := 0; k < 2*len(newPubs); k++ {
+		forged := *resps[k%len(resps)].Response
+		forged.Signature = randomBytes(len(forged.Signature))
+		_, err := rec.ProcessResponse(&Response{Index: 0, Response: &forged})
+		require.Error(t, err)
+	}
+	forged := *justs[0].Justification
+	forged.Signature = randomBytes(len(forged.Signature))
+	require.Error(t, rec.ProcessJustification(&Justification{Index: 0, Justification: &forged}))
+
+	for _, resp := range resps {
+		j, err := rec.ProcessResponse(resp)
+		require.Nil(t, err)
+		require.Nil(t, j)
+	}
+	for _, j := range justs {
+		require.Nil(t, rec.ProcessJustification(j))
+	}
+	resp, err := rec.ProcessDeal(deals[3])
+	require.Nil(t, err)
+	require.Equal(t, vss.StatusApproval, resp.Response.Status)
+	require.Empty(t, rec.pending.resps)
+	require.Empty(t, rec.pending.justs)
+	require.True(t, rec.verifiers[0].DealCertified())
+}
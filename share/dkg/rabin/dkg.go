@@ -76,6 +76,12 @@ func (d *DistKeyShare) Commitments() []kyber.Point {
 	return d.Commits
 }
 
+// Destroy overwrites the share of the distributed secret. The commitments
+// are kept.
+func (d *DistKeyShare) Destroy() {
+	d.Share.Destroy()
+}
+
 // Deal holds the Deal for one participant as well as the index of the issuing
 // Dealer.
 //  NOTE: Doing that in vss.go would be possible but then the Dealer is always
@@ -6,7 +6,9 @@
 // scheme allows a committer to commit to a secret sharing polynomial so that
 // a verifier can check the claimed evaluations of the committed polynomial.
 // Both schemes of this package are core building blocks for more advanced
-// secret sharing techniques.
+// secret sharing techniques, such as the verifiable secret sharing schemes
+// of the share/vss packages, in which the dealer commits to its polynomial
+// so that the receivers can check their shares.
 package share
 
 import (
@@ -43,6 +45,11 @@ func (p *PriShare) Hash(s Suite) []byte {
 	return h.Sum(nil)
 }
 
+// Destroy overwrites the value of the private share.
+func (p *PriShare) Destroy() {
+	kyber.Destroy(p.V)
+}
+
 // PriPoly represents a secret sharing polynomial.
 type PriPoly struct {
 	s      Suite          // Cryptographic suite
@@ -74,6 +81,14 @@ func (p *PriPoly) Secret() kyber.Scalar {
 	return p.coeffs[0]
 }
 
+// Destroy overwrites the coefficients of the polynomial, including the
+// secret given to NewPriPoly, which the caller must no longer use.
+func (p *PriPoly) Destroy() {
+	for _, c := range p.coeffs {
+		kyber.Destroy(c)
+	}
+}
+
 // Eval computes the private share v = p(i).
 func (p *PriPoly) Eval(i int) *PriShare {
 	xi := p.s.Scalar().SetInt64(1 + int64(i))
@@ -171,14 +186,13 @@ func RecoverSecret(g kyber.Group, shares []*PriShare, t, n int) (kyber.Scalar, e
 		return nil, errors.New("share: not enough shares to recover secret")
 	}
 
-	acc := g.Scalar().Zero()
-	num := g.Scalar()
-	den := g.Scalar()
+	nums := make([]kyber.Scalar, 0, len(x))
+	dens := make([]kyber.Scalar, 0, len(x))
 	tmp := g.Scalar()
 
 	for i, xi := range x {
-		num.Set(shares[i].V)
-		den.One()
+		num := g.Scalar().Set(shares[i].V)
+		den := g.Scalar().One()
 		for j, xj := range x {
 			if i == j {
 				continue
@@ -186,9 +200,15 @@ func RecoverSecret(g kyber.Group, shares []*PriShare, t, n int) (kyber.Scalar, e
 			num.Mul(num, xj)
 			den.Mul(den, tmp.Sub(xj, xi))
 		}
-		acc.Add(acc, num.Div(num, den))
+		nums = append(nums, num)
+		dens = append(dens, den)
 	}
 
+	kyber.BatchInvert(dens)
+	acc := g.Scalar().Zero()
+	for i, num := range nums {
+		acc.Add(acc, num.Mul(num, dens[i]))
+	}
 	return acc, nil
 }
 
@@ -226,27 +246,35 @@ func RecoverPriPoly(s Suite, shares []*PriShare, t, n int) (*PriPoly, error) {
 
 	var accPoly *PriPoly
 	var err error
-	den := s.Scalar()
+	tmp := s.Scalar()
 	// notations following the wikipedia article on Lagrange interpolation
 	// https://en.wikipedia.org/wiki/Lagrange_polynomial
+	bases := make([]*PriPoly, 0, len(x))
+	dens := make([]kyber.Scalar, 0, len(x))
+	ys := make([]kyber.Scalar, 0, len(x))
 	for j, xj := range x {
 		var basis = &PriPoly{
 			s:      s,
 			coeffs: []kyber.Scalar{s.Scalar().One()},
 		}
-		var acc = s.Scalar().Set(shares[j].V)
+		den := s.Scalar().One()
 		// compute lagrange basis l_j
 		for m, xm := range x {
 			if j == m {
 				continue
 			}
 			basis = basis.Mul(xMinusConst(s, xm)) // basis = basis * (x - xm)
-
-			den.Sub(xj, xm)   // den = xj - xm
-			den.Inv(den)      // den = 1 / den
-			acc.Mul(acc, den) // acc = acc * den
+			den.Mul(den, tmp.Sub(xj, xm))         // den = den * (xj - xm)
 		}
+		bases = append(bases, basis)
+		dens = append(dens, den)
+		ys = append(ys, shares[j].V)
+	}
 
+	// all the denominators are inverted at once
+	kyber.BatchInvert(dens)
+	for j, basis := range bases {
+		acc := dens[j].Mul(dens[j], ys[j]) // acc = y_j / den
 		for i := range basis.coeffs {
 			basis.coeffs[i] = basis.coeffs[i].Mul(basis.coeffs[i], acc)
 		}
@@ -397,15 +425,14 @@ func RecoverCommit(g kyber.Group, shares []*PubShare, t, n int) (kyber.Point, er
 		return nil, errors.New("share: not enough good public shares to reconstruct secret commitment")
 	}
 
-	num := g.Scalar()
-	den := g.Scalar()
+	nums := make([]kyber.Scalar, 0, len(x))
+	dens := make([]kyber.Scalar, 0, len(x))
+	Vs := make([]kyber.Point, 0, len(x))
 	tmp := g.Scalar()
-	Acc := g.Point().Null()
-	Tmp := g.Point()
 
 	for i, xi := range x {
-		num.One()
-		den.One()
+		num := g.Scalar().One()
+		den := g.Scalar().One()
 		for j, xj := range x {
 			if i == j {
 				continue
@@ -413,7 +440,16 @@ func RecoverCommit(g kyber.Group, shares []*PubShare, t, n int) (kyber.Point, er
 			num.Mul(num, xj)
 			den.Mul(den, tmp.Sub(xj, xi))
 		}
-		Tmp.Mul(num.Div(num, den), shares[i].V)
+		nums = append(nums, num)
+		dens = append(dens, den)
+		Vs = append(Vs, shares[i].V)
+	}
+
+	kyber.BatchInvert(dens)
+	Acc := g.Point().Null()
+	Tmp := g.Point()
+	for i, num := range nums {
+		Tmp.Mul(num.Mul(num, dens[i]), Vs[i])
 		Acc.Add(Acc, Tmp)
 	}
 
@@ -302,3 +302,18 @@ func TestRecoverPriPoly(test *testing.T) {
 		assert.Equal(test, reverseRecovered.Eval(i).V.String(), a.Eval(i).V.String())
 	}
 }
+
+func TestPriPolyDestroy(test *testing.T) {
+	g := edwards25519.NewBlakeSHA256Ed25519()
+	poly := NewPriPoly(g, 3, nil)
+	sh := poly.Eval(1)
+	zero := g.Scalar().Zero()
+
+	sh.Destroy()
+	assert.True(test, sh.V.Equal(zero))
+
+	poly.Destroy()
+	for _, c := range poly.coeffs {
+		assert.True(test, c.Equal(zero))
+	}
+}
@@ -4,7 +4,9 @@
 // secret sharing schemes, PVSS enables any third party to verify shares
 // distributed by a dealer using zero-knowledge proofs. PVSS runs in three steps:
 //  1. The dealer creates a list of encrypted public verifiable shares using
-//     EncShares() and distributes them to the trustees.
+//     EncShares() and distributes them to the trustees. Anyone, such as a
+//     third party that did not take part in the sharing, can check the whole
+//     dealing against the public commitment polynomial with VerifyEncShares().
 //  2. Upon the announcement that the secret should be released, each trustee
 //     uses DecShare() to first verify and, if valid, decrypt his share.
 //  3. Once a threshold of decrypted shares has been released, anyone can
@@ -17,6 +19,7 @@ import (
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/proof/transcript"
 	"github.com/dedis/kyber/share"
 )
 
@@ -31,6 +34,12 @@ type Suite interface {
 }
 
 // Some error definitions.
+// Labels of the transcripts of the proofs of encrypted and decrypted shares.
+const (
+	encLabel = "pvss encrypted share"
+	decLabel = "pvss decrypted share"
+)
+
 var errorTooFewShares = errors.New("not enough shares to recover secret")
 var errorDifferentLengths = errors.New("inputs of different lengths")
 var errorEncVerification = errors.New("verification of encrypted share failed")
@@ -70,7 +79,7 @@ func EncShares(suite Suite, H kyber.Point, X []kyber.Point, secret kyber.Scalar,
 	}
 
 	// Create NIZK discrete-logarithm equality proofs
-	proofs, _, sX, err := dleq.NewDLEQProofBatch(suite, HS, X, values)
+	proofs, _, sX, err := dleq.NewDLEQProofBatchTranscript(suite, transcript.New(suite, encLabel), HS, X, values)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -87,12 +96,35 @@ func EncShares(suite Suite, H kyber.Point, X []kyber.Point, secret kyber.Scalar,
 // log_{H}(sH) == log_{X}(sX) where sH is the public commitment computed by
 // evaluating the public commitment polynomial at the encrypted share's index i.
 func VerifyEncShare(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, encShare *PubVerShare) error {
-	if err := encShare.P.Verify(suite, H, X, sH, encShare.S.V); err != nil {
+	if err := encShare.P.VerifyTranscript(suite, transcript.New(suite, encLabel), H, X, sH, encShare.S.V); err != nil {
 		return errorEncVerification
 	}
 	return nil
 }
 
+// VerifyEncShares checks a complete dealing: that there is one encrypted
+// share for each public key of X, at the index of that key, and that every
+// share is consistent with the public commitment polynomial. It returns nil
+// if all the shares are valid, so that any t of them can be decrypted into
+// the secret committed by the polynomial.
+func VerifyEncShares(suite Suite, H kyber.Point, X []kyber.Point, commit *share.PubPoly, encShares []*PubVerShare) error {
+	if len(X) != len(encShares) {
+		return errorDifferentLengths
+	}
+	if commit.Threshold() > len(X) {
+		return errorTooFewShares
+	}
+	for i, encShare := range encShares {
+		if encShare == nil || encShare.S.I != i {
+			return errorEncVerification
+		}
+		if err := VerifyEncShare(suite, H, X[i], commit.Eval(i).V, encShare); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // VerifyEncShareBatch provides the same functionality as VerifyEncShare but for
 // slices of encrypted shares. The function returns the valid encrypted shares
 // together with the corresponding public keys.
@@ -121,7 +153,7 @@ func DecShare(suite Suite, H kyber.Point, X kyber.Point, sH kyber.Point, x kyber
 	G := suite.Point().Base()
 	V := suite.Point().Mul(suite.Scalar().Inv(x), encShare.S.V) // decryption: x^{-1} * (xS)
 	ps := &share.PubShare{I: encShare.S.I, V: V}
-	P, _, _, err := dleq.NewDLEQProof(suite, G, V, x)
+	P, _, _, err := dleq.NewDLEQProofTranscript(suite, transcript.New(suite, decLabel), G, V, x)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +183,7 @@ func DecShareBatch(suite Suite, H kyber.Point, X []kyber.Point, sH []kyber.Point
 // VerifyDecShare checks that the decrypted share sG satisfies
 // log_{G}(X) == log_{sG}(sX). Note that X = xG and sX = s(xG) = x(sG).
 func VerifyDecShare(suite Suite, G kyber.Point, X kyber.Point, encShare *PubVerShare, decShare *PubVerShare) error {
-	if err := decShare.P.Verify(suite, G, decShare.S.V, X, encShare.S.V); err != nil {
+	if err := decShare.P.VerifyTranscript(suite, transcript.New(suite, decLabel), G, decShare.S.V, X, encShare.S.V); err != nil {
 		return errorDecVerification
 	}
 	return nil
@@ -52,6 +52,37 @@ func TestPVSS(test *testing.T) {
 	require.True(test, suite.Point().Mul(secret, nil).Equal(recovered))
 }
 
+func TestPVSSVerifyEncShares(test *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	H := suite.Point().Pick(suite.XOF([]byte("H")))
+	n := 10
+	t := 2*n/3 + 1
+	X := make([]kyber.Point, n)
+	for i := 0; i < n; i++ {
+		X[i] = suite.Point().Mul(suite.Scalar().Pick(suite.RandomStream()), nil)
+	}
+	secret := suite.Scalar().Pick(suite.RandomStream())
+	encShares, pubPoly, err := EncShares(suite, H, X, secret, t)
+	require.NoError(test, err)
+	require.NoError(test, VerifyEncShares(suite, H, X, pubPoly, encShares))
+
+	// Missing, reordered and wrong shares are rejected.
+	require.Error(test, VerifyEncShares(suite, H, X[1:], pubPoly, encShares[1:]))
+	swapped := append([]*PubVerShare(nil), encShares...)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	require.Error(test, VerifyEncShares(suite, H, X, pubPoly, swapped))
+	bad := *encShares[3]
+	bad.S.V = suite.Point().Add(bad.S.V, X[3])
+	wrong := append([]*PubVerShare(nil), encShares...)
+	wrong[3] = &bad
+	require.Error(test, VerifyEncShares(suite, H, X, pubPoly, wrong))
+
+	// So is a dealing committed to another polynomial.
+	_, other, err := EncShares(suite, H, X, secret, t)
+	require.NoError(test, err)
+	require.Error(test, VerifyEncShares(suite, H, X, other, encShares))
+}
+
 func TestPVSSDelete(test *testing.T) {
 	suite := edwards25519.NewBlakeSHA256Ed25519()
 	G := suite.Point().Base()
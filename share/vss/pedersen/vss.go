@@ -15,6 +15,7 @@ import (
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/share"
 	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/dedis/kyber/util/key"
 	"github.com/dedis/protobuf"
 )
 
@@ -396,6 +397,10 @@ func (v *Verifier) decryptDeal(e *EncryptedDeal) (*Deal, error) {
 		return nil, err
 	}
 
+	if err := key.ValidatePublicKey(v.suite, e.DHKey); err != nil {
+		return nil, err
+	}
+
 	// compute shared key and AES526-GCM cipher
 	pre := dhExchange(v.suite, v.longterm, e.DHKey)
 	gcm, err := newAEAD(v.suite.Hash, pre, v.hkdfContext)
@@ -478,6 +483,72 @@ func RecoverSecret(suite Suite, deals []*Deal, n, t int) (kyber.Scalar, error) {
 	return share.RecoverSecret(suite, shares, t, n)
 }
 
+// Reconstruct recovers the secret from the Deals revealed by the verifiers,
+// once the Deal received by this verifier is certified. Unlike RecoverSecret,
+// it checks every revealed share against the commitments of the certified
+// Deal, so that an invalid share, from a verifier that lies about its Deal,
+// is detected and discarded. It returns an error if fewer than t Deals are
+// valid.
+func (v *Verifier) Reconstruct(deals []*Deal) (kyber.Scalar, error) {
+	if v.aggregator == nil || v.Deal() == nil {
+		return nil, errors.New("vss: deal not certified")
+	}
+	var shares []*share.PriShare
+	var invalid []int
+	seen := make(map[int]bool)
+	for _, d := range deals {
+		if d == nil || d.SecShare == nil || seen[d.SecShare.I] {
+			continue
+		}
+		if !equalCommits(d.Commitments, v.commits) || v.VerifyDeal(d, false) != nil {
+			invalid = append(invalid, d.SecShare.I)
+			continue
+		}
+		seen[d.SecShare.I] = true
+		shares = append(shares, d.SecShare)
+	}
+	if len(shares) < v.t {
+		return nil, fmt.Errorf("vss: not enough valid deals, invalid deals from %v", invalid)
+	}
+	return share.RecoverSecret(v.suite, shares, v.t, len(v.verifiers))
+}
+
+// VerifyEvidence checks whether the Justification j, signed by the dealer of
+// the given public key, proves that the dealer misbehaved in the session sid,
+// as returned by Verifier.SessionID: that the Deal it reveals is not a valid
+// deal of the session for the complainer. Anyone knowing the public keys of
+// the dealer and of the verifiers can check it. It returns nil if j is such
+// evidence, and an error if j is not signed by the dealer, if it belongs to
+// another session, such as an earlier one, or if its Deal is valid.
+func VerifyEvidence(suite Suite, dealer kyber.Point, verifiers []kyber.Point, sid []byte, j *Justification) error {
+	if j == nil || j.Deal == nil || j.Deal.SecShare == nil {
+		return errors.New("vss: malformed justification")
+	}
+	if !bytes.Equal(j.SessionID, sid) {
+		return errors.New("vss: justification of another session")
+	}
+	if err := schnorr.Verify(suite, dealer, j.Hash(suite), j.Signature); err != nil {
+		return err
+	}
+	d := j.Deal
+	if !validT(int(d.T), verifiers) || !bytes.Equal(j.SessionID, d.SessionID) ||
+		d.SecShare.I != int(j.Index) || d.SecShare.I < 0 || d.SecShare.I >= len(verifiers) {
+		return nil
+	}
+	sid, err := sessionID(suite, dealer, verifiers, d.Commitments, int(d.T))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(sid, d.SessionID) {
+		return nil
+	}
+	pubShare := share.NewPubPoly(suite, nil, d.Commitments).Eval(d.SecShare.I)
+	if !suite.Point().Mul(d.SecShare.V, nil).Equal(pubShare.V) {
+		return nil
+	}
+	return errors.New("vss: justification reveals a valid deal")
+}
+
 // SetTimeout marks the end of a round, invalidating any missing (or future) response
 // for this DKG protocol round. The caller is expected to call this after a long timeout
 // so each DKG node can still compute its share if enough Deals are valid.
@@ -685,6 +756,18 @@ func deriveH(suite Suite, verifiers []kyber.Point) kyber.Point {
 	return base
 }
 
+func equalCommits(a, b []kyber.Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func findPub(verifiers []kyber.Point, idx uint32) (kyber.Point, bool) {
 	iidx := int(idx)
 	if iidx >= len(verifiers) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/share"
 	"github.com/dedis/kyber/sign/schnorr"
 	"github.com/dedis/kyber/xof/blake"
 	"github.com/stretchr/testify/assert"
@@ -81,6 +82,57 @@ func TestVSSWhole(t *testing.T) {
 	assert.Equal(t, dealer.secret.String(), sec.String())
 }
 
+func TestVSSReconstruct(t *testing.T) {
+	dealer, verifiers := genAll()
+	v := verifiers[0]
+	_, err := v.Reconstruct(nil)
+	assert.Error(t, err)
+
+	encDeals, err := dealer.EncryptedDeals()
+	require.Nil(t, err)
+	resps := make([]*Response, nbVerifiers)
+	for i, d := range encDeals {
+		resps[i], err = verifiers[i].ProcessEncryptedDeal(d)
+		require.Nil(t, err)
+	}
+	for _, resp := range resps {
+		for i, v := range verifiers {
+			if resp.Index != uint32(i) {
+				require.Nil(t, v.ProcessResponse(resp))
+			}
+		}
+	}
+	require.True(t, v.DealCertified())
+
+	deals := make([]*Deal, nbVerifiers)
+	for i, v := range verifiers {
+		deals[i] = v.Deal()
+	}
+	sec, err := v.Reconstruct(deals)
+	require.Nil(t, err)
+	assert.True(t, secret.Equal(sec))
+
+	// A wrong share is detected and discarded.
+	bad := *deals[1]
+	bad.SecShare = &share.PriShare{I: 1, V: suite.Scalar().Pick(suite.RandomStream())}
+	deals[1] = &bad
+	sec, err = v.Reconstruct(deals)
+	require.Nil(t, err)
+	assert.True(t, secret.Equal(sec))
+
+	// So is a share consistent with forged commitments.
+	forged := *deals[2]
+	forged.Commitments = append([]kyber.Point{suite.Point().Pick(suite.RandomStream())}, deals[2].Commitments[1:]...)
+	deals[2] = &forged
+	sec, err = v.Reconstruct(deals)
+	require.Nil(t, err)
+	assert.True(t, secret.Equal(sec))
+
+	// Reconstruction fails with fewer than t valid shares.
+	_, err = v.Reconstruct(deals[:vssThreshold])
+	assert.Error(t, err)
+}
+
 func TestVSSDealerNew(t *testing.T) {
 	goodT := MinimumT(nbVerifiers)
 	_, err := NewDealer(suite, dealerSec, secret, verifiersPub, goodT)
@@ -553,6 +605,42 @@ func TestVSSAggregatorAddComplaint(t *testing.T) {
 
 }
 
+func TestVSSVerifyEvidence(t *testing.T) {
+	// A justification revealing a wrong share is evidence.
+	dealer, verifiers := genAll()
+	deal := dealer.deals[0]
+	deal.SecShare.V = suite.Scalar().Zero()
+	encD, err := dealer.EncryptedDeal(0)
+	require.Nil(t, err)
+	resp, err := verifiers[0].ProcessEncryptedDeal(encD)
+	require.Nil(t, err)
+	assert.Equal(t, StatusComplaint, resp.Status)
+	j, err := dealer.ProcessResponse(resp)
+	require.Nil(t, err)
+	require.NotNil(t, j)
+	sid := verifiers[0].SessionID()
+	assert.Nil(t, VerifyEvidence(suite, dealerPub, verifiersPub, sid, j))
+
+	// It must be signed by the dealer.
+	forged := *j
+	forged.Signature = randomBytes(len(j.Signature))
+	assert.Error(t, VerifyEvidence(suite, dealerPub, verifiersPub, sid, &forged))
+	assert.Error(t, VerifyEvidence(suite, verifiersPub[0], verifiersPub, sid, j))
+
+	// A justification of a valid deal, answering a false complaint, is not.
+	dealer, _ = genAll()
+	resp = &Response{SessionID: dealer.sessionID, Index: 0, Status: StatusComplaint}
+	resp.Signature, err = schnorr.Sign(suite, verifiersSec[0], resp.Hash(suite))
+	require.Nil(t, err)
+	valid, err := dealer.ProcessResponse(resp)
+	require.Nil(t, err)
+	require.NotNil(t, valid)
+	assert.Error(t, VerifyEvidence(suite, dealerPub, verifiersPub, dealer.sessionID, valid))
+
+	// Nor is the evidence of an earlier session, in the new one.
+	assert.Error(t, VerifyEvidence(suite, dealerPub, verifiersPub, dealer.sessionID, j))
+}
+
 func TestVSSSessionID(t *testing.T) {
 	dealer, _ := NewDealer(suite, dealerSec, secret, verifiersPub, vssThreshold)
 	commitments := dealer.deals[0].Commitments
@@ -26,6 +26,9 @@
 //   accept the shared secret if there are at least t approvals at which point
 //   any t out of n verifiers can reveal their shares to reconstruct the shared
 //   secret.
+//   5) The verifiers reconstruct the secret from the revealed shares with
+//   `Reconstruct`, which discards the shares that do not match the
+//   commitments of the certified deal.
 package vss
 
 import (
@@ -498,6 +501,36 @@ func RecoverSecret(suite Suite, deals []*Deal, n, t int) (kyber.Scalar, error) {
 	return share.RecoverSecret(suite, shares, t, n)
 }
 
+// Reconstruct recovers the secret from the Deals revealed by the verifiers,
+// once the Deal received by this verifier is certified. Unlike RecoverSecret,
+// it checks every revealed share against the commitments of the certified
+// Deal, so that an invalid share, from a verifier that lies about its Deal,
+// is detected and discarded. It returns an error if fewer than t Deals are
+// valid.
+func (v *Verifier) Reconstruct(deals []*Deal) (kyber.Scalar, error) {
+	if v.aggregator == nil || v.Deal() == nil {
+		return nil, errors.New("vss: deal not certified")
+	}
+	var shares []*share.PriShare
+	var invalid []int
+	seen := make(map[int]bool)
+	for _, d := range deals {
+		if d == nil || d.SecShare == nil || d.RndShare == nil || seen[d.SecShare.I] {
+			continue
+		}
+		if !equalCommits(d.Commitments, v.commits) || v.VerifyDeal(d, false) != nil {
+			invalid = append(invalid, d.SecShare.I)
+			continue
+		}
+		seen[d.SecShare.I] = true
+		shares = append(shares, d.SecShare)
+	}
+	if len(shares) < v.t {
+		return nil, fmt.Errorf("vss: not enough valid deals, invalid deals from %v", invalid)
+	}
+	return share.RecoverSecret(v.suite, shares, v.t, len(v.verifiers))
+}
+
 // SetTimeout tells this verifier to consider this moment the maximum time limit.
 // it calls cleanVerifiers which will take care of all Verifiers who have not
 // responded until now.
@@ -710,6 +743,18 @@ func deriveH(suite Suite, verifiers []kyber.Point) kyber.Point {
 	return base
 }
 
+func equalCommits(a, b []kyber.Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func findPub(verifiers []kyber.Point, idx uint32) (kyber.Point, bool) {
 	iidx := int(idx)
 	if iidx >= len(verifiers) {
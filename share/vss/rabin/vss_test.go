@@ -6,6 +6,7 @@ import (
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/share"
 	"github.com/dedis/kyber/sign/schnorr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,6 +78,57 @@ func TestVSSWhole(t *testing.T) {
 	assert.Equal(t, dealer.secret.String(), sec.String())
 }
 
+func TestVSSReconstruct(t *testing.T) {
+	dealer, verifiers := genAll()
+	v := verifiers[0]
+	_, err := v.Reconstruct(nil)
+	assert.Error(t, err)
+
+	encDeals, err := dealer.EncryptedDeals()
+	require.Nil(t, err)
+	resps := make([]*Response, nbVerifiers)
+	for i, d := range encDeals {
+		resps[i], err = verifiers[i].ProcessEncryptedDeal(d)
+		require.Nil(t, err)
+	}
+	for _, resp := range resps {
+		for i, v := range verifiers {
+			if resp.Index != uint32(i) {
+				require.Nil(t, v.ProcessResponse(resp))
+			}
+		}
+	}
+	require.True(t, v.DealCertified())
+
+	deals := make([]*Deal, nbVerifiers)
+	for i, v := range verifiers {
+		deals[i] = v.Deal()
+	}
+	sec, err := v.Reconstruct(deals)
+	require.Nil(t, err)
+	assert.True(t, secret.Equal(sec))
+
+	// A wrong share is detected and discarded.
+	bad := *deals[1]
+	bad.SecShare = &share.PriShare{I: 1, V: suite.Scalar().Pick(suite.RandomStream())}
+	deals[1] = &bad
+	sec, err = v.Reconstruct(deals)
+	require.Nil(t, err)
+	assert.True(t, secret.Equal(sec))
+
+	// So is a share consistent with forged commitments.
+	forged := *deals[2]
+	forged.Commitments = append([]kyber.Point{suite.Point().Pick(suite.RandomStream())}, deals[2].Commitments[1:]...)
+	deals[2] = &forged
+	sec, err = v.Reconstruct(deals)
+	require.Nil(t, err)
+	assert.True(t, secret.Equal(sec))
+
+	// Reconstruction fails with fewer than t valid shares.
+	_, err = v.Reconstruct(deals[:vssThreshold])
+	assert.Error(t, err)
+}
+
 func TestVSSDealerNew(t *testing.T) {
 	goodT := MinimumT(nbVerifiers)
 	_, err := NewDealer(suite, dealerSec, secret, verifiersPub, goodT)
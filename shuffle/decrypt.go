@@ -0,0 +1,163 @@
+// +build experimental
+
+package shuffle
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/dleq"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/share"
+)
+
+// Decrypt decrypts the ElGamal pairs (X[i], Y[i]), such as the output of a
+// shuffle, under the private key x, into the points M[i] = Y[i] - x*X[i].
+// It also returns a single proof, whatever the number of pairs, that every
+// M[i] is the decryption with the key of the public key x*G, which
+// VerifyDecryption checks.
+func Decrypt(suite Suite, x kyber.Scalar, X, Y []kyber.Point) (M []kyber.Point, prf *dleq.Proof, err error) {
+	if len(X) != len(Y) {
+		return nil, nil, errors.New("X,Y vectors have inconsistent length")
+	}
+	t := decryptionTranscript(suite, "shuffle decryption", X, Y)
+	prf, _, D, err := dleq.NewBatchProofTranscript(suite, t, suite.Point().Base(), X, x)
+	if err != nil {
+		return nil, nil, err
+	}
+	M = make([]kyber.Point, len(X))
+	for i := range M {
+		M[i] = suite.Point().Sub(Y[i], D[i])
+	}
+	return M, prf, nil
+}
+
+// VerifyDecryption checks the proof of Decrypt that the points M are the
+// decryptions of the pairs (X, Y) under the private key of the public key H.
+func VerifyDecryption(suite Suite, H kyber.Point, X, Y, M []kyber.Point, prf *dleq.Proof) error {
+	if len(X) != len(Y) || len(X) != len(M) || prf == nil {
+		return errors.New("malformed decryption")
+	}
+	D := make([]kyber.Point, len(M))
+	for i := range D {
+		D[i] = suite.Point().Sub(Y[i], M[i])
+	}
+	t := decryptionTranscript(suite, "shuffle decryption", X, Y)
+	if err := prf.VerifyBatchTranscript(suite, t, suite.Point().Base(), X, H, D); err != nil {
+		return errors.New("invalid decryption")
+	}
+	return nil
+}
+
+// DecryptionShare holds the shares D[i] = x_j*X[i] of the decryptions of
+// the pairs (X[i], Y[i]) by the holder of the share of index j of a private
+// key, with a single proof that it used the same x_j for all of them as in
+// its public share x_j*G.
+type DecryptionShare struct {
+	Index int
+	D     []kyber.Point
+	Proof *dleq.Proof
+}
+
+// NewDecryptionShare returns the decryption shares of the pairs (X, Y) for
+// the share of a private key, as held by one of the participants among
+// whom the key is shared, such as with package share/dkg. Combine turns
+// the shares of enough participants into the decrypted points.
+func NewDecryptionShare(suite Suite, private *share.PriShare, X, Y []kyber.Point) (*DecryptionShare, error) {
+	if len(X) != len(Y) {
+		return nil, errors.New("X,Y vectors have inconsistent length")
+	}
+	t := shareTranscript(suite, private.I, X, Y)
+	prf, _, D, err := dleq.NewBatchProofTranscript(suite, t, suite.Point().Base(), X, private.V)
+	if err != nil {
+		return nil, err
+	}
+	return &DecryptionShare{Index: private.I, D: D, Proof: prf}, nil
+}
+
+// VerifyDecryptionShare checks the decryption shares of the pairs (X, Y)
+// against the public polynomial of the shared key.
+func VerifyDecryptionShare(suite Suite, pub *share.PubPoly, X, Y []kyber.Point, ds *DecryptionShare) error {
+	if ds.Index < 0 || len(ds.D) != len(X) || len(X) != len(Y) || ds.Proof == nil {
+		return errors.New("malformed decryption share")
+	}
+	t := shareTranscript(suite, ds.Index, X, Y)
+	H := pub.Eval(ds.Index).V
+	if err := ds.Proof.VerifyBatchTranscript(suite, t, suite.Point().Base(), X, H, ds.D); err != nil {
+		return fmt.Errorf("invalid decryption share from participant %d", ds.Index)
+	}
+	return nil
+}
+
+// Combine checks the decryption shares of the pairs (X, Y), and combines
+// those of the first t participants with valid ones, where t is the
+// threshold of the public polynomial, into the decrypted points. Invalid
+// shares are left out, so that the decryption succeeds as long as t of the
+// participants are honest.
+func Combine(suite Suite, pub *share.PubPoly, X, Y []kyber.Point, shares []*DecryptionShare) ([]kyber.Point, error) {
+	t := pub.Threshold()
+	seen := make(map[int]bool)
+	var valid []*DecryptionShare
+	n := 0
+	for _, ds := range shares {
+		if len(valid) == t {
+			break
+		}
+		if ds == nil || seen[ds.Index] || VerifyDecryptionShare(suite, pub, X, Y, ds) != nil {
+			continue
+		}
+		seen[ds.Index] = true
+		valid = append(valid, ds)
+		if ds.Index >= n {
+			n = ds.Index + 1
+		}
+	}
+	if len(valid) < t {
+		return nil, errors.New("not enough valid decryption shares")
+	}
+
+	// The shares of each pair interpolate to x*X[i].
+	M := make([]kyber.Point, len(X))
+	errs := make([]error, ranges(len(X)))
+	parallel(len(X), len(errs), func(j, lo, hi int) {
+		pubShares := make([]*share.PubShare, len(valid))
+		for i := lo; i < hi; i++ {
+			for l, ds := range valid {
+				pubShares[l] = &share.PubShare{I: ds.Index, V: ds.D[i]}
+			}
+			D, err := share.RecoverCommit(suite, pubShares, t, n)
+			if err != nil {
+				errs[j] = err
+				return
+			}
+			M[i] = D.Sub(Y[i], D)
+		}
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return M, nil
+}
+
+// shareTranscript returns the transcript of the proof of the decryption
+// shares of the pairs (X, Y) for the key share of the given index.
+func shareTranscript(suite Suite, index int, X, Y []kyber.Point) *transcript.Transcript {
+	t := decryptionTranscript(suite, "shuffle threshold decryption", X, Y)
+	var i [4]byte
+	binary.BigEndian.PutUint32(i[:], uint32(index))
+	t.Append("index", i[:])
+	return t
+}
+
+// decryptionTranscript returns a transcript of the given label bound to
+// the pairs (X, Y).
+func decryptionTranscript(suite Suite, label string, X, Y []kyber.Point) *transcript.Transcript {
+	t := transcript.New(suite, label)
+	t.AppendPoints("X", X...)
+	t.AppendPoints("Y", Y...)
+	return t
+}
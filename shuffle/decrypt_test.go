@@ -0,0 +1,128 @@
+// +build experimental
+
+package shuffle
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+// mixTest encrypts k random points under H, shuffles them with a checked
+// proof, and returns the points with the shuffled pairs.
+func mixTest(t *testing.T, suite Suite, H kyber.Point, k int) (C, Xbar, Ybar []kyber.Point) {
+	rand := suite.RandomStream()
+	C = make([]kyber.Point, k)
+	X := make([]kyber.Point, k)
+	Y := make([]kyber.Point, k)
+	for i := range C {
+		C[i] = suite.Point().Pick(rand)
+		r := suite.Scalar().Pick(rand)
+		X[i] = suite.Point().Mul(r, nil)
+		Y[i] = suite.Point().Mul(r, H)
+		Y[i].Add(Y[i], C[i])
+	}
+	Xbar, Ybar, prover := Shuffle(suite, nil, H, X, Y, rand)
+	prf, err := proof.HashProve(suite, "PairShuffle", prover)
+	if err != nil {
+		t.Fatal("Shuffle proof failed:", err)
+	}
+	verifier := Verifier(suite, nil, H, X, Y, Xbar, Ybar)
+	if err := proof.HashVerify(suite, "PairShuffle", verifier, prf); err != nil {
+		t.Fatal("Shuffle verify failed:", err)
+	}
+	return C, Xbar, Ybar
+}
+
+// checkPlaintexts checks that the points M are the points C in some order.
+func checkPlaintexts(t *testing.T, C, M []kyber.Point) {
+	count := make(map[string]int)
+	for _, P := range C {
+		count[P.String()]++
+	}
+	for i, P := range M {
+		s := P.String()
+		if count[s]--; count[s] < 0 {
+			t.Fatal("wrong plaintext", i)
+		}
+	}
+}
+
+func TestDecrypt(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519WithRand(blake.New(nil))
+	x := suite.Scalar().Pick(suite.RandomStream())
+	H := suite.Point().Mul(x, nil)
+	C, X, Y := mixTest(t, suite, H, 8)
+
+	M, prf, err := Decrypt(suite, x, X, Y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPlaintexts(t, C, M)
+	if err := VerifyDecryption(suite, H, X, Y, M, prf); err != nil {
+		t.Fatal("decryption verify failed:", err)
+	}
+
+	// A wrong plaintext, or another key, is detected
+	bad := append([]kyber.Point(nil), M...)
+	bad[3] = suite.Point().Add(bad[3], suite.Point().Base())
+	if VerifyDecryption(suite, H, X, Y, bad, prf) == nil {
+		t.Fatal("verified a wrong plaintext")
+	}
+	if VerifyDecryption(suite, suite.Point().Add(H, H), X, Y, M, prf) == nil {
+		t.Fatal("verified a decryption under another key")
+	}
+	if VerifyDecryption(suite, H, X[1:], Y[1:], M[1:], prf) == nil {
+		t.Fatal("verified the proof of other pairs")
+	}
+	if VerifyDecryption(suite, H, X, Y, M[1:], prf) == nil {
+		t.Fatal("verified too few plaintexts")
+	}
+}
+
+func TestCombine(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519WithRand(blake.New(nil))
+	const n, th = 5, 3
+	poly := share.NewPriPoly(suite, th, nil)
+	pub := poly.Commit(nil)
+	C, X, Y := mixTest(t, suite, pub.Commit(), 10)
+
+	var shares []*DecryptionShare
+	for _, s := range poly.Shares(n) {
+		ds, err := NewDecryptionShare(suite, s, X, Y)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := VerifyDecryptionShare(suite, pub, X, Y, ds); err != nil {
+			t.Fatal("decryption share verify failed:", err)
+		}
+		shares = append(shares, ds)
+	}
+	M, err := Combine(suite, pub, X, Y, shares[2:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPlaintexts(t, C, M)
+
+	// A wrong share is detected and left out
+	bad := *shares[0]
+	bad.D = append([]kyber.Point(nil), bad.D...)
+	bad.D[7] = suite.Point().Add(bad.D[7], suite.Point().Base())
+	if VerifyDecryptionShare(suite, pub, X, Y, &bad) == nil {
+		t.Fatal("verified a wrong decryption share")
+	}
+	M, err = Combine(suite, pub, X, Y, []*DecryptionShare{&bad, shares[1], shares[1], shares[3], shares[4]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPlaintexts(t, C, M)
+
+	// Fewer than th valid shares do not decrypt
+	if _, err := Combine(suite, pub, X, Y, []*DecryptionShare{&bad, shares[1], shares[1], shares[3]}); err == nil {
+		t.Fatal("decrypted with too few shares")
+	}
+}
@@ -20,6 +20,17 @@
 // but SimpleShuffle may also be used by itself in situations
 // that satisfy its assumptions, and is more efficient.
 //
+// SequencesShuffle shuffles sequences of ElGamal pairs, such as ballots
+// with several answers, keeping the pairs of each sequence together, and
+// proves it with a single PairShuffle proof.
+//
+// All of them spread the computations on the elements of a shuffle over as
+// many goroutines as runtime.GOMAXPROCS(0), or as set with SetWorkers.
+//
+// Decrypt, or NewDecryptionShare and Combine for a key shared among
+// several participants, decrypt the output of the last shuffle of a mixnet
+// with a single proof of correctness for all the pairs.
+//
 // Package shuffle requires build tag "experimental".
 package shuffle
 
@@ -29,6 +40,7 @@ import (
 	"errors"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/msm"
 	"github.com/dedis/kyber/proof"
 	"github.com/dedis/kyber/util/random"
 )
@@ -91,14 +103,15 @@ type ega6 struct {
 // to pick a random permutation, compute the shuffle,
 // and compute the correctness proof.
 type PairShuffle struct {
-	grp kyber.Group
-	k   int
-	p1  ega1
-	v2  ega2
-	p3  ega3
-	v4  ega4
-	p5  ega5
-	pv6 SimpleShuffle
+	grp  kyber.Group
+	pool *kyber.Pool // temporaries of Prove and Verify
+	k    int
+	p1   ega1
+	v2   ega2
+	p3   ega3
+	v4   ega4
+	p5   ega5
+	pv6  SimpleShuffle
 }
 
 // Init creates a new PairShuffleProof instance for a k-element ElGamal pair shuffle.
@@ -112,6 +125,7 @@ func (ps *PairShuffle) Init(grp kyber.Group, k int) *PairShuffle {
 
 	// Create a well-formed PairShuffleProof with arrays correctly sized.
 	ps.grp = grp
+	ps.pool = kyber.PoolOf(grp)
 	ps.k = k
 	ps.p1.A = make([]kyber.Point, k)
 	ps.p1.C = make([]kyber.Point, k)
@@ -145,7 +159,8 @@ func (ps *PairShuffle) Prove(
 
 	// P step 1
 	p1 := &ps.p1
-	z := grp.Scalar() // scratch
+	pool := ps.pool
+	z := pool.Scalar() // scratch
 
 	// pick random secrets
 	u := make([]kyber.Scalar, k)
@@ -154,23 +169,42 @@ func (ps *PairShuffle) Prove(
 	var tau0, nu, gamma kyber.Scalar
 	ctx.PriRand(u, w, a, &tau0, &nu, &gamma)
 
-	// compute public commits
+	// compute public commits, with partial sums for every range
 	p1.Gamma = grp.Point().Mul(gamma, g)
-	wbeta := grp.Scalar() // scratch
-	wbetasum := grp.Scalar().Set(tau0)
+	m := ranges(k)
+	wbetasums := make([]kyber.Scalar, m)
+	Lambda1s := make([]kyber.Point, m)
+	Lambda2s := make([]kyber.Point, m)
+	parallel(k, m, func(j, lo, hi int) {
+		z := pool.Scalar()  // scratch
+		XY := pool.Point()  // scratch
+		wu := pool.Scalar() // scratch
+		defer pool.Release(z, XY, wu)
+		wbetasum := pool.Scalar().Zero()
+		Lambda1 := pool.Point().Null()
+		Lambda2 := pool.Point().Null()
+		for i := lo; i < hi; i++ {
+			p1.A[i] = grp.Point().Mul(a[i], g)
+			p1.C[i] = grp.Point().Mul(z.Mul(gamma, a[pi[i]]), g)
+			p1.U[i] = grp.Point().Mul(u[i], g)
+			p1.W[i] = grp.Point().Mul(z.Mul(gamma, w[i]), g)
+			wbetasum.Add(wbetasum, z.Mul(w[i], beta[pi[i]]))
+			wu.Sub(w[piinv[i]], u[i])
+			Lambda1.Add(Lambda1, XY.Mul(wu, X[i]))
+			Lambda2.Add(Lambda2, XY.Mul(wu, Y[i]))
+		}
+		wbetasums[j], Lambda1s[j], Lambda2s[j] = wbetasum, Lambda1, Lambda2
+	})
+	wbetasum := pool.Scalar().Set(tau0)
 	p1.Lambda1 = grp.Point().Null()
 	p1.Lambda2 = grp.Point().Null()
-	XY := grp.Point()  // scratch
-	wu := grp.Scalar() // scratch
-	for i := 0; i < k; i++ {
-		p1.A[i] = grp.Point().Mul(a[i], g)
-		p1.C[i] = grp.Point().Mul(z.Mul(gamma, a[pi[i]]), g)
-		p1.U[i] = grp.Point().Mul(u[i], g)
-		p1.W[i] = grp.Point().Mul(z.Mul(gamma, w[i]), g)
-		wbetasum.Add(wbetasum, wbeta.Mul(w[i], beta[pi[i]]))
-		p1.Lambda1.Add(p1.Lambda1, XY.Mul(wu.Sub(w[piinv[i]], u[i]), X[i]))
-		p1.Lambda2.Add(p1.Lambda2, XY.Mul(wu.Sub(w[piinv[i]], u[i]), Y[i]))
+	for j := 0; j < m; j++ {
+		wbetasum.Add(wbetasum, wbetasums[j])
+		p1.Lambda1.Add(p1.Lambda1, Lambda1s[j])
+		p1.Lambda2.Add(p1.Lambda2, Lambda2s[j])
 	}
+	XY := pool.Point() // scratch
+	defer pool.Release(z, wbetasum, wbetasums, Lambda1s, Lambda2s, XY)
 	p1.Lambda1.Add(p1.Lambda1, XY.Mul(wbetasum, g))
 	p1.Lambda2.Add(p1.Lambda2, XY.Mul(wbetasum, h))
 	if err := ctx.Put(p1); err != nil {
@@ -182,23 +216,21 @@ func (ps *PairShuffle) Prove(
 	if err := ctx.PubRand(v2); err != nil {
 		return err
 	}
-	B := make([]kyber.Point, k)
-	for i := 0; i < k; i++ {
-		P := grp.Point().Mul(v2.Zrho[i], g)
-		B[i] = P.Sub(P, p1.U[i])
-	}
 
 	// P step 3
 	p3 := &ps.p3
 	b := make([]kyber.Scalar, k)
 	for i := 0; i < k; i++ {
-		b[i] = grp.Scalar().Sub(v2.Zrho[i], u[i])
+		b[i] = pool.Scalar().Sub(v2.Zrho[i], u[i])
 	}
 	d := make([]kyber.Scalar, k)
-	for i := 0; i < k; i++ {
-		d[i] = grp.Scalar().Mul(gamma, b[pi[i]])
-		p3.D[i] = grp.Point().Mul(d[i], g)
-	}
+	parallel(k, ranges(k), func(_, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			d[i] = pool.Scalar().Mul(gamma, b[pi[i]])
+			p3.D[i] = grp.Point().Mul(d[i], g)
+		}
+	})
+	defer pool.Release(b, d)
 	if err := ctx.Put(p3); err != nil {
 		return err
 	}
@@ -213,12 +245,13 @@ func (ps *PairShuffle) Prove(
 	p5 := &ps.p5
 	r := make([]kyber.Scalar, k)
 	for i := 0; i < k; i++ {
-		r[i] = grp.Scalar().Add(a[i], z.Mul(v4.Zlambda, b[i]))
+		r[i] = pool.Scalar().Add(a[i], z.Mul(v4.Zlambda, b[i]))
 	}
 	s := make([]kyber.Scalar, k)
 	for i := 0; i < k; i++ {
-		s[i] = grp.Scalar().Mul(gamma, r[pi[i]])
+		s[i] = pool.Scalar().Mul(gamma, r[pi[i]])
 	}
+	defer pool.Release(r, s)
 	p5.Ztau = grp.Scalar().Neg(tau0)
 	for i := 0; i < k; i++ {
 		p5.Zsigma[i] = grp.Scalar().Add(w[i], b[pi[i]])
@@ -238,7 +271,7 @@ func (ps *PairShuffle) Verify(
 	ctx proof.VerifierContext) error {
 
 	// Validate all vector lengths
-	grp := ps.grp
+	pool := ps.pool
 	k := ps.k
 	if len(X) != k || len(Y) != k || len(Xbar) != k || len(Ybar) != k {
 		panic("mismatched vector lengths")
@@ -255,11 +288,6 @@ func (ps *PairShuffle) Verify(
 	if err := ctx.PubRand(v2); err != nil {
 		return err
 	}
-	B := make([]kyber.Point, k)
-	for i := 0; i < k; i++ {
-		P := grp.Point().Mul(v2.Zrho[i], g)
-		B[i] = P.Sub(P, p1.U[i])
-	}
 
 	// P step 3
 	p3 := &ps.p3
@@ -284,22 +312,54 @@ func (ps *PairShuffle) Verify(
 		return err
 	}
 
-	// V step 7
-	Phi1 := grp.Point().Null()
-	Phi2 := grp.Point().Null()
-	P := grp.Point() // scratch
-	Q := grp.Point() // scratch
+	// V step 7, with the sums of (31) and (32) as multi-scalar
+	// multiplications of Zsigma and -Zrho, split into partial sums over
+	// ranges of the terms
+	scalars := make([]kyber.Scalar, 2*k)
+	points1 := make([]kyber.Point, 2*k)
+	points2 := make([]kyber.Point, 2*k)
+	copy(scalars, p5.Zsigma)
 	for i := 0; i < k; i++ {
-		Phi1 = Phi1.Add(Phi1, P.Mul(p5.Zsigma[i], Xbar[i])) // (31)
-		Phi1 = Phi1.Sub(Phi1, P.Mul(v2.Zrho[i], X[i]))
-		Phi2 = Phi2.Add(Phi2, P.Mul(p5.Zsigma[i], Ybar[i])) // (32)
-		Phi2 = Phi2.Sub(Phi2, P.Mul(v2.Zrho[i], Y[i]))
-		//		println("i",i)
-		if !P.Mul(p5.Zsigma[i], p1.Gamma).Equal( // (33)
-			Q.Add(p1.W[i], p3.D[i])) {
+		scalars[k+i] = pool.Scalar().Neg(v2.Zrho[i])
+	}
+	defer pool.Release(scalars[k:])
+	copy(points1, Xbar)
+	copy(points1[k:], X)
+	copy(points2, Ybar)
+	copy(points2[k:], Y)
+	m := ranges(2 * k)
+	Phi1s := make([]kyber.Point, m)
+	Phi2s := make([]kyber.Point, m)
+	parallel(2*k, m, func(j, lo, hi int) {
+		Phi1s[j] = msm.Mul(ps.grp, scalars[lo:hi], points1[lo:hi]) // (31)
+		Phi2s[j] = msm.Mul(ps.grp, scalars[lo:hi], points2[lo:hi]) // (32)
+	})
+	Phi1, Phi2 := Phi1s[0], Phi2s[0]
+	for j := 1; j < m; j++ {
+		Phi1.Add(Phi1, Phi1s[j])
+		Phi2.Add(Phi2, Phi2s[j])
+	}
+
+	m = ranges(k)
+	good := make([]bool, m)
+	parallel(k, m, func(j, lo, hi int) {
+		P := pool.Point() // scratch
+		Q := pool.Point() // scratch
+		defer pool.Release(P, Q)
+		good[j] = true
+		for i := lo; i < hi && good[j]; i++ {
+			good[j] = P.Mul(p5.Zsigma[i], p1.Gamma).Equal( // (33)
+				Q.Add(p1.W[i], p3.D[i]))
+		}
+	})
+	for _, ok := range good {
+		if !ok {
 			return errors.New("invalid PairShuffleProof")
 		}
 	}
+	P := pool.Point() // scratch
+	Q := pool.Point() // scratch
+	defer pool.Release(P, Q)
 	//	println("last")
 	//	println("Phi1",Phi1.String());
 	//	println("Phi2",Phi2.String());
@@ -329,18 +389,7 @@ func Shuffle(group kyber.Group, g, h kyber.Point, X, Y []kyber.Point,
 	ps.Init(group, k)
 
 	// Pick a random permutation
-	pi := make([]int, k)
-	for i := 0; i < k; i++ { // Initialize a trivial permutation
-		pi[i] = i
-	}
-	for i := k - 1; i > 0; i-- { // Shuffle by random swaps
-		j := int(randUint64(rand) % uint64(i+1))
-		if j != i {
-			t := pi[j]
-			pi[j] = pi[i]
-			pi[i] = t
-		}
-	}
+	pi := randPermutation(k, rand)
 
 	// Pick a fresh ElGamal blinding factor for each pair
 	beta := make([]kyber.Scalar, k)
@@ -351,12 +400,14 @@ func Shuffle(group kyber.Group, g, h kyber.Point, X, Y []kyber.Point,
 	// Create the output pair vectors
 	Xbar := make([]kyber.Point, k)
 	Ybar := make([]kyber.Point, k)
-	for i := 0; i < k; i++ {
-		Xbar[i] = ps.grp.Point().Mul(beta[pi[i]], g)
-		Xbar[i].Add(Xbar[i], X[pi[i]])
-		Ybar[i] = ps.grp.Point().Mul(beta[pi[i]], h)
-		Ybar[i].Add(Ybar[i], Y[pi[i]])
-	}
+	parallel(k, ranges(k), func(_, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			Xbar[i] = ps.grp.Point().Mul(beta[pi[i]], g)
+			Xbar[i].Add(Xbar[i], X[pi[i]])
+			Ybar[i] = ps.grp.Point().Mul(beta[pi[i]], h)
+			Ybar[i].Add(Ybar[i], Y[pi[i]])
+		}
+	})
 
 	prover := func(ctx proof.ProverContext) error {
 		return ps.Prove(pi, g, h, beta, X, Y, rand, ctx)
@@ -364,6 +415,23 @@ func Shuffle(group kyber.Group, g, h kyber.Point, X, Y []kyber.Point,
 	return Xbar, Ybar, prover
 }
 
+// randPermutation picks a random permutation of k elements.
+func randPermutation(k int, rand cipher.Stream) []int {
+	pi := make([]int, k)
+	for i := 0; i < k; i++ { // Initialize a trivial permutation
+		pi[i] = i
+	}
+	for i := k - 1; i > 0; i-- { // Shuffle by random swaps
+		j := int(randUint64(rand) % uint64(i+1))
+		if j != i {
+			t := pi[j]
+			pi[j] = pi[i]
+			pi[i] = t
+		}
+	}
+	return pi
+}
+
 // randUint64 chooses a uniform random uint64
 func randUint64(rand cipher.Stream) uint64 {
 	b := random.Bits(64, false, rand)
@@ -0,0 +1,62 @@
+// +build experimental
+
+package shuffle
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// workers is the number of goroutines set by SetWorkers.
+var workers atomic.Int32
+
+// SetWorkers sets the number of goroutines among which shuffles, their
+// proofs and their verifications split the computations on the elements,
+// such as the products of every pair, which take most of their time. If n
+// is 0 or less, the default, it is runtime.GOMAXPROCS(0). The results,
+// proofs included, are the same whatever the number of goroutines.
+func SetWorkers(n int) {
+	workers.Store(int32(n))
+}
+
+// minRange is the fewest elements given to a goroutine, below which
+// starting it costs more than it saves.
+const minRange = 8
+
+// ranges returns the number of ranges into which parallel splits n
+// elements.
+func ranges(n int) int {
+	w := int(workers.Load())
+	if w <= 0 {
+		w = runtime.GOMAXPROCS(0)
+	}
+	if max := n / minRange; w > max {
+		w = max
+	}
+	if w < 1 {
+		w = 1
+	}
+	return w
+}
+
+// parallel calls f(j, lo, hi) for every range j of m consecutive ranges
+// [lo, hi) covering [0, n), as many as ranges(n) returns, each in a
+// goroutine of its own if there are more than one, and returns once all the
+// calls return. With j, f can keep the partial sums or the errors of its
+// range apart from those of the others.
+func parallel(n, m int, f func(j, lo, hi int)) {
+	if m == 1 {
+		f(0, 0, n)
+		return
+	}
+	var wg sync.WaitGroup
+	wg.Add(m)
+	for j := 0; j < m; j++ {
+		go func(j int) {
+			defer wg.Done()
+			f(j, j*n/m, (j+1)*n/m)
+		}(j)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,174 @@
+// +build experimental
+
+package shuffle
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/msm"
+	"github.com/dedis/kyber/proof"
+)
+
+// SequencesShuffle shuffles k sequences of n ElGamal pairs each, such as
+// ballots with several answers, the i-th of which is made of the pairs
+// (X[i][j], Y[i][j]). It moves whole sequences by a single random
+// permutation, re-randomizes every pair, and returns the shuffled sequences
+// (Xbar, Ybar) with a prover of one proof for all of them. If g or h is nil,
+// the standard base point is used.
+//
+// The proof combines the n pairs of every sequence, input or output, into
+// the single pair sum_j e[j]*(X[i][j], Y[i][j]), for scalars e drawn from a
+// hash of all the sequences, and proves the PairShuffle of the combined
+// pairs. A shuffle that does not move whole sequences, or alters any pair,
+// yields a valid proof only for a negligible fraction of the choices of e.
+func SequencesShuffle(suite Suite, g, h kyber.Point, X, Y [][]kyber.Point,
+	rand cipher.Stream) (Xbar, Ybar [][]kyber.Point, prover proof.Prover) {
+
+	k := len(X)
+	n := sequenceLen(X, Y)
+	if n < 0 {
+		panic("X,Y sequences have inconsistent lengths")
+	}
+
+	pi := randPermutation(k, rand)
+
+	// Pick a fresh ElGamal blinding factor for each pair
+	beta := make([][]kyber.Scalar, k)
+	for i := range beta {
+		beta[i] = make([]kyber.Scalar, n)
+		for j := range beta[i] {
+			beta[i][j] = suite.Scalar().Pick(rand)
+		}
+	}
+
+	// Create the output sequences
+	Xbar = make([][]kyber.Point, k)
+	Ybar = make([][]kyber.Point, k)
+	parallel(k, ranges(k), func(_, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			Xbar[i] = make([]kyber.Point, n)
+			Ybar[i] = make([]kyber.Point, n)
+			for j := 0; j < n; j++ {
+				Xbar[i][j] = suite.Point().Mul(beta[pi[i]][j], g)
+				Xbar[i][j].Add(Xbar[i][j], X[pi[i]][j])
+				Ybar[i][j] = suite.Point().Mul(beta[pi[i]][j], h)
+				Ybar[i][j].Add(Ybar[i][j], Y[pi[i]][j])
+			}
+		}
+	})
+
+	prover = func(ctx proof.ProverContext) error {
+		e, err := sequenceChallenges(suite, g, h, n, X, Y, Xbar, Ybar)
+		if err != nil {
+			return err
+		}
+		betahat := make([]kyber.Scalar, k)
+		for i := range betahat {
+			betahat[i] = suite.Scalar().Zero()
+			z := suite.Scalar()
+			for j := 0; j < n; j++ {
+				betahat[i].Add(betahat[i], z.Mul(e[j], beta[i][j]))
+			}
+		}
+		ps := PairShuffle{}
+		ps.Init(suite, k)
+		return ps.Prove(pi, g, h, betahat, combine(suite, e, X),
+			combine(suite, e, Y), rand, ctx)
+	}
+	return Xbar, Ybar, prover
+}
+
+// SequencesVerifier produces a Sigma-protocol verifier to check the
+// correctness of a shuffle of sequences by SequencesShuffle.
+func SequencesVerifier(suite Suite, g, h kyber.Point,
+	X, Y, Xbar, Ybar [][]kyber.Point) proof.Verifier {
+
+	k := len(X)
+	n := sequenceLen(X, Y)
+	if n < 0 {
+		panic("X,Y sequences have inconsistent lengths")
+	}
+	ps := PairShuffle{}
+	ps.Init(suite, k)
+	verifier := func(ctx proof.VerifierContext) error {
+		if len(Xbar) != k || sequenceLen(Xbar, Ybar) != n {
+			return errors.New("shuffled sequences of inconsistent lengths")
+		}
+		e, err := sequenceChallenges(suite, g, h, n, X, Y, Xbar, Ybar)
+		if err != nil {
+			return err
+		}
+		return ps.Verify(g, h, combine(suite, e, X), combine(suite, e, Y),
+			combine(suite, e, Xbar), combine(suite, e, Ybar), ctx)
+	}
+	return verifier
+}
+
+// sequenceLen returns the common length of the sequences of X and Y, or -1
+// if there are none, if X and Y have different numbers of them, or if any
+// of them is empty or of another length.
+func sequenceLen(X, Y [][]kyber.Point) int {
+	if len(X) == 0 || len(X) != len(Y) {
+		return -1
+	}
+	n := len(X[0])
+	if n == 0 {
+		return -1
+	}
+	for i := range X {
+		if len(X[i]) != n || len(Y[i]) != n {
+			return -1
+		}
+	}
+	return n
+}
+
+// sequenceChallenges returns the n scalars with which the pairs of the
+// sequences are combined, read from an XOF of the bases and of all the
+// points of seqs, so that they are only known once the shuffle is.
+func sequenceChallenges(suite Suite, g, h kyber.Point, n int,
+	seqs ...[][]kyber.Point) ([]kyber.Scalar, error) {
+
+	xof := suite.XOF([]byte("shuffle.SequencesShuffle"))
+	var size [16]byte
+	binary.BigEndian.PutUint64(size[:8], uint64(len(seqs[0])))
+	binary.BigEndian.PutUint64(size[8:], uint64(n))
+	xof.Write(size[:])
+	for _, P := range []kyber.Point{g, h} {
+		if P == nil {
+			P = suite.Point().Base()
+		}
+		if _, err := P.MarshalTo(xof); err != nil {
+			return nil, err
+		}
+	}
+	for _, seq := range seqs {
+		for _, row := range seq {
+			for _, P := range row {
+				if _, err := P.MarshalTo(xof); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	e := make([]kyber.Scalar, n)
+	for j := range e {
+		e[j] = suite.Scalar().Pick(xof)
+	}
+	return e, nil
+}
+
+// combine returns the sums of e[j]*seqs[i][j] over j, for every sequence i.
+func combine(g kyber.Group, e []kyber.Scalar, seqs [][]kyber.Point) []kyber.Point {
+	sums := make([]kyber.Point, len(seqs))
+	parallel(len(seqs), ranges(len(seqs)), func(_, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			sums[i] = msm.Mul(g, e, seqs[i])
+		}
+	})
+	return sums
+}
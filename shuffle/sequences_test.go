@@ -0,0 +1,99 @@
+// +build experimental
+
+package shuffle
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/proof"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+func TestSequencesShuffle(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519WithRand(blake.New(nil))
+	rand := suite.RandomStream()
+	h := suite.Scalar().Pick(rand)
+	H := suite.Point().Mul(h, nil)
+
+	// ElGamal-encrypt k ballots of n answers each
+	k, n := 6, 3
+	X := make([][]kyber.Point, k)
+	Y := make([][]kyber.Point, k)
+	for i := range X {
+		X[i] = make([]kyber.Point, n)
+		Y[i] = make([]kyber.Point, n)
+		for j := range X[i] {
+			r := suite.Scalar().Pick(rand)
+			X[i][j] = suite.Point().Mul(r, nil)
+			Y[i][j] = suite.Point().Mul(r, H)
+			Y[i][j].Add(Y[i][j], suite.Point().Pick(rand))
+		}
+	}
+
+	Xbar, Ybar, prover := SequencesShuffle(suite, nil, H, X, Y, rand)
+	prf, err := proof.HashProve(suite, "SequencesShuffle", prover)
+	if err != nil {
+		t.Fatal("SequencesShuffle proof failed:", err)
+	}
+	verify := func(Xbar, Ybar [][]kyber.Point) error {
+		verifier := SequencesVerifier(suite, nil, H, X, Y, Xbar, Ybar)
+		return proof.HashVerify(suite, "SequencesShuffle", verifier, prf)
+	}
+	if err := verify(Xbar, Ybar); err != nil {
+		t.Fatal("SequencesShuffle verify failed:", err)
+	}
+
+	// Every output ballot decrypts to the answers of an input ballot
+	decrypt := func(X, Y []kyber.Point) string {
+		var b []byte
+		for j := range X {
+			M := suite.Point().Mul(h, X[j])
+			M.Sub(Y[j], M)
+			enc, _ := M.MarshalBinary()
+			b = append(b, enc...)
+		}
+		return string(b)
+	}
+	ballots := make(map[string]int)
+	for i := range X {
+		ballots[decrypt(X[i], Y[i])]++
+	}
+	for i := range Xbar {
+		b := decrypt(Xbar[i], Ybar[i])
+		if ballots[b]--; ballots[b] < 0 {
+			t.Fatal("SequencesShuffle changed ballot", i)
+		}
+	}
+
+	// Swapping one answer between two ballots breaks the proof
+	swapped := make([][]kyber.Point, k)
+	for i := range Xbar {
+		swapped[i] = append([]kyber.Point(nil), Xbar[i]...)
+	}
+	swapped[0][1], swapped[1][1] = swapped[1][1], swapped[0][1]
+	if verify(swapped, Ybar) == nil {
+		t.Fatal("SequencesShuffle verified a shuffle mixing ballots")
+	}
+
+	// So does altering a single pair
+	altered := make([][]kyber.Point, k)
+	for i := range Ybar {
+		altered[i] = append([]kyber.Point(nil), Ybar[i]...)
+	}
+	altered[k-1][n-1] = suite.Point().Add(altered[k-1][n-1], suite.Point().Base())
+	if verify(Xbar, altered) == nil {
+		t.Fatal("SequencesShuffle verified an altered pair")
+	}
+
+	// And shuffled sequences of the wrong lengths are rejected
+	if verify(Xbar[:k-1], Ybar[:k-1]) == nil {
+		t.Fatal("SequencesShuffle verified too few sequences")
+	}
+	short := append([][]kyber.Point(nil), Xbar...)
+	short[2] = short[2][:n-1]
+	if verify(short, Ybar) == nil {
+		t.Fatal("SequencesShuffle verified a short sequence")
+	}
+}
@@ -3,6 +3,7 @@
 package shuffle
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/dedis/kyber"
@@ -66,3 +67,39 @@ func shuffleTest(suite Suite, k, N int) {
 		}
 	}
 }
+
+func TestShuffleWorkers(t *testing.T) {
+	defer SetWorkers(0)
+	k := 40
+	var proofs [][]byte
+	for _, workers := range []int{1, 3} {
+		SetWorkers(workers)
+		suite := edwards25519.NewBlakeSHA256Ed25519WithRand(blake.New(nil))
+		rand := suite.RandomStream()
+		H := suite.Point().Pick(rand)
+		X := make([]kyber.Point, k)
+		Y := make([]kyber.Point, k)
+		for i := range X {
+			X[i] = suite.Point().Pick(rand)
+			Y[i] = suite.Point().Pick(rand)
+		}
+		Xbar, Ybar, prover := Shuffle(suite, nil, H, X, Y, rand)
+		prf, err := proof.HashProve(suite, "PairShuffle", prover)
+		if err != nil {
+			t.Fatal(err)
+		}
+		verifier := Verifier(suite, nil, H, X, Y, Xbar, Ybar)
+		if err := proof.HashVerify(suite, "PairShuffle", verifier, prf); err != nil {
+			t.Fatal(err)
+		}
+		prf[len(prf)/2] ^= 1
+		if proof.HashVerify(suite, "PairShuffle", verifier, prf) == nil {
+			t.Fatal("altered proof accepted with", workers, "workers")
+		}
+		prf[len(prf)/2] ^= 1
+		proofs = append(proofs, prf)
+	}
+	if !bytes.Equal(proofs[0], proofs[1]) {
+		t.Fatal("proofs differ with the number of workers")
+	}
+}
@@ -43,40 +43,44 @@ type ssa4 struct {
 // SimpleShuffle is the "Simple k-shuffle" defined in section 3 of
 // Neff, "Verifiable Mixing (Shuffling) of ElGamal Pairs", 2004.
 type SimpleShuffle struct {
-	grp kyber.Group
-	p0  ssa0
-	v1  ssa1
-	p2  ssa2
-	v3  ssa3
-	p4  ssa4
+	grp  kyber.Group
+	pool *kyber.Pool // temporaries of Prove and Verify
+	p0   ssa0
+	v1   ssa1
+	p2   ssa2
+	v3   ssa3
+	p4   ssa4
 }
 
 // Simple helper to compute G^{ab-cd} for Theta vector computation.
-func thenc(grp kyber.Group, G kyber.Point,
+func (ss *SimpleShuffle) thenc(G kyber.Point,
 	a, b, c, d kyber.Scalar) kyber.Point {
 
-	var ab, cd kyber.Scalar
+	ab := ss.pool.Scalar()
+	cd := ss.pool.Scalar()
+	defer ss.pool.Release(ab, cd)
 	if a != nil {
-		ab = grp.Scalar().Mul(a, b)
+		ab.Mul(a, b)
 	} else {
-		ab = grp.Scalar().Zero()
+		ab.Zero()
 	}
 	if c != nil {
 		if d != nil {
-			cd = grp.Scalar().Mul(c, d)
+			cd.Mul(c, d)
 		} else {
-			cd = c
+			cd.Set(c)
 		}
 	} else {
-		cd = grp.Scalar().Zero()
+		cd.Zero()
 	}
-	return grp.Point().Mul(ab.Sub(ab, cd), G)
+	return ss.grp.Point().Mul(ab.Sub(ab, cd), G)
 }
 
 // Init initializes the simple shuffle with the given group and the k parameter
 // from the paper.
 func (ss *SimpleShuffle) Init(grp kyber.Group, k int) *SimpleShuffle {
 	ss.grp = grp
+	ss.pool = kyber.PoolOf(grp)
 	ss.p0.X = make([]kyber.Point, k)
 	ss.p0.Y = make([]kyber.Point, k)
 	ss.p2.Theta = make([]kyber.Point, 2*k)
@@ -111,10 +115,12 @@ func (ss *SimpleShuffle) Prove(G kyber.Point, gamma kyber.Scalar,
 	//	}
 
 	// Step 0: inputs
-	for i := 0; i < k; i++ { // (4)
-		ss.p0.X[i] = grp.Point().Mul(x[i], G)
-		ss.p0.Y[i] = grp.Point().Mul(y[i], G)
-	}
+	parallel(k, ranges(k), func(_, lo, hi int) {
+		for i := lo; i < hi; i++ { // (4)
+			ss.p0.X[i] = grp.Point().Mul(x[i], G)
+			ss.p0.Y[i] = grp.Point().Mul(y[i], G)
+		}
+	})
 	if err := ctx.Put(ss.p0); err != nil {
 		return err
 	}
@@ -126,27 +132,35 @@ func (ss *SimpleShuffle) Prove(G kyber.Point, gamma kyber.Scalar,
 	t := ss.v1.Zt
 
 	// P step 2
-	gammaT := grp.Scalar().Mul(gamma, t)
+	pool := ss.pool
+	gammaT := pool.Scalar().Mul(gamma, t)
 	xhat := make([]kyber.Scalar, k)
 	yhat := make([]kyber.Scalar, k)
 	for i := 0; i < k; i++ { // (5) and (6) xhat,yhat vectors
-		xhat[i] = grp.Scalar().Sub(x[i], t)
-		yhat[i] = grp.Scalar().Sub(y[i], gammaT)
+		xhat[i] = pool.Scalar().Sub(x[i], t)
+		yhat[i] = pool.Scalar().Sub(y[i], gammaT)
 	}
+	defer pool.Release(gammaT, xhat, yhat)
 	thlen := 2*k - 1 // (7) theta and Theta vectors
 	theta := make([]kyber.Scalar, thlen)
 	ctx.PriRand(theta)
 	Theta := make([]kyber.Point, thlen+1)
-	Theta[0] = thenc(grp, G, nil, nil, theta[0], yhat[0])
-	for i := 1; i < k; i++ {
-		Theta[i] = thenc(grp, G, theta[i-1], xhat[i],
-			theta[i], yhat[i])
-	}
-	for i := k; i < thlen; i++ {
-		Theta[i] = thenc(grp, G, theta[i-1], gamma,
-			theta[i], nil)
-	}
-	Theta[thlen] = thenc(grp, G, theta[thlen-1], gamma, nil, nil)
+	parallel(thlen+1, ranges(thlen+1), func(_, lo, hi int) {
+		for i := lo; i < hi; i++ {
+			switch {
+			case i == 0:
+				Theta[0] = ss.thenc(G, nil, nil, theta[0], yhat[0])
+			case i < k:
+				Theta[i] = ss.thenc(G, theta[i-1], xhat[i],
+					theta[i], yhat[i])
+			case i < thlen:
+				Theta[i] = ss.thenc(G, theta[i-1], gamma,
+					theta[i], nil)
+			default:
+				Theta[thlen] = ss.thenc(G, theta[thlen-1], gamma, nil, nil)
+			}
+		}
+	})
 	ss.p2.Theta = Theta
 	if err := ctx.Put(ss.p2); err != nil {
 		return err
@@ -158,16 +172,18 @@ func (ss *SimpleShuffle) Prove(G kyber.Point, gamma kyber.Scalar,
 	}
 	c := ss.v3.Zc
 
-	// P step 4
+	// P step 4, with the yhat now inverted, which Theta no longer needs
 	alpha := make([]kyber.Scalar, thlen)
-	runprod := grp.Scalar().Set(c)
+	runprod := pool.Scalar().Set(c)
+	kyber.BatchInvert(yhat)
 	for i := 0; i < k; i++ { // (8)
 		runprod.Mul(runprod, xhat[i])
-		runprod.Div(runprod, yhat[i])
+		runprod.Mul(runprod, yhat[i])
 		alpha[i] = grp.Scalar().Add(theta[i], runprod)
 	}
-	gammainv := grp.Scalar().Inv(gamma)
-	rungamma := grp.Scalar().Set(c)
+	gammainv := pool.Scalar().Inv(gamma)
+	rungamma := pool.Scalar().Set(c)
+	defer pool.Release(runprod, gammainv, rungamma)
 	for i := 1; i < k; i++ {
 		rungamma.Mul(rungamma, gammainv)
 		alpha[thlen-i] = grp.Scalar().Add(theta[thlen-i], rungamma)
@@ -190,8 +206,6 @@ func thver(A, B, T, P, Q kyber.Point, a, b, s kyber.Scalar) bool {
 func (ss *SimpleShuffle) Verify(G, Gamma kyber.Point,
 	ctx proof.VerifierContext) error {
 
-	grp := ss.grp
-
 	// extract proof transcript
 	X := ss.p0.X
 	Y := ss.p0.Y
@@ -226,32 +240,46 @@ func (ss *SimpleShuffle) Verify(G, Gamma kyber.Point,
 	}
 
 	// Verifier step 5
-	negt := grp.Scalar().Neg(t)
-	U := grp.Point().Mul(negt, G)
-	W := grp.Point().Mul(negt, Gamma)
+	pool := ss.pool
+	negt := pool.Scalar().Neg(t)
+	U := pool.Point().Mul(negt, G)
+	W := pool.Point().Mul(negt, Gamma)
 	Xhat := make([]kyber.Point, k)
 	Yhat := make([]kyber.Point, k)
 	for i := 0; i < k; i++ {
-		Xhat[i] = grp.Point().Add(X[i], U)
-		Yhat[i] = grp.Point().Add(Y[i], W)
-	}
-	P := grp.Point() // scratch variables
-	Q := grp.Point()
-	s := grp.Scalar()
-	good := true
-	good = good && thver(Xhat[0], Yhat[0], Theta[0], P, Q, c, alpha[0], s)
-	for i := 1; i < k; i++ {
-		good = good && thver(Xhat[i], Yhat[i], Theta[i], P, Q,
-			alpha[i-1], alpha[i], s)
+		Xhat[i] = pool.Point().Add(X[i], U)
+		Yhat[i] = pool.Point().Add(Y[i], W)
 	}
-	for i := k; i < thlen; i++ {
-		good = good && thver(Gamma, G, Theta[i], P, Q,
-			alpha[i-1], alpha[i], s)
-	}
-	good = good && thver(Gamma, G, Theta[thlen], P, Q,
-		alpha[thlen-1], c, s)
-	if !good {
-		return errors.New("incorrect SimpleShuffleProof")
+	defer pool.Release(negt, U, W, Xhat, Yhat)
+	m := ranges(thlen + 1)
+	good := make([]bool, m)
+	parallel(thlen+1, m, func(j, lo, hi int) {
+		P := pool.Point() // scratch variables
+		Q := pool.Point()
+		s := pool.Scalar()
+		defer pool.Release(P, Q, s)
+		good[j] = true
+		for i := lo; i < hi && good[j]; i++ {
+			switch {
+			case i == 0:
+				good[j] = thver(Xhat[0], Yhat[0], Theta[0], P, Q,
+					c, alpha[0], s)
+			case i < k:
+				good[j] = thver(Xhat[i], Yhat[i], Theta[i], P, Q,
+					alpha[i-1], alpha[i], s)
+			case i < thlen:
+				good[j] = thver(Gamma, G, Theta[i], P, Q,
+					alpha[i-1], alpha[i], s)
+			default:
+				good[j] = thver(Gamma, G, Theta[thlen], P, Q,
+					alpha[thlen-1], c, s)
+			}
+		}
+	})
+	for _, ok := range good {
+		if !ok {
+			return errors.New("incorrect SimpleShuffleProof")
+		}
 	}
 
 	return nil
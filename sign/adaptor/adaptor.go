@@ -0,0 +1,128 @@
+// Package adaptor implements Schnorr adaptor signatures, also known as
+// one-time verifiably encrypted signatures.
+//
+// A pre-signature of a message is made for an adaptor point T = t*G and is
+// not a valid signature by itself. Anyone can check with PreVerify that it
+// becomes a valid signature once adapted with the discrete logarithm t of
+// T, which Adapt does. Conversely, anyone holding both the pre-signature and
+// the adapted signature can Extract t. This is the building block of atomic
+// swaps and payment channels: publishing a signature reveals a secret.
+//
+// Pre-signatures are made of a commitment R' and a response s', encoded
+// like the signatures of package sign/schnorr. The adapted signature
+// (R' + T, s' + t) is an ordinary Schnorr signature, verified with
+// sign/schnorr.Verify.
+package adaptor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/sign/schnorr"
+)
+
+// Suite represents the set of functionalities needed by the package adaptor.
+type Suite interface {
+	kyber.Group
+	kyber.Random
+}
+
+var errInvalidPreSignature = errors.New("adaptor: invalid pre-signature")
+
+// PreSign returns the pre-signature of msg with the private key for the
+// adaptor point T.
+func PreSign(s Suite, private kyber.Scalar, T kyber.Point, msg []byte) ([]byte, error) {
+	k := s.Scalar().Pick(s.RandomStream())
+	R := s.Point().Mul(k, nil)
+	public := s.Point().Mul(private, nil)
+
+	// s' = k + H(R' + T || public || msg)*x
+	h, err := schnorr.Challenge(s, public, s.Point().Add(R, T), msg)
+	if err != nil {
+		return nil, err
+	}
+	S := s.Scalar().Add(k, h.Mul(h, private))
+	return encode(R, S)
+}
+
+// PreVerify checks that the pre-signature of msg under the public key is
+// valid for the adaptor point T, that is that adapting it with the discrete
+// logarithm of T gives a valid signature. It returns nil iff it is.
+func PreVerify(g kyber.Group, public, T kyber.Point, msg, preSig []byte) error {
+	R, S, err := decode(g, preSig)
+	if err != nil {
+		return err
+	}
+	h, err := schnorr.Challenge(g, public, g.Point().Add(R, T), msg)
+	if err != nil {
+		return err
+	}
+	// s'*G = R' + h*public
+	rhs := g.Point().Add(R, g.Point().Mul(h, public))
+	if !g.Point().Mul(S, nil).Equal(rhs) {
+		return errInvalidPreSignature
+	}
+	return nil
+}
+
+// Adapt completes the pre-signature with the discrete logarithm t of its
+// adaptor point, and returns the resulting Schnorr signature.
+func Adapt(g kyber.Group, preSig []byte, t kyber.Scalar) ([]byte, error) {
+	R, S, err := decode(g, preSig)
+	if err != nil {
+		return nil, err
+	}
+	R.Add(R, g.Point().Mul(t, nil))
+	return encode(R, S.Add(S, t))
+}
+
+// Extract returns the discrete logarithm of the adaptor point of the
+// pre-signature from the signature adapted from it. It fails if the
+// signature was not adapted from the pre-signature.
+func Extract(g kyber.Group, preSig, sig []byte) (kyber.Scalar, error) {
+	R1, S1, err := decode(g, preSig)
+	if err != nil {
+		return nil, err
+	}
+	R2, S2, err := decode(g, sig)
+	if err != nil {
+		return nil, err
+	}
+	// t = s - s', with R = R' + t*G
+	t := g.Scalar().Sub(S2, S1)
+	if !g.Point().Add(R1, g.Point().Mul(t, nil)).Equal(R2) {
+		return nil, errors.New("adaptor: signature not adapted from the pre-signature")
+	}
+	return t, nil
+}
+
+func encode(R kyber.Point, S kyber.Scalar) ([]byte, error) {
+	var b bytes.Buffer
+	if _, err := R.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	if _, err := S.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// decode splits a (pre-)signature into its commitment and response.
+func decode(g kyber.Group, sig []byte) (kyber.Point, kyber.Scalar, error) {
+	R := g.Point()
+	S := g.Scalar()
+	pointSize := R.MarshalSize()
+	sigSize := pointSize + S.MarshalSize()
+	if len(sig) != sigSize {
+		return nil, nil, fmt.Errorf("adaptor: signature of invalid length %d instead of %d", len(sig), sigSize)
+	}
+	if err := R.UnmarshalBinary(sig[:pointSize]); err != nil {
+		return nil, nil, err
+	}
+	if err := S.UnmarshalBinary(sig[pointSize:]); err != nil {
+		return nil, nil, err
+	}
+	return R, S, nil
+}
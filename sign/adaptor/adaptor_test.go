@@ -0,0 +1,62 @@
+package adaptor
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/sign/eddsa"
+	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func TestAdaptor(t *testing.T) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X := suite.Point().Mul(x, nil)
+	secret := suite.Scalar().Pick(suite.RandomStream())
+	T := suite.Point().Mul(secret, nil)
+	msg := []byte("Hello adaptor signatures")
+
+	preSig, err := PreSign(suite, x, T, msg)
+	require.NoError(t, err)
+	require.NoError(t, PreVerify(suite, X, T, msg, preSig))
+	require.Error(t, PreVerify(suite, X, T, []byte("other"), preSig))
+	require.Error(t, PreVerify(suite, X, suite.Point().Base(), msg, preSig))
+
+	// A pre-signature is not a signature.
+	require.Error(t, schnorr.Verify(suite, X, msg, preSig))
+
+	sig, err := Adapt(suite, preSig, secret)
+	require.NoError(t, err)
+	require.NoError(t, schnorr.Verify(suite, X, msg, sig))
+	require.NoError(t, eddsa.Verify(X, msg, sig))
+
+	extracted, err := Extract(suite, preSig, sig)
+	require.NoError(t, err)
+	require.True(t, extracted.Equal(secret))
+}
+
+func TestExtract(t *testing.T) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	secret := suite.Scalar().Pick(suite.RandomStream())
+	T := suite.Point().Mul(secret, nil)
+	msg := []byte("Hello adaptor signatures")
+
+	preSig, err := PreSign(suite, x, T, msg)
+	require.NoError(t, err)
+
+	// Adapting with a wrong witness does not give a valid signature.
+	wrong := suite.Scalar().Add(secret, suite.Scalar().One())
+	sig, err := Adapt(suite, preSig, wrong)
+	require.NoError(t, err)
+	require.Error(t, schnorr.Verify(suite, suite.Point().Mul(x, nil), msg, sig))
+
+	// An unrelated signature reveals nothing.
+	other, err := schnorr.Sign(suite, x, msg)
+	require.NoError(t, err)
+	_, err = Extract(suite, preSig, other)
+	require.Error(t, err)
+	_, err = Extract(suite, preSig, other[1:])
+	require.Error(t, err)
+}
@@ -36,12 +36,21 @@ func encryptKey(suite Suite, anonymitySet Set, hide bool) (k, c []byte) {
 	kp := new(key.Pair)
 	var Xb []byte
 	if hide {
-		kp.GenHiding(suite)
-		Xb = kp.Hiding.HideEncode(suite.RandomStream())
+		// Keep the first encoding that succeeds: for some suites,
+		// whether a point encodes depends on the randomness used.
+		rand := suite.RandomStream()
+		for {
+			kp.Gen(suite)
+			if Xb = kp.Public.(kyber.Hiding).HideEncode(rand); Xb != nil {
+				break
+			}
+			kp.Destroy()
+		}
 	} else {
 		kp.Gen(suite)
 		Xb, _ = kp.Public.MarshalBinary()
 	}
+	defer kp.Destroy()
 	xb, _ := kp.Private.MarshalBinary()
 	// Generate the ciphertext header
 	return xb, header(suite, kp.Public, kp.Private, Xb, xb, anonymitySet)
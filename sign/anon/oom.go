@@ -0,0 +1,253 @@
+package anon
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/msm"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+// one-out-of-many ring signature: commitments to the bits of the signer's
+// index, with the responses of the proof that they open to a valid index.
+type oomSig struct {
+	CL, CA, CB, CD []kyber.Point
+	F, ZA, ZB      []kyber.Scalar
+	ZD             kyber.Scalar
+}
+
+// oomBits returns the number of bits of an index in a ring of n keys, at
+// least one.
+func oomBits(n int) int {
+	bits := 1
+	for 1<<uint(bits) < n {
+		bits++
+	}
+	return bits
+}
+
+// oomRing pads the anonymity set to a power of two by repeating its last
+// key.
+func oomRing(anonymitySet Set, bits int) []kyber.Point {
+	ring := make([]kyber.Point, 1<<uint(bits))
+	copy(ring, anonymitySet)
+	for i := len(anonymitySet); i < len(ring); i++ {
+		ring[i] = anonymitySet[len(anonymitySet)-1]
+	}
+	return ring
+}
+
+// oomBase returns the second generator H of the Pedersen commitments
+// m*H + r*G of the proof, whose discrete logarithm is unknown.
+func oomBase(suite Suite) kyber.Point {
+	return suite.Point().Pick(suite.XOF([]byte("anon one-out-of-many H")))
+}
+
+// oomCommit returns the Pedersen commitment m*H + r*G.
+func oomCommit(suite Suite, H kyber.Point, m, r kyber.Scalar) kyber.Point {
+	P := suite.Point().Mul(m, H)
+	return P.Add(P, suite.Point().Mul(r, nil))
+}
+
+// oomChallenge returns the Fiat-Shamir challenge of the proof, binding the
+// message, the ring and the commitments.
+func oomChallenge(suite Suite, message []byte, ring []kyber.Point, sig *oomSig) kyber.Scalar {
+	t := transcript.New(suite, "anon one-out-of-many")
+	t.Append("message", message)
+	t.AppendPoints("ring", ring...)
+	t.AppendPoints("CL", sig.CL...)
+	t.AppendPoints("CA", sig.CA...)
+	t.AppendPoints("CB", sig.CB...)
+	t.AppendPoints("CD", sig.CD...)
+	return t.Challenge("challenge")
+}
+
+// SignOneOfMany creates an unlinkable anonymous signature on a given
+// message, like Sign, whose size is logarithmic in the size of the
+// anonymity set rather than linear.
+//
+// The signature is a non-interactive version of the one-out-of-many proof
+// of Groth and Kohlweiss, "One-out-of-Many Proofs: Or How to Leak a Secret
+// and Spend a Coin" (https://eprint.iacr.org/2014/764), proving knowledge of
+// the private key of one of the public keys of the anonymity set without
+// revealing which. For a set of n keys, the signature holds 4*log2(n)
+// points and 3*log2(n)+1 scalars; signing takes O(n log n) and verification
+// O(n) group operations, which keeps sets of thousands of keys practical.
+// The set is padded to a power of two by repeating its last key.
+func SignOneOfMany(suite Suite, message []byte, anonymitySet Set, mine int, privateKey kyber.Scalar) ([]byte, error) {
+	if len(anonymitySet) == 0 {
+		return nil, errors.New("empty anonymity set")
+	}
+	if mine < 0 || mine >= len(anonymitySet) {
+		return nil, errors.New("signer index out of range")
+	}
+	bits := oomBits(len(anonymitySet))
+	ring := oomRing(anonymitySet, bits)
+	H := oomBase(suite)
+	rand := suite.RandomStream()
+	sig := oomSig{
+		CL: make([]kyber.Point, bits),
+		CA: make([]kyber.Point, bits),
+		CB: make([]kyber.Point, bits),
+		CD: make([]kyber.Point, bits),
+		F:  make([]kyber.Scalar, bits),
+		ZA: make([]kyber.Scalar, bits),
+		ZB: make([]kyber.Scalar, bits),
+	}
+
+	// Commit to every bit l_j of the index, to a mask a_j of it, and to
+	// l_j*a_j, which proves that l_j is a bit.
+	l := make([]kyber.Scalar, bits)
+	r := make([]kyber.Scalar, bits)
+	a := make([]kyber.Scalar, bits)
+	s := make([]kyber.Scalar, bits)
+	t := make([]kyber.Scalar, bits)
+	rho := make([]kyber.Scalar, bits)
+	for j := 0; j < bits; j++ {
+		l[j] = suite.Scalar().SetInt64(int64(mine >> uint(j) & 1))
+		r[j] = suite.Scalar().Pick(rand)
+		a[j] = suite.Scalar().Pick(rand)
+		s[j] = suite.Scalar().Pick(rand)
+		t[j] = suite.Scalar().Pick(rand)
+		rho[j] = suite.Scalar().Pick(rand)
+		sig.CL[j] = oomCommit(suite, H, l[j], r[j])
+		sig.CA[j] = oomCommit(suite, H, a[j], s[j])
+		sig.CB[j] = oomCommit(suite, H, suite.Scalar().Mul(l[j], a[j]), t[j])
+	}
+
+	// The key of index i is weighted in the verification by
+	// p_i(x) = prod_j f_{j,i_j}, with f_{j,1} = l_j*x + a_j and
+	// f_{j,0} = x - f_{j,1}. Only p_mine has degree bits; the commitments
+	// CD_k to the sums of the lower coefficients cancel the others out.
+	p := [][]kyber.Scalar{{suite.Scalar().One()}}
+	for j := 0; j < bits; j++ {
+		next := make([][]kyber.Scalar, 2*len(p))
+		for i, poly := range p {
+			one := suite.Scalar().Sub(suite.Scalar().One(), l[j])
+			next[i] = mulLinear(suite, poly, one, suite.Scalar().Neg(a[j]))
+			next[i+len(p)] = mulLinear(suite, poly, l[j], a[j])
+		}
+		p = next
+	}
+	scalars := make([]kyber.Scalar, len(ring)+1)
+	points := append(append([]kyber.Point(nil), ring...), nil)
+	for k := 0; k < bits; k++ {
+		for i := range ring {
+			scalars[i] = p[i][k]
+		}
+		scalars[len(ring)] = rho[k]
+		sig.CD[k] = msm.Mul(suite, scalars, points)
+	}
+
+	x := oomChallenge(suite, message, ring, &sig)
+	for j := 0; j < bits; j++ {
+		// f_j = l_j*x + a_j, za_j = r_j*x + s_j, zb_j = r_j*(x - f_j) + t_j
+		sig.F[j] = suite.Scalar().Mul(l[j], x)
+		sig.F[j].Add(sig.F[j], a[j])
+		sig.ZA[j] = suite.Scalar().Mul(r[j], x)
+		sig.ZA[j].Add(sig.ZA[j], s[j])
+		sig.ZB[j] = suite.Scalar().Sub(x, sig.F[j])
+		sig.ZB[j].Mul(sig.ZB[j], r[j]).Add(sig.ZB[j], t[j])
+	}
+	// zd = x^bits*privateKey - sum_k x^k*rho_k
+	xk := suite.Scalar().One()
+	sig.ZD = suite.Scalar().Zero()
+	for k := 0; k < bits; k++ {
+		sig.ZD.Sub(sig.ZD, suite.Scalar().Mul(xk, rho[k]))
+		xk.Mul(xk, x)
+	}
+	sig.ZD.Add(sig.ZD, xk.Mul(xk, privateKey))
+
+	buf := bytes.Buffer{}
+	if err := suite.Write(&buf, &sig); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// mulLinear returns the coefficients of poly(x)*(d*x + c).
+func mulLinear(suite Suite, poly []kyber.Scalar, d, c kyber.Scalar) []kyber.Scalar {
+	res := make([]kyber.Scalar, len(poly)+1)
+	for k := range res {
+		res[k] = suite.Scalar().Zero()
+	}
+	for k, coeff := range poly {
+		res[k].Add(res[k], suite.Scalar().Mul(coeff, c))
+		res[k+1].Add(res[k+1], suite.Scalar().Mul(coeff, d))
+	}
+	return res
+}
+
+// VerifyOneOfMany checks a signature generated by SignOneOfMany on the
+// message for the anonymity set. It returns nil iff the signature is valid.
+func VerifyOneOfMany(suite Suite, message []byte, anonymitySet Set, signature []byte) error {
+	if len(anonymitySet) == 0 {
+		return errors.New("empty anonymity set")
+	}
+	bits := oomBits(len(anonymitySet))
+	ring := oomRing(anonymitySet, bits)
+	H := oomBase(suite)
+
+	sig := oomSig{
+		CL: make([]kyber.Point, bits),
+		CA: make([]kyber.Point, bits),
+		CB: make([]kyber.Point, bits),
+		CD: make([]kyber.Point, bits),
+		F:  make([]kyber.Scalar, bits),
+		ZA: make([]kyber.Scalar, bits),
+		ZB: make([]kyber.Scalar, bits),
+	}
+	buf := bytes.NewBuffer(signature)
+	if err := suite.Read(buf, &sig); err != nil {
+		return err
+	}
+	if buf.Len() != 0 {
+		return errors.New("invalid signature length")
+	}
+	x := oomChallenge(suite, message, ring, &sig)
+
+	// Check that every CL_j commits to a bit:
+	// x*CL_j + CA_j = Com(f_j, za_j) and (x - f_j)*CL_j + CB_j = Com(0, zb_j).
+	invalid := errors.New("invalid signature")
+	zero := suite.Scalar().Zero()
+	for j := 0; j < bits; j++ {
+		left := suite.Point().Mul(x, sig.CL[j])
+		left.Add(left, sig.CA[j])
+		if !left.Equal(oomCommit(suite, H, sig.F[j], sig.ZA[j])) {
+			return invalid
+		}
+		left.Mul(suite.Scalar().Sub(x, sig.F[j]), sig.CL[j])
+		left.Add(left, sig.CB[j])
+		if !left.Equal(oomCommit(suite, H, zero, sig.ZB[j])) {
+			return invalid
+		}
+	}
+
+	// Check that sum_i p_i(x)*P_i - sum_k x^k*CD_k - zd*G = 0, with
+	// p_i(x) = prod_j f_{j,i_j}.
+	p := []kyber.Scalar{suite.Scalar().One()}
+	for j := 0; j < bits; j++ {
+		f0 := suite.Scalar().Sub(x, sig.F[j])
+		next := make([]kyber.Scalar, 2*len(p))
+		for i, pi := range p {
+			next[i] = suite.Scalar().Mul(pi, f0)
+			next[i+len(p)] = suite.Scalar().Mul(pi, sig.F[j])
+		}
+		p = next
+	}
+	scalars := append([]kyber.Scalar(nil), p...)
+	points := append([]kyber.Point(nil), ring...)
+	xk := suite.Scalar().One()
+	for k := 0; k < bits; k++ {
+		scalars = append(scalars, suite.Scalar().Neg(xk))
+		points = append(points, sig.CD[k])
+		xk.Mul(xk, x)
+	}
+	scalars = append(scalars, suite.Scalar().Neg(sig.ZD))
+	points = append(points, nil)
+	if !msm.Mul(suite, scalars, points).Equal(suite.Point().Null()) {
+		return invalid
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package anon
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+)
+
+func oomKeys(suite Suite, n int) ([]kyber.Point, []kyber.Scalar) {
+	rand := suite.RandomStream()
+	X := make([]kyber.Point, n)
+	x := make([]kyber.Scalar, n)
+	for i := range X {
+		x[i] = suite.Scalar().Pick(rand)
+		X[i] = suite.Point().Mul(x[i], nil)
+	}
+	return X, x
+}
+
+func TestOneOfMany(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	M := []byte("Hello World!")
+	for _, n := range []int{1, 2, 3, 8, 13} {
+		X, x := oomKeys(suite, n)
+		for _, mine := range []int{0, n / 2, n - 1} {
+			sig, err := SignOneOfMany(suite, M, Set(X), mine, x[mine])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := VerifyOneOfMany(suite, M, Set(X), sig); err != nil {
+				t.Fatalf("ring of %d, signer %d: %v", n, mine, err)
+			}
+			if err := VerifyOneOfMany(suite, []byte("Goodbye world!"), Set(X), sig); err == nil {
+				t.Fatal("signature verified against wrong message")
+			}
+		}
+	}
+}
+
+func TestOneOfManyInvalid(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	M := []byte("Hello World!")
+	X, x := oomKeys(suite, 5)
+
+	// A key outside of the set cannot sign for it.
+	outsider := suite.Scalar().Pick(suite.RandomStream())
+	sig, err := SignOneOfMany(suite, M, Set(X), 2, outsider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyOneOfMany(suite, M, Set(X), sig); err == nil {
+		t.Fatal("signature of an outsider verified")
+	}
+
+	sig, err = SignOneOfMany(suite, M, Set(X), 2, x[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _ := oomKeys(suite, 5)
+	if err := VerifyOneOfMany(suite, M, Set(other), sig); err == nil {
+		t.Fatal("signature verified against wrong anonymity set")
+	}
+	if err := VerifyOneOfMany(suite, M, Set(X[:4]), sig); err == nil {
+		t.Fatal("signature verified against smaller anonymity set")
+	}
+	if err := VerifyOneOfMany(suite, M, Set(X), sig[:len(sig)-1]); err == nil {
+		t.Fatal("truncated signature verified")
+	}
+	if err := VerifyOneOfMany(suite, M, Set(X), append(sig, 0)); err == nil {
+		t.Fatal("signature with trailing data verified")
+	}
+	if _, err := SignOneOfMany(suite, M, Set(X), 5, x[2]); err == nil {
+		t.Fatal("signer index out of range accepted")
+	}
+}
+
+var benchPubOneOfMany, benchPriOneOfMany = oomKeys(edwards25519.NewBlakeSHA256Ed25519(), 1000)
+var benchSig1000OneOfMany = benchGenSigOneOfMany(1000)
+
+func benchGenSigOneOfMany(nkeys int) []byte {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	sig, err := SignOneOfMany(suite, benchMessage, Set(benchPubOneOfMany[:nkeys]), 0, benchPriOneOfMany[0])
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}
+
+func BenchmarkSignOneOfMany1000Ed25519(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchGenSigOneOfMany(1000)
+	}
+}
+
+func BenchmarkVerifyOneOfMany1000Ed25519(b *testing.B) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	for i := 0; i < b.N; i++ {
+		if err := VerifyOneOfMany(suite, benchMessage, Set(benchPubOneOfMany), benchSig1000OneOfMany); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/transcript"
 )
 
 // unlinkable ring signature
@@ -20,26 +21,30 @@ type lSig struct {
 	Tag kyber.Point
 }
 
-func signH1pre(suite Suite, linkScope []byte, linkTag kyber.Point,
-	message []byte) kyber.XOF {
-	H1pre := suite.XOF(message) // m
+// signH1pre returns the transcript of the parameters of the challenges
+// that are the same at every ring position: the ring, the message and, for
+// linkable signatures, the linkage scope and tag.
+func signH1pre(suite Suite, ring []kyber.Point, linkScope []byte,
+	linkTag kyber.Point, message []byte) *transcript.Transcript {
+	H1pre := transcript.New(suite, "anon ring signature")
+	H1pre.AppendPoints("ring", ring...)
+	H1pre.Append("message", message) // m
 	if linkScope != nil {
-		_, _ = H1pre.Write(linkScope) // L
-		tag, _ := linkTag.MarshalBinary()
-		_, _ = H1pre.Write(tag) // ~y
+		H1pre.Append("scope", linkScope)   // L
+		H1pre.AppendPoints("tag", linkTag) // ~y
 	}
 	return H1pre
 }
 
-func signH1(suite Suite, H1pre kyber.XOF, PG, PH kyber.Point) kyber.Scalar {
+// signH1 returns the challenge of the next ring position from the
+// commitments of the current one.
+func signH1(H1pre *transcript.Transcript, PG, PH kyber.Point) kyber.Scalar {
 	H1 := H1pre.Clone()
-	PGb, _ := PG.MarshalBinary()
-	_, _ = H1.Write(PGb)
+	H1.AppendPoints("commitment", PG)
 	if PH != nil {
-		PHb, _ := PH.MarshalBinary()
-		_, _ = H1.Write(PHb)
+		H1.AppendPoints("commitment", PH)
 	}
-	return suite.Scalar().Pick(H1)
+	return H1.Challenge("challenge")
 }
 
 // Sign creates an optionally anonymous, optionally linkable
@@ -137,7 +142,7 @@ func Sign(suite Suite, message []byte,
 	// First pre-hash the parameters to H1
 	// that are invariant for different ring positions,
 	// so that we don't have to hash them many times.
-	H1pre := signH1pre(suite, linkScope, linkTag, message)
+	H1pre := signH1pre(suite, L, linkScope, linkTag, message)
 
 	// Pick a random commit for my ring position
 	u := suite.Scalar().Pick(suite.RandomStream())
@@ -150,7 +155,7 @@ func Sign(suite Suite, message []byte,
 	// Build the challenge ring
 	s := make([]kyber.Scalar, n)
 	c := make([]kyber.Scalar, n)
-	c[(pi+1)%n] = signH1(suite, H1pre, UB, UL)
+	c[(pi+1)%n] = signH1(H1pre, UB, UL)
 	var P, PG, PH kyber.Point
 	P = suite.Point()
 	PG = suite.Point()
@@ -163,7 +168,7 @@ func Sign(suite Suite, message []byte,
 		if linkScope != nil {
 			PH.Add(PH.Mul(s[i], linkBase), P.Mul(c[i], linkTag))
 		}
-		c[(i+1)%n] = signH1(suite, H1pre, PG, PH)
+		c[(i+1)%n] = signH1(H1pre, PG, PH)
 		//fmt.Printf("s%d %s\n",i,s[i].String())
 		//fmt.Printf("c%d %s\n",(i+1)%n,c[(i+1)%n].String())
 	}
@@ -220,7 +225,7 @@ func Verify(suite Suite, message []byte, anonymitySet Set,
 	}
 
 	// Pre-hash the ring-position-invariant parameters to H1.
-	H1pre := signH1pre(suite, linkScope, linkTag, message)
+	H1pre := signH1pre(suite, L, linkScope, linkTag, message)
 
 	// Verify the signature
 	var P, PG, PH kyber.Point
@@ -236,7 +241,7 @@ func Verify(suite Suite, message []byte, anonymitySet Set,
 		if linkScope != nil {
 			PH.Add(PH.Mul(s[i], linkBase), P.Mul(ci, linkTag))
 		}
-		ci = signH1(suite, H1pre, PG, PH)
+		ci = signH1(H1pre, PG, PH)
 	}
 	if !ci.Equal(sig.C0) {
 		return nil, errors.New("invalid signature")
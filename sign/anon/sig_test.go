@@ -55,10 +55,10 @@ func ExampleSign_one() {
 
 	// Output:
 	// Signature:
-	// 00000000  45 30 41 6a 51 d1 01 cf  7e ee 63 66 1d e9 e3 cf  |E0AjQ...~.cf....|
-	// 00000010  a3 d2 1b 98 fc 46 99 6d  9f 91 cc 65 f4 9d 10 03  |.....F.m...e....|
-	// 00000020  45 a0 e0 5a bc fe 62 62  45 a9 e5 eb 00 e2 6b 66  |E..Z..bbE.....kf|
-	// 00000030  dc aa f0 53 7c 10 3e bf  bd f6 30 8d 2d 2c 5c 0f  |...S|.>...0.-,\.|
+	// 00000000  f6 6a f3 c2 75 1e 26 d8  dd 41 5b 4f 52 5f f4 8b  |.j..u.&..A[OR_..|
+	// 00000010  22 aa 1b 11 b0 fb b1 2e  54 be 39 15 71 0a 34 0b  |".......T.9.q.4.|
+	// 00000020  09 8b 7a a9 61 c8 73 e1  27 a8 75 72 ca 77 64 80  |..z.a.s.'.ur.wd.|
+	// 00000030  93 0e d3 d2 59 5b bb 04  80 13 01 12 28 e3 6f 00  |....Y[......(.o.|
 	// Signature verified against correct message.
 	// Verifying against wrong message: invalid signature
 }
@@ -108,12 +108,12 @@ func ExampleSign_anonSet() {
 
 	// Output:
 	// Signature:
-	// 00000000  dc 43 94 ce 5e c5 ab c1  f8 3e bd e1 30 a8 19 bd  |.C..^....>..0...|
-	// 00000010  13 f7 b4 0d f0 f5 39 40  c3 de 71 26 f9 1c ba 0f  |......9@..q&....|
+	// 00000000  11 1d 14 2e 2c 22 15 f2  b3 1c 74 6f 9e 68 3a 9b  |....,"....to.h:.|
+	// 00000010  d3 bd 89 43 73 87 f4 88  25 12 5a dc f8 67 62 03  |...Cs...%.Z..gb.|
 	// 00000020  61 f7 23 a0 e6 7c 95 b7  e4 b2 32 55 40 d4 25 87  |a.#..|....2U@.%.|
 	// 00000030  da d4 76 18 01 22 fb c7  93 f7 40 6b d6 e0 e7 0b  |..v.."....@k....|
-	// 00000040  3d a3 1f 32 50 f8 c1 d2  c6 93 f4 19 e0 c7 2a 06  |=..2P.........*.|
-	// 00000050  ef 6f 1c 4d c9 4f 0e db  c8 30 4d 20 94 52 e8 04  |.o.M.O...0M .R..|
+	// 00000040  e7 df 43 e6 3a 4a 7f 77  0d ad 3a eb ba 3b f7 4f  |..C.:J.w..:..;.O|
+	// 00000050  48 be cf 56 2c d2 9d eb  69 40 8c 55 62 e1 c3 01  |H..V,...i@.Ub...|
 	// 00000060  f4 6d eb 7c 5f 30 09 60  bf c7 37 cd 44 16 fe bb  |.m.|_0.`..7.D...|
 	// 00000070  b6 5a e5 45 b3 6c 7f b1  12 6d 60 b9 9f 60 0e 0c  |.Z.E.l...m`..`..|
 	// Signature verified against correct message.
@@ -186,47 +186,47 @@ func ExampleSign_linkable() {
 
 	// Output:
 	// Signature 0:
-	// 00000000  a2 f1 f3 e3 07 35 6c a9  16 fb 4f c9 a7 35 c7 3b  |.....5l...O..5.;|
-	// 00000010  7f 09 8b 70 45 8d 5f c1  2b 74 22 f2 bf 3d d1 0a  |...pE._.+t"..=..|
+	// 00000000  9a 59 d0 7d c4 3c a3 6f  c3 46 57 6d 51 38 a0 47  |.Y.}.<.o.FWmQ8.G|
+	// 00000010  7a 5f 88 04 e4 09 33 79  12 12 ad cc 72 12 3a 04  |z_....3y....r.:.|
 	// 00000020  4b 8b 88 78 28 d6 5f 77  d0 d6 1b 26 47 cb 7a 2e  |K..x(._w...&G.z.|
 	// 00000030  3c f8 8c 4b 8b 39 cd 3e  92 e1 2c 2d ac 7f db 01  |<..K.9.>..,-....|
-	// 00000040  1b 1d c2 e4 1d fd 54 b9  29 b9 f1 ec 9c e1 bc c8  |......T.).......|
-	// 00000050  b5 db c8 9f 71 1c 48 1c  2c 02 b2 14 de e7 b6 08  |....q.H.,.......|
+	// 00000040  7a 24 c1 98 f2 0b 81 dc  5b f3 42 87 30 f6 78 a5  |z$......[.B.0.x.|
+	// 00000050  36 f4 f2 32 9d da b1 92  36 36 a4 e4 96 40 eb 0f  |6..2....66...@..|
 	// 00000060  61 f7 23 a0 e6 7c 95 b7  e4 b2 32 55 40 d4 25 87  |a.#..|....2U@.%.|
 	// 00000070  da d4 76 18 01 22 fb c7  93 f7 40 6b d6 e0 e7 0b  |..v.."....@k....|
 	// 00000080  da 86 5d 31 13 21 f5 95  70 d8 d7 a1 26 3b 47 dd  |..]1.!..p...&;G.|
 	// 00000090  60 5d c2 1d 38 bf b7 49  e9 47 4a 8d 89 a4 b0 89  |`]..8..I.GJ.....|
 	// Signature 1:
-	// 00000000  14 b6 dd a5 99 0c e7 f7  d5 82 43 d5 45 84 19 7b  |..........C.E..{|
-	// 00000010  db c6 3b f5 ee ce 01 50  17 57 58 21 37 31 25 0d  |..;....P.WX!71%.|
+	// 00000000  b1 c7 ca f8 b5 0c ea b9  a6 f7 37 2e 40 e4 0a d2  |..........7.@...|
+	// 00000010  92 a2 d4 cc 5e 04 55 9b  17 1c 0f 22 a2 74 f7 07  |....^.U....".t..|
 	// 00000020  81 b1 81 c3 f3 00 f9 0f  9d 58 58 5f 66 f4 52 75  |.........XX_f.Ru|
 	// 00000030  0f bb bc fc 25 58 f7 29  74 8a 57 79 93 75 d9 0b  |....%X.)t.Wy.u..|
-	// 00000040  11 3d 25 cb be 39 0f 88  2c f8 ee 63 93 d8 98 94  |.=%..9..,..c....|
-	// 00000050  1b 85 fd 38 0a 37 87 0b  c1 db a7 53 50 72 98 0c  |...8.7.....SPr..|
+	// 00000040  19 3a 21 94 36 97 9f 3f  d6 82 bf d4 d8 52 35 8f  |.:!.6..?.....R5.|
+	// 00000050  37 26 44 93 c1 ee 33 c0  01 75 b8 0e 8d fe dd 02  |7&D...3..u......|
 	// 00000060  7f 9a fb 37 f7 64 66 5c  7c b5 1f 2d b1 d5 63 67  |...7.df\|..-..cg|
 	// 00000070  12 1b d4 18 0a 5b 42 b2  c0 9e 3a 42 e2 c2 77 0c  |.....[B...:B..w.|
 	// 00000080  da 86 5d 31 13 21 f5 95  70 d8 d7 a1 26 3b 47 dd  |..]1.!..p...&;G.|
 	// 00000090  60 5d c2 1d 38 bf b7 49  e9 47 4a 8d 89 a4 b0 89  |`]..8..I.GJ.....|
 	// Signature 2:
-	// 00000000  5f 11 1a 2f 10 28 55 d9  e2 be 10 56 7e 57 37 ae  |_../.(U....V~W7.|
-	// 00000010  7a a1 bc ec 87 0f 98 4f  52 cc 70 e6 14 79 8a 01  |z......OR.p..y..|
+	// 00000000  91 0a ee b4 f2 cc 1f 23  a6 ec d2 85 0c 6e cd 20  |.......#.....n. |
+	// 00000010  51 ce 8d 42 eb e4 e0 fd  cc 4f ab 11 c8 73 de 03  |Q..B.....O...s..|
 	// 00000020  89 f7 f8 b6 91 d1 52 f7  f0 b2 3d 3c 70 f1 95 9e  |......R...=<p...|
 	// 00000030  2b 3b 76 1c d6 9e 2f 77  09 83 6a 7f 4d d8 4d 09  |+;v.../w..j.M.M.|
 	// 00000040  98 6f d5 7f 3b c0 00 e9  f7 80 0d ed 3c 15 b7 58  |.o..;.......<..X|
 	// 00000050  ba c2 c2 53 84 ff d0 6f  47 c3 b6 e6 24 66 19 00  |...S...oG...$f..|
-	// 00000060  9f a5 96 bf 08 a4 3f 2b  bd 26 f2 0b 79 b9 92 c2  |......?+.&..y...|
-	// 00000070  00 6b f8 71 2a 95 60 07  92 4a 3b 86 c4 1b 98 0a  |.k.q*.`..J;.....|
+	// 00000060  1d db 5a 90 47 4d 2b cf  6d 64 d2 1f d7 20 dd 96  |..Z.GM+.md... ..|
+	// 00000070  50 cc d6 65 50 58 c5 ca  a2 e4 ad 32 ab 38 f9 03  |P..ePX.....2.8..|
 	// 00000080  49 d9 9a 38 a8 da c4 44  3d 6b 56 70 78 9e f0 01  |I..8...D=kVpx...|
 	// 00000090  c6 da 3e d2 ff 20 b0 7c  0e 88 c6 52 a1 60 f5 6a  |..>.. .|...R.`.j|
 	// Signature 3:
-	// 00000000  a9 0f 3b 86 6f 4e c6 ea  8d e8 57 2c 1a 20 c6 14  |..;.oN....W,. ..|
-	// 00000010  5e 5b 66 95 0b 41 ce 57  94 a1 f0 36 73 cd c8 04  |^[f..A.W...6s...|
+	// 00000000  8a 55 b8 6c 8d 43 8f da  d2 b1 03 20 0a e8 84 fb  |.U.l.C..... ....|
+	// 00000010  08 e1 2e 54 64 e9 2e 0e  c0 22 62 d5 c2 7d 3a 0a  |...Td...."b..}:.|
 	// 00000020  ff 47 7b f3 6e ee 9e 1f  bb 0d 96 e7 b8 50 1d 9f  |.G{.n........P..|
 	// 00000030  8f bf ea bc ef f3 d5 d9  9b 05 9b d3 5e c9 41 0e  |............^.A.|
 	// 00000040  d1 e8 a3 f6 7b b4 8e 38  db 73 4a ef ca 9a 68 7b  |....{..8.sJ...h{|
 	// 00000050  c3 d0 2a e3 a9 e5 c1 a3  b7 bb 60 92 75 f1 7e 00  |..*.......`.u.~.|
-	// 00000060  9a bd 63 f7 c0 cf 2d a1  4d 1e 2c 40 ff 11 d6 4f  |..c...-.M.,@...O|
-	// 00000070  c5 a2 70 ab 14 2e 11 ee  24 e6 ca ca 15 e2 f7 0f  |..p.....$.......|
+	// 00000060  fb 75 bf 41 d9 70 1e 74  6a 22 c6 f9 36 a7 77 99  |.u.A.p.tj"..6.w.|
+	// 00000070  e5 24 b4 73 df dc 11 32  04 ad c0 f8 08 6b 2e 0b  |.$.s...2.....k..|
 	// 00000080  49 d9 9a 38 a8 da c4 44  3d 6b 56 70 78 9e f0 01  |I..8...D=kVpx...|
 	// 00000090  c6 da 3e d2 ff 20 b0 7c  0e 88 c6 52 a1 60 f5 6a  |..>.. .|...R.`.j|
 	// Sig0 tag: da865d311321f59570d8d7a1263b47dd605dc21d38bfb749e9474a8d89a4b089
@@ -0,0 +1,76 @@
+package anon
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// linkableSigLen returns the length of a linkable signature by Sign for an
+// anonymity set of n keys.
+func linkableSigLen(suite Suite, n int) int {
+	return (n+1)*suite.ScalarLen() + suite.PointLen()
+}
+
+// CombineThreshold combines linkable signatures on the same message, made
+// by distinct members of an anonymity set with Sign in the same linkScope,
+// into a threshold signature, which VerifyThreshold checks.
+//
+// A threshold signature proves that at least t distinct members of the set
+// signed the message, without revealing which; this makes for anonymous
+// petitions with a quorum. The members sign independently of each other,
+// and anyone can combine their signatures. Since the signatures are linkable,
+// a member cannot be counted twice in a scope, but its participation can be
+// linked across the threshold signatures of the scope.
+func CombineThreshold(suite Suite, message []byte, anonymitySet Set, linkScope []byte, sigs [][]byte) ([]byte, error) {
+	if linkScope == nil {
+		return nil, errors.New("threshold signatures require a link scope")
+	}
+	var buf bytes.Buffer
+	tags := make(map[string]bool)
+	for i, sig := range sigs {
+		tag, err := Verify(suite, message, anonymitySet, linkScope, sig)
+		if err != nil || len(sig) != linkableSigLen(suite, len(anonymitySet)) {
+			return nil, fmt.Errorf("invalid signature %d", i)
+		}
+		if tags[string(tag)] {
+			return nil, fmt.Errorf("signature %d is from a member who already signed", i)
+		}
+		tags[string(tag)] = true
+		buf.Write(sig)
+	}
+	return buf.Bytes(), nil
+}
+
+// VerifyThreshold checks a threshold signature created by CombineThreshold,
+// and that it holds the signatures of at least t distinct members of the
+// anonymity set in the linkScope. It returns the linkage tags of the
+// signers, which can be compared with those of other signatures in the same
+// scope, or an error if the signature is invalid.
+func VerifyThreshold(suite Suite, message []byte, anonymitySet Set, linkScope []byte, t int, signature []byte) ([][]byte, error) {
+	if linkScope == nil {
+		return nil, errors.New("threshold signatures require a link scope")
+	}
+	l := linkableSigLen(suite, len(anonymitySet))
+	if len(signature)%l != 0 {
+		return nil, errors.New("invalid signature length")
+	}
+	var tags [][]byte
+	seen := make(map[string]bool)
+	for len(signature) > 0 {
+		tag, err := Verify(suite, message, anonymitySet, linkScope, signature[:l])
+		if err != nil {
+			return nil, err
+		}
+		if seen[string(tag)] {
+			return nil, errors.New("duplicate signer")
+		}
+		seen[string(tag)] = true
+		tags = append(tags, tag)
+		signature = signature[l:]
+	}
+	if len(tags) < t {
+		return nil, fmt.Errorf("only %d signers instead of %d", len(tags), t)
+	}
+	return tags, nil
+}
@@ -0,0 +1,67 @@
+package anon
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+)
+
+func TestThreshold(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	M := []byte("Petition")
+	S := []byte("Petition scope")
+	X, x := oomKeys(suite, 5)
+
+	var sigs [][]byte
+	for _, mine := range []int{0, 2, 3} {
+		sigs = append(sigs, Sign(suite, M, Set(X), S, mine, x[mine]))
+	}
+	sig, err := CombineThreshold(suite, M, Set(X), S, sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags, err := VerifyThreshold(suite, M, Set(X), S, 3, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 3 {
+		t.Fatal("wrong number of tags")
+	}
+	if _, err := VerifyThreshold(suite, M, Set(X), S, 4, sig); err == nil {
+		t.Fatal("threshold not reached but signature verified")
+	}
+	if _, err := VerifyThreshold(suite, []byte("other"), Set(X), S, 3, sig); err == nil {
+		t.Fatal("signature verified against wrong message")
+	}
+	if _, err := VerifyThreshold(suite, M, Set(X), []byte("other"), 3, sig); err == nil {
+		t.Fatal("signature verified against wrong scope")
+	}
+	if _, err := VerifyThreshold(suite, M, Set(X), S, 3, sig[1:]); err == nil {
+		t.Fatal("truncated signature verified")
+	}
+
+	// A member signing twice is counted once, so a signature cannot be
+	// padded with its second signature.
+	again := Sign(suite, M, Set(X), S, 2, x[2])
+	if _, err := CombineThreshold(suite, M, Set(X), S, append(sigs, again)); err == nil {
+		t.Fatal("duplicate signer combined")
+	}
+	if _, err := VerifyThreshold(suite, M, Set(X), S, 4, append(sig, again...)); err == nil {
+		t.Fatal("duplicate signer counted")
+	}
+
+	// Linkage tags are stable within the scope.
+	tag, err := Verify(suite, M, Set(X), S, again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tag, tags[1]) {
+		t.Fatal("linkage tag changed within the scope")
+	}
+
+	// Signatures need a scope.
+	if _, err := CombineThreshold(suite, M, Set(X), nil, sigs); err == nil {
+		t.Fatal("threshold signature without scope")
+	}
+}
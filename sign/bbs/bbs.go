@@ -0,0 +1,360 @@
+// Package bbs implements BBS+ signatures over a pairing-friendly suite: a
+// signer signs a list of messages at once, and the holder of the signature
+// can later prove in zero knowledge that it holds a signature of messages
+// of which it discloses only a subset. This is the basis of anonymous
+// credentials, where the messages are the attributes of a credential.
+//
+// The signatures are those of Au, Susilo and Mu
+// (https://eprint.iacr.org/2008/136), and the proofs of selective disclosure
+// those of Camenisch, Drijvers and Lehmann
+// (https://eprint.iacr.org/2016/663). Public keys are points of G2 and
+// signatures (A, e, s) consist of a point of G1 and two scalars. A proof
+// does not reveal the signature, so that two proofs from the same signature
+// cannot be linked.
+package bbs
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+var (
+	errInvalidSignature = errors.New("bbs: invalid signature")
+	errInvalidProof     = errors.New("bbs: invalid proof")
+)
+
+// NewKeyPair creates a new BBS+ signing key pair. The private key x is a
+// scalar and the public key X is a point of G2.
+func NewKeyPair(suite pairing.Suite, random cipher.Stream) (kyber.Scalar, kyber.Point) {
+	x := suite.G2().Scalar().Pick(random)
+	X := suite.G2().Point().Mul(x, nil)
+	return x, X
+}
+
+// Sign creates the BBS+ signature of the messages msgs with the private key
+// x.
+func Sign(suite pairing.Suite, x kyber.Scalar, msgs [][]byte, random cipher.Stream) ([]byte, error) {
+	g1 := suite.G1()
+	e := g1.Scalar().Pick(random)
+	s := g1.Scalar().Pick(random)
+	// A = (G1 + s*H0 + sum m_i*H_i) / (x + e)
+	B := commitment(suite, s, messages(suite, msgs))
+	xe := g1.Scalar().Add(x, e)
+	A := B.Mul(xe.Inv(xe), B)
+
+	var b bytes.Buffer
+	if _, err := A.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	if _, err := e.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	if _, err := s.MarshalTo(&b); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Verify checks the BBS+ signature sig of the messages msgs under the
+// public key X. It returns nil iff the signature is valid.
+func Verify(suite pairing.Suite, X kyber.Point, msgs [][]byte, sig []byte) error {
+	A, e, s, err := decode(suite, sig)
+	if err != nil {
+		return err
+	}
+	// e(A, X + e*G2) = e(B, G2)
+	B := commitment(suite, s, messages(suite, msgs))
+	Xe := suite.G2().Point().Mul(e, nil)
+	Xe.Add(Xe, X)
+	g2 := suite.G2().Point().Base()
+	if !suite.PairingCheck([]kyber.Point{A, B.Neg(B)}, []kyber.Point{Xe, g2}) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// Prove creates a proof of knowledge of the BBS+ signature sig of the
+// messages msgs under the public key X, which discloses only the messages of
+// the given indices. The proof is bound to the nonce, which the verifier
+// chooses to prevent replays.
+func Prove(suite pairing.Suite, X kyber.Point, msgs [][]byte, sig []byte, disclosed []int, nonce []byte, random cipher.Stream) ([]byte, error) {
+	if err := Verify(suite, X, msgs, sig); err != nil {
+		return nil, err
+	}
+	A, e, s, err := decode(suite, sig)
+	if err != nil {
+		return nil, err
+	}
+	revealed, err := disclosure(disclosed, len(msgs))
+	if err != nil {
+		return nil, err
+	}
+	g1 := suite.G1()
+	m := messages(suite, msgs)
+	H := generators(suite, len(msgs))
+	pick := func() kyber.Scalar { return g1.Scalar().Pick(random) }
+
+	// Randomize the signature: A' = r1*A, Abar = r1*B - e*A' = x*A',
+	// D = r1*B - r2*H0 and s' = s - r2/r1.
+	r1 := pick()
+	for r1.Equal(g1.Scalar().Zero()) {
+		r1 = pick()
+	}
+	r2 := pick()
+	r3 := g1.Scalar().Inv(r1)
+	B := commitment(suite, s, m)
+	p := &proof{A: g1.Point().Mul(r1, A)}
+	rB := g1.Point().Mul(r1, B)
+	p.Abar = g1.Point().Sub(rB, g1.Point().Mul(e, p.A))
+	p.D = g1.Point().Sub(rB, g1.Point().Mul(r2, H[0]))
+	sPrime := g1.Scalar().Sub(s, g1.Scalar().Mul(r2, r3))
+
+	// Prove knowledge of e, r2, r3, s' and the hidden messages with
+	// Abar - D = -e*A' + r2*H0 and
+	// G1 + sum_disclosed m_i*H_i = r3*D - s'*H0 - sum_hidden m_i*H_i.
+	re, rr2, rr3, rs := pick(), pick(), pick(), pick()
+	T1 := g1.Point().Mul(g1.Scalar().Neg(re), p.A)
+	T1.Add(T1, g1.Point().Mul(rr2, H[0]))
+	T2 := g1.Point().Mul(rr3, p.D)
+	T2.Sub(T2, g1.Point().Mul(rs, H[0]))
+	var hidden []int
+	var rm []kyber.Scalar
+	for i := range msgs {
+		if !revealed[i] {
+			r := pick()
+			hidden = append(hidden, i)
+			rm = append(rm, r)
+			T2.Sub(T2, g1.Point().Mul(r, H[i+1]))
+		}
+	}
+
+	c := challenge(suite, X, p, T1, T2, revealed, msgs, nonce)
+	response := func(r, w kyber.Scalar) kyber.Scalar {
+		return g1.Scalar().Add(r, g1.Scalar().Mul(c, w))
+	}
+	p.C = c
+	p.Ze = response(re, e)
+	p.Zr2 = response(rr2, r2)
+	p.Zr3 = response(rr3, r3)
+	p.Zs = response(rs, sPrime)
+	for j, i := range hidden {
+		p.Zm = append(p.Zm, response(rm[j], m[i]))
+	}
+	return p.marshal()
+}
+
+// VerifyProof checks a proof created by Prove for the nonce, under the
+// public key X: that its creator holds a BBS+ signature of n messages, of
+// which the disclosed ones are given by their index. It returns nil iff the
+// proof is valid.
+func VerifyProof(suite pairing.Suite, X kyber.Point, n int, disclosed map[int][]byte, nonce, buf []byte) error {
+	indices := make([]int, 0, len(disclosed))
+	for i := range disclosed {
+		indices = append(indices, i)
+	}
+	revealed, err := disclosure(indices, n)
+	if err != nil {
+		return err
+	}
+	msgs := make([][]byte, n)
+	for i, msg := range disclosed {
+		msgs[i] = msg
+	}
+	p, err := unmarshalProof(suite, buf, n-len(disclosed))
+	if err != nil {
+		return err
+	}
+	g1 := suite.G1()
+	if p.A.Equal(g1.Point().Null()) {
+		return errInvalidProof
+	}
+	// e(A', X) = e(Abar, G2)
+	g2 := suite.G2().Point().Base()
+	if !suite.PairingCheck([]kyber.Point{p.A, g1.Point().Neg(p.Abar)}, []kyber.Point{X, g2}) {
+		return errInvalidProof
+	}
+
+	H := generators(suite, n)
+	c := p.C
+	// T1 = -ze*A' + zr2*H0 - c*(Abar - D)
+	T1 := g1.Point().Mul(g1.Scalar().Neg(p.Ze), p.A)
+	T1.Add(T1, g1.Point().Mul(p.Zr2, H[0]))
+	T1.Sub(T1, g1.Point().Mul(c, g1.Point().Sub(p.Abar, p.D)))
+	// T2 = zr3*D - zs*H0 - sum_hidden zm_i*H_i - c*(G1 + sum_disclosed m_i*H_i)
+	T2 := g1.Point().Mul(p.Zr3, p.D)
+	T2.Sub(T2, g1.Point().Mul(p.Zs, H[0]))
+	public := g1.Point().Base()
+	j := 0
+	for i := 0; i < n; i++ {
+		if revealed[i] {
+			public.Add(public, g1.Point().Mul(message(suite, msgs[i]), H[i+1]))
+		} else {
+			T2.Sub(T2, g1.Point().Mul(p.Zm[j], H[i+1]))
+			j++
+		}
+	}
+	T2.Sub(T2, public.Mul(c, public))
+
+	if !challenge(suite, X, p, T1, T2, revealed, msgs, nonce).Equal(c) {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// proof is a proof of knowledge of a signature with selective disclosure.
+type proof struct {
+	A, Abar, D          kyber.Point
+	C, Ze, Zr2, Zr3, Zs kyber.Scalar
+	Zm                  []kyber.Scalar // responses of the hidden messages
+}
+
+func (p *proof) marshal() ([]byte, error) {
+	var b bytes.Buffer
+	for _, P := range []kyber.Point{p.A, p.Abar, p.D} {
+		if _, err := P.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range append([]kyber.Scalar{p.C, p.Ze, p.Zr2, p.Zr3, p.Zs}, p.Zm...) {
+		if _, err := s.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
+
+func unmarshalProof(suite pairing.Suite, buf []byte, hidden int) (*proof, error) {
+	g1 := suite.G1()
+	pointSize, scalarSize := g1.PointLen(), g1.ScalarLen()
+	size := 3*pointSize + (5+hidden)*scalarSize
+	if len(buf) != size {
+		return nil, fmt.Errorf("bbs: proof of invalid length %d instead of %d", len(buf), size)
+	}
+	points := make([]kyber.Point, 3)
+	for i := range points {
+		points[i] = g1.Point()
+		if err := points[i].UnmarshalBinary(buf[:pointSize]); err != nil {
+			return nil, err
+		}
+		buf = buf[pointSize:]
+	}
+	scalars := make([]kyber.Scalar, 5+hidden)
+	for i := range scalars {
+		scalars[i] = g1.Scalar()
+		if err := scalars[i].UnmarshalBinary(buf[:scalarSize]); err != nil {
+			return nil, err
+		}
+		buf = buf[scalarSize:]
+	}
+	return &proof{
+		A: points[0], Abar: points[1], D: points[2],
+		C: scalars[0], Ze: scalars[1], Zr2: scalars[2], Zr3: scalars[3], Zs: scalars[4],
+		Zm: scalars[5:],
+	}, nil
+}
+
+func decode(suite pairing.Suite, sig []byte) (kyber.Point, kyber.Scalar, kyber.Scalar, error) {
+	g1 := suite.G1()
+	pointSize, scalarSize := g1.PointLen(), g1.ScalarLen()
+	if len(sig) != pointSize+2*scalarSize {
+		return nil, nil, nil, fmt.Errorf("bbs: signature of invalid length %d instead of %d", len(sig), pointSize+2*scalarSize)
+	}
+	A := g1.Point()
+	if err := A.UnmarshalBinary(sig[:pointSize]); err != nil {
+		return nil, nil, nil, err
+	}
+	e := g1.Scalar()
+	if err := e.UnmarshalBinary(sig[pointSize : pointSize+scalarSize]); err != nil {
+		return nil, nil, nil, err
+	}
+	s := g1.Scalar()
+	if err := s.UnmarshalBinary(sig[pointSize+scalarSize:]); err != nil {
+		return nil, nil, nil, err
+	}
+	return A, e, s, nil
+}
+
+// disclosure returns the set of disclosed indices among n messages.
+func disclosure(disclosed []int, n int) (map[int]bool, error) {
+	revealed := make(map[int]bool, len(disclosed))
+	for _, i := range disclosed {
+		if i < 0 || i >= n {
+			return nil, fmt.Errorf("bbs: disclosed index %d out of range", i)
+		}
+		revealed[i] = true
+	}
+	return revealed, nil
+}
+
+// generators returns the points H0, ..., Hn of G1 used for the signatures
+// of n messages, whose discrete logarithms are unknown.
+func generators(suite pairing.Suite, n int) []kyber.Point {
+	H := make([]kyber.Point, n+1)
+	var b [4]byte
+	for i := range H {
+		binary.BigEndian.PutUint32(b[:], uint32(i))
+		H[i] = suite.G1().Point().Pick(suite.XOF(append([]byte("BBS generator"), b[:]...)))
+	}
+	return H
+}
+
+// message maps a message to a scalar.
+func message(suite pairing.Suite, msg []byte) kyber.Scalar {
+	return suite.G1().Scalar().Pick(suite.XOF(append([]byte("BBS message"), msg...)))
+}
+
+func messages(suite pairing.Suite, msgs [][]byte) []kyber.Scalar {
+	m := make([]kyber.Scalar, len(msgs))
+	for i, msg := range msgs {
+		m[i] = message(suite, msg)
+	}
+	return m
+}
+
+// commitment returns B = G1 + s*H0 + sum m_i*H_i.
+func commitment(suite pairing.Suite, s kyber.Scalar, m []kyber.Scalar) kyber.Point {
+	g1 := suite.G1()
+	H := generators(suite, len(m))
+	B := g1.Point().Base()
+	B.Add(B, g1.Point().Mul(s, H[0]))
+	for i, mi := range m {
+		B.Add(B, g1.Point().Mul(mi, H[i+1]))
+	}
+	return B
+}
+
+// challenge returns the Fiat-Shamir challenge of a proof, binding the
+// public key, the disclosed messages and the nonce.
+func challenge(suite pairing.Suite, X kyber.Point, p *proof, T1, T2 kyber.Point, revealed map[int]bool, msgs [][]byte, nonce []byte) kyber.Scalar {
+	t := transcript.New(transcriptSuite(suite), "BBS proof")
+	t.AppendPoints("public", X)
+	t.AppendPoints("commitment", p.A, p.Abar, p.D, T1, T2)
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(len(msgs)))
+	t.Append("count", b[:])
+	for i, msg := range msgs {
+		if revealed[i] {
+			binary.BigEndian.PutUint64(b[:], uint64(i))
+			t.Append("index", b[:])
+			t.Append("message", msg)
+		}
+	}
+	t.Append("nonce", nonce)
+	return t.Challenge("challenge")
+}
+
+// transcriptSuite returns the suite of the transcripts of the challenges,
+// on G1.
+func transcriptSuite(suite pairing.Suite) transcript.Suite {
+	return &struct {
+		kyber.Group
+		pairing.Suite
+	}{suite.G1(), suite}
+}
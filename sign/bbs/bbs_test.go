@@ -0,0 +1,75 @@
+// +build vartime
+
+package bbs
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+var msgs = [][]byte{
+	[]byte("name: Alice"),
+	[]byte("birth: 1990-01-01"),
+	[]byte("country: CH"),
+	[]byte("id: 1234"),
+}
+
+func TestBBS(t *testing.T) {
+	x, X := NewKeyPair(suite, suite.RandomStream())
+	sig, err := Sign(suite, x, msgs, suite.RandomStream())
+	require.NoError(t, err)
+	require.NoError(t, Verify(suite, X, msgs, sig))
+
+	other := append([][]byte(nil), msgs...)
+	other[2] = []byte("country: FR")
+	require.Error(t, Verify(suite, X, other, sig))
+	require.Error(t, Verify(suite, X, msgs[:3], sig))
+	_, X2 := NewKeyPair(suite, suite.RandomStream())
+	require.Error(t, Verify(suite, X2, msgs, sig))
+	require.Error(t, Verify(suite, X, msgs, sig[1:]))
+}
+
+func TestProof(t *testing.T) {
+	x, X := NewKeyPair(suite, suite.RandomStream())
+	sig, err := Sign(suite, x, msgs, suite.RandomStream())
+	require.NoError(t, err)
+	nonce := []byte("verifier nonce")
+
+	for _, disclosed := range [][]int{nil, {2}, {0, 3}, {0, 1, 2, 3}} {
+		proof, err := Prove(suite, X, msgs, sig, disclosed, nonce, suite.RandomStream())
+		require.NoError(t, err)
+		revealed := make(map[int][]byte)
+		for _, i := range disclosed {
+			revealed[i] = msgs[i]
+		}
+		require.NoError(t, VerifyProof(suite, X, len(msgs), revealed, nonce, proof))
+		require.Error(t, VerifyProof(suite, X, len(msgs), revealed, []byte("other nonce"), proof))
+		if len(disclosed) > 0 {
+			i := disclosed[0]
+			revealed[i] = []byte("forged")
+			require.Error(t, VerifyProof(suite, X, len(msgs), revealed, nonce, proof))
+		}
+	}
+
+	// A proof discloses exactly the claimed messages.
+	proof, err := Prove(suite, X, msgs, sig, []int{1}, nonce, suite.RandomStream())
+	require.NoError(t, err)
+	require.Error(t, VerifyProof(suite, X, len(msgs), map[int][]byte{2: msgs[2]}, nonce, proof))
+	require.Error(t, VerifyProof(suite, X, len(msgs), nil, nonce, proof))
+
+	// Proofs are unlinkable and do not reveal the signature.
+	proof2, err := Prove(suite, X, msgs, sig, []int{1}, nonce, suite.RandomStream())
+	require.NoError(t, err)
+	require.NotEqual(t, proof, proof2)
+	require.NotContains(t, string(proof), string(sig[:suite.G1().PointLen()]))
+
+	// An invalid signature cannot be proven.
+	_, err = Prove(suite, X, msgs[:3], sig, nil, nonce, suite.RandomStream())
+	require.Error(t, err)
+	_, err = Prove(suite, X, msgs, sig, []int{4}, nonce, suite.RandomStream())
+	require.Error(t, err)
+}
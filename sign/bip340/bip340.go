@@ -0,0 +1,184 @@
+// +build vartime
+
+// Package bip340 implements the Schnorr signature scheme of Bitcoin's
+// Taproot upgrade over the secp256k1 curve, as specified in BIP-340
+// (https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki).
+//
+// Public keys are x-only: a key is encoded as the 32-byte x-coordinate of
+// a point, standing for the point with that x-coordinate and an even
+// y-coordinate. Any private key, including one shared by a threshold
+// protocol, can sign: if its public point has an odd y-coordinate, the key
+// is negated before signing. Signatures are 64 bytes long and hashes are
+// tagged with the name of their purpose.
+//
+// Like package group/secp256k1, this package is not constant time and must
+// be compiled with the "vartime" compilation flag.
+package bip340
+
+import (
+	"crypto/cipher"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/secp256k1"
+	"github.com/dedis/kyber/util/random"
+)
+
+const (
+	// PublicKeySize is the size in bytes of an x-only public key.
+	PublicKeySize = 32
+	// SignatureSize is the size in bytes of a signature.
+	SignatureSize = 64
+)
+
+var group = new(secp256k1.Curve)
+
+var (
+	errInvalidPublicKey = errors.New("bip340: invalid public key")
+	errInvalidSignature = errors.New("bip340: invalid signature")
+)
+
+// PublicKey returns the x-only encoding of the point P.
+func PublicKey(P kyber.Point) ([]byte, error) {
+	b, err := P.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if b[0] == 0 {
+		return nil, errInvalidPublicKey
+	}
+	return b[1:], nil
+}
+
+// ParsePublicKey returns the point with even y-coordinate encoded by the
+// x-only public key b.
+func ParsePublicKey(b []byte) (kyber.Point, error) {
+	if len(b) != PublicKeySize {
+		return nil, errInvalidPublicKey
+	}
+	P, err := liftX(b)
+	if err != nil {
+		return nil, errInvalidPublicKey
+	}
+	return P, nil
+}
+
+// Sign returns the signature of msg under the private key. The auxiliary
+// randomness recommended by BIP-340 is read from rand; it protects the
+// signer against side channel attacks, while the security of the
+// signature does not depend on its quality.
+func Sign(private kyber.Scalar, msg []byte, rand cipher.Stream) ([]byte, error) {
+	var aux [32]byte
+	random.Bytes(aux[:], rand)
+
+	P := group.Point().Mul(private, nil)
+	d, Pb, err := evenKey(private, P)
+	if err != nil {
+		return nil, err
+	}
+	db, err := d.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	t := taggedHash("BIP0340/aux", aux[:])
+	for i := range t {
+		t[i] ^= db[i]
+	}
+	kb := taggedHash("BIP0340/nonce", t, Pb, msg)
+	k := group.Scalar().SetBytes(kb)
+	if k.Equal(group.Scalar().Zero()) {
+		return nil, errors.New("bip340: nonce is zero")
+	}
+	R := group.Point().Mul(k, nil)
+	k, Rb, err := evenKey(k, R)
+	if err != nil {
+		return nil, err
+	}
+	e := challenge(Rb, Pb, msg)
+
+	// s = k + e*d
+	s := group.Scalar().Mul(e, d)
+	s.Add(k, s)
+	sb, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(Rb, sb...), nil
+}
+
+// Verify checks the signature of msg under the x-only public key. It
+// returns nil if the signature is valid, and an error otherwise.
+func Verify(public []byte, msg, sig []byte) error {
+	P, err := ParsePublicKey(public)
+	if err != nil {
+		return err
+	}
+	if len(sig) != SignatureSize {
+		return errInvalidSignature
+	}
+	// r must be the x-coordinate of a point, which liftX checks, and s
+	// must be fully reduced.
+	if _, err := liftX(sig[:32]); err != nil {
+		return errInvalidSignature
+	}
+	s := group.Scalar().SetBytes(sig[32:])
+	if sb, _ := s.MarshalBinary(); string(sb) != string(sig[32:]) {
+		return errInvalidSignature
+	}
+	e := challenge(sig[:32], public, msg)
+
+	// R = s*G - e*P
+	R := group.Point().Mul(s, nil)
+	R.Sub(R, group.Point().Mul(e, P))
+	Rb, err := R.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if Rb[0] != 2 || string(Rb[1:]) != string(sig[:32]) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// evenKey returns the scalar x or its negation, whichever has a public
+// point with an even y-coordinate, and the x-only encoding of that point
+// X = x*G.
+func evenKey(x kyber.Scalar, X kyber.Point) (kyber.Scalar, []byte, error) {
+	b, err := X.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch b[0] {
+	case 2:
+		return x, b[1:], nil
+	case 3:
+		return group.Scalar().Neg(x), b[1:], nil
+	}
+	return nil, nil, errors.New("bip340: key is zero")
+}
+
+// liftX returns the point with even y-coordinate and x-coordinate x.
+func liftX(x []byte) (kyber.Point, error) {
+	P := group.Point()
+	if err := P.UnmarshalBinary(append([]byte{2}, x...)); err != nil {
+		return nil, err
+	}
+	return P, nil
+}
+
+func challenge(R, P, msg []byte) kyber.Scalar {
+	return group.Scalar().SetBytes(taggedHash("BIP0340/challenge", R, P, msg))
+}
+
+// taggedHash returns SHA256(SHA256(tag) || SHA256(tag) || data...).
+func taggedHash(tag string, data ...[]byte) []byte {
+	th := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(th[:])
+	h.Write(th[:])
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,122 @@
+// +build vartime
+
+package bip340
+
+import (
+	"crypto/cipher"
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Signing test vectors from the BIP-340 test-vectors.csv file.
+var vectors = []struct {
+	private string
+	public  string
+	aux     string
+	msg     string
+	sig     string
+}{
+	{"0000000000000000000000000000000000000000000000000000000000000003",
+		"F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0"},
+	{"B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+		"DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+		"6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A"},
+	{"C90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74020BBEA63B14E5C9",
+		"DD308AFEC5777E13121FA72B9CC1B7CC0139715309B086C960E18FD969774EB8",
+		"C87AA53824B4D7AE2EB035A2B5BBBCCC080E76CDC6D1692C4B0B62D798E6D906",
+		"7E2D58D8B3BCDF1ABADEC7829054F90DDA9805AAB56C77333024B9D0A508B75C",
+		"5831AAEED7B44BB74E5EAB94BA9D4294C49BCF2A60728D8B4C200F50DD313C1BAB745879A5AD954A72C45A91C3A51D3C7ADEA98D82F8481E0E1E03674A6F3FB7"},
+}
+
+type constantStream []byte
+
+func (c constantStream) XORKeyStream(dst, src []byte) {
+	copy(dst, c)
+}
+
+func fromHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestVectors(t *testing.T) {
+	for i, v := range vectors {
+		private := group.Scalar().SetBytes(fromHex(t, v.private))
+		public, err := PublicKey(group.Point().Mul(private, nil))
+		require.NoError(t, err)
+		assert.Equal(t, fromHex(t, v.public), public, "vector %d", i)
+
+		msg := fromHex(t, v.msg)
+		sig, err := Sign(private, msg, constantStream(fromHex(t, v.aux)))
+		require.NoError(t, err)
+		assert.Equal(t, fromHex(t, v.sig), sig, "vector %d", i)
+		assert.NoError(t, Verify(public, msg, sig), "vector %d", i)
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	msg := []byte("Hello Taproot")
+	var stream cipher.Stream = random.New()
+	for i := 0; i < 8; i++ {
+		// Half of the keys have an odd y-coordinate.
+		private := group.Scalar().Pick(stream)
+		P := group.Point().Mul(private, nil)
+		public, err := PublicKey(P)
+		require.NoError(t, err)
+
+		sig, err := Sign(private, msg, stream)
+		require.NoError(t, err)
+		require.Len(t, sig, SignatureSize)
+		assert.NoError(t, Verify(public, msg, sig))
+
+		Q, err := ParsePublicKey(public)
+		require.NoError(t, err)
+		Pb, _ := P.MarshalBinary()
+		Qb, _ := Q.MarshalBinary()
+		assert.Equal(t, Pb[1:], Qb[1:])
+		assert.Equal(t, byte(2), Qb[0])
+
+		assert.Error(t, Verify(public, []byte("Hello Bitcoin"), sig))
+		for _, j := range []int{0, 31, 32, 63} {
+			bad := append([]byte(nil), sig...)
+			bad[j] ^= 1
+			assert.Error(t, Verify(public, msg, bad), "byte %d", j)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	msg := fromHex(t, vectors[1].msg)
+	public := fromHex(t, vectors[1].public)
+	sig := fromHex(t, vectors[1].sig)
+
+	// Public key not on the curve, from test vector 5.
+	assert.Error(t, Verify(fromHex(t, "EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34"), msg, sig))
+	assert.Error(t, Verify(public[1:], msg, sig))
+	assert.Error(t, Verify(public, msg, sig[1:]))
+
+	// s equal to the curve order, from test vector 13.
+	bad := append([]byte(nil), sig...)
+	copy(bad[32:], fromHex(t, "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141"))
+	assert.Error(t, Verify(public, msg, bad))
+
+	// r equal to the field size, from test vector 12.
+	bad = append([]byte(nil), sig...)
+	copy(bad, fromHex(t, "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F"))
+	assert.Error(t, Verify(public, msg, bad))
+
+	_, err := PublicKey(group.Point().Null())
+	assert.Error(t, err)
+	_, err = Sign(group.Scalar().Zero(), msg, random.New())
+	assert.Error(t, err)
+}
@@ -0,0 +1,397 @@
+// Package blind implements blind Schnorr signatures, with which a user
+// obtains the signature of a message from a signer without revealing the
+// message to it. The signer cannot link a signature to the session that
+// produced it, which makes blind signatures the basis of anonymous tokens
+// and e-cash.
+//
+// A signing session takes three moves:
+//
+//  1. the signer opens a session with Signer.Commit and sends the
+//     commitment R to the user;
+//  2. the user blinds the commitment and derives a blinded challenge c from
+//     it with User.Challenge, which it sends to the signer;
+//  3. the signer answers the challenge with Signer.Respond, and the user
+//     unblinds the response into a signature with User.Finalize.
+//
+// The resulting signatures are ordinary Schnorr signatures, verified with
+// package sign/schnorr.
+//
+// For partially blind signatures, the signer binds a public info tag, such
+// as an expiry date or a denomination, into the signature, with the scheme
+// of Abe and Okamoto (https://doi.org/10.1007/3-540-44598-6_17). The tag is
+// hashed to a point Z of unknown discrete logarithm, and the signature is
+// the OR-proof of the knowledge of the private key of the signer or of that
+// of Z. The signer knows the first and simulates the second, so that the
+// tag enters the signing equation through Z and a response obtained for one
+// tag cannot be turned into a signature for another. The signer opens such
+// sessions with Signer.CommitInfo and answers them with Signer.RespondInfo,
+// the user obtains the signature with a PartialUser, and Verify checks it.
+//
+// Blind and partially blind Schnorr signatures are only secure if few
+// sessions are open at the same time: with l concurrent sessions, the
+// attack of Benhamouda et al. on the ROS problem
+// (https://eprint.iacr.org/2020/945) forges l+1 signatures in polynomial
+// time once l exceeds the bit length of the group order, and Wagner's
+// algorithm needs subexponential time for smaller l. A Signer thus limits
+// the number of its open sessions, and running them one at a time gives
+// the best security.
+package blind
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/sign/schnorr"
+)
+
+// Suite represents the set of functionalities needed by the package blind.
+type Suite interface {
+	kyber.Group
+	kyber.XOFFactory
+	kyber.Random
+}
+
+var (
+	errTooManySessions = errors.New("blind: too many open sessions")
+	errUnknownSession  = errors.New("blind: unknown session")
+	errSessionKind     = errors.New("blind: session of another kind")
+)
+
+// Commitment is the first message of a session, sent by the signer.
+type Commitment struct {
+	Session uint64
+	R       kyber.Point
+}
+
+// PartialCommitment is the first message of a partially blind session,
+// sent by the signer: A = u*G and B = s*G + d*Z, where Z is the point of the
+// info tag.
+type PartialCommitment struct {
+	Session uint64
+	A, B    kyber.Point
+}
+
+// PartialResponse is the last message of a partially blind session, sent by
+// the signer. It satisfies A = R*G + C*X, B = S*G + D*Z and C + D = E, where
+// E is the blinded challenge of the user.
+type PartialResponse struct {
+	R, C, S, D kyber.Scalar
+}
+
+// Signer holds the private key of a signer and its open sessions.
+type Signer struct {
+	mu          sync.Mutex
+	suite       Suite
+	private     kyber.Scalar
+	maxSessions int
+	next        uint64
+	sessions    map[uint64]*session
+}
+
+// session is an open session of a signer.
+type session struct {
+	k kyber.Scalar // nonce
+
+	// Simulated response and challenge of the info tag, in partially blind
+	// sessions.
+	s, d kyber.Scalar
+}
+
+// NewSigner returns a signer for the private key that allows at most
+// maxSessions sessions to be open at the same time.
+func NewSigner(suite Suite, private kyber.Scalar, maxSessions int) *Signer {
+	return &Signer{
+		suite:       suite,
+		private:     private,
+		maxSessions: maxSessions,
+		sessions:    make(map[uint64]*session),
+	}
+}
+
+// Commit opens a session and returns its commitment, to be sent to the
+// user. It fails if the maximum number of open sessions is reached.
+func (s *Signer) Commit() (*Commitment, error) {
+	k := s.suite.Scalar().Pick(s.suite.RandomStream())
+	id, err := s.open(&session{k: k})
+	if err != nil {
+		return nil, err
+	}
+	return &Commitment{Session: id, R: s.suite.Point().Mul(k, nil)}, nil
+}
+
+// CommitInfo opens a session of a partially blind signature bound to the
+// public info tag, and returns its commitment.
+func (s *Signer) CommitInfo(info []byte) (*PartialCommitment, error) {
+	rand := s.suite.RandomStream()
+	open := &session{
+		k: s.suite.Scalar().Pick(rand),
+		s: s.suite.Scalar().Pick(rand),
+		d: s.suite.Scalar().Pick(rand),
+	}
+	id, err := s.open(open)
+	if err != nil {
+		return nil, err
+	}
+	// A = u*G, B = s*G + d*Z
+	Z := tagPoint(s.suite, info)
+	B := s.suite.Point().Add(s.suite.Point().Mul(open.s, nil), s.suite.Point().Mul(open.d, Z))
+	return &PartialCommitment{Session: id, A: s.suite.Point().Mul(open.k, nil), B: B}, nil
+}
+
+// open records the session and returns its identifier.
+func (s *Signer) open(open *session) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.sessions) >= s.maxSessions {
+		return 0, errTooManySessions
+	}
+	id := s.next
+	s.next++
+	s.sessions[id] = open
+	return id, nil
+}
+
+// close closes the given session and returns it, if it is of the given
+// kind.
+func (s *Signer) close(id uint64, partial bool) (*session, error) {
+	s.mu.Lock()
+	open, ok := s.sessions[id]
+	delete(s.sessions, id)
+	s.mu.Unlock()
+	if !ok {
+		return nil, errUnknownSession
+	}
+	if (open.s != nil) != partial {
+		return nil, errSessionKind
+	}
+	return open, nil
+}
+
+// Respond answers the blinded challenge c of the user in the given session,
+// and closes the session.
+func (s *Signer) Respond(session uint64, c kyber.Scalar) (kyber.Scalar, error) {
+	open, err := s.close(session, false)
+	if err != nil {
+		return nil, err
+	}
+	// s = k + c*x
+	return s.suite.Scalar().Add(open.k, s.suite.Scalar().Mul(c, s.private)), nil
+}
+
+// RespondInfo answers the blinded challenge e of the user in the given
+// partially blind session, and closes the session.
+func (s *Signer) RespondInfo(session uint64, e kyber.Scalar) (*PartialResponse, error) {
+	open, err := s.close(session, true)
+	if err != nil {
+		return nil, err
+	}
+	// c = e - d, r = u - c*x
+	c := s.suite.Scalar().Sub(e, open.d)
+	r := s.suite.Scalar().Sub(open.k, s.suite.Scalar().Mul(c, s.private))
+	return &PartialResponse{R: r, C: c, S: open.s, D: open.d}, nil
+}
+
+// Abort closes the given session without answering it.
+func (s *Signer) Abort(session uint64) {
+	s.mu.Lock()
+	delete(s.sessions, session)
+	s.mu.Unlock()
+}
+
+// User holds the state of a user obtaining the signature of a message in
+// a session.
+type User struct {
+	suite  Suite
+	public kyber.Point
+	msg    []byte
+
+	// Blinding factors, and the values of the session.
+	alpha, beta kyber.Scalar
+	R, RPrm     kyber.Point
+	c           kyber.Scalar
+}
+
+// NewUser returns a user that obtains the signature of msg under the
+// public key from one session.
+func NewUser(suite Suite, public kyber.Point, msg []byte) *User {
+	return &User{suite: suite, public: public, msg: msg}
+}
+
+// Challenge blinds the commitment R of the signer and returns the blinded
+// challenge, to be sent to the signer.
+func (u *User) Challenge(R kyber.Point) (kyber.Scalar, error) {
+	rand := u.suite.RandomStream()
+	u.alpha = u.suite.Scalar().Pick(rand)
+	u.beta = u.suite.Scalar().Pick(rand)
+	u.R = R
+	// R' = R + alpha*G + beta*X
+	u.RPrm = u.suite.Point().Add(R, u.suite.Point().Mul(u.alpha, nil))
+	u.RPrm.Add(u.RPrm, u.suite.Point().Mul(u.beta, u.public))
+	// c = H(R' || X || msg) + beta
+	h, err := schnorr.Challenge(u.suite, u.public, u.RPrm, u.msg)
+	if err != nil {
+		return nil, err
+	}
+	u.c = u.suite.Scalar().Add(h, u.beta)
+	return u.c, nil
+}
+
+// Finalize checks the response s of the signer and unblinds it into the
+// Schnorr signature of the message.
+func (u *User) Finalize(s kyber.Scalar) ([]byte, error) {
+	if u.c == nil {
+		return nil, errors.New("blind: challenge not computed")
+	}
+	// s*G = R + c*X
+	rhs := u.suite.Point().Add(u.R, u.suite.Point().Mul(u.c, u.public))
+	if !u.suite.Point().Mul(s, nil).Equal(rhs) {
+		return nil, errors.New("blind: invalid response")
+	}
+	// s' = s + alpha
+	sPrm := u.suite.Scalar().Add(s, u.alpha)
+	R, err := u.RPrm.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	sb, err := sPrm.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	sig := append(R, sb...)
+	if err := schnorr.Verify(u.suite, u.public, u.msg, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// PartialUser holds the state of a user obtaining the partially blind
+// signature of a message, bound to a public info tag, in a session.
+type PartialUser struct {
+	suite  Suite
+	public kyber.Point
+	Z      kyber.Point // point of the info tag
+	info   []byte
+	msg    []byte
+
+	// Blinding factors, and the values of the session.
+	t1, t2, t3, t4 kyber.Scalar
+	A, B           kyber.Point
+	e              kyber.Scalar
+}
+
+// NewPartialUser returns a user that obtains the partially blind signature
+// of msg bound to the public info tag, from a session opened with
+// Signer.CommitInfo.
+func NewPartialUser(suite Suite, public kyber.Point, info, msg []byte) *PartialUser {
+	return &PartialUser{suite: suite, public: public, Z: tagPoint(suite, info), info: info, msg: msg}
+}
+
+// Challenge blinds the commitment of the signer and returns the blinded
+// challenge, to be sent to the signer.
+func (u *PartialUser) Challenge(com *PartialCommitment) (kyber.Scalar, error) {
+	if com == nil || com.A == nil || com.B == nil {
+		return nil, errors.New("blind: missing commitment")
+	}
+	rand := u.suite.RandomStream()
+	u.t1 = u.suite.Scalar().Pick(rand)
+	u.t2 = u.suite.Scalar().Pick(rand)
+	u.t3 = u.suite.Scalar().Pick(rand)
+	u.t4 = u.suite.Scalar().Pick(rand)
+	u.A, u.B = com.A, com.B
+	// A' = A + t1*G + t2*X, B' = B + t3*G + t4*Z
+	APrm := u.suite.Point().Add(com.A, u.suite.Point().Mul(u.t1, nil))
+	APrm.Add(APrm, u.suite.Point().Mul(u.t2, u.public))
+	BPrm := u.suite.Point().Add(com.B, u.suite.Point().Mul(u.t3, nil))
+	BPrm.Add(BPrm, u.suite.Point().Mul(u.t4, u.Z))
+	// e = H(X, info, msg, A', B') - t2 - t4
+	e := partialChallenge(u.suite, u.public, u.info, u.msg, APrm, BPrm)
+	e.Sub(e, u.t2)
+	u.e = e.Sub(e, u.t4)
+	return u.e, nil
+}
+
+// Finalize checks the response of the signer and unblinds it into the
+// partially blind signature of the message.
+func (u *PartialUser) Finalize(resp *PartialResponse) ([]byte, error) {
+	if u.e == nil {
+		return nil, errors.New("blind: challenge not computed")
+	}
+	if resp == nil || resp.R == nil || resp.C == nil || resp.S == nil || resp.D == nil {
+		return nil, errors.New("blind: missing response")
+	}
+	// A = R*G + C*X, B = S*G + D*Z, C + D = e
+	A := u.suite.Point().Add(u.suite.Point().Mul(resp.R, nil), u.suite.Point().Mul(resp.C, u.public))
+	B := u.suite.Point().Add(u.suite.Point().Mul(resp.S, nil), u.suite.Point().Mul(resp.D, u.Z))
+	if !A.Equal(u.A) || !B.Equal(u.B) || !u.suite.Scalar().Add(resp.C, resp.D).Equal(u.e) {
+		return nil, errors.New("blind: invalid response")
+	}
+	// rho = R + t1, omega = C + t2, sigma = S + t3, delta = D + t4
+	var sig []byte
+	for _, v := range []kyber.Scalar{
+		u.suite.Scalar().Add(resp.R, u.t1),
+		u.suite.Scalar().Add(resp.C, u.t2),
+		u.suite.Scalar().Add(resp.S, u.t3),
+		u.suite.Scalar().Add(resp.D, u.t4),
+	} {
+		b, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		sig = append(sig, b...)
+	}
+	if err := Verify(u.suite, u.public, u.info, u.msg, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// Verify checks the partially blind signature sig of msg, bound to the info
+// tag, under the public key of the signer. The signature is made of the
+// scalars rho, omega, sigma and delta, and is valid if
+// omega + delta = H(X, info, msg, rho*G + omega*X, sigma*G + delta*Z).
+func Verify(suite Suite, public kyber.Point, info, msg, sig []byte) error {
+	n := suite.ScalarLen()
+	if len(sig) != 4*n {
+		return errors.New("blind: signature of invalid length")
+	}
+	v := make([]kyber.Scalar, 4)
+	for i := range v {
+		v[i] = suite.Scalar()
+		if err := v[i].UnmarshalBinary(sig[i*n : (i+1)*n]); err != nil {
+			return err
+		}
+	}
+	rho, omega, sigma, delta := v[0], v[1], v[2], v[3]
+	A := suite.Point().Add(suite.Point().Mul(rho, nil), suite.Point().Mul(omega, public))
+	B := suite.Point().Add(suite.Point().Mul(sigma, nil), suite.Point().Mul(delta, tagPoint(suite, info)))
+	e := partialChallenge(suite, public, info, msg, A, B)
+	if !e.Equal(suite.Scalar().Add(omega, delta)) {
+		return errors.New("blind: invalid signature")
+	}
+	return nil
+}
+
+// partialChallenge returns the challenge of a partially blind signature.
+func partialChallenge(suite Suite, public kyber.Point, info, msg []byte, A, B kyber.Point) kyber.Scalar {
+	t := transcript.New(suite, "blind partially blind signature")
+	t.AppendPoints("public", public)
+	t.Append("info", info)
+	t.Append("message", msg)
+	t.AppendPoints("commitments", A, B)
+	return t.Challenge("challenge")
+}
+
+// tagPoint returns the point Z of the info tag, whose discrete logarithm is
+// unknown. It uses the hash_to_curve of RFC 9380 if the points of the suite
+// implement kyber.HashablePoint, and otherwise picks the point from the XOF
+// of the suite keyed with the tag.
+func tagPoint(suite Suite, info []byte) kyber.Point {
+	const dst = "blind info tag"
+	Z := suite.Point()
+	if hp, ok := Z.(kyber.HashablePoint); ok {
+		return hp.HashToPoint(info, []byte(dst))
+	}
+	return Z.Pick(suite.XOF(append([]byte(dst), info...)))
+}
@@ -0,0 +1,209 @@
+package blind
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+
+	"github.com/dedis/kyber/sign/eddsa"
+	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+// run runs a whole signing session and returns the signature.
+func run(t *testing.T, signer *Signer, user *User) []byte {
+	com, err := signer.Commit()
+	require.NoError(t, err)
+	c, err := user.Challenge(com.R)
+	require.NoError(t, err)
+	s, err := signer.Respond(com.Session, c)
+	require.NoError(t, err)
+	sig, err := user.Finalize(s)
+	require.NoError(t, err)
+	return sig
+}
+
+func TestBlind(t *testing.T) {
+	msg := []byte("Hello blind Schnorr")
+	for _, s := range []Suite{suite, edwards25519.NewBlakeSHA256Ristretto255()} {
+		x := s.Scalar().Pick(s.RandomStream())
+		X := s.Point().Mul(x, nil)
+		sig := run(t, NewSigner(s, x, 1), NewUser(s, X, msg))
+		require.NoError(t, schnorr.Verify(s, X, msg, sig))
+		require.Error(t, schnorr.Verify(s, X, []byte("other"), sig))
+	}
+}
+
+func TestEdDSA(t *testing.T) {
+	e := eddsa.NewEdDSA(suite.RandomStream())
+	msg := []byte("Hello blind EdDSA")
+	sig := run(t, NewSigner(suite, e.Secret, 1), NewUser(suite, e.Public, msg))
+	require.NoError(t, eddsa.Verify(e.Public, msg, sig))
+}
+
+func TestUnlinkable(t *testing.T) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X := suite.Point().Mul(x, nil)
+	msg := []byte("Hello blind Schnorr")
+	signer := NewSigner(suite, x, 1)
+	user := NewUser(suite, X, msg)
+
+	com, err := signer.Commit()
+	require.NoError(t, err)
+	c, err := user.Challenge(com.R)
+	require.NoError(t, err)
+	s, err := signer.Respond(com.Session, c)
+	require.NoError(t, err)
+	sig, err := user.Finalize(s)
+	require.NoError(t, err)
+
+	// The signature shares neither the commitment, nor the challenge, nor
+	// the response of the session.
+	R, err := com.R.MarshalBinary()
+	require.NoError(t, err)
+	sb, err := s.MarshalBinary()
+	require.NoError(t, err)
+	size := com.R.MarshalSize()
+	require.NotEqual(t, R, sig[:size])
+	require.NotEqual(t, sb, sig[size:])
+	h, err := schnorr.Challenge(suite, X, com.R, msg)
+	require.NoError(t, err)
+	require.False(t, h.Equal(c))
+}
+
+func TestSessions(t *testing.T) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X := suite.Point().Mul(x, nil)
+	signer := NewSigner(suite, x, 2)
+
+	com1, err := signer.Commit()
+	require.NoError(t, err)
+	com2, err := signer.Commit()
+	require.NoError(t, err)
+	_, err = signer.Commit()
+	require.Error(t, err)
+
+	// An answered session is closed and cannot be answered again.
+	user := NewUser(suite, X, []byte("one"))
+	c, err := user.Challenge(com1.R)
+	require.NoError(t, err)
+	_, err = signer.Respond(com1.Session, c)
+	require.NoError(t, err)
+	_, err = signer.Respond(com1.Session, c)
+	require.Error(t, err)
+
+	// An aborted session is closed too.
+	signer.Abort(com2.Session)
+	_, err = signer.Respond(com2.Session, c)
+	require.Error(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = signer.Commit()
+		require.NoError(t, err)
+	}
+}
+
+func TestInvalidResponse(t *testing.T) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X := suite.Point().Mul(x, nil)
+	signer := NewSigner(suite, x, 1)
+	user := NewUser(suite, X, []byte("Hello blind Schnorr"))
+
+	_, err := user.Finalize(suite.Scalar().One())
+	require.Error(t, err)
+
+	com, err := signer.Commit()
+	require.NoError(t, err)
+	c, err := user.Challenge(com.R)
+	require.NoError(t, err)
+	s, err := signer.Respond(com.Session, c)
+	require.NoError(t, err)
+	_, err = user.Finalize(suite.Scalar().Add(s, suite.Scalar().One()))
+	require.Error(t, err)
+}
+
+// runPartial runs a whole partially blind session for the info tag of the
+// signer, and returns the response of the signer.
+func runPartial(t *testing.T, signer *Signer, info []byte, user *PartialUser) *PartialResponse {
+	com, err := signer.CommitInfo(info)
+	require.NoError(t, err)
+	e, err := user.Challenge(com)
+	require.NoError(t, err)
+	resp, err := signer.RespondInfo(com.Session, e)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestPartiallyBlind(t *testing.T) {
+	msg := []byte("Hello partially blind Schnorr")
+	info := []byte("expires 2030-01-01")
+	for _, s := range []Suite{suite, edwards25519.NewBlakeSHA256Ristretto255()} {
+		x := s.Scalar().Pick(s.RandomStream())
+		X := s.Point().Mul(x, nil)
+		user := NewPartialUser(s, X, info, msg)
+		sig, err := user.Finalize(runPartial(t, NewSigner(s, x, 1), info, user))
+		require.NoError(t, err)
+
+		require.NoError(t, Verify(s, X, info, msg, sig))
+		require.Error(t, Verify(s, X, []byte("expires 2040-01-01"), msg, sig))
+		require.Error(t, Verify(s, X, info, []byte("other"), sig))
+		require.Error(t, Verify(s, s.Point().Mul(s.Scalar().One(), nil), info, msg, sig))
+		require.Error(t, Verify(s, X, info, msg, sig[1:]))
+	}
+}
+
+func TestPartiallyBlindOtherTag(t *testing.T) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	X := suite.Point().Mul(x, nil)
+	msg := []byte("Hello partially blind Schnorr")
+	info1 := []byte("$1")
+	info2 := []byte("$100")
+	signer := NewSigner(suite, x, 1)
+
+	// A user expecting another tag rejects the response.
+	user := NewPartialUser(suite, X, info2, msg)
+	_, err := user.Finalize(runPartial(t, signer, info1, user))
+	require.Error(t, err)
+
+	// Unblinding the response as if it were made for the other tag gives
+	// no signature for it: the commitment B of the signer is bound to the
+	// point of its tag, which the user cannot change.
+	resp := runPartial(t, signer, info1, user)
+	var sig []byte
+	for _, v := range []kyber.Scalar{
+		suite.Scalar().Add(resp.R, user.t1),
+		suite.Scalar().Add(resp.C, user.t2),
+		suite.Scalar().Add(resp.S, user.t3),
+		suite.Scalar().Add(resp.D, user.t4),
+	} {
+		b, err := v.MarshalBinary()
+		require.NoError(t, err)
+		sig = append(sig, b...)
+	}
+	require.Error(t, Verify(suite, X, info2, msg, sig))
+	require.Error(t, Verify(suite, X, info1, msg, sig))
+
+}
+
+func TestSessionKinds(t *testing.T) {
+	x := suite.Scalar().Pick(suite.RandomStream())
+	signer := NewSigner(suite, x, 2)
+	com, err := signer.Commit()
+	require.NoError(t, err)
+	pcom, err := signer.CommitInfo([]byte("info"))
+	require.NoError(t, err)
+
+	one := suite.Scalar().One()
+	_, err = signer.RespondInfo(com.Session, one)
+	require.Error(t, err)
+	_, err = signer.Respond(pcom.Session, one)
+	require.Error(t, err)
+
+	user := NewPartialUser(suite, suite.Point().Mul(x, nil), []byte("info"), nil)
+	_, err = user.Challenge(&PartialCommitment{})
+	require.Error(t, err)
+	_, err = user.Finalize(&PartialResponse{})
+	require.Error(t, err)
+}
@@ -0,0 +1,275 @@
+// +build vartime
+
+// Package blindrsa implements the RSA blind signatures of RFC 9474
+// (RSABSSA), with which a user obtains the RSA signature of a message from
+// a signer without revealing the message to it. The signatures are standard
+// RSASSA-PSS signatures, which interoperate with other implementations of
+// the RFC such as those of Privacy Pass.
+//
+// A user prepares its message with Prepare and blinds it with Blind. The
+// signer signs the blinded message with BlindSign, and the user unblinds the
+// result into a signature of the prepared message with Finalize. Anyone can
+// then check the signature with Verify, or with crypto/rsa.VerifyPSS.
+//
+// Keys are those of package crypto/rsa. The package is a pure Go
+// implementation of the RFC over math/big and is not constant time, so it
+// must be compiled with the "vartime" compilation flag.
+package blindrsa
+
+import (
+	"crypto"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math/big"
+
+	// Register SHA-384 for crypto.SHA384.
+	_ "crypto/sha512"
+
+	"github.com/dedis/kyber/util/random"
+)
+
+// Variant is one of the RSABSSA variants of RFC 9474, which all use
+// SHA-384.
+type Variant int
+
+const (
+	// SHA384PSSRandomized is RSABSSA-SHA384-PSS-Randomized, the variant
+	// recommended by the RFC.
+	SHA384PSSRandomized Variant = iota
+	// SHA384PSSZeroRandomized is RSABSSA-SHA384-PSSZERO-Randomized.
+	SHA384PSSZeroRandomized
+	// SHA384PSSDeterministic is RSABSSA-SHA384-PSS-Deterministic.
+	SHA384PSSDeterministic
+	// SHA384PSSZeroDeterministic is RSABSSA-SHA384-PSSZERO-Deterministic.
+	SHA384PSSZeroDeterministic
+)
+
+// prefixSize is the length of the random prefix of the randomized
+// variants.
+const prefixSize = 32
+
+var (
+	errInvalidVariant   = errors.New("blindrsa: invalid variant")
+	errMessageTooLong   = errors.New("blindrsa: message too long")
+	errInvalidSignature = errors.New("blindrsa: invalid signature")
+)
+
+// saltSize returns the PSS salt length of the variant.
+func (v Variant) saltSize() int {
+	if v == SHA384PSSRandomized || v == SHA384PSSDeterministic {
+		return crypto.SHA384.Size()
+	}
+	return 0
+}
+
+func (v Variant) valid() bool {
+	return v >= SHA384PSSRandomized && v <= SHA384PSSZeroDeterministic
+}
+
+// Prepare returns the message to blind and to verify for msg: msg prefixed
+// by 32 random bytes for the randomized variants, and msg itself for the
+// deterministic ones.
+func Prepare(v Variant, msg []byte, rand cipher.Stream) ([]byte, error) {
+	switch v {
+	case SHA384PSSRandomized, SHA384PSSZeroRandomized:
+		prefix := make([]byte, prefixSize)
+		random.Bytes(prefix, rand)
+		return append(prefix, msg...), nil
+	case SHA384PSSDeterministic, SHA384PSSZeroDeterministic:
+		return msg, nil
+	}
+	return nil, errInvalidVariant
+}
+
+// Blind blinds the prepared message msg for the signer of the public key.
+// It returns the blinded message, to be sent to the signer, and the inverse
+// of the blinding factor, to be kept for Finalize.
+func Blind(v Variant, pub *rsa.PublicKey, msg []byte, rand cipher.Stream) ([]byte, *big.Int, error) {
+	if !v.valid() {
+		return nil, nil, errInvalidVariant
+	}
+	salt := make([]byte, v.saltSize())
+	random.Bytes(salt, rand)
+	em, err := encode(msg, pub.N.BitLen()-1, salt)
+	if err != nil {
+		return nil, nil, err
+	}
+	m := new(big.Int).SetBytes(em)
+	if new(big.Int).GCD(nil, nil, m, pub.N).Cmp(big.NewInt(1)) != 0 {
+		return nil, nil, errors.New("blindrsa: invalid message")
+	}
+	var r, inv *big.Int
+	for inv == nil {
+		r = random.Int(pub.N, rand)
+		inv = new(big.Int).ModInverse(r, pub.N)
+	}
+	// z = m * r^e mod n
+	z := verifyPrimitive(pub, r)
+	z.Mul(z, m).Mod(z, pub.N)
+	return i2osp(z, size(pub)), inv, nil
+}
+
+// BlindSign signs the blinded message with the private key, and returns the
+// blind signature, to be sent back to the user.
+func BlindSign(priv *rsa.PrivateKey, blinded []byte) ([]byte, error) {
+	pub := &priv.PublicKey
+	if len(blinded) != size(pub) {
+		return nil, errors.New("blindrsa: blinded message of invalid length")
+	}
+	m := new(big.Int).SetBytes(blinded)
+	if m.Cmp(pub.N) >= 0 {
+		return nil, errors.New("blindrsa: blinded message out of range")
+	}
+	s := new(big.Int).Exp(m, priv.D, pub.N)
+	// Check the signature against faults before releasing it.
+	if verifyPrimitive(pub, s).Cmp(m) != 0 {
+		return nil, errors.New("blindrsa: signing failure")
+	}
+	return i2osp(s, size(pub)), nil
+}
+
+// Finalize unblinds the blind signature of the prepared message msg with the
+// inverse returned by Blind, and returns the signature of msg after checking
+// it.
+func Finalize(v Variant, pub *rsa.PublicKey, msg, blindSig []byte, inv *big.Int) ([]byte, error) {
+	if len(blindSig) != size(pub) {
+		return nil, errInvalidSignature
+	}
+	z := new(big.Int).SetBytes(blindSig)
+	s := z.Mul(z, inv).Mod(z, pub.N)
+	sig := i2osp(s, size(pub))
+	if err := Verify(v, pub, msg, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// Verify checks the signature sig of the prepared message msg under the
+// public key. It returns nil iff the signature is valid.
+func Verify(v Variant, pub *rsa.PublicKey, msg, sig []byte) error {
+	if !v.valid() {
+		return errInvalidVariant
+	}
+	if len(sig) != size(pub) {
+		return errInvalidSignature
+	}
+	s := new(big.Int).SetBytes(sig)
+	if s.Cmp(pub.N) >= 0 {
+		return errInvalidSignature
+	}
+	emBits := pub.N.BitLen() - 1
+	m := verifyPrimitive(pub, s)
+	if m.BitLen() > emBits {
+		return errInvalidSignature
+	}
+	return verify(msg, i2osp(m, (emBits+7)/8), emBits, v.saltSize())
+}
+
+// size returns the length in bytes of the modulus of the public key.
+func size(pub *rsa.PublicKey) int {
+	return (pub.N.BitLen() + 7) / 8
+}
+
+// verifyPrimitive returns s^e mod n.
+func verifyPrimitive(pub *rsa.PublicKey, s *big.Int) *big.Int {
+	return new(big.Int).Exp(s, big.NewInt(int64(pub.E)), pub.N)
+}
+
+// i2osp returns the big-endian encoding of x on l bytes.
+func i2osp(x *big.Int, l int) []byte {
+	b := make([]byte, l)
+	return x.FillBytes(b)
+}
+
+// encode returns the EMSA-PSS encoding of msg on emBits bits with the given
+// salt, using SHA-384 and MGF1 with SHA-384 (RFC 8017, Section 9.1.1).
+func encode(msg []byte, emBits int, salt []byte) ([]byte, error) {
+	h := crypto.SHA384.New()
+	hLen := h.Size()
+	emLen := (emBits + 7) / 8
+	if emLen < hLen+len(salt)+2 {
+		return nil, errMessageTooLong
+	}
+	h.Write(msg)
+	mHash := h.Sum(nil)
+
+	// H = Hash(0^8 || mHash || salt)
+	h.Reset()
+	h.Write(make([]byte, 8))
+	h.Write(mHash)
+	h.Write(salt)
+	H := h.Sum(nil)
+
+	// EM = (DB xor MGF1(H)) || H || 0xbc, with DB = 0...0 || 0x01 || salt.
+	em := make([]byte, emLen)
+	db := em[:emLen-hLen-1]
+	db[len(db)-len(salt)-1] = 0x01
+	copy(db[len(db)-len(salt):], salt)
+	mgf1XOR(db, h, H)
+	db[0] &= 0xff >> uint(8*emLen-emBits)
+	copy(em[emLen-hLen-1:], H)
+	em[emLen-1] = 0xbc
+	return em, nil
+}
+
+// verify checks that em is the EMSA-PSS encoding of msg on emBits bits with
+// a salt of sLen bytes (RFC 8017, Section 9.1.2).
+func verify(msg, em []byte, emBits, sLen int) error {
+	h := crypto.SHA384.New()
+	hLen := h.Size()
+	emLen := len(em)
+	if emLen < hLen+sLen+2 || em[emLen-1] != 0xbc {
+		return errInvalidSignature
+	}
+	h.Write(msg)
+	mHash := h.Sum(nil)
+
+	db := append([]byte(nil), em[:emLen-hLen-1]...)
+	H := em[emLen-hLen-1 : emLen-1]
+	mask := byte(0xff >> uint(8*emLen-emBits))
+	if db[0]&^mask != 0 {
+		return errInvalidSignature
+	}
+	mgf1XOR(db, h, H)
+	db[0] &= mask
+	ps := len(db) - sLen - 1
+	for _, b := range db[:ps] {
+		if b != 0 {
+			return errInvalidSignature
+		}
+	}
+	if db[ps] != 0x01 {
+		return errInvalidSignature
+	}
+
+	h.Reset()
+	h.Write(make([]byte, 8))
+	h.Write(mHash)
+	h.Write(db[len(db)-sLen:])
+	if subtle.ConstantTimeCompare(h.Sum(nil), H) != 1 {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// mgf1XOR XORs out with the MGF1 mask generated from seed with h.
+func mgf1XOR(out []byte, h hash.Hash, seed []byte) {
+	var counter [4]byte
+	for done := 0; done < len(out); {
+		h.Reset()
+		h.Write(seed)
+		h.Write(counter[:])
+		for _, b := range h.Sum(nil) {
+			if done == len(out) {
+				break
+			}
+			out[done] ^= b
+			done++
+		}
+		binary.BigEndian.PutUint32(counter[:], binary.BigEndian.Uint32(counter[:])+1)
+	}
+}
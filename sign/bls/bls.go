@@ -0,0 +1,230 @@
+// Package bls implements the Boneh-Lynn-Shacham signature scheme over a
+// pairing-friendly suite, together with the aggregation of signatures and
+// public keys.
+//
+// Private keys are scalars, public keys are points of G2 and signatures are
+// points of G1, so that signatures are as short as possible. A signature of
+// a message is its hash to G1 multiplied by the private key, and is checked
+// with a pairing equation.
+//
+// Signatures of different messages, or by different signers, can be
+// aggregated into a single signature of the same size, which is checked at
+// once against all the messages and public keys. An attacker can however
+// choose its public key as a function of the keys of others, so as to forge
+// an aggregate signature that seems to involve them. This package offers
+// two defenses against such rogue keys:
+//
+// - Proofs of possession: every signer publishes, when registering its
+// public key, a proof that it knows the corresponding private key, created
+// with ProvePossession. Once the proofs are checked with VerifyPossession,
+// signatures of the same message can be verified with FastAggregateVerify.
+//
+// - Message augmentation: SignAugmented signs the public key of the signer
+// together with the message, so that the signed messages are always
+// distinct and aggregates can be verified with BatchVerifyAugmented.
+//
+// Without either, BatchVerify checks aggregate signatures only if the
+// messages are distinct.
+package bls
+
+import (
+	"crypto/cipher"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+)
+
+// Domain separation tags of the hashes to G1 of messages, augmented
+// messages and public keys for proofs of possession.
+var (
+	dst    = []byte("BLS_SIG_KYBER_G1_NUL_")
+	dstAug = []byte("BLS_SIG_KYBER_G1_AUG_")
+	dstPoP = []byte("BLS_POP_KYBER_G1_POP_")
+)
+
+var errInvalidSignature = errors.New("bls: invalid signature")
+
+// NewKeyPair creates a new BLS signing key pair. The private key x is a
+// scalar and the public key X is a point of G2.
+func NewKeyPair(suite pairing.Suite, random cipher.Stream) (kyber.Scalar, kyber.Point) {
+	x := suite.G2().Scalar().Pick(random)
+	X := suite.G2().Point().Mul(x, nil)
+	return x, X
+}
+
+// Sign creates a BLS signature S = x * H(m) of the message msg using the
+// private key x. The signature is a point of G1, in its binary encoding.
+func Sign(suite pairing.Suite, x kyber.Scalar, msg []byte) ([]byte, error) {
+	HM := pairing.HashToPoint(suite, suite.G1(), dst, msg)
+	xHM := HM.Mul(x, HM)
+	return xHM.MarshalBinary()
+}
+
+// Verify checks the given BLS signature S of the message msg using the
+// public key X, by verifying that e(H(m), X) == e(S, B2), where B2 is the
+// base point of G2. It returns nil if the signature is valid.
+func Verify(suite pairing.Suite, X kyber.Point, msg, sig []byte) error {
+	return verify(suite, dst, []kyber.Point{X}, [][]byte{msg}, sig)
+}
+
+// AggregateSignatures combines signatures created with Sign into a single
+// signature, which is valid for the messages and public keys of all of
+// them.
+func AggregateSignatures(suite pairing.Suite, sigs ...[]byte) ([]byte, error) {
+	sum := suite.G1().Point().Null()
+	for _, sig := range sigs {
+		S := suite.G1().Point()
+		if err := S.UnmarshalBinary(sig); err != nil {
+			return nil, err
+		}
+		sum.Add(sum, S)
+	}
+	return sum.MarshalBinary()
+}
+
+// AggregatePublicKeys combines public keys into a single public key, under
+// which an aggregate of signatures of a same message by the corresponding
+// private keys can be verified with Verify. This is only secure if every
+// public key is known to have been generated honestly.
+func AggregatePublicKeys(suite pairing.Suite, Xs ...kyber.Point) kyber.Point {
+	sum := suite.G2().Point().Null()
+	for _, X := range Xs {
+		sum.Add(sum, X)
+	}
+	return sum
+}
+
+// BatchVerify checks an aggregate signature of the messages msgs, where
+// the i-th message is signed under the i-th public key, by verifying that
+// e(H(m_1), X_1) * ... * e(H(m_n), X_n) == e(S, B2). The messages must be
+// distinct, which makes the check safe against rogue public keys. It
+// returns nil if the signature is valid.
+func BatchVerify(suite pairing.Suite, publics []kyber.Point, msgs [][]byte, sig []byte) error {
+	if !distinct(msgs) {
+		return errors.New("bls: messages are not distinct")
+	}
+	return verify(suite, dst, publics, msgs, sig)
+}
+
+// ProvePossession returns a proof that the holder of the public key
+// corresponding to the private key x knows x. It is a signature of the
+// public key in a domain of its own, which cannot be mistaken for a
+// signature of a message.
+func ProvePossession(suite pairing.Suite, x kyber.Scalar) ([]byte, error) {
+	X := suite.G2().Point().Mul(x, nil)
+	Xbuff, err := X.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	HX := pairing.HashToPoint(suite, suite.G1(), dstPoP, Xbuff)
+	return HX.Mul(x, HX).MarshalBinary()
+}
+
+// VerifyPossession checks a proof of possession of the private key of X
+// created by ProvePossession. It returns nil if the proof is valid.
+func VerifyPossession(suite pairing.Suite, X kyber.Point, proof []byte) error {
+	Xbuff, err := X.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return verify(suite, dstPoP, []kyber.Point{X}, [][]byte{Xbuff}, proof)
+}
+
+// FastAggregateVerify checks an aggregate of signatures of the same message
+// msg, created with Sign under the given public keys. It is only secure if
+// the possession of every public key has been checked with
+// VerifyPossession. It returns nil if the signature is valid.
+func FastAggregateVerify(suite pairing.Suite, publics []kyber.Point, msg, sig []byte) error {
+	if len(publics) == 0 {
+		return errors.New("bls: no public key")
+	}
+	return Verify(suite, AggregatePublicKeys(suite, publics...), msg, sig)
+}
+
+// SignAugmented creates a BLS signature of the public key of x followed by
+// the message msg. Such signatures can be aggregated and verified with
+// BatchVerifyAugmented even when the messages are not distinct.
+func SignAugmented(suite pairing.Suite, x kyber.Scalar, msg []byte) ([]byte, error) {
+	X := suite.G2().Point().Mul(x, nil)
+	aug, err := augment(X, msg)
+	if err != nil {
+		return nil, err
+	}
+	HM := pairing.HashToPoint(suite, suite.G1(), dstAug, aug)
+	return HM.Mul(x, HM).MarshalBinary()
+}
+
+// VerifyAugmented checks a signature of the message msg created with
+// SignAugmented under the public key X. It returns nil if the signature is
+// valid.
+func VerifyAugmented(suite pairing.Suite, X kyber.Point, msg, sig []byte) error {
+	return BatchVerifyAugmented(suite, []kyber.Point{X}, [][]byte{msg}, sig)
+}
+
+// BatchVerifyAugmented checks an aggregate of signatures created with
+// SignAugmented, where the i-th message is signed under the i-th public
+// key. It returns nil if the signature is valid.
+func BatchVerifyAugmented(suite pairing.Suite, publics []kyber.Point, msgs [][]byte, sig []byte) error {
+	if len(publics) != len(msgs) {
+		return errors.New("bls: inconsistent number of public keys and messages")
+	}
+	augs := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		aug, err := augment(publics[i], msg)
+		if err != nil {
+			return err
+		}
+		augs[i] = aug
+	}
+	return verify(suite, dstAug, publics, augs, sig)
+}
+
+// augment returns the encoding of X followed by msg.
+func augment(X kyber.Point, msg []byte) ([]byte, error) {
+	Xbuff, err := X.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(Xbuff, msg...), nil
+}
+
+// verify checks that sig is an aggregate signature of msgs under publics,
+// with messages hashed using the domain separation tag dst.
+func verify(suite pairing.Suite, dst []byte, publics []kyber.Point, msgs [][]byte, sig []byte) error {
+	if len(publics) != len(msgs) {
+		return errors.New("bls: inconsistent number of public keys and messages")
+	}
+	if len(msgs) == 0 {
+		return errors.New("bls: no message to verify")
+	}
+	S := suite.G1().Point()
+	if err := S.UnmarshalBinary(sig); err != nil {
+		return err
+	}
+	null := suite.G2().Point().Null()
+	p1 := []kyber.Point{S.Neg(S)}
+	p2 := []kyber.Point{suite.G2().Point().Base()}
+	for i, X := range publics {
+		if X.Equal(null) {
+			return errors.New("bls: public key is the identity")
+		}
+		p1 = append(p1, pairing.HashToPoint(suite, suite.G1(), dst, msgs[i]))
+		p2 = append(p2, X)
+	}
+	if !suite.PairingCheck(p1, p2) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+func distinct(msgs [][]byte) bool {
+	seen := make(map[string]bool, len(msgs))
+	for _, msg := range msgs {
+		if seen[string(msg)] {
+			return false
+		}
+		seen[string(msg)] = true
+	}
+	return true
+}
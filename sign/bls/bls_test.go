@@ -0,0 +1,165 @@
+// +build vartime
+
+package bls
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+func TestBLS(t *testing.T) {
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	private, public := NewKeyPair(suite, random.New())
+	sig, err := Sign(suite, private, msg)
+	require.Nil(t, err)
+	require.Nil(t, Verify(suite, public, msg, sig))
+
+	require.Error(t, Verify(suite, public, []byte("Hello"), sig))
+	_, other := NewKeyPair(suite, random.New())
+	require.Error(t, Verify(suite, other, msg, sig))
+	require.Error(t, Verify(suite, suite.G2().Point().Null(), msg, sig))
+	require.Error(t, Verify(suite, public, msg, sig[1:]))
+}
+
+func TestBLSAggregateSameMessage(t *testing.T) {
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	private1, public1 := NewKeyPair(suite, random.New())
+	private2, public2 := NewKeyPair(suite, random.New())
+	sig1, err := Sign(suite, private1, msg)
+	require.Nil(t, err)
+	sig2, err := Sign(suite, private2, msg)
+	require.Nil(t, err)
+
+	aggSig, err := AggregateSignatures(suite, sig1, sig2)
+	require.Nil(t, err)
+	aggPub := AggregatePublicKeys(suite, public1, public2)
+	require.Nil(t, Verify(suite, aggPub, msg, aggSig))
+
+	// The aggregate signature is not valid for a single key.
+	require.Error(t, Verify(suite, public1, msg, aggSig))
+}
+
+func TestBLSBatchVerify(t *testing.T) {
+	n := 4
+	publics := make([]kyber.Point, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		var private kyber.Scalar
+		private, publics[i] = NewKeyPair(suite, random.New())
+		msgs[i] = []byte{byte(i)}
+		sig, err := Sign(suite, private, msgs[i])
+		require.Nil(t, err)
+		sigs[i] = sig
+	}
+	aggSig, err := AggregateSignatures(suite, sigs...)
+	require.Nil(t, err)
+	require.Nil(t, BatchVerify(suite, publics, msgs, aggSig))
+
+	// Messages must match their keys, and be distinct.
+	msgs[0], msgs[1] = msgs[1], msgs[0]
+	require.Error(t, BatchVerify(suite, publics, msgs, aggSig))
+	msgs[0] = msgs[1]
+	require.Error(t, BatchVerify(suite, publics, msgs, aggSig))
+	require.Error(t, BatchVerify(suite, publics[1:], msgs, aggSig))
+	require.Error(t, BatchVerify(suite, nil, nil, aggSig))
+}
+
+func BenchmarkSign(b *testing.B) {
+	private, _ := NewKeyPair(suite, random.New())
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Sign(suite, private, msg)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	private, public := NewKeyPair(suite, random.New())
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	sig, _ := Sign(suite, private, msg)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(suite, public, msg, sig)
+	}
+}
+
+func TestBLSProofOfPossession(t *testing.T) {
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	n := 3
+	publics := make([]kyber.Point, n)
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		var private kyber.Scalar
+		private, publics[i] = NewKeyPair(suite, random.New())
+		proof, err := ProvePossession(suite, private)
+		require.Nil(t, err)
+		require.Nil(t, VerifyPossession(suite, publics[i], proof))
+		_, other := NewKeyPair(suite, random.New())
+		require.Error(t, VerifyPossession(suite, other, proof))
+
+		// A proof of possession is not a signature of the public key.
+		Xbuff, _ := publics[i].MarshalBinary()
+		require.Error(t, Verify(suite, publics[i], Xbuff, proof))
+
+		sigs[i], err = Sign(suite, private, msg)
+		require.Nil(t, err)
+	}
+	aggSig, err := AggregateSignatures(suite, sigs...)
+	require.Nil(t, err)
+	require.Nil(t, FastAggregateVerify(suite, publics, msg, aggSig))
+	require.Error(t, FastAggregateVerify(suite, publics[1:], msg, aggSig))
+	require.Error(t, FastAggregateVerify(suite, nil, msg, aggSig))
+}
+
+func TestBLSRogueKey(t *testing.T) {
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	_, honest := NewKeyPair(suite, random.New())
+
+	// The attacker picks X = x*B2 - honest, so that the sum of the keys
+	// is x*B2, and signs alone for both.
+	x, _ := NewKeyPair(suite, random.New())
+	rogue := suite.G2().Point().Mul(x, nil)
+	rogue.Sub(rogue, honest)
+	sig, err := Sign(suite, x, msg)
+	require.Nil(t, err)
+	require.Nil(t, FastAggregateVerify(suite, []kyber.Point{honest, rogue}, msg, sig))
+
+	// Distinct messages are required by BatchVerify, and the attacker
+	// cannot prove possession of its key.
+	require.Error(t, BatchVerify(suite, []kyber.Point{honest, rogue}, [][]byte{msg, msg}, sig))
+	proof, err := ProvePossession(suite, x)
+	require.Nil(t, err)
+	require.Error(t, VerifyPossession(suite, rogue, proof))
+}
+
+func TestBLSAugmented(t *testing.T) {
+	msg := []byte("Hello Boneh-Lynn-Shacham")
+	n := 3
+	publics := make([]kyber.Point, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		var private kyber.Scalar
+		private, publics[i] = NewKeyPair(suite, random.New())
+		msgs[i] = msg
+		sig, err := SignAugmented(suite, private, msg)
+		require.Nil(t, err)
+		require.Nil(t, VerifyAugmented(suite, publics[i], msg, sig))
+		require.Error(t, Verify(suite, publics[i], msg, sig))
+		sigs[i] = sig
+	}
+	aggSig, err := AggregateSignatures(suite, sigs...)
+	require.Nil(t, err)
+	require.Nil(t, BatchVerifyAugmented(suite, publics, msgs, aggSig))
+
+	msgs[2] = []byte("Hello")
+	require.Error(t, BatchVerifyAugmented(suite, publics, msgs, aggSig))
+	require.Error(t, BatchVerifyAugmented(suite, publics[1:], msgs, aggSig))
+}
@@ -36,10 +36,18 @@ response r_i = v_i + c*a_i and sends it back to the leader.
 P' or a timer has run out. If he has not enough replies he aborts. Finally,
 the leader computes the aggregate response r = \sum{j ∈ P'}(r_j) and publishes
 (V,r,Z) as the signature for the message M.
+
+For large sets of participants, the phases can instead be run over a tree
+rooted at the leader (see Tree and Node), where every node aggregates the
+commitments and responses of its subtree before passing them to its parent.
+Nodes that do not commit in time are left out of the signature and marked
+as absent in its participation bitmask, which the verification policy then
+judges.
 */
 package cosi
 
 import (
+	"crypto/sha512"
 	"errors"
 	"fmt"
 
@@ -84,7 +92,7 @@ func Challenge(suite Suite, commitment, public kyber.Point, message []byte) (kyb
 	if message == nil {
 		return nil, errors.New("no message provided")
 	}
-	hash := suite.Hash()
+	hash := sha512.New()
 	if _, err := commitment.MarshalTo(hash); err != nil {
 		return nil, err
 	}
@@ -92,7 +100,9 @@ func Challenge(suite Suite, commitment, public kyber.Point, message []byte) (kyb
 		return nil, err
 	}
 	hash.Write(message)
-	return suite.Scalar().SetBytes(hash.Sum(nil)), nil
+	var wide [64]byte
+	hash.Sum(wide[:0])
+	return kyber.SetBytesWide(suite.Scalar(), wide), nil
 }
 
 // Response creates the response from the given random scalar v, (collective)
@@ -188,24 +198,18 @@ func Verify(suite Suite, publics []kyber.Point, message, sig []byte, policy Poli
 	}
 	mask.SetMask(sig[lenRes:])
 	A := mask.AggregatePublic
-	ABuff, err := A.MarshalBinary()
-	if err != nil {
-		return errors.New("marshalling of aggregate public key failed")
-	}
 
 	// Recompute the challenge
-	hash := suite.Hash()
-	hash.Write(VBuff)
-	hash.Write(ABuff)
-	hash.Write(message)
-	buff := hash.Sum(nil)
-	k := suite.Scalar().SetBytes(buff)
+	k, err := Challenge(suite, V, A, message)
+	if err != nil {
+		return err
+	}
 
 	// k * -aggPublic + s * B = k*-A + s*B
 	// from s = k * a + r => s * B = k * a * B + r * B <=> s*B = k*A + r*B
 	// <=> s*B + k*-A = r*B
 	minusPublic := suite.Point().Neg(A)
-	kA := suite.Point().Mul(k, minusPublic)
+	kA := kyber.VarTimePoint(suite).Mul(k, minusPublic)
 	sB := suite.Point().Mul(r, nil)
 	left := suite.Point().Add(kA, sB)
 
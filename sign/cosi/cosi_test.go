@@ -9,6 +9,7 @@ import (
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/sign/eddsa"
 	"github.com/dedis/kyber/util/key"
 	"github.com/dedis/kyber/xof/blake"
 )
@@ -106,6 +107,11 @@ func TestCoSi(t *testing.T) {
 		if err := Verify(testSuite, publics, message, sig, nil); err != nil {
 			t.Fatal(err)
 		}
+		// Without the mask, the signature is an Ed25519 signature of the
+		// aggregate public key.
+		if err := eddsa.Verify(masks[i].AggregatePublic, message, sig[:64]); err != nil {
+			t.Fatal(err)
+		}
 	}
 }
 
@@ -250,3 +256,229 @@ func TestMask(t *testing.T) {
 		}
 	}
 }
+
+// treeNodes returns the nodes of n participants over a tree with the given
+// branching factor.
+func treeNodes(t *testing.T, n, branching int) ([]kyber.Point, *Tree, []*Node) {
+	tree, err := NewTree(n, branching)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kps []*key.Pair
+	var publics []kyber.Point
+	for i := 0; i < n; i++ {
+		kp := key.NewKeyPair(testSuite)
+		kps = append(kps, kp)
+		publics = append(publics, kp.Public)
+	}
+	var nodes []*Node
+	for i, kp := range kps {
+		node, err := NewNode(testSuite, tree, publics, i, kp.Private)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, node)
+	}
+	return publics, tree, nodes
+}
+
+// commitTree runs the commitment phase bottom-up, leaving out the absent
+// participants.
+func commitTree(t *testing.T, tree *Tree, nodes []*Node, absent map[int]bool) {
+	commits := make([]*TreeCommitment, len(nodes))
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if absent[i] {
+			continue
+		}
+		var children []*TreeCommitment
+		for _, c := range tree.Children(i) {
+			if commits[c] != nil {
+				children = append(children, commits[c])
+			}
+		}
+		com, err := nodes[i].Commit(children)
+		if err != nil {
+			t.Fatal(err)
+		}
+		commits[i] = com
+	}
+}
+
+// respondTree runs the response phase bottom-up among the participants of
+// the challenge, letting tamper modify the responses, and returns the
+// response of the leader or the first error.
+func respondTree(tree *Tree, nodes []*Node, publics []kyber.Point, message []byte, ch *TreeChallenge, tamper func(*TreeResponse)) (*TreeResponse, error) {
+	mask, err := NewMask(testSuite, publics, nil)
+	if err != nil {
+		return nil, err
+	}
+	mask.SetMask(ch.Mask)
+	responses := make([]*TreeResponse, len(nodes))
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if ok, _ := mask.IndexEnabled(i); !ok {
+			continue
+		}
+		var children []*TreeResponse
+		for _, c := range tree.Children(i) {
+			if responses[c] != nil {
+				children = append(children, responses[c])
+			}
+		}
+		r, err := nodes[i].Respond(message, ch, children)
+		if err != nil {
+			return nil, err
+		}
+		if tamper != nil {
+			tamper(r)
+		}
+		responses[i] = r
+	}
+	return responses[0], nil
+}
+
+func TestTree(t *testing.T) {
+	tree, err := NewTree(10, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Parent(0) != -1 || tree.Parent(4) != 1 || tree.Parent(9) != 2 {
+		t.Fatal("wrong parents")
+	}
+	if len(tree.Children(0)) != 3 || len(tree.Children(3)) != 0 {
+		t.Fatal("wrong children")
+	}
+	if len(tree.Subtree(0)) != 10 || len(tree.Subtree(1)) != 4 || len(tree.Subtree(3)) != 1 {
+		t.Fatal("wrong subtrees")
+	}
+	if _, err := NewTree(0, 2); err == nil {
+		t.Fatal("empty tree accepted")
+	}
+}
+
+func TestCoSiTree(t *testing.T) {
+	message := []byte("Hello World Cosi")
+	n := 13
+
+	// Participants 2 and 4 are absent, and so is the subtree of 2.
+	for _, absent := range []map[int]bool{nil, {2: true, 4: true}} {
+		publics, tree, nodes := treeNodes(t, n, 3)
+		commitTree(t, tree, nodes, absent)
+		ch, err := nodes[0].Challenge()
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, err := respondTree(tree, nodes, publics, message, ch, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := nodes[0].Signature(ch, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mask, err := NewMask(testSuite, publics, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mask.SetMask(ch.Mask)
+		if absent == nil {
+			if err := Verify(testSuite, publics, message, sig, nil); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		// The absent participants are marked in the signature.
+		for i := range publics {
+			enabled, _ := mask.IndexEnabled(i)
+			if enabled == (i == 2 || i == 4 || i >= 7 && i <= 9) {
+				t.Fatalf("wrong participation of %d", i)
+			}
+		}
+		if err := Verify(testSuite, publics, message, sig, nil); err == nil {
+			t.Fatal("incomplete signature accepted by complete policy")
+		}
+		if err := Verify(testSuite, publics, message, sig, NewThresholdPolicy(n-5)); err != nil {
+			t.Fatal(err)
+		}
+		if err := Verify(testSuite, publics, []byte("other"), sig, NewThresholdPolicy(n-5)); err == nil {
+			t.Fatal("signature of another message accepted")
+		}
+	}
+}
+
+func TestCoSiTreeFailures(t *testing.T) {
+	message := []byte("Hello World Cosi")
+	_, tree, nodes := treeNodes(t, 7, 2)
+
+	// A child claiming participants outside of its subtree is rejected.
+	if _, err := nodes[1].Commit([]*TreeCommitment{{Index: 2, V: testSuite.Point().Null(), Mask: []byte{4}}}); err == nil {
+		t.Fatal("commitment from a non-child accepted")
+	}
+	_, tree, nodes = treeNodes(t, 7, 2)
+	leaf, err := nodes[3].Commit(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf.Mask = []byte{1<<3 | 1<<5}
+	if _, err := nodes[1].Commit([]*TreeCommitment{leaf}); err == nil {
+		t.Fatal("mask outside of the subtree accepted")
+	}
+
+	// A wrong response is blamed on the root of its subtree.
+	publics, tree, nodes := treeNodes(t, 7, 2)
+	commitTree(t, tree, nodes, nil)
+	ch, err := nodes[0].Challenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = respondTree(tree, nodes, publics, message, ch, func(r *TreeResponse) {
+		if r.Index == 5 {
+			r.R.Add(r.R, testSuite.Scalar().One())
+		}
+	})
+	if err == nil || err.Error() != "invalid response from participant 5" {
+		t.Fatal("wrong response not detected:", err)
+	}
+
+	// Only the leader creates the challenge.
+	if _, err := nodes[1].Challenge(); err == nil {
+		t.Fatal("challenge created by a non-leader")
+	}
+}
+
+func TestCoSiTreeMalformed(t *testing.T) {
+	message := []byte("Hello World Cosi")
+	_, _, nodes := treeNodes(t, 3, 2)
+
+	// Malformed commitments from the network are errors, not panics.
+	for _, c := range []*TreeCommitment{
+		nil,
+		{Index: 7, V: testSuite.Point().Null(), Mask: []byte{1 << 1}},
+		{Index: -1, V: testSuite.Point().Null(), Mask: []byte{1 << 1}},
+		{Index: 1, Mask: []byte{1 << 1}},
+		{Index: 1, V: testSuite.Point().Null()},
+	} {
+		if _, err := nodes[0].Commit([]*TreeCommitment{c}); err == nil {
+			t.Fatalf("malformed commitment %v accepted", c)
+		}
+	}
+
+	publics, tree, nodes := treeNodes(t, 3, 2)
+	commitTree(t, tree, nodes, nil)
+	ch, err := nodes[0].Challenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nodes[1].Respond(message, nil, nil); err == nil {
+		t.Fatal("nil challenge accepted")
+	}
+	if _, err := nodes[0].Respond(message, ch, []*TreeResponse{nil}); err == nil {
+		t.Fatal("nil response accepted")
+	}
+	if _, err := nodes[0].Respond(message, ch, []*TreeResponse{{Index: 1}}); err == nil {
+		t.Fatal("response without a scalar accepted")
+	}
+	if _, err := respondTree(tree, nodes, publics, message, ch, nil); err != nil {
+		t.Fatal(err)
+	}
+}
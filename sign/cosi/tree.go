@@ -0,0 +1,262 @@
+package cosi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+)
+
+// Tree is a communication tree over the participants of CoSi, rooted at the
+// leader. Commitments and responses are aggregated up the tree, every node
+// summing those of its subtree, so that no node handles more than a few
+// messages however many participants there are.
+type Tree struct {
+	parents  []int
+	children [][]int
+}
+
+// NewTree returns the complete tree with the given branching factor over n
+// participants, rooted at participant 0: the children of participant i are
+// participants i*branching+1 to i*branching+branching.
+func NewTree(n, branching int) (*Tree, error) {
+	if n < 1 || branching < 1 {
+		return nil, errors.New("invalid tree size or branching factor")
+	}
+	t := &Tree{parents: make([]int, n), children: make([][]int, n)}
+	t.parents[0] = -1
+	for i := 1; i < n; i++ {
+		p := (i - 1) / branching
+		t.parents[i] = p
+		t.children[p] = append(t.children[p], i)
+	}
+	return t, nil
+}
+
+// Parent returns the parent of participant i, or -1 for the root.
+func (t *Tree) Parent(i int) int {
+	return t.parents[i]
+}
+
+// Children returns the children of participant i.
+func (t *Tree) Children(i int) []int {
+	return t.children[i]
+}
+
+// Subtree returns the participants of the subtree rooted at participant i,
+// including i.
+func (t *Tree) Subtree(i int) []int {
+	nodes := []int{i}
+	for j := 0; j < len(nodes); j++ {
+		nodes = append(nodes, t.children[nodes[j]]...)
+	}
+	return nodes
+}
+
+// TreeCommitment is the aggregate commitment of the participants of a
+// subtree that take part in the signature, sent by the root of the subtree
+// to its parent. Mask marks the participants whose commitments V sums.
+type TreeCommitment struct {
+	Index int
+	V     kyber.Point
+	Mask  []byte
+}
+
+// TreeChallenge is sent down the tree by the leader once it has aggregated
+// all the commitments: the aggregate commitment V of the signature and its
+// participation mask.
+type TreeChallenge struct {
+	V    kyber.Point
+	Mask []byte
+}
+
+// TreeResponse is the aggregate response of the participants of a subtree,
+// sent by the root of the subtree to its parent.
+type TreeResponse struct {
+	Index int
+	R     kyber.Scalar
+}
+
+// Node holds the state of a participant in an execution of CoSi over a
+// tree. A node commits once its children have committed, and responds once
+// its children have responded. Children missing in the commitment phase are
+// left out of the signature, together with their subtree, and show up as
+// absent in its participation mask. A node takes part in a single
+// signature.
+type Node struct {
+	suite   Suite
+	tree    *Tree
+	publics []kyber.Point
+	index   int
+	private kyber.Scalar
+
+	v       kyber.Scalar
+	commits map[int]*TreeCommitment // commitments of the children
+	commit  *TreeCommitment
+}
+
+// NewNode returns the node of participant index, holding the private key
+// of publics[index], in the given tree.
+func NewNode(suite Suite, tree *Tree, publics []kyber.Point, index int, private kyber.Scalar) (*Node, error) {
+	if len(publics) != len(tree.parents) {
+		return nil, errors.New("mismatching number of public keys and tree size")
+	}
+	if index < 0 || index >= len(publics) {
+		return nil, errors.New("index out of range")
+	}
+	if !suite.Point().Mul(private, nil).Equal(publics[index]) {
+		return nil, errors.New("private key does not match public key")
+	}
+	return &Node{suite: suite, tree: tree, publics: publics, index: index, private: private}, nil
+}
+
+// Commit creates the commitment of the node and returns the aggregate
+// commitment of its subtree, given those of its children that committed in
+// time.
+func (n *Node) Commit(children []*TreeCommitment) (*TreeCommitment, error) {
+	if n.commit != nil {
+		return nil, errors.New("node already committed")
+	}
+	mask, err := NewMask(n.suite, n.publics, n.publics[n.index])
+	if err != nil {
+		return nil, err
+	}
+	n.commits = make(map[int]*TreeCommitment)
+	v, V := Commit(n.suite)
+	for _, c := range children {
+		if c == nil || c.V == nil || c.Mask == nil {
+			return nil, errors.New("malformed commitment")
+		}
+		if c.Index < 0 || c.Index >= len(n.tree.parents) ||
+			n.tree.parents[c.Index] != n.index || c.Index == n.index {
+			return nil, fmt.Errorf("participant %d is not a child", c.Index)
+		}
+		if _, ok := n.commits[c.Index]; ok {
+			return nil, fmt.Errorf("duplicate commitment from participant %d", c.Index)
+		}
+		if err := n.checkMask(c.Index, c.Mask); err != nil {
+			return nil, err
+		}
+		n.commits[c.Index] = c
+		V.Add(V, c.V)
+		m, err := AggregateMasks(mask.mask, c.Mask)
+		if err != nil {
+			return nil, err
+		}
+		mask.SetMask(m)
+	}
+	n.v = v
+	n.commit = &TreeCommitment{Index: n.index, V: V, Mask: mask.Mask()}
+	return n.commit, nil
+}
+
+// checkMask checks that the mask of the participant i only marks
+// participants of its subtree, including i itself.
+func (n *Node) checkMask(i int, mask []byte) error {
+	m, err := NewMask(n.suite, n.publics, nil)
+	if err != nil {
+		return err
+	}
+	if err := m.SetMask(mask); err != nil {
+		return err
+	}
+	inside := 0
+	for _, j := range n.tree.Subtree(i) {
+		if ok, _ := m.IndexEnabled(j); ok {
+			inside++
+		}
+	}
+	if ok, _ := m.IndexEnabled(i); !ok || inside != m.CountEnabled() {
+		return fmt.Errorf("invalid mask from participant %d", i)
+	}
+	return nil
+}
+
+// Challenge returns the challenge to send down the tree. It must be called
+// on the leader, once it committed.
+func (n *Node) Challenge() (*TreeChallenge, error) {
+	if n.tree.parents[n.index] != -1 {
+		return nil, errors.New("only the leader creates the challenge")
+	}
+	if n.commit == nil {
+		return nil, errors.New("leader has not committed")
+	}
+	return &TreeChallenge{V: n.commit.V, Mask: n.commit.Mask}, nil
+}
+
+// Respond computes the response of the node to the challenge for message,
+// and returns the aggregate response of its subtree, given the responses of
+// all its children that committed. The responses of the children are
+// checked against their commitments, so that a node answering for a wrong
+// or incomplete subtree is caught by its parent.
+func (n *Node) Respond(message []byte, ch *TreeChallenge, children []*TreeResponse) (*TreeResponse, error) {
+	if n.v == nil {
+		return nil, errors.New("node has not committed")
+	}
+	if ch == nil || ch.V == nil {
+		return nil, errors.New("malformed challenge")
+	}
+	mask, err := NewMask(n.suite, n.publics, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := mask.SetMask(ch.Mask); err != nil {
+		return nil, err
+	}
+	if ok, _ := mask.IndexEnabled(n.index); !ok {
+		return nil, errors.New("node missing from participation mask")
+	}
+	c, err := Challenge(n.suite, ch.V, mask.AggregatePublic, message)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make(map[int]*TreeResponse)
+	for _, r := range children {
+		if r == nil || r.R == nil {
+			return nil, errors.New("malformed response")
+		}
+		if _, ok := n.commits[r.Index]; !ok {
+			return nil, fmt.Errorf("unexpected response from participant %d", r.Index)
+		}
+		responses[r.Index] = r
+	}
+	r, err := Response(n.suite, n.private, n.v, c)
+	if err != nil {
+		return nil, err
+	}
+	n.v = nil
+	for i, com := range n.commits {
+		child, ok := responses[i]
+		if !ok {
+			return nil, fmt.Errorf("missing response from participant %d", i)
+		}
+		// r*G = V + c*A for the aggregate key A of the subtree.
+		sub, err := NewMask(n.suite, n.publics, nil)
+		if err != nil {
+			return nil, err
+		}
+		sub.SetMask(com.Mask)
+		right := n.suite.Point().Mul(c, sub.AggregatePublic)
+		right.Add(right, com.V)
+		if !n.suite.Point().Mul(child.R, nil).Equal(right) {
+			return nil, fmt.Errorf("invalid response from participant %d", i)
+		}
+		r.Add(r, child.R)
+	}
+	return &TreeResponse{Index: n.index, R: r}, nil
+}
+
+// Signature returns the collective signature V || r || Z from the
+// challenge and the aggregate response of the whole tree, computed by the
+// leader.
+func (n *Node) Signature(ch *TreeChallenge, r *TreeResponse) ([]byte, error) {
+	mask, err := NewMask(n.suite, n.publics, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := mask.SetMask(ch.Mask); err != nil {
+		return nil, err
+	}
+	return Sign(n.suite, ch.V, r.R, mask)
+}
@@ -0,0 +1,227 @@
+// +build vartime
+
+// Package ecdsa implements the ECDSA signature algorithm of FIPS 186-4 with
+// the deterministic nonce generation of RFC 6979.
+//
+// Signatures are encoded in the ASN.1 DER format used by X.509, TLS and
+// JWT libraries, so that they can be verified by any standard ECDSA
+// implementation, such as Go's crypto/ecdsa, given the same curve and hash.
+// The nonce of a signature is derived from the private key and the message
+// with HMAC, so that signing does not depend on a random source.
+//
+// The package works with the curves of packages group/nist and
+// group/secp256k1. It uses math/big and is not constant time, so it must
+// be compiled with the "vartime" compilation flag.
+package ecdsa
+
+import (
+	"crypto/hmac"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+
+	"github.com/dedis/kyber"
+)
+
+// Suite represents the set of functionalities needed by the package ecdsa.
+// The points of the group must be encoded in the SEC 1 format and its
+// scalars as big-endian integers, and Hash is used both to hash messages
+// and for nonce generation.
+type Suite interface {
+	kyber.Group
+	kyber.HashFactory
+	Order() *big.Int
+}
+
+var errInvalidSignature = errors.New("ecdsa: invalid signature")
+
+// signature is the ASN.1 structure of an ECDSA signature.
+type signature struct {
+	R, S *big.Int
+}
+
+// Sign returns the DER-encoded ECDSA signature of msg under the private
+// key.
+func Sign(suite Suite, private kyber.Scalar, msg []byte) ([]byte, error) {
+	n := suite.Order()
+	buff, err := private.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	d := new(big.Int).SetBytes(buff)
+	if d.Sign() == 0 {
+		return nil, errors.New("ecdsa: private key is zero")
+	}
+	h := suite.Hash()
+	h.Write(msg)
+	digest := h.Sum(nil)
+	e := HashToInt(digest, n)
+
+	nonces := newNonceGenerator(suite, d, digest)
+	for {
+		k := nonces.next()
+		R := suite.Point().Mul(scalar(suite, k), nil)
+		r, err := XCoordinate(R)
+		if err != nil {
+			return nil, err
+		}
+		r.Mod(r, n)
+		if r.Sign() == 0 {
+			continue
+		}
+		// s = k^-1 * (e + r*d) mod n
+		s := new(big.Int).Mul(r, d)
+		s.Add(s, e)
+		s.Mul(s, new(big.Int).ModInverse(k, n))
+		s.Mod(s, n)
+		if s.Sign() == 0 {
+			continue
+		}
+		return asn1.Marshal(signature{r, s})
+	}
+}
+
+// Verify checks the DER-encoded ECDSA signature of msg under the public
+// key. It returns nil if the signature is valid, and an error otherwise.
+func Verify(suite Suite, public kyber.Point, msg, sig []byte) error {
+	n := suite.Order()
+	var rs signature
+	rest, err := asn1.Unmarshal(sig, &rs)
+	if err != nil || len(rest) != 0 {
+		return errInvalidSignature
+	}
+	// Reject non-canonical encodings, which asn1.Unmarshal allows.
+	if der, err := asn1.Marshal(rs); err != nil || string(der) != string(sig) {
+		return errInvalidSignature
+	}
+	if rs.R.Sign() <= 0 || rs.S.Sign() <= 0 ||
+		rs.R.Cmp(n) >= 0 || rs.S.Cmp(n) >= 0 {
+		return errInvalidSignature
+	}
+	h := suite.Hash()
+	h.Write(msg)
+	e := HashToInt(h.Sum(nil), n)
+
+	// X = (e/s)*G + (r/s)*Q
+	w := new(big.Int).ModInverse(rs.S, n)
+	u1 := new(big.Int).Mul(e, w)
+	u1.Mod(u1, n)
+	u2 := new(big.Int).Mul(rs.R, w)
+	u2.Mod(u2, n)
+	X := suite.Point().Mul(scalar(suite, u1), nil)
+	X.Add(X, suite.Point().Mul(scalar(suite, u2), public))
+	if X.Equal(suite.Point().Null()) {
+		return errInvalidSignature
+	}
+	x, err := XCoordinate(X)
+	if err != nil {
+		return err
+	}
+	if x.Mod(x, n).Cmp(rs.R) != 0 {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// HashToInt converts a digest to an integer modulo n, keeping its
+// leftmost bits as specified by FIPS 186-4.
+func HashToInt(digest []byte, n *big.Int) *big.Int {
+	e := bitsToInt(digest, n.BitLen())
+	return e.Mod(e, n)
+}
+
+// bitsToInt interprets b as a big-endian integer of at most qlen bits,
+// dropping its rightmost bits if it is longer.
+func bitsToInt(b []byte, qlen int) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if blen := 8 * len(b); blen > qlen {
+		v.Rsh(v, uint(blen-qlen))
+	}
+	return v
+}
+
+// XCoordinate returns the affine x-coordinate of P from its SEC 1 encoding.
+func XCoordinate(P kyber.Point) (*big.Int, error) {
+	b, err := P.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(b) > 1 && b[0] == 4 && len(b)%2 == 1:
+		return new(big.Int).SetBytes(b[1 : 1+len(b)/2]), nil
+	case len(b) > 1 && (b[0] == 2 || b[0] == 3):
+		return new(big.Int).SetBytes(b[1:]), nil
+	case len(b) > 1 && b[0] == 0:
+		// The point at infinity.
+		return new(big.Int), nil
+	}
+	return nil, errors.New("ecdsa: point is not SEC 1 encoded")
+}
+
+// scalar returns the scalar of the group with value v.
+func scalar(suite Suite, v *big.Int) kyber.Scalar {
+	return suite.Scalar().SetBytes(v.Bytes())
+}
+
+// nonceGenerator produces the sequence of candidate nonces of RFC 6979,
+// section 3.2.
+type nonceGenerator struct {
+	suite Suite
+	n     *big.Int
+	k, v  []byte
+}
+
+func newNonceGenerator(suite Suite, d *big.Int, digest []byte) *nonceGenerator {
+	n := suite.Order()
+	g := &nonceGenerator{suite: suite, n: n}
+	size := suite.Hash().Size()
+	g.v = make([]byte, size)
+	for i := range g.v {
+		g.v[i] = 1
+	}
+	g.k = make([]byte, size)
+
+	rlen := (n.BitLen() + 7) / 8
+	x := intToOctets(d, rlen)
+	h := intToOctets(HashToInt(digest, n), rlen)
+	g.k = g.mac(g.v, []byte{0}, x, h)
+	g.v = g.mac(g.v)
+	g.k = g.mac(g.v, []byte{1}, x, h)
+	g.v = g.mac(g.v)
+	return g
+}
+
+// next returns the next candidate nonce in [1, n-1].
+func (g *nonceGenerator) next() *big.Int {
+	qlen := g.n.BitLen()
+	for {
+		var t []byte
+		for len(t)*8 < qlen {
+			g.v = g.mac(g.v)
+			t = append(t, g.v...)
+		}
+		k := bitsToInt(t, qlen)
+		// Prepare the state for a further candidate, in case this one
+		// is rejected here or by the caller.
+		g.k = g.mac(g.v, []byte{0})
+		g.v = g.mac(g.v)
+		if k.Sign() > 0 && k.Cmp(g.n) < 0 {
+			return k
+		}
+	}
+}
+
+func (g *nonceGenerator) mac(data ...[]byte) []byte {
+	m := hmac.New(g.suite.Hash, g.k)
+	for _, d := range data {
+		m.Write(d)
+	}
+	return m.Sum(nil)
+}
+
+func intToOctets(v *big.Int, rlen int) []byte {
+	b := make([]byte, rlen)
+	vb := v.Bytes()
+	copy(b[rlen-len(vb):], vb)
+	return b
+}
@@ -0,0 +1,119 @@
+// +build vartime
+
+package ecdsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/group/nist"
+	"github.com/dedis/kyber/group/secp256k1"
+	"github.com/dedis/kyber/util/key"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// P-256 with SHA-256 test vectors from RFC 6979, section A.2.5.
+var vectors = []struct {
+	msg  string
+	r, s string
+}{
+	{"sample",
+		"efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716",
+		"f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8"},
+	{"test",
+		"f1abb023518351cd71d881567b1ea663ed3efcf6c5132b354f28d3b0b7d38367",
+		"019f4113742a2b14bd25926b49c649155f267e60d3814b4c0cc84250e46f0083"},
+}
+
+func TestRFC6979(t *testing.T) {
+	suite := nist.NewBlakeSHA256P256()
+	x, _ := hex.DecodeString("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	private := suite.Scalar().SetBytes(x)
+	public := suite.Point().Mul(private, nil)
+
+	for _, v := range vectors {
+		sig, err := Sign(suite, private, []byte(v.msg))
+		require.NoError(t, err)
+		var rs signature
+		_, err = asn1.Unmarshal(sig, &rs)
+		require.NoError(t, err)
+		assert.Equal(t, v.r, hex.EncodeToString(rs.R.Bytes()))
+		assert.Equal(t, v.s, hex.EncodeToString(intToOctets(rs.S, 32)))
+		assert.NoError(t, Verify(suite, public, []byte(v.msg), sig))
+	}
+}
+
+// Signatures must verify with Go's crypto/ecdsa package.
+func TestStandardCompatibility(t *testing.T) {
+	suite := nist.NewBlakeSHA256P256()
+	msg := []byte("Hello ECDSA")
+	for i := 0; i < 8; i++ {
+		kp := key.NewKeyPair(suite)
+		sig, err := Sign(suite, kp.Private, msg)
+		require.NoError(t, err)
+
+		buff, _ := kp.Public.MarshalBinary()
+		x, y := elliptic.Unmarshal(elliptic.P256(), buff)
+		require.NotNil(t, x)
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		var rs signature
+		_, err = asn1.Unmarshal(sig, &rs)
+		require.NoError(t, err)
+		h := suite.Hash()
+		h.Write(msg)
+		assert.True(t, ecdsa.Verify(pub, h.Sum(nil), rs.R, rs.S))
+
+		// And the other way round.
+		db, _ := kp.Private.MarshalBinary()
+		priv := &ecdsa.PrivateKey{PublicKey: *pub, D: new(big.Int).SetBytes(db)}
+		r, s, err := ecdsa.Sign(rand.Reader, priv, h.Sum(nil))
+		require.NoError(t, err)
+		der, err := asn1.Marshal(signature{r, s})
+		require.NoError(t, err)
+		assert.NoError(t, Verify(suite, kp.Public, msg, der))
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	for _, suite := range []Suite{nist.NewBlakeSHA256P256(), secp256k1.NewBlakeSHA256Secp256k1()} {
+		msg := []byte("Hello ECDSA")
+		kp := key.NewKeyPair(suite.(key.Suite))
+		sig, err := Sign(suite, kp.Private, msg)
+		require.NoError(t, err)
+		assert.NoError(t, Verify(suite, kp.Public, msg, sig), "%s", suite)
+
+		// Signatures are deterministic.
+		sig2, err := Sign(suite, kp.Private, msg)
+		require.NoError(t, err)
+		assert.Equal(t, sig, sig2)
+
+		assert.Error(t, Verify(suite, kp.Public, []byte("Hello DSA"), sig))
+		other := key.NewKeyPair(suite.(key.Suite))
+		assert.Error(t, Verify(suite, other.Public, msg, sig))
+
+		bad := append([]byte(nil), sig...)
+		bad[len(bad)-1] ^= 1
+		assert.Error(t, Verify(suite, kp.Public, msg, bad))
+		assert.Error(t, Verify(suite, kp.Public, msg, append(sig, 0)))
+
+		// r and s must be in [1, n-1].
+		var rs signature
+		_, err = asn1.Unmarshal(sig, &rs)
+		require.NoError(t, err)
+		for _, v := range []signature{
+			{rs.R, new(big.Int)},
+			{new(big.Int).Add(rs.R, suite.Order()), rs.S},
+			{rs.R, new(big.Int).Neg(rs.S)},
+		} {
+			der, err := asn1.Marshal(v)
+			require.NoError(t, err)
+			assert.Error(t, Verify(suite, kp.Public, msg, der))
+		}
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"crypto/sha512"
 	"errors"
 	"fmt"
+	"hash"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/internal/msm"
 	"github.com/dedis/kyber/util/random"
 )
 
@@ -79,17 +81,37 @@ func (e *EdDSA) UnmarshalBinary(buff []byte) error {
 
 // Sign will return a EdDSA signature of the message msg using Ed25519.
 func (e *EdDSA) Sign(msg []byte) ([]byte, error) {
+	return e.sign(nil, msg)
+}
+
+// SignPh will return a EdDSA signature of the message msg using Ed25519ph,
+// the prehash variant of RFC8032, in which the message is first hashed with
+// SHA-512. The context, at most 255 bytes long, binds the signature to a
+// protocol and may be empty.
+func (e *EdDSA) SignPh(msg, context []byte) ([]byte, error) {
+	dom, err := dom2(context)
+	if err != nil {
+		return nil, err
+	}
+	digest := sha512.Sum512(msg)
+	return e.sign(dom, digest[:])
+}
+
+// sign signs msg, prefixing every hash with dom.
+func (e *EdDSA) sign(dom, msg []byte) ([]byte, error) {
 	hash := sha512.New()
+	_, _ = hash.Write(dom)
 	_, _ = hash.Write(e.prefix)
 	_, _ = hash.Write(msg)
 
 	// deterministic random secret and its commit
-	r := group.Scalar().SetBytes(hash.Sum(nil))
+	r := hashToScalar(hash)
 	R := group.Point().Mul(r, nil)
 
 	// challenge
 	// H( R || Public || Msg)
 	hash.Reset()
+	_, _ = hash.Write(dom)
 	Rbuff, err := R.MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -103,7 +125,7 @@ func (e *EdDSA) Sign(msg []byte) ([]byte, error) {
 	_, _ = hash.Write(Abuff)
 	_, _ = hash.Write(msg)
 
-	h := group.Scalar().SetBytes(hash.Sum(nil))
+	h := hashToScalar(hash)
 
 	// response
 	// s = r + h * s
@@ -126,6 +148,31 @@ func (e *EdDSA) Sign(msg []byte) ([]byte, error) {
 // Verify uses a public key, a message and a signature. It will return nil if
 // sig is a valid signature for msg created by key public, or an error otherwise.
 func Verify(public kyber.Point, msg, sig []byte) error {
+	return verify(public, nil, msg, sig, false)
+}
+
+// VerifyCofactored is like Verify, but checks the cofactored equation
+// [8]S = [8](R + h*A) of RFC8032, which ignores the small-order components
+// of R and of the public key, as VerifyBatch does. It accepts signatures
+// that Verify and the standard Ed25519 implementation reject, and is meant
+// to find the invalid signatures of a batch rejected by VerifyBatch.
+func VerifyCofactored(public kyber.Point, msg, sig []byte) error {
+	return verify(public, nil, msg, sig, true)
+}
+
+// VerifyPh uses a public key, a message, a context and a signature created
+// by SignPh. It will return nil if sig is a valid Ed25519ph signature for msg
+// and context created by key public, or an error otherwise.
+func VerifyPh(public kyber.Point, msg, context, sig []byte) error {
+	dom, err := dom2(context)
+	if err != nil {
+		return err
+	}
+	digest := sha512.Sum512(msg)
+	return verify(public, dom, digest[:], sig, false)
+}
+
+func verify(public kyber.Point, dom, msg, sig []byte, cofactored bool) error {
 	if len(sig) != 64 {
 		return errors.New("signature length invalid")
 	}
@@ -146,22 +193,117 @@ func Verify(public kyber.Point, msg, sig []byte) error {
 		return err
 	}
 	hash := sha512.New()
+	_, _ = hash.Write(dom)
 	_, _ = hash.Write(sig[:32])
 	_, _ = hash.Write(Pbuff)
 	_, _ = hash.Write(msg)
 
-	h := group.Scalar().SetBytes(hash.Sum(nil))
-	// reconstruct S == k*A + R
+	h := hashToScalar(hash)
+	// reconstruct S == h*A + R, or [8]S == [8](h*A + R) if cofactored
 	S := group.Point().Mul(s, nil)
-	hA := group.Point().Mul(h, public)
-	RhA := group.Point().Add(R, hA)
+	hA := kyber.VarTimePoint(group).Mul(h, public)
+	RhA := hA.Add(hA, R)
 
+	if cofactored {
+		S = mulCofactor(S.Sub(S, RhA))
+		RhA = group.Point().Null()
+	}
 	if !RhA.Equal(S) {
 		return errors.New("reconstructed S is not equal to signature")
 	}
 	return nil
 }
 
+// VerifyBatch uses public keys, messages and signatures, where the i-th
+// signature is checked against the i-th message and public key. It will
+// return nil if all the signatures are valid Ed25519 signatures, or an error
+// otherwise.
+//
+// The signatures are checked at once with a random linear combination of
+// their verification equations, which is much faster than calling Verify on
+// each of them. The combination uses the cofactored equation of RFC8032, in
+// which small-order components of R and of the public keys are cleared;
+// without it, such a component would cancel out of the combination whenever
+// its random coefficient is even, so that the result would depend on the
+// coefficients. VerifyBatch thus agrees with VerifyCofactored, which can be
+// used to find the invalid signatures when the check fails, and accepts
+// the signatures with small-order components that Verify rejects.
+func VerifyBatch(publics []kyber.Point, msgs, sigs [][]byte) error {
+	if len(publics) != len(msgs) || len(msgs) != len(sigs) {
+		return errors.New("batch of inconsistent length")
+	}
+	stream := random.New()
+	var zb [16]byte
+
+	// check that 8*(sum(z_i*s_i)*B - sum(z_i*R_i) - sum(z_i*h_i*A_i)) = 0
+	sum := group.Scalar().Zero()
+	scalars := make([]kyber.Scalar, 0, 2*len(sigs)+1)
+	points := make([]kyber.Point, 0, 2*len(sigs)+1)
+	for i, sig := range sigs {
+		if len(sig) != 64 {
+			return errors.New("signature length invalid")
+		}
+		R := group.Point()
+		if err := R.UnmarshalBinary(sig[:32]); err != nil {
+			return fmt.Errorf("got R invalid point: %s", err)
+		}
+		s := group.Scalar()
+		if err := s.UnmarshalBinary(sig[32:]); err != nil {
+			return fmt.Errorf("schnorr: s invalid scalar %s", err)
+		}
+		Pbuff, err := publics[i].MarshalBinary()
+		if err != nil {
+			return err
+		}
+		hash := sha512.New()
+		_, _ = hash.Write(sig[:32])
+		_, _ = hash.Write(Pbuff)
+		_, _ = hash.Write(msgs[i])
+		h := hashToScalar(hash)
+
+		// 128 bits of randomness are enough to catch an invalid signature
+		random.Bytes(zb[:], stream)
+		z := group.Scalar().SetBytes(zb[:])
+
+		sum.Add(sum, group.Scalar().Mul(z, s))
+		scalars = append(scalars, group.Scalar().Neg(z), group.Scalar().Neg(h.Mul(h, z)))
+		points = append(points, R, publics[i])
+	}
+
+	P := msm.Mul(group, append(scalars, sum), append(points, nil))
+	if !mulCofactor(P).Equal(group.Point().Null()) {
+		return errors.New("invalid signature in batch")
+	}
+	return nil
+}
+
+// mulCofactor sets P to 8*P, with three doublings, and returns it.
+func mulCofactor(P kyber.Point) kyber.Point {
+	for i := 0; i < 3; i++ {
+		P.Add(P, P)
+	}
+	return P
+}
+
+// dom2 returns the domain separation prefix of Ed25519ph for the given
+// context.
+func dom2(context []byte) ([]byte, error) {
+	if len(context) > 255 {
+		return nil, errors.New("context longer than 255 bytes")
+	}
+	dom := []byte("SigEd25519 no Ed25519 collisions")
+	dom = append(dom, 1, byte(len(context)))
+	return append(dom, context...), nil
+}
+
+// hashToScalar returns the digest of the SHA-512 hash reduced modulo the
+// group order, as a little-endian integer.
+func hashToScalar(hash hash.Hash) kyber.Scalar {
+	var digest [64]byte
+	hash.Sum(digest[:0])
+	return kyber.SetBytesWide(group.Scalar(), digest)
+}
+
 func hashSeed(seed []byte) (hash [64]byte) {
 	hash = sha512.Sum512(seed)
 	hash[0] &= 0xf8
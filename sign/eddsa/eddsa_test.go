@@ -5,12 +5,16 @@ import (
 	"bytes"
 	"compress/gzip"
 	"crypto/cipher"
+	"crypto/sha512"
 	"encoding/hex"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/random"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
 )
 
 // EdDSATestVectors taken from RFC8032 section 7.1
@@ -95,6 +99,50 @@ func TestEdDSASigning(t *testing.T) {
 	}
 }
 
+// Ed25519ph test vector taken from RFC8032 section 7.3
+func TestEdDSASigningPh(t *testing.T) {
+	seed, _ := hex.DecodeString("833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42")
+	public := "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf"
+	signature := "98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406"
+	msg := []byte("abc")
+
+	ed := NewEdDSA(ConstantStream(seed))
+	data, _ := ed.Public.MarshalBinary()
+	assert.Equal(t, public, hex.EncodeToString(data))
+
+	sig, err := ed.SignPh(msg, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, signature, hex.EncodeToString(sig))
+	assert.Nil(t, VerifyPh(ed.Public, msg, nil, sig))
+
+	// Ed25519ph signatures are not valid Ed25519 signatures, and are
+	// bound to their context.
+	assert.Error(t, Verify(ed.Public, msg, sig))
+	assert.Error(t, VerifyPh(ed.Public, msg, []byte("ctx"), sig))
+	assert.Error(t, VerifyPh(ed.Public, []byte("abd"), nil, sig))
+
+	ctx := []byte("kyber")
+	sig, err = ed.SignPh(msg, ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, VerifyPh(ed.Public, msg, ctx, sig))
+	assert.Error(t, VerifyPh(ed.Public, msg, nil, sig))
+
+	_, err = ed.SignPh(msg, make([]byte, 256))
+	assert.Error(t, err)
+}
+
+// Signatures must verify with the standard Ed25519 implementation.
+func TestXCryptoCompatibility(t *testing.T) {
+	for i := 0; i < 16; i++ {
+		ed := NewEdDSA(random.New())
+		msg := random.Bits(uint(8*i), false, random.New())
+		sig, err := ed.Sign(msg)
+		assert.Nil(t, err)
+		public, _ := ed.Public.MarshalBinary()
+		assert.True(t, ed25519.Verify(public, msg, sig))
+	}
+}
+
 type constantStream struct {
 	seed []byte
 }
@@ -179,3 +227,99 @@ func TestGolden(t *testing.T) {
 		t.Fatalf("error reading test data: %s", err)
 	}
 }
+
+func TestVerifyBatch(t *testing.T) {
+	n := 16
+	publics := make([]kyber.Point, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		ed := NewEdDSA(random.New())
+		publics[i] = ed.Public
+		msgs[i] = random.Bits(uint(8*i), false, random.New())
+		sig, err := ed.Sign(msgs[i])
+		assert.Nil(t, err)
+		sigs[i] = sig
+	}
+	assert.Nil(t, VerifyBatch(publics, msgs, sigs))
+	assert.Nil(t, VerifyBatch(nil, nil, nil))
+
+	publics[2], publics[5] = publics[5], publics[2]
+	assert.Error(t, VerifyBatch(publics, msgs, sigs))
+	publics[2], publics[5] = publics[5], publics[2]
+
+	sig := sigs[9]
+	sigs[9] = append([]byte(nil), sig...)
+	sigs[9][0] ^= 1
+	assert.Error(t, VerifyBatch(publics, msgs, sigs))
+	sigs[9] = sig[:63]
+	assert.Error(t, VerifyBatch(publics, msgs, sigs))
+	sigs[9] = sig
+
+	assert.Error(t, VerifyBatch(publics, msgs[1:], sigs))
+	assert.Nil(t, VerifyBatch(publics, msgs, sigs))
+}
+
+// TestSmallOrderR checks a signature whose R = r*B + T has a component T of
+// order 2: Verify rejects it, as the standard Ed25519 implementation does,
+// and VerifyCofactored and VerifyBatch consistently accept it, where
+// VerifyBatch used to accept it only when the random coefficient of the
+// signature was even.
+func TestSmallOrderR(t *testing.T) {
+	T := group.Point()
+	b, _ := hex.DecodeString("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	assert.Nil(t, T.UnmarshalBinary(b))
+	assert.False(t, T.Equal(group.Point().Null()))
+	assert.True(t, group.Point().Add(T, T).Equal(group.Point().Null()))
+
+	ed := NewEdDSA(random.New())
+	msg := []byte("small-order R")
+	r := group.Scalar().Pick(random.New())
+	R := group.Point().Mul(r, nil)
+	R.Add(R, T)
+	Rbuff, _ := R.MarshalBinary()
+	Pbuff, _ := ed.Public.MarshalBinary()
+	hash := sha512.New()
+	hash.Write(Rbuff)
+	hash.Write(Pbuff)
+	hash.Write(msg)
+	s := group.Scalar().Mul(hashToScalar(hash), ed.Secret)
+	s.Add(s, r)
+	sBuff, _ := s.MarshalBinary()
+	sig := append(Rbuff, sBuff...)
+
+	assert.Error(t, Verify(ed.Public, msg, sig))
+	assert.False(t, ed25519.Verify(Pbuff, msg, sig))
+
+	// The cofactored equation clears T, so that both accept the signature.
+	assert.Nil(t, VerifyCofactored(ed.Public, msg, sig))
+	for i := 0; i < 200; i++ {
+		assert.Nil(t, VerifyBatch([]kyber.Point{ed.Public}, [][]byte{msg}, [][]byte{sig}))
+	}
+
+	// Whereas a wrong s is rejected by all.
+	sBuff, _ = s.Add(s, group.Scalar().One()).MarshalBinary()
+	sig = append(Rbuff, sBuff...)
+	assert.Error(t, Verify(ed.Public, msg, sig))
+	assert.Error(t, VerifyCofactored(ed.Public, msg, sig))
+	for i := 0; i < 200; i++ {
+		assert.Error(t, VerifyBatch([]kyber.Point{ed.Public}, [][]byte{msg}, [][]byte{sig}))
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	n := 64
+	publics := make([]kyber.Point, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		ed := NewEdDSA(random.New())
+		publics[i] = ed.Public
+		msgs[i] = []byte("Hello EdDSA")
+		sigs[i], _ = ed.Sign(msgs[i])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyBatch(publics, msgs, sigs)
+	}
+}
@@ -0,0 +1,368 @@
+// Package frost implements FROST, the two-round threshold Schnorr signature
+// protocol of Komlo and Goldberg (https://eprint.iacr.org/2020/852), over
+// any kyber group.
+//
+// A group of n participants holds shares of a private key, as created by a
+// trusted dealer with package share or by a distributed key generation
+// with package share/dkg, such that any t of them can sign. A coordinator
+// drives the signing of a message:
+//
+//  1. Every participant creates a pair of nonce commitments with
+//     Signer.Commit and sends them to the coordinator, which collects them
+//     with Coordinator.AddCommitment. Once it has t of them, the signing set
+//     is fixed, and the coordinator sends the message and the commitments
+//     of the set, Coordinator.Commitments, to its members.
+//  2. Every member of the set computes its signature share with
+//     Signer.Sign and sends it to the coordinator, which checks it and
+//     collects it with Coordinator.AddShare. Once it has all the shares,
+//     Coordinator.Signature returns the signature.
+//
+// If a member of the set drops out, or sends an invalid share, the
+// coordinator excludes it with Coordinator.Drop and the protocol restarts
+// from the first round with the remaining participants, as long as at
+// least t of them remain. Nonces are never reused: a signer forgets its
+// nonces once it has signed, and must commit again for every attempt.
+//
+// The resulting signatures are plain Schnorr signatures R || s, which are
+// verified with package sign/schnorr and, on the edwards25519 group, by
+// any Ed25519 implementation.
+package frost
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/sign/schnorr"
+)
+
+// Suite represents the set of functionalities needed by the package frost.
+type Suite interface {
+	kyber.Group
+	kyber.Random
+}
+
+// Commitment holds the nonce commitments D = d*G and E = e*G published by
+// the participant of the given index in the first round.
+type Commitment struct {
+	Index int
+	D, E  kyber.Point
+}
+
+// SignatureShare is the share z of a signature created by the participant
+// of the given index in the second round.
+type SignatureShare struct {
+	Index int
+	Z     kyber.Scalar
+}
+
+// Signer holds the private key share of a participant.
+type Signer struct {
+	suite   Suite
+	private *share.PriShare
+	public  kyber.Point
+
+	// Nonces of the pending commitment, nil once used.
+	d, e   kyber.Scalar
+	commit *Commitment
+}
+
+// NewSigner returns a signer for the private key share pri of the
+// key whose public polynomial is pub.
+func NewSigner(suite Suite, pri *share.PriShare, pub *share.PubPoly) *Signer {
+	return &Signer{suite: suite, private: pri, public: pub.Commit()}
+}
+
+// Commit creates fresh nonces and returns their commitment, to be sent to
+// the coordinator. Any nonces of a previous commitment are forgotten.
+func (s *Signer) Commit() *Commitment {
+	rand := s.suite.RandomStream()
+	s.d = s.suite.Scalar().Pick(rand)
+	s.e = s.suite.Scalar().Pick(rand)
+	s.commit = &Commitment{
+		Index: s.private.I,
+		D:     s.suite.Point().Mul(s.d, nil),
+		E:     s.suite.Point().Mul(s.e, nil),
+	}
+	return s.commit
+}
+
+// Sign returns the signature share of msg for the signing set given by
+// commits, which must include the last commitment of s. The nonces of that
+// commitment are forgotten, so that Commit must be called again before the
+// next signature.
+func (s *Signer) Sign(msg []byte, commits []*Commitment) (*SignatureShare, error) {
+	if s.commit == nil {
+		return nil, errors.New("frost: no pending commitment")
+	}
+	var own *Commitment
+	for _, c := range commits {
+		if err := checkCommitment(s.suite, c); err != nil {
+			return nil, err
+		}
+		if c.Index == s.private.I {
+			own = c
+		}
+	}
+	if own == nil || !own.D.Equal(s.commit.D) || !own.E.Equal(s.commit.E) {
+		return nil, errors.New("frost: own commitment missing from signing set")
+	}
+	d, e := s.d, s.e
+	s.d, s.e, s.commit = nil, nil, nil
+
+	sess, err := newSession(s.suite, s.public, msg, commits)
+	if err != nil {
+		return nil, err
+	}
+	// z = d + e*rho + lambda*x*c
+	z := s.suite.Scalar().Mul(e, sess.rho[s.private.I])
+	z.Add(z, d)
+	lx := s.suite.Scalar().Mul(sess.lambda[s.private.I], s.private.V)
+	z.Add(z, lx.Mul(lx, sess.c))
+	return &SignatureShare{Index: s.private.I, Z: z}, nil
+}
+
+// Coordinator collects the commitments and signature shares of the
+// participants, and aggregates them into a signature.
+type Coordinator struct {
+	suite   Suite
+	pub     *share.PubPoly
+	t, n    int
+	msg     []byte
+	dropped map[int]bool
+
+	commits map[int]*Commitment
+	sess    *session // set once the signing set is fixed
+	shares  map[int]*SignatureShare
+}
+
+// NewCoordinator returns a coordinator for the signature of msg by t of the
+// n participants holding shares of the key whose public polynomial is pub.
+func NewCoordinator(suite Suite, pub *share.PubPoly, t, n int, msg []byte) *Coordinator {
+	c := &Coordinator{suite: suite, pub: pub, t: t, n: n, msg: msg, dropped: make(map[int]bool)}
+	c.reset()
+	return c
+}
+
+func (c *Coordinator) reset() {
+	c.commits = make(map[int]*Commitment)
+	c.sess = nil
+	c.shares = make(map[int]*SignatureShare)
+}
+
+// AddCommitment adds the commitment of a participant. It returns true once
+// the signing set is fixed, when t commitments have been added.
+func (c *Coordinator) AddCommitment(com *Commitment) (bool, error) {
+	if c.sess != nil {
+		return false, errors.New("frost: signing set already fixed")
+	}
+	if err := checkCommitment(c.suite, com); err != nil {
+		return false, err
+	}
+	if com.Index < 0 || com.Index >= c.n {
+		return false, errors.New("frost: commitment index out of range")
+	}
+	if c.dropped[com.Index] {
+		return false, fmt.Errorf("frost: participant %d was dropped", com.Index)
+	}
+	if _, ok := c.commits[com.Index]; ok {
+		return false, fmt.Errorf("frost: duplicate commitment from participant %d", com.Index)
+	}
+	c.commits[com.Index] = com
+	if len(c.commits) < c.t {
+		return false, nil
+	}
+	commits := make([]*Commitment, 0, len(c.commits))
+	for _, com := range c.commits {
+		commits = append(commits, com)
+	}
+	sess, err := newSession(c.suite, c.pub.Commit(), c.msg, commits)
+	if err != nil {
+		return false, err
+	}
+	c.sess = sess
+	return true, nil
+}
+
+// checkCommitment checks that both nonce commitments of com are set, are
+// not the identity, and lie in the prime-order group if g can tell, as a
+// participant could otherwise cancel its nonces out of the group commitment
+// or give it a small-order component.
+func checkCommitment(g kyber.Group, com *Commitment) error {
+	if com == nil || com.D == nil || com.E == nil {
+		return errors.New("frost: malformed commitment")
+	}
+	null := g.Point().Null()
+	for _, P := range []kyber.Point{com.D, com.E} {
+		v, ok := P.(kyber.ValidatablePoint)
+		if P.Equal(null) || ok && !v.Valid() {
+			return fmt.Errorf("frost: invalid commitment from participant %d", com.Index)
+		}
+	}
+	return nil
+}
+
+// Commitments returns the commitments of the signing set, sorted by index,
+// or nil if the set is not fixed yet.
+func (c *Coordinator) Commitments() []*Commitment {
+	if c.sess == nil {
+		return nil
+	}
+	return c.sess.commits
+}
+
+// AddShare checks and adds the signature share of a member of the signing
+// set. It returns true once all the shares have been added. An invalid
+// share is rejected with an error; its sender should then be dropped.
+func (c *Coordinator) AddShare(s *SignatureShare) (bool, error) {
+	if c.sess == nil {
+		return false, errors.New("frost: signing set not fixed yet")
+	}
+	if s == nil || s.Z == nil {
+		return false, errors.New("frost: malformed signature share")
+	}
+	com, ok := c.commits[s.Index]
+	if !ok {
+		return false, fmt.Errorf("frost: participant %d is not in the signing set", s.Index)
+	}
+	if _, ok := c.shares[s.Index]; ok {
+		return false, fmt.Errorf("frost: duplicate share from participant %d", s.Index)
+	}
+	// z*G = D + rho*E + c*lambda*X_i
+	g := c.suite
+	left := g.Point().Mul(s.Z, nil)
+	right := g.Point().Mul(c.sess.rho[s.Index], com.E)
+	right.Add(right, com.D)
+	cl := g.Scalar().Mul(c.sess.c, c.sess.lambda[s.Index])
+	right.Add(right, g.Point().Mul(cl, c.pub.Eval(s.Index).V))
+	if !left.Equal(right) {
+		return false, fmt.Errorf("frost: invalid share from participant %d", s.Index)
+	}
+	c.shares[s.Index] = s
+	return len(c.shares) == len(c.commits), nil
+}
+
+// Drop excludes a participant that dropped out or misbehaved, and restarts
+// the protocol from the first round: all the remaining participants must
+// send new commitments. It returns an error if fewer than t participants
+// remain.
+func (c *Coordinator) Drop(index int) error {
+	c.dropped[index] = true
+	c.reset()
+	if c.n-len(c.dropped) < c.t {
+		return errors.New("frost: not enough participants left")
+	}
+	return nil
+}
+
+// Signature returns the Schnorr signature R || s of the message, once all
+// the shares of the signing set have been added.
+func (c *Coordinator) Signature() ([]byte, error) {
+	if c.sess == nil || len(c.shares) != len(c.commits) {
+		return nil, errors.New("frost: missing signature shares")
+	}
+	z := c.suite.Scalar().Zero()
+	for _, s := range c.shares {
+		z.Add(z, s.Z)
+	}
+	Rbuff, err := c.sess.R.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	zbuff, err := z.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(Rbuff, zbuff...), nil
+}
+
+// session holds the values derived from the commitments of a signing set.
+type session struct {
+	commits []*Commitment
+	rho     map[int]kyber.Scalar // binding factors
+	lambda  map[int]kyber.Scalar // Lagrange coefficients
+	R       kyber.Point          // group commitment
+	c       kyber.Scalar         // challenge
+}
+
+func newSession(g kyber.Group, public kyber.Point, msg []byte, commits []*Commitment) (*session, error) {
+	sorted := append([]*Commitment(nil), commits...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Index == sorted[i-1].Index {
+			return nil, errors.New("frost: duplicate index in signing set")
+		}
+	}
+	s := &session{
+		commits: sorted,
+		rho:     make(map[int]kyber.Scalar),
+		lambda:  make(map[int]kyber.Scalar),
+		R:       g.Point().Null(),
+	}
+
+	// The binding factors bind every nonce to the message and to the
+	// whole signing set.
+	h := sha512.New()
+	h.Write([]byte("FROST binding"))
+	if _, err := public.MarshalTo(h); err != nil {
+		return nil, err
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(len(msg)))
+	h.Write(buf[:])
+	h.Write(msg)
+	for _, com := range sorted {
+		binary.BigEndian.PutUint32(buf[:4], uint32(com.Index))
+		h.Write(buf[:4])
+		if _, err := com.D.MarshalTo(h); err != nil {
+			return nil, err
+		}
+		if _, err := com.E.MarshalTo(h); err != nil {
+			return nil, err
+		}
+	}
+	prefix := h.Sum(nil)
+	for _, com := range sorted {
+		h.Reset()
+		h.Write(prefix)
+		binary.BigEndian.PutUint32(buf[:4], uint32(com.Index))
+		h.Write(buf[:4])
+		rho := g.Scalar().SetBytes(h.Sum(nil))
+		s.rho[com.Index] = rho
+		s.R.Add(s.R, com.D)
+		s.R.Add(s.R, g.Point().Mul(rho, com.E))
+	}
+
+	c, err := schnorr.Challenge(g, public, s.R, msg)
+	if err != nil {
+		return nil, err
+	}
+	s.c = c
+
+	// lambda_i = prod_{j != i} x_j / (x_j - x_i), where x_i = i+1, with
+	// the denominators inverted together.
+	nums := make([]kyber.Scalar, len(sorted))
+	dens := make([]kyber.Scalar, len(sorted))
+	for i, ci := range sorted {
+		xi := g.Scalar().SetInt64(int64(ci.Index) + 1)
+		nums[i] = g.Scalar().One()
+		dens[i] = g.Scalar().One()
+		for _, cj := range sorted {
+			if cj.Index == ci.Index {
+				continue
+			}
+			xj := g.Scalar().SetInt64(int64(cj.Index) + 1)
+			nums[i].Mul(nums[i], xj)
+			dens[i].Mul(dens[i], g.Scalar().Sub(xj, xi))
+		}
+	}
+	kyber.BatchInvert(dens)
+	for i, ci := range sorted {
+		s.lambda[ci.Index] = nums[i].Mul(nums[i], dens[i])
+	}
+	return s, nil
+}
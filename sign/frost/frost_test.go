@@ -0,0 +1,199 @@
+package frost
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/sign/eddsa"
+	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func setup(t, n int) (*share.PubPoly, []*Signer) {
+	priPoly := share.NewPriPoly(suite, t, nil)
+	pubPoly := priPoly.Commit(nil)
+	signers := make([]*Signer, n)
+	for i, s := range priPoly.Shares(n) {
+		signers[i] = NewSigner(suite, s, pubPoly)
+	}
+	return pubPoly, signers
+}
+
+// round runs both rounds of the protocol with the given participants, and
+// returns whether the signing set got fixed.
+func round(test *testing.T, c *Coordinator, signers []*Signer, msg []byte) bool {
+	fixed := false
+	for _, s := range signers {
+		if fixed {
+			break
+		}
+		var err error
+		fixed, err = c.AddCommitment(s.Commit())
+		require.Nil(test, err)
+	}
+	if !fixed {
+		return false
+	}
+	commits := c.Commitments()
+	for _, com := range commits {
+		sig, err := signers[indexOf(signers, com.Index)].Sign(msg, commits)
+		require.Nil(test, err)
+		_, err = c.AddShare(sig)
+		require.Nil(test, err)
+	}
+	return true
+}
+
+func indexOf(signers []*Signer, index int) int {
+	for i, s := range signers {
+		if s.private.I == index {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestFROST(test *testing.T) {
+	msg := []byte("Hello FROST")
+	n := 5
+	t := 3
+	pubPoly, signers := setup(t, n)
+	c := NewCoordinator(suite, pubPoly, t, n, msg)
+	require.True(test, round(test, c, signers, msg))
+	require.Len(test, c.Commitments(), t)
+
+	sig, err := c.Signature()
+	require.Nil(test, err)
+	require.Nil(test, schnorr.Verify(suite, pubPoly.Commit(), msg, sig))
+	require.Nil(test, eddsa.Verify(pubPoly.Commit(), msg, sig))
+	require.Error(test, schnorr.Verify(suite, pubPoly.Commit(), []byte("Hello"), sig))
+
+	// Any t participants can sign.
+	c = NewCoordinator(suite, pubPoly, t, n, msg)
+	require.True(test, round(test, c, signers[n-t:], msg))
+	sig, err = c.Signature()
+	require.Nil(test, err)
+	require.Nil(test, schnorr.Verify(suite, pubPoly.Commit(), msg, sig))
+}
+
+func TestFROSTDropout(test *testing.T) {
+	msg := []byte("Hello FROST")
+	n := 5
+	t := 3
+	pubPoly, signers := setup(t, n)
+	c := NewCoordinator(suite, pubPoly, t, n, msg)
+
+	for _, s := range signers[:t] {
+		_, err := c.AddCommitment(s.Commit())
+		require.Nil(test, err)
+	}
+	_, err := c.AddCommitment(signers[t].Commit())
+	require.Error(test, err)
+	_, err = c.Signature()
+	require.Error(test, err)
+
+	// Participant 1 signs, then participant 0 drops out.
+	commits := c.Commitments()
+	sig1, err := signers[1].Sign(msg, commits)
+	require.Nil(test, err)
+	_, err = c.AddShare(sig1)
+	require.Nil(test, err)
+	require.Nil(test, c.Drop(0))
+
+	// The nonces of participant 1 are used up, and shares of the aborted
+	// attempt are rejected.
+	_, err = signers[1].Sign(msg, commits)
+	require.Error(test, err)
+	_, err = c.AddShare(sig1)
+	require.Error(test, err)
+
+	// The dropped participant is excluded, and the others restart.
+	_, err = c.AddCommitment(signers[0].Commit())
+	require.Error(test, err)
+	require.True(test, round(test, c, signers[1:], msg))
+	sig, err := c.Signature()
+	require.Nil(test, err)
+	require.Nil(test, schnorr.Verify(suite, pubPoly.Commit(), msg, sig))
+
+	require.Nil(test, c.Drop(1))
+	require.Error(test, c.Drop(2))
+}
+
+func TestFROSTInvalidShare(test *testing.T) {
+	msg := []byte("Hello FROST")
+	n := 3
+	t := 2
+	pubPoly, signers := setup(t, n)
+	c := NewCoordinator(suite, pubPoly, t, n, msg)
+	for _, s := range signers[:t] {
+		_, err := c.AddCommitment(s.Commit())
+		require.Nil(test, err)
+	}
+	commits := c.Commitments()
+
+	// A share for another message is rejected.
+	bad, err := signers[0].Sign([]byte("Hello"), commits)
+	require.Nil(test, err)
+	_, err = c.AddShare(bad)
+	require.Error(test, err)
+
+	// Malformed shares are rejected.
+	_, err = c.AddShare(nil)
+	require.EqualError(test, err, "frost: malformed signature share")
+	_, err = c.AddShare(&SignatureShare{Index: commits[0].Index})
+	require.EqualError(test, err, "frost: malformed signature share")
+
+	// A signer refuses a signing set without its commitment.
+	signers[2].Commit()
+	_, err = signers[2].Sign(msg, commits)
+	require.Error(test, err)
+
+	// Participant 0 is dropped, and 1 and 2 sign instead.
+	require.Nil(test, c.Drop(0))
+	require.True(test, round(test, c, signers[1:], msg))
+	sig, err := c.Signature()
+	require.Nil(test, err)
+	require.Nil(test, eddsa.Verify(pubPoly.Commit(), msg, sig))
+}
+
+func TestFROSTInvalidCommitment(test *testing.T) {
+	msg := []byte("Hello FROST")
+	n := 3
+	t := 2
+	pubPoly, signers := setup(t, n)
+
+	// The point of order 2 is on the curve but not in the prime-order group.
+	torsion := suite.Point()
+	require.Nil(test, torsion.UnmarshalBinary(append(append([]byte{0xec},
+		bytes.Repeat([]byte{0xff}, 30)...), 0x7f)))
+
+	own := signers[0].Commit()
+	c := NewCoordinator(suite, pubPoly, t, n, msg)
+	for _, bad := range []*Commitment{
+		nil,
+		{Index: 1, E: own.E},
+		{Index: 1, D: own.D},
+		{Index: 1, D: suite.Point().Null(), E: own.E},
+		{Index: 1, D: own.D, E: suite.Point().Null()},
+		{Index: 1, D: torsion, E: own.E},
+		{Index: 1, D: own.D, E: suite.Point().Add(own.E, torsion)},
+	} {
+		_, err := c.AddCommitment(bad)
+		require.Error(test, err)
+
+		// A signer refuses a signing set with the invalid commitment.
+		_, err = signers[0].Sign(msg, []*Commitment{own, bad})
+		require.Error(test, err)
+	}
+
+	// Valid commitments are still accepted after the invalid ones.
+	_, err := c.AddCommitment(own)
+	require.Nil(test, err)
+	done, err := c.AddCommitment(signers[1].Commit())
+	require.Nil(test, err)
+	require.True(test, done)
+}
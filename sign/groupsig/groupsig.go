@@ -0,0 +1,289 @@
+// Package groupsig implements the short group signatures of Boneh, Boyen
+// and Shacham (https://crypto.stanford.edu/~dabo/pubs/papers/groupsigs.pdf)
+// over a pairing-friendly suite.
+//
+// Members of a group sign messages anonymously: a signature shows that some
+// member of the group signed, but not which one, and two signatures cannot
+// be linked to the same member. A designated opener, holding the opening
+// key, can however trace a signature back to its signer.
+//
+// The group is managed by an issuer, which lets members join by issuing
+// them a member key with IssuerKey.Join, and revokes them with
+// IssuerKey.Revoke. A revocation updates the group public key, and every
+// remaining member updates its key with MemberKey.Apply; the revoked member
+// cannot, so its later signatures do not verify under the new group key.
+// Revocations are applied in the order of the revocation list published by
+// the issuer. Since the issuer creates the member keys, it must be trusted
+// not to sign in their name.
+//
+// Signatures consist of three points of G1 and six scalars.
+package groupsig
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+var errInvalidSignature = errors.New("groupsig: invalid signature")
+
+// PublicKey is the public key of a group. G1, H, U and V are points of G1,
+// and G2 and W points of G2, with U = H/xi1, V = H/xi2 and W = gamma*G2.
+type PublicKey struct {
+	G1, H, U, V kyber.Point
+	G2, W       kyber.Point
+}
+
+// IssuerKey is the secret key gamma of the issuer of member keys.
+type IssuerKey struct {
+	Gamma kyber.Scalar
+}
+
+// OpeningKey is the secret key (xi1, xi2) of the opener of signatures.
+type OpeningKey struct {
+	Xi1, Xi2 kyber.Scalar
+}
+
+// MemberKey is the key of a member: a scalar X and a point A of G1 such
+// that (gamma + X)*A = G1. The opener identifies members by A.
+type MemberKey struct {
+	A kyber.Point
+	X kyber.Scalar
+}
+
+// Revocation is the revocation of the member key (A, X), published by the
+// issuer together with B = G2/(gamma + X).
+type Revocation struct {
+	A kyber.Point
+	B kyber.Point
+	X kyber.Scalar
+}
+
+// NewGroup creates a group, and returns its public key with the keys of its
+// issuer and of its opener.
+func NewGroup(suite pairing.Suite, rand cipher.Stream) (*PublicKey, *IssuerKey, *OpeningKey) {
+	g1, g2 := suite.G1(), suite.G2()
+	xi1 := g1.Scalar().Pick(rand)
+	xi2 := g1.Scalar().Pick(rand)
+	gamma := g1.Scalar().Pick(rand)
+	H := g1.Point().Pick(rand)
+	pub := &PublicKey{
+		G1: g1.Point().Base(),
+		H:  H,
+		U:  g1.Point().Mul(g1.Scalar().Inv(xi1), H),
+		V:  g1.Point().Mul(g1.Scalar().Inv(xi2), H),
+		G2: g2.Point().Base(),
+		W:  g2.Point().Mul(gamma, nil),
+	}
+	return pub, &IssuerKey{Gamma: gamma}, &OpeningKey{Xi1: xi1, Xi2: xi2}
+}
+
+// Join issues the key of a new member of the group of the given public key.
+func (ik *IssuerKey) Join(suite pairing.Suite, pub *PublicKey, rand cipher.Stream) *MemberKey {
+	g1 := suite.G1()
+	x := g1.Scalar().Pick(rand)
+	e := g1.Scalar().Add(ik.Gamma, x)
+	return &MemberKey{A: g1.Point().Mul(e.Inv(e), pub.G1), X: x}
+}
+
+// Revoke returns the revocation of the member key under the group public
+// key.
+func (ik *IssuerKey) Revoke(suite pairing.Suite, pub *PublicKey, mk *MemberKey) *Revocation {
+	e := suite.G2().Scalar().Add(ik.Gamma, mk.X)
+	return &Revocation{A: mk.A, B: suite.G2().Point().Mul(e.Inv(e), pub.G2), X: mk.X}
+}
+
+// Verify checks that the member key is valid for the group public key, as
+// a member should on joining.
+func (mk *MemberKey) Verify(suite pairing.Suite, pub *PublicKey) error {
+	// e(A, W + X*G2) = e(G1, G2)
+	WX := suite.G2().Point().Mul(mk.X, pub.G2)
+	WX.Add(WX, pub.W)
+	if !suite.PairingCheck([]kyber.Point{mk.A, suite.G1().Point().Neg(pub.G1)}, []kyber.Point{WX, pub.G2}) {
+		return errors.New("groupsig: invalid member key")
+	}
+	return nil
+}
+
+// Apply returns the group public key updated by the revocation, after
+// checking it.
+func (pub *PublicKey) Apply(suite pairing.Suite, rev *Revocation) (*PublicKey, error) {
+	// The revocation must hold a valid member key, and B must match A:
+	// e(A, G2) = e(G1, B).
+	if err := (&MemberKey{A: rev.A, X: rev.X}).Verify(suite, pub); err != nil {
+		return nil, err
+	}
+	if !suite.PairingCheck([]kyber.Point{rev.A, suite.G1().Point().Neg(pub.G1)}, []kyber.Point{pub.G2, rev.B}) {
+		return nil, errors.New("groupsig: invalid revocation")
+	}
+	// W' = G2 - X*B = gamma*B
+	W := suite.G2().Point().Mul(rev.X, rev.B)
+	W.Sub(pub.G2, W)
+	return &PublicKey{G1: rev.A, H: pub.H, U: pub.U, V: pub.V, G2: rev.B, W: W}, nil
+}
+
+// Apply returns the member key updated by a revocation, which must have
+// been checked by PublicKey.Apply. It fails for the revoked member.
+func (mk *MemberKey) Apply(suite pairing.Suite, rev *Revocation) (*MemberKey, error) {
+	g1 := suite.G1()
+	d := g1.Scalar().Sub(mk.X, rev.X)
+	if d.Equal(g1.Scalar().Zero()) {
+		return nil, errors.New("groupsig: member key revoked")
+	}
+	// A' = (A* - A)/(X - X*)
+	A := g1.Point().Sub(rev.A, mk.A)
+	return &MemberKey{A: A.Mul(d.Inv(d), A), X: mk.X}, nil
+}
+
+// signature is a group signature: the encryption (T1, T2, T3) of the A of
+// the signer to the opener, with a proof of knowledge of its member key.
+type signature struct {
+	T1, T2, T3                     kyber.Point
+	C, SAlpha, SBeta, SX, SD1, SD2 kyber.Scalar
+}
+
+// Sign creates the group signature of msg with the member key.
+func Sign(suite pairing.Suite, pub *PublicKey, mk *MemberKey, msg []byte, rand cipher.Stream) ([]byte, error) {
+	g1 := suite.G1()
+	pick := func() kyber.Scalar { return g1.Scalar().Pick(rand) }
+	alpha, beta := pick(), pick()
+	d1 := g1.Scalar().Mul(mk.X, alpha)
+	d2 := g1.Scalar().Mul(mk.X, beta)
+	ab := g1.Scalar().Add(alpha, beta)
+	sig := &signature{
+		T1: g1.Point().Mul(alpha, pub.U),
+		T2: g1.Point().Mul(beta, pub.V),
+		T3: g1.Point().Add(mk.A, g1.Point().Mul(ab, pub.H)),
+	}
+
+	// Prove knowledge of alpha, beta, x, d1 = x*alpha and d2 = x*beta with
+	// T1 = alpha*U, T2 = beta*V, x*T1 = d1*U, x*T2 = d2*V and
+	// e(T3, G2)^x * e(H, W)^-(alpha+beta) * e(H, G2)^-(d1+d2) = e(G1, G2) / e(T3, W).
+	ra, rb, rx, rd1, rd2 := pick(), pick(), pick(), pick(), pick()
+	R1 := g1.Point().Mul(ra, pub.U)
+	R2 := g1.Point().Mul(rb, pub.V)
+	R4 := g1.Point().Sub(g1.Point().Mul(rx, sig.T1), g1.Point().Mul(rd1, pub.U))
+	R5 := g1.Point().Sub(g1.Point().Mul(rx, sig.T2), g1.Point().Mul(rd2, pub.V))
+	P := g1.Point().Mul(rx, sig.T3)
+	P.Sub(P, g1.Point().Mul(g1.Scalar().Add(rd1, rd2), pub.H))
+	Q := g1.Point().Mul(g1.Scalar().Neg(g1.Scalar().Add(ra, rb)), pub.H)
+	R3 := suite.GT().Point().Add(suite.Pair(P, pub.G2), suite.Pair(Q, pub.W))
+
+	c := challenge(suite, pub, msg, sig, R1, R2, R3, R4, R5)
+	response := func(r, v kyber.Scalar) kyber.Scalar {
+		return g1.Scalar().Add(r, g1.Scalar().Mul(c, v))
+	}
+	sig.C = c
+	sig.SAlpha = response(ra, alpha)
+	sig.SBeta = response(rb, beta)
+	sig.SX = response(rx, mk.X)
+	sig.SD1 = response(rd1, d1)
+	sig.SD2 = response(rd2, d2)
+
+	var b bytes.Buffer
+	for _, p := range []kyber.Point{sig.T1, sig.T2, sig.T3} {
+		if _, err := p.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []kyber.Scalar{sig.C, sig.SAlpha, sig.SBeta, sig.SX, sig.SD1, sig.SD2} {
+		if _, err := s.MarshalTo(&b); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// Verify checks the group signature sig of msg under the group public key.
+// It returns nil iff the signature is valid.
+func Verify(suite pairing.Suite, pub *PublicKey, msg, sig []byte) error {
+	_, err := verify(suite, pub, msg, sig)
+	return err
+}
+
+// Open checks the group signature sig of msg and returns the A of the
+// member key that created it, which the issuer can match with the members
+// it registered.
+func (ok *OpeningKey) Open(suite pairing.Suite, pub *PublicKey, msg, sig []byte) (kyber.Point, error) {
+	s, err := verify(suite, pub, msg, sig)
+	if err != nil {
+		return nil, err
+	}
+	// A = T3 - xi1*T1 - xi2*T2
+	g1 := suite.G1()
+	A := g1.Point().Sub(s.T3, g1.Point().Mul(ok.Xi1, s.T1))
+	return A.Sub(A, g1.Point().Mul(ok.Xi2, s.T2)), nil
+}
+
+func verify(suite pairing.Suite, pub *PublicKey, msg, buf []byte) (*signature, error) {
+	sig, err := decode(suite, buf)
+	if err != nil {
+		return nil, err
+	}
+	g1 := suite.G1()
+	c := sig.C
+	R1 := g1.Point().Sub(g1.Point().Mul(sig.SAlpha, pub.U), g1.Point().Mul(c, sig.T1))
+	R2 := g1.Point().Sub(g1.Point().Mul(sig.SBeta, pub.V), g1.Point().Mul(c, sig.T2))
+	R4 := g1.Point().Sub(g1.Point().Mul(sig.SX, sig.T1), g1.Point().Mul(sig.SD1, pub.U))
+	R5 := g1.Point().Sub(g1.Point().Mul(sig.SX, sig.T2), g1.Point().Mul(sig.SD2, pub.V))
+	// R3 = e(sx*T3 - (sd1+sd2)*H - c*G1, G2) * e(c*T3 - (salpha+sbeta)*H, W)
+	P := g1.Point().Mul(sig.SX, sig.T3)
+	P.Sub(P, g1.Point().Mul(g1.Scalar().Add(sig.SD1, sig.SD2), pub.H))
+	P.Sub(P, g1.Point().Mul(c, pub.G1))
+	Q := g1.Point().Mul(c, sig.T3)
+	Q.Sub(Q, g1.Point().Mul(g1.Scalar().Add(sig.SAlpha, sig.SBeta), pub.H))
+	R3 := suite.GT().Point().Add(suite.Pair(P, pub.G2), suite.Pair(Q, pub.W))
+
+	if !challenge(suite, pub, msg, sig, R1, R2, R3, R4, R5).Equal(c) {
+		return nil, errInvalidSignature
+	}
+	return sig, nil
+}
+
+func decode(suite pairing.Suite, buf []byte) (*signature, error) {
+	g1 := suite.G1()
+	pointSize := g1.PointLen()
+	scalarSize := g1.ScalarLen()
+	if len(buf) != 3*pointSize+6*scalarSize {
+		return nil, fmt.Errorf("groupsig: signature of invalid length %d instead of %d", len(buf), 3*pointSize+6*scalarSize)
+	}
+	points := make([]kyber.Point, 3)
+	for i := range points {
+		points[i] = g1.Point()
+		if err := points[i].UnmarshalBinary(buf[:pointSize]); err != nil {
+			return nil, err
+		}
+		buf = buf[pointSize:]
+	}
+	scalars := make([]kyber.Scalar, 6)
+	for i := range scalars {
+		scalars[i] = g1.Scalar()
+		if err := scalars[i].UnmarshalBinary(buf[:scalarSize]); err != nil {
+			return nil, err
+		}
+		buf = buf[scalarSize:]
+	}
+	return &signature{
+		T1: points[0], T2: points[1], T3: points[2],
+		C: scalars[0], SAlpha: scalars[1], SBeta: scalars[2],
+		SX: scalars[3], SD1: scalars[4], SD2: scalars[5],
+	}, nil
+}
+
+// challenge returns the Fiat-Shamir challenge of a signature.
+func challenge(suite pairing.Suite, pub *PublicKey, msg []byte, sig *signature, R ...kyber.Point) kyber.Scalar {
+	t := transcript.New(&struct {
+		kyber.Group
+		pairing.Suite
+	}{suite.G1(), suite}, "groupsig")
+	t.Append("message", msg)
+	t.AppendPoints("public", pub.G1, pub.H, pub.U, pub.V, pub.G2, pub.W)
+	t.AppendPoints("T", sig.T1, sig.T2, sig.T3)
+	t.AppendPoints("R", R...)
+	return t.Challenge("challenge")
+}
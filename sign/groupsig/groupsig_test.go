@@ -0,0 +1,111 @@
+// +build vartime
+
+package groupsig
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+func TestGroupSig(t *testing.T) {
+	rand := suite.RandomStream()
+	pub, ik, ok := NewGroup(suite, rand)
+	alice := ik.Join(suite, pub, rand)
+	bob := ik.Join(suite, pub, rand)
+	require.NoError(t, alice.Verify(suite, pub))
+	require.NoError(t, bob.Verify(suite, pub))
+
+	msg := []byte("Hello group signatures")
+	sig1, err := Sign(suite, pub, alice, msg, rand)
+	require.NoError(t, err)
+	sig2, err := Sign(suite, pub, alice, msg, rand)
+	require.NoError(t, err)
+	require.NotEqual(t, sig1, sig2)
+	sig3, err := Sign(suite, pub, bob, msg, rand)
+	require.NoError(t, err)
+	for _, sig := range [][]byte{sig1, sig2, sig3} {
+		require.NoError(t, Verify(suite, pub, msg, sig))
+		require.Error(t, Verify(suite, pub, []byte("other"), sig))
+	}
+
+	A, err := ok.Open(suite, pub, msg, sig1)
+	require.NoError(t, err)
+	require.True(t, A.Equal(alice.A))
+	A, err = ok.Open(suite, pub, msg, sig3)
+	require.NoError(t, err)
+	require.True(t, A.Equal(bob.A))
+
+	// Another group does not accept the signatures.
+	other, _, _ := NewGroup(suite, rand)
+	require.Error(t, Verify(suite, other, msg, sig1))
+	require.Error(t, Verify(suite, pub, msg, sig1[1:]))
+	tampered := append([]byte(nil), sig1...)
+	tampered[len(tampered)-1] ^= 1
+	require.Error(t, Verify(suite, pub, msg, tampered))
+}
+
+func TestMemberKey(t *testing.T) {
+	rand := suite.RandomStream()
+	pub, ik, _ := NewGroup(suite, rand)
+	mk := ik.Join(suite, pub, rand)
+	mk.X = suite.G1().Scalar().Add(mk.X, suite.G1().Scalar().One())
+	require.Error(t, mk.Verify(suite, pub))
+
+	// A forged member key does not sign.
+	sig, err := Sign(suite, pub, mk, []byte("forged"), rand)
+	require.NoError(t, err)
+	require.Error(t, Verify(suite, pub, []byte("forged"), sig))
+}
+
+func TestRevocation(t *testing.T) {
+	rand := suite.RandomStream()
+	pub, ik, ok := NewGroup(suite, rand)
+	alice := ik.Join(suite, pub, rand)
+	bob := ik.Join(suite, pub, rand)
+	carol := ik.Join(suite, pub, rand)
+	msg := []byte("Hello group signatures")
+
+	rev := ik.Revoke(suite, pub, bob)
+	pub2, err := pub.Apply(suite, rev)
+	require.NoError(t, err)
+	alice2, err := alice.Apply(suite, rev)
+	require.NoError(t, err)
+	require.NoError(t, alice2.Verify(suite, pub2))
+	_, err = bob.Apply(suite, rev)
+	require.Error(t, err)
+
+	sig, err := Sign(suite, pub2, alice2, msg, rand)
+	require.NoError(t, err)
+	require.NoError(t, Verify(suite, pub2, msg, sig))
+	A, err := ok.Open(suite, pub2, msg, sig)
+	require.NoError(t, err)
+	require.True(t, A.Equal(alice2.A))
+
+	// The revoked member can no longer sign for the group.
+	sig, err = Sign(suite, pub2, bob, msg, rand)
+	require.NoError(t, err)
+	require.Error(t, Verify(suite, pub2, msg, sig))
+
+	// Revocations apply in sequence, and new members join the updated
+	// group.
+	rev2 := ik.Revoke(suite, pub2, alice2)
+	pub3, err := pub2.Apply(suite, rev2)
+	require.NoError(t, err)
+	carol3, err := carol.Apply(suite, rev)
+	require.NoError(t, err)
+	carol3, err = carol3.Apply(suite, rev2)
+	require.NoError(t, err)
+	require.NoError(t, carol3.Verify(suite, pub3))
+	dave := ik.Join(suite, pub3, rand)
+	require.NoError(t, dave.Verify(suite, pub3))
+
+	// A forged revocation is rejected.
+	bad := *rev2
+	bad.B = rev.B
+	_, err = pub2.Apply(suite, &bad)
+	require.Error(t, err)
+}
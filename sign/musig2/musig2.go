@@ -0,0 +1,300 @@
+// Package musig2 implements MuSig2, the two-round n-of-n Schnorr
+// multisignature protocol of Nick, Ruffing and Seurin
+// (https://eprint.iacr.org/2020/1261), over any kyber group.
+//
+// The public keys of the n signers are aggregated with AggregateKey into a
+// single key, each weighted by a coefficient that depends on all of them,
+// so that a signer cannot choose its key to cancel out the others. The
+// signature of a message under the aggregate key takes two rounds:
+//
+//  1. Every signer creates a pair of nonce commitments with Signer.Commit
+//     and sends them to the others. This round does not depend on the
+//     message, and can be run before it is known.
+//  2. Once it has the commitments of all the signers, every signer computes
+//     its signature share of the message with Signer.Sign and sends it to
+//     the others, or to an aggregator. Signature checks the shares and
+//     aggregates them into the signature.
+//
+// Nonces are never reused: a signer forgets its nonces once it has signed,
+// and must commit again for every signature. The resulting signatures are
+// plain Schnorr signatures R || s under the aggregate key, which are
+// verified with package sign/schnorr and, on the edwards25519 group, by any
+// Ed25519 implementation.
+package musig2
+
+import (
+	"crypto/sha512"
+	"errors"
+	"fmt"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/sign/schnorr"
+)
+
+// Suite represents the set of functionalities needed by the package musig2.
+type Suite interface {
+	kyber.Group
+	kyber.Random
+}
+
+// Commitment holds the nonce commitments R1 = r1*G and R2 = r2*G published
+// by the signer of the given index in the first round.
+type Commitment struct {
+	Index  int
+	R1, R2 kyber.Point
+}
+
+// SignatureShare is the share s of a signature created by the signer of
+// the given index in the second round.
+type SignatureShare struct {
+	Index int
+	S     kyber.Scalar
+}
+
+// AggregateKey returns the aggregate public key of the signers of the given
+// public keys, under which they sign together.
+func AggregateKey(g kyber.Group, publics []kyber.Point) (kyber.Point, error) {
+	k, err := newKeyAgg(g, publics)
+	if err != nil {
+		return nil, err
+	}
+	return k.key, nil
+}
+
+// Signer holds the private key of a signer.
+type Signer struct {
+	suite   Suite
+	private kyber.Scalar
+	index   int
+	keys    *keyAgg
+
+	// Nonces of the pending commitment, nil once used.
+	r1, r2 kyber.Scalar
+	commit *Commitment
+}
+
+// NewSigner returns a signer for the private key, among the signers of the
+// given public keys, which must include the public key of private. The
+// index of the signer is that of its key in publics.
+func NewSigner(suite Suite, private kyber.Scalar, publics []kyber.Point) (*Signer, error) {
+	keys, err := newKeyAgg(suite, publics)
+	if err != nil {
+		return nil, err
+	}
+	public := suite.Point().Mul(private, nil)
+	for i, p := range publics {
+		if p.Equal(public) {
+			return &Signer{suite: suite, private: private, index: i, keys: keys}, nil
+		}
+	}
+	return nil, errors.New("musig2: public key of the signer missing")
+}
+
+// Commit creates fresh nonces and returns their commitment, to be sent to
+// the other signers. Any nonces of a previous commitment are forgotten.
+func (s *Signer) Commit() *Commitment {
+	rand := s.suite.RandomStream()
+	s.r1 = s.suite.Scalar().Pick(rand)
+	s.r2 = s.suite.Scalar().Pick(rand)
+	s.commit = &Commitment{
+		Index: s.index,
+		R1:    s.suite.Point().Mul(s.r1, nil),
+		R2:    s.suite.Point().Mul(s.r2, nil),
+	}
+	return s.commit
+}
+
+// Sign returns the signature share of msg given the commitments of all the
+// signers, which must include the last commitment of s. The nonces of that
+// commitment are forgotten, so that Commit must be called again before the
+// next signature.
+func (s *Signer) Sign(msg []byte, commits []*Commitment) (*SignatureShare, error) {
+	if s.commit == nil {
+		return nil, errors.New("musig2: no pending commitment")
+	}
+	sess, err := newSession(s.suite, s.keys, msg, commits)
+	if err != nil {
+		return nil, err
+	}
+	own := sess.commits[s.index]
+	if !own.R1.Equal(s.commit.R1) || !own.R2.Equal(s.commit.R2) {
+		return nil, errors.New("musig2: own commitment missing")
+	}
+	r1, r2 := s.r1, s.r2
+	s.r1, s.r2, s.commit = nil, nil, nil
+
+	// s = r1 + b*r2 + c*a*x
+	z := s.suite.Scalar().Mul(sess.b, r2)
+	z.Add(z, r1)
+	ax := s.suite.Scalar().Mul(s.keys.coeffs[s.index], s.private)
+	z.Add(z, ax.Mul(ax, sess.c))
+	return &SignatureShare{Index: s.index, S: z}, nil
+}
+
+// Signature checks the signature shares of msg by the signers of the given
+// public keys, made for the given commitments, and returns the resulting
+// Schnorr signature R || s under their aggregate key. An invalid share is
+// reported with the index of its signer.
+func Signature(g kyber.Group, publics []kyber.Point, msg []byte, commits []*Commitment, shares []*SignatureShare) ([]byte, error) {
+	keys, err := newKeyAgg(g, publics)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := newSession(g, keys, msg, commits)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) != len(publics) {
+		return nil, errors.New("musig2: missing signature shares")
+	}
+	seen := make([]bool, len(publics))
+	z := g.Scalar().Zero()
+	for _, share := range shares {
+		if share == nil || share.S == nil {
+			return nil, errors.New("musig2: malformed signature share")
+		}
+		i := share.Index
+		if i < 0 || i >= len(publics) || seen[i] {
+			return nil, errors.New("musig2: invalid or duplicate share index")
+		}
+		seen[i] = true
+		// s*G = R1 + b*R2 + c*a*X
+		com := sess.commits[i]
+		right := g.Point().Mul(sess.b, com.R2)
+		right.Add(right, com.R1)
+		ca := g.Scalar().Mul(sess.c, keys.coeffs[i])
+		right.Add(right, g.Point().Mul(ca, publics[i]))
+		if !g.Point().Mul(share.S, nil).Equal(right) {
+			return nil, fmt.Errorf("musig2: invalid share from signer %d", i)
+		}
+		z.Add(z, share.S)
+	}
+	Rbuff, err := sess.R.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	zbuff, err := z.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(Rbuff, zbuff...), nil
+}
+
+// keyAgg holds the aggregation of a list of public keys.
+type keyAgg struct {
+	coeffs []kyber.Scalar // coefficient of each key
+	key    kyber.Point    // aggregate key
+}
+
+func newKeyAgg(g kyber.Group, publics []kyber.Point) (*keyAgg, error) {
+	if len(publics) == 0 {
+		return nil, errors.New("musig2: no public keys")
+	}
+	null := g.Point().Null()
+	for i, P := range publics {
+		if P == nil {
+			return nil, errors.New("musig2: malformed public key")
+		}
+		v, ok := P.(kyber.ValidatablePoint)
+		if P.Equal(null) || ok && !v.Valid() {
+			return nil, fmt.Errorf("musig2: invalid public key %d", i)
+		}
+	}
+	for i := range publics {
+		for j := 0; j < i; j++ {
+			if publics[i].Equal(publics[j]) {
+				return nil, errors.New("musig2: duplicate public key")
+			}
+		}
+	}
+	// The coefficient of X_i is H(H(X_1 || ... || X_n) || X_i).
+	h := sha512.New()
+	h.Write([]byte("MuSig2 key list"))
+	for _, p := range publics {
+		if _, err := p.MarshalTo(h); err != nil {
+			return nil, err
+		}
+	}
+	list := h.Sum(nil)
+	k := &keyAgg{key: g.Point().Null()}
+	for _, p := range publics {
+		h.Reset()
+		h.Write([]byte("MuSig2 key coefficient"))
+		h.Write(list)
+		if _, err := p.MarshalTo(h); err != nil {
+			return nil, err
+		}
+		a := g.Scalar().SetBytes(h.Sum(nil))
+		k.coeffs = append(k.coeffs, a)
+		k.key.Add(k.key, g.Point().Mul(a, p))
+	}
+	return k, nil
+}
+
+// checkCommitment checks that both nonce commitments of com are set, are
+// not the identity, and lie in the prime-order group if g can tell, as a
+// signer could otherwise cancel its nonces out of the aggregate nonce or
+// give it a small-order component.
+func checkCommitment(g kyber.Group, com *Commitment) error {
+	if com == nil || com.R1 == nil || com.R2 == nil {
+		return errors.New("musig2: malformed commitment")
+	}
+	null := g.Point().Null()
+	for _, P := range []kyber.Point{com.R1, com.R2} {
+		v, ok := P.(kyber.ValidatablePoint)
+		if P.Equal(null) || ok && !v.Valid() {
+			return fmt.Errorf("musig2: invalid commitment from signer %d", com.Index)
+		}
+	}
+	return nil
+}
+
+// session holds the values derived from the commitments of all the signers.
+type session struct {
+	commits []*Commitment // indexed by signer
+	b       kyber.Scalar  // nonce coefficient
+	R       kyber.Point   // aggregate nonce
+	c       kyber.Scalar  // challenge
+}
+
+func newSession(g kyber.Group, keys *keyAgg, msg []byte, commits []*Commitment) (*session, error) {
+	n := len(keys.coeffs)
+	s := &session{commits: make([]*Commitment, n)}
+	for _, com := range commits {
+		if err := checkCommitment(g, com); err != nil {
+			return nil, err
+		}
+		if com.Index < 0 || com.Index >= n || s.commits[com.Index] != nil {
+			return nil, errors.New("musig2: invalid or duplicate commitment index")
+		}
+		s.commits[com.Index] = com
+	}
+	R1 := g.Point().Null()
+	R2 := g.Point().Null()
+	for i, com := range s.commits {
+		if com == nil {
+			return nil, fmt.Errorf("musig2: missing commitment from signer %d", i)
+		}
+		R1.Add(R1, com.R1)
+		R2.Add(R2, com.R2)
+	}
+
+	// b = H(X || R1 || R2 || msg) binds the nonces to the message.
+	h := sha512.New()
+	h.Write([]byte("MuSig2 nonce coefficient"))
+	for _, p := range []kyber.Point{keys.key, R1, R2} {
+		if _, err := p.MarshalTo(h); err != nil {
+			return nil, err
+		}
+	}
+	h.Write(msg)
+	s.b = g.Scalar().SetBytes(h.Sum(nil))
+	s.R = R1.Add(R1, R2.Mul(s.b, R2))
+
+	c, err := schnorr.Challenge(g, keys.key, s.R, msg)
+	if err != nil {
+		return nil, err
+	}
+	s.c = c
+	return s, nil
+}
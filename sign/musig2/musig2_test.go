@@ -0,0 +1,215 @@
+package musig2
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/sign/eddsa"
+	"github.com/dedis/kyber/sign/schnorr"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = edwards25519.NewBlakeSHA256Ed25519()
+
+func setup(t *testing.T, n int) ([]kyber.Point, []*Signer) {
+	privates := make([]kyber.Scalar, n)
+	publics := make([]kyber.Point, n)
+	for i := range privates {
+		privates[i] = suite.Scalar().Pick(suite.RandomStream())
+		publics[i] = suite.Point().Mul(privates[i], nil)
+	}
+	signers := make([]*Signer, n)
+	for i, x := range privates {
+		s, err := NewSigner(suite, x, publics)
+		require.NoError(t, err)
+		signers[i] = s
+	}
+	return publics, signers
+}
+
+// commit runs the first round.
+func commit(signers []*Signer) []*Commitment {
+	commits := make([]*Commitment, len(signers))
+	for i, s := range signers {
+		commits[i] = s.Commit()
+	}
+	return commits
+}
+
+// sign runs the second round.
+func sign(t *testing.T, signers []*Signer, msg []byte, commits []*Commitment) []*SignatureShare {
+	shares := make([]*SignatureShare, len(signers))
+	for i, s := range signers {
+		share, err := s.Sign(msg, commits)
+		require.NoError(t, err)
+		shares[i] = share
+	}
+	return shares
+}
+
+func TestMuSig2(t *testing.T) {
+	msg := []byte("Hello MuSig2")
+	for _, n := range []int{1, 2, 5} {
+		publics, signers := setup(t, n)
+		key, err := AggregateKey(suite, publics)
+		require.NoError(t, err)
+
+		commits := commit(signers)
+		shares := sign(t, signers, msg, commits)
+		sig, err := Signature(suite, publics, msg, commits, shares)
+		require.NoError(t, err)
+		require.NoError(t, schnorr.Verify(suite, key, msg, sig))
+		require.NoError(t, eddsa.Verify(key, msg, sig))
+		require.Error(t, schnorr.Verify(suite, key, []byte("other"), sig))
+	}
+}
+
+func TestAggregateKey(t *testing.T) {
+	publics, _ := setup(t, 3)
+	key, err := AggregateKey(suite, publics)
+	require.NoError(t, err)
+
+	// The aggregate key is not the plain sum of the keys, and depends on
+	// their order.
+	sum := suite.Point().Null()
+	for _, p := range publics {
+		sum.Add(sum, p)
+	}
+	require.False(t, key.Equal(sum))
+	other, err := AggregateKey(suite, []kyber.Point{publics[1], publics[0], publics[2]})
+	require.NoError(t, err)
+	require.False(t, key.Equal(other))
+
+	_, err = AggregateKey(suite, nil)
+	require.Error(t, err)
+	_, err = AggregateKey(suite, []kyber.Point{publics[0], publics[0]})
+	require.Error(t, err)
+	_, err = NewSigner(suite, suite.Scalar().One(), publics)
+	require.Error(t, err)
+}
+
+func TestNonces(t *testing.T) {
+	msg := []byte("Hello MuSig2")
+	_, signers := setup(t, 2)
+	_, err := signers[0].Sign(msg, nil)
+	require.Error(t, err)
+
+	// A signer refuses a missing or foreign commitment of its own.
+	commits := commit(signers)
+	_, err = signers[0].Sign(msg, commits[1:])
+	require.Error(t, err)
+	foreign := &Commitment{Index: 0, R1: commits[1].R1, R2: commits[1].R2}
+	_, err = signers[0].Sign(msg, []*Commitment{foreign, commits[1]})
+	require.Error(t, err)
+
+	// Nonces are used only once.
+	_, err = signers[0].Sign(msg, commits)
+	require.NoError(t, err)
+	_, err = signers[0].Sign(msg, commits)
+	require.Error(t, err)
+}
+
+func TestInvalidShare(t *testing.T) {
+	msg := []byte("Hello MuSig2")
+	publics, signers := setup(t, 3)
+	commits := commit(signers)
+	shares := sign(t, signers, msg, commits)
+
+	shares[1].S = suite.Scalar().Add(shares[1].S, suite.Scalar().One())
+	_, err := Signature(suite, publics, msg, commits, shares)
+	require.EqualError(t, err, "musig2: invalid share from signer 1")
+
+	_, err = Signature(suite, publics, msg, commits, shares[:2])
+	require.Error(t, err)
+	_, err = Signature(suite, publics, msg, commits, []*SignatureShare{shares[0], shares[0], shares[2]})
+	require.Error(t, err)
+}
+
+func TestInvalidCommitment(t *testing.T) {
+	msg := []byte("Hello MuSig2")
+	publics, signers := setup(t, 2)
+
+	// The point of order 2 is on the curve but not in the prime-order group.
+	torsion := suite.Point()
+	require.NoError(t, torsion.UnmarshalBinary(append(append([]byte{0xec},
+		bytes.Repeat([]byte{0xff}, 30)...), 0x7f)))
+
+	own := signers[0].Commit()
+	other := signers[1].Commit()
+	for _, c := range []struct {
+		name string
+		bad  *Commitment
+	}{
+		{"nil", nil},
+		{"nil R1", &Commitment{Index: 1, R2: other.R2}},
+		{"nil R2", &Commitment{Index: 1, R1: other.R1}},
+		{"identity R1", &Commitment{Index: 1, R1: suite.Point().Null(), R2: other.R2}},
+		{"identity R2", &Commitment{Index: 1, R1: other.R1, R2: suite.Point().Null()}},
+		{"small-order R1", &Commitment{Index: 1, R1: torsion, R2: other.R2}},
+		{"mixed-order R2", &Commitment{Index: 1, R1: other.R1, R2: suite.Point().Add(other.R2, torsion)}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			commits := []*Commitment{own, c.bad}
+			_, err := signers[0].Sign(msg, commits)
+			require.Error(t, err)
+			_, err = Signature(suite, publics, msg, commits, nil)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "commitment")
+		})
+	}
+
+	// The nonces of the signer are kept after an invalid set of commitments.
+	_, err := signers[0].Sign(msg, []*Commitment{own, other})
+	require.NoError(t, err)
+}
+
+func TestInvalidPublicKey(t *testing.T) {
+	publics, signers := setup(t, 2)
+
+	torsion := suite.Point()
+	require.NoError(t, torsion.UnmarshalBinary(append(append([]byte{0xec},
+		bytes.Repeat([]byte{0xff}, 30)...), 0x7f)))
+
+	for _, c := range []struct {
+		name string
+		bad  kyber.Point
+		err  string
+	}{
+		{"nil", nil, "musig2: malformed public key"},
+		{"identity", suite.Point().Null(), "musig2: invalid public key 1"},
+		{"small-order", torsion, "musig2: invalid public key 1"},
+		{"mixed-order", suite.Point().Add(publics[1], torsion), "musig2: invalid public key 1"},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			keys := []kyber.Point{publics[0], c.bad}
+			_, err := AggregateKey(suite, keys)
+			require.EqualError(t, err, c.err)
+			_, err = NewSigner(suite, signers[0].private, keys)
+			require.EqualError(t, err, c.err)
+			_, err = Signature(suite, keys, []byte("Hello MuSig2"), nil, nil)
+			require.EqualError(t, err, c.err)
+		})
+	}
+}
+
+func TestNilShare(t *testing.T) {
+	msg := []byte("Hello MuSig2")
+	publics, signers := setup(t, 2)
+	commits := commit(signers)
+	shares := sign(t, signers, msg, commits)
+
+	for _, c := range []struct {
+		name string
+		bad  *SignatureShare
+	}{
+		{"nil", nil},
+		{"nil S", &SignatureShare{Index: 1}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := Signature(suite, publics, msg, commits, []*SignatureShare{shares[0], c.bad})
+			require.EqualError(t, err, "musig2: malformed signature share")
+		})
+	}
+}
@@ -8,6 +8,10 @@ challenge times private key while the Wikipedia article substracts them.
 
 The resulting signature is compatible with EdDSA verification algorithm
 when using the edwards25519 group, and by extension the CoSi verification algorithm.
+
+A signature is encoded as the binary encoding of the commitment R followed
+by that of the response s. Key pairs for any group can be created with
+package util/key.
 */
 package schnorr
 
@@ -18,6 +22,8 @@ import (
 	"fmt"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/msm"
+	"github.com/dedis/kyber/util/random"
 )
 
 // Suite represents the set of functionalities needed by the package schnorr.
@@ -26,18 +32,47 @@ type Suite interface {
 	kyber.Random
 }
 
+// DeterministicSuite represents the set of functionalities needed by
+// SignDeterministic.
+type DeterministicSuite interface {
+	kyber.Group
+	kyber.XOFFactory
+}
+
 // Sign creates a Sign signature from a msg and a private key. This
 // signature can be verified with VerifySchnorr. It's also a valid EdDSA
 // signature when using the edwards25519 Group.
 func Sign(s Suite, private kyber.Scalar, msg []byte) ([]byte, error) {
-	var g kyber.Group = s
-	// create random secret k and public point commitment R
-	k := g.Scalar().Pick(s.RandomStream())
+	// create random secret k
+	k := s.Scalar().Pick(s.RandomStream())
+	return sign(s, private, k, msg)
+}
+
+// SignDeterministic creates a Schnorr signature like Sign, but derives the
+// random secret from the private key and the message using the suite's XOF
+// instead of a random stream, so that signing the same message twice gives
+// the same signature and a weak random source cannot leak the private key.
+// The signature can be verified with Verify.
+func SignDeterministic(s DeterministicSuite, private kyber.Scalar, msg []byte) ([]byte, error) {
+	seed, err := private.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	xof := s.XOF(append([]byte("schnorr nonce"), seed...))
+	if _, err := xof.Write(msg); err != nil {
+		return nil, err
+	}
+	k := s.Scalar().Pick(xof)
+	return sign(s, private, k, msg)
+}
+
+func sign(g kyber.Group, private, k kyber.Scalar, msg []byte) ([]byte, error) {
+	// public point commitment R
 	R := g.Point().Mul(k, nil)
 
 	// create hash(public || R || message)
 	public := g.Point().Mul(private, nil)
-	h, err := hash(g, public, R, msg)
+	h, err := Challenge(g, public, R, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -60,22 +95,12 @@ func Sign(s Suite, private kyber.Scalar, msg []byte) ([]byte, error) {
 // Verify verifies a given Schnorr signature. It returns nil iff the
 // given signature is valid.
 func Verify(g kyber.Group, public kyber.Point, msg, sig []byte) error {
-	R := g.Point()
-	s := g.Scalar()
-	pointSize := R.MarshalSize()
-	scalarSize := s.MarshalSize()
-	sigSize := scalarSize + pointSize
-	if len(sig) != sigSize {
-		return fmt.Errorf("schnorr: signature of invalid length %d instead of %d", len(sig), sigSize)
-	}
-	if err := R.UnmarshalBinary(sig[:pointSize]); err != nil {
-		return err
-	}
-	if err := s.UnmarshalBinary(sig[pointSize:]); err != nil {
+	R, s, err := decode(g, sig)
+	if err != nil {
 		return err
 	}
 	// recompute hash(public || R || msg)
-	h, err := hash(g, public, R, msg)
+	h, err := Challenge(g, public, R, msg)
 	if err != nil {
 		return err
 	}
@@ -83,7 +108,7 @@ func Verify(g kyber.Group, public kyber.Point, msg, sig []byte) error {
 	// compute S = g^s
 	S := g.Point().Mul(s, nil)
 	// compute RAh = R + A^h
-	Ah := g.Point().Mul(h, public)
+	Ah := kyber.VarTimePoint(g).Mul(h, public)
 	RAs := g.Point().Add(R, Ah)
 
 	if !S.Equal(RAs) {
@@ -93,9 +118,103 @@ func Verify(g kyber.Group, public kyber.Point, msg, sig []byte) error {
 	return nil
 }
 
-func hash(g kyber.Group, public, r kyber.Point, msg []byte) (kyber.Scalar, error) {
+// VerifyBatch verifies the Schnorr signatures sigs of the messages msgs
+// under the public keys publics, where the i-th signature is checked
+// against the i-th message and key. It returns nil iff all the signatures
+// are valid.
+//
+// Instead of checking every signature equation on its own, VerifyBatch
+// checks a single random linear combination of them, so that an invalid
+// signature makes the check fail except with negligible probability. The
+// coefficients are drawn from the suite's random stream. When the check
+// fails, Verify can be used to find the invalid signatures.
+//
+// A small-order component of R or of a public key would cancel out of the
+// combination whenever its coefficient is even. In groups whose points
+// implement kyber.CofactorPoint, VerifyBatch thus clears the cofactor of
+// the combination, as in the cofactored equation of RFC8032, and accepts
+// the signatures with such components that Verify may reject. In other
+// groups whose points implement kyber.ValidatablePoint, it rejects R and
+// public keys outside of the prime-order group.
+func VerifyBatch(s Suite, publics []kyber.Point, msgs, sigs [][]byte) error {
+	if len(publics) != len(msgs) || len(msgs) != len(sigs) {
+		return errors.New("schnorr: batch of inconsistent length")
+	}
+	rand := s.RandomStream()
+	var zb [16]byte
+	cofactor, cofactored := s.Point().(kyber.CofactorPoint)
+
+	// Check that sum(z_i*s_i)*G - sum(z_i*R_i) - sum(z_i*h_i*A_i) = 0.
+	sum := s.Scalar().Zero()
+	scalars := make([]kyber.Scalar, 0, 2*len(sigs)+1)
+	points := make([]kyber.Point, 0, 2*len(sigs)+1)
+	for i, sig := range sigs {
+		R, S, err := decode(s, sig)
+		if err != nil {
+			return err
+		}
+		if !cofactored && !valid(R) {
+			return errors.New("schnorr: invalid commitment")
+		}
+		if !cofactored && !valid(publics[i]) {
+			return errors.New("schnorr: invalid public key")
+		}
+		h, err := Challenge(s, publics[i], R, msgs[i])
+		if err != nil {
+			return err
+		}
+		// A 128-bit coefficient is enough to catch an invalid signature.
+		random.Bytes(zb[:], rand)
+		z := s.Scalar().SetBytes(zb[:])
+
+		sum.Add(sum, s.Scalar().Mul(z, S))
+		scalars = append(scalars, s.Scalar().Neg(z), s.Scalar().Neg(h.Mul(h, z)))
+		points = append(points, R, publics[i])
+	}
+
+	P := msm.Mul(s, append(scalars, sum), append(points, nil))
+	if cofactored {
+		P = cofactor.MulCofactor(P)
+	}
+	if !P.Equal(s.Point().Null()) {
+		return errors.New("schnorr: invalid signature in batch")
+	}
+	return nil
+}
+
+// decode splits a signature into its commitment R and response s.
+func decode(g kyber.Group, sig []byte) (kyber.Point, kyber.Scalar, error) {
+	R := g.Point()
+	s := g.Scalar()
+	pointSize := R.MarshalSize()
+	scalarSize := s.MarshalSize()
+	sigSize := scalarSize + pointSize
+	if len(sig) != sigSize {
+		return nil, nil, fmt.Errorf("schnorr: signature of invalid length %d instead of %d", len(sig), sigSize)
+	}
+	if err := R.UnmarshalBinary(sig[:pointSize]); err != nil {
+		return nil, nil, err
+	}
+	if err := s.UnmarshalBinary(sig[pointSize:]); err != nil {
+		return nil, nil, err
+	}
+	return R, s, nil
+}
+
+// valid reports whether P lies in the prime-order group, if its group can
+// check it.
+func valid(P kyber.Point) bool {
+	v, ok := P.(kyber.ValidatablePoint)
+	return !ok || v.Valid()
+}
+
+// Challenge returns the challenge H(R || public || msg) of a signature with
+// the commitment R under the key public, as a scalar of g reduced from the
+// 64 bytes of the SHA-512 digest. Schemes producing signatures that Verify
+// checks, such as sign/adaptor or sign/musig2, compute it with Challenge.
+func Challenge(g kyber.Group, public, R kyber.Point, msg []byte) (kyber.Scalar, error) {
 	h := sha512.New()
-	if _, err := r.MarshalTo(h); err != nil {
+	if _, err := R.MarshalTo(h); err != nil {
 		return nil, err
 	}
 	if _, err := public.MarshalTo(h); err != nil {
@@ -104,5 +223,7 @@ func hash(g kyber.Group, public, r kyber.Point, msg []byte) (kyber.Scalar, error
 	if _, err := h.Write(msg); err != nil {
 		return nil, err
 	}
-	return g.Scalar().SetBytes(h.Sum(nil)), nil
+	var digest [64]byte
+	h.Sum(digest[:0])
+	return kyber.SetBytesWide(g.Scalar(), digest), nil
 }
@@ -1,8 +1,12 @@
 package schnorr
 
 import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
 	"testing"
 
+	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
 	"github.com/dedis/kyber/sign/eddsa"
 	"github.com/dedis/kyber/util/key"
@@ -62,3 +66,140 @@ func TestEdDSACompatibility(t *testing.T) {
 	}
 
 }
+
+func TestSignDeterministic(t *testing.T) {
+	msg := []byte("Hello Schnorr")
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	kp := key.NewKeyPair(suite)
+
+	s1, err := SignDeterministic(suite, kp.Private, msg)
+	assert.Nil(t, err)
+	assert.Nil(t, Verify(suite, kp.Public, msg, s1))
+
+	s2, err := SignDeterministic(suite, kp.Private, msg)
+	assert.Nil(t, err)
+	assert.Equal(t, s1, s2)
+
+	s3, err := SignDeterministic(suite, kp.Private, []byte("Hello again"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, s1[:32], s3[:32])
+
+	wrKp := key.NewKeyPair(suite)
+	assert.Error(t, Verify(suite, wrKp.Public, msg, s1))
+}
+
+func TestVerifyBatch(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	n := 16
+	publics := make([]kyber.Point, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		kp := key.NewKeyPair(suite)
+		publics[i] = kp.Public
+		msgs[i] = []byte(fmt.Sprintf("Hello Schnorr %d", i))
+		s, err := Sign(suite, kp.Private, msgs[i])
+		assert.Nil(t, err)
+		sigs[i] = s
+	}
+	assert.Nil(t, VerifyBatch(suite, publics, msgs, sigs))
+	assert.Nil(t, VerifyBatch(suite, nil, nil, nil))
+
+	// wrong message
+	msgs[3], msgs[4] = msgs[4], msgs[3]
+	assert.Error(t, VerifyBatch(suite, publics, msgs, sigs))
+	msgs[3], msgs[4] = msgs[4], msgs[3]
+
+	// wrong response
+	wrResp := append([]byte(nil), sigs[7]...)
+	wrResp[40] ^= 1
+	sigs[7], wrResp = wrResp, sigs[7]
+	assert.Error(t, VerifyBatch(suite, publics, msgs, sigs))
+	sigs[7] = wrResp
+
+	// inconsistent lengths
+	assert.Error(t, VerifyBatch(suite, publics[1:], msgs, sigs))
+	assert.Nil(t, VerifyBatch(suite, publics, msgs, sigs))
+}
+
+// TestSmallOrderR checks a signature whose R = r*G + T has a component T of
+// order 2: Verify rejects it, as EdDSA does, and VerifyBatch consistently
+// accepts it by clearing the cofactor, where it used to accept it only when
+// its random coefficient was even.
+func TestSmallOrderR(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	T := suite.Point()
+	b, _ := hex.DecodeString("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	assert.Nil(t, T.UnmarshalBinary(b))
+	assert.True(t, suite.Point().Add(T, T).Equal(suite.Point().Null()))
+
+	kp := key.NewKeyPair(suite)
+	msg := []byte("small-order R")
+	r := suite.Scalar().Pick(suite.RandomStream())
+	R := suite.Point().Mul(r, nil)
+	R.Add(R, T)
+	h, err := Challenge(suite, kp.Public, R, msg)
+	assert.Nil(t, err)
+	s := suite.Scalar().Mul(kp.Private, h)
+	s.Add(s, r)
+	var sig bytes.Buffer
+	_, _ = R.MarshalTo(&sig)
+	_, _ = s.MarshalTo(&sig)
+
+	assert.Error(t, Verify(suite, kp.Public, msg, sig.Bytes()))
+	for i := 0; i < 200; i++ {
+		assert.Nil(t, VerifyBatch(suite, []kyber.Point{kp.Public}, [][]byte{msg}, [][]byte{sig.Bytes()}))
+	}
+
+	// So is a signature under a public key with a small-order component.
+	A := suite.Point().Add(kp.Public, T)
+	R2 := suite.Point().Mul(r, nil)
+	h, err = Challenge(suite, A, R2, msg)
+	assert.Nil(t, err)
+	s2 := suite.Scalar().Mul(kp.Private, h)
+	s2.Add(s2, r)
+	var sig2 bytes.Buffer
+	_, _ = R2.MarshalTo(&sig2)
+	_, _ = s2.MarshalTo(&sig2)
+	for i := 0; i < 200; i++ {
+		assert.Nil(t, VerifyBatch(suite, []kyber.Point{A}, [][]byte{msg}, [][]byte{sig2.Bytes()}))
+	}
+
+	// Whereas a wrong response is always rejected.
+	s.Add(s, suite.Scalar().One())
+	sig.Reset()
+	_, _ = R.MarshalTo(&sig)
+	_, _ = s.MarshalTo(&sig)
+	for i := 0; i < 200; i++ {
+		assert.Error(t, VerifyBatch(suite, []kyber.Point{kp.Public}, [][]byte{msg}, [][]byte{sig.Bytes()}))
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	kp := key.NewKeyPair(suite)
+	msg := []byte("Hello Schnorr")
+	s, _ := Sign(suite, kp.Private, msg)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Verify(suite, kp.Public, msg, s)
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	n := 64
+	publics := make([]kyber.Point, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := range sigs {
+		kp := key.NewKeyPair(suite)
+		publics[i] = kp.Public
+		msgs[i] = []byte("Hello Schnorr")
+		sigs[i], _ = Sign(suite, kp.Private, msgs[i])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyBatch(suite, publics, msgs, sigs)
+	}
+}
@@ -0,0 +1,105 @@
+// Package tbls implements the (t,n)-threshold Boneh-Lynn-Shacham signature
+// scheme. During setup, a group of n participants runs a distributed key
+// generation algorithm (see package share/dkg) to compute a joint public
+// signing key X and one private key share x_i per participant, of which at
+// least t are needed to sign. Each participant signs a message with its
+// share, creating a signature share. Any t valid signature shares can then
+// be combined into a regular BLS signature of the message under X, which
+// can be checked with package sign/bls.
+//
+// Threshold BLS signatures are unique, since they do not depend on which t
+// shares are combined, which makes them a building block for distributed
+// randomness beacons.
+package tbls
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/sign/bls"
+)
+
+// SigShare encodes a threshold BLS signature share Si = i || v, where the
+// 2-byte big-endian value i denotes the index of the share and v its
+// associated BLS signature.
+type SigShare []byte
+
+// Index returns the index i of the TBLS share Si.
+func (s SigShare) Index() (int, error) {
+	var index uint16
+	if len(s) < 2 {
+		return -1, errors.New("tbls: signature share too short")
+	}
+	index = binary.BigEndian.Uint16(s[:2])
+	return int(index), nil
+}
+
+// Value returns the value v of the TBLS share Si.
+func (s SigShare) Value() []byte {
+	return s[2:]
+}
+
+// Sign creates a threshold BLS signature Si = xi * H(m) of the message msg
+// using the private key share xi.
+func Sign(suite pairing.Suite, private *share.PriShare, msg []byte) ([]byte, error) {
+	if private.I < 0 || private.I > 0xffff {
+		return nil, errors.New("tbls: share index out of range")
+	}
+	buf := make([]byte, 2, 2+suite.G1().PointLen())
+	binary.BigEndian.PutUint16(buf, uint16(private.I))
+	s, err := bls.Sign(suite, private.V, msg)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, s...), nil
+}
+
+// Verify checks the given threshold BLS signature Si of the message msg
+// using the public key share Xi associated with the secret key share xi.
+// The public key shares are the evaluations of the public polynomial,
+// whose commitments are points of G2. It returns nil if the share is
+// valid.
+func Verify(suite pairing.Suite, public *share.PubPoly, msg, sig []byte) error {
+	s := SigShare(sig)
+	i, err := s.Index()
+	if err != nil {
+		return err
+	}
+	return bls.Verify(suite, public.Eval(i).V, msg, s.Value())
+}
+
+// Recover reconstructs the full BLS signature S = x * H(m) from a threshold
+// t of signature shares Si using Lagrange interpolation. The full signature
+// S can be verified with bls.Verify under the public key X = public.Commit().
+// Invalid shares are ignored, so that Recover succeeds as long as at least
+// t of the n shares are valid.
+func Recover(suite pairing.Suite, public *share.PubPoly, msg []byte, sigs [][]byte, t, n int) ([]byte, error) {
+	pubShares := make([]*share.PubShare, 0, t)
+	seen := make(map[int]bool)
+	for _, sig := range sigs {
+		s := SigShare(sig)
+		i, err := s.Index()
+		if err != nil || i >= n || seen[i] {
+			continue
+		}
+		if err := Verify(suite, public, msg, sig); err != nil {
+			continue
+		}
+		point := suite.G1().Point()
+		if err := point.UnmarshalBinary(s.Value()); err != nil {
+			continue
+		}
+		seen[i] = true
+		pubShares = append(pubShares, &share.PubShare{I: i, V: point})
+		if len(pubShares) >= t {
+			break
+		}
+	}
+	commit, err := share.RecoverCommit(suite.G1(), pubShares, t, n)
+	if err != nil {
+		return nil, err
+	}
+	return commit.MarshalBinary()
+}
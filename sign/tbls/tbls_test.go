@@ -0,0 +1,82 @@
+// +build vartime
+
+package tbls
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/pairing"
+	"github.com/dedis/kyber/pairing/bls12381"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/sign/bls"
+	"github.com/stretchr/testify/require"
+)
+
+var suite = bls12381.NewBlakeSHA256BLS12381()
+
+// g2Suite makes G2 usable as a share.Suite, so that the commitments of a
+// secret sharing polynomial are points of G2.
+type g2Suite struct {
+	kyber.Group
+	pairing.Suite
+}
+
+func setup(t, n int) (*share.PubPoly, []*share.PriShare) {
+	priPoly := share.NewPriPoly(&g2Suite{suite.G2(), suite}, t, nil)
+	return priPoly.Commit(nil), priPoly.Shares(n)
+}
+
+func TestTBLS(test *testing.T) {
+	msg := []byte("Hello threshold Boneh-Lynn-Shacham")
+	n := 7
+	t := n/2 + 1
+	pubPoly, shares := setup(t, n)
+	sigShares := make([][]byte, 0)
+	for _, x := range shares {
+		sig, err := Sign(suite, x, msg)
+		require.Nil(test, err)
+		require.Nil(test, Verify(suite, pubPoly, msg, sig))
+		sigShares = append(sigShares, sig)
+	}
+	sig, err := Recover(suite, pubPoly, msg, sigShares, t, n)
+	require.Nil(test, err)
+	require.Nil(test, bls.Verify(suite, pubPoly.Commit(), msg, sig))
+
+	// The recovered signature does not depend on the shares used.
+	sig2, err := Recover(suite, pubPoly, msg, sigShares[n-t:], t, n)
+	require.Nil(test, err)
+	require.Equal(test, sig, sig2)
+}
+
+func TestTBLSInvalidShares(test *testing.T) {
+	msg := []byte("Hello threshold Boneh-Lynn-Shacham")
+	n := 5
+	t := 3
+	pubPoly, shares := setup(t, n)
+	sigShares := make([][]byte, n)
+	for i, x := range shares {
+		sig, err := Sign(suite, x, msg)
+		require.Nil(test, err)
+		sigShares[i] = sig
+	}
+
+	// A share signing another message, a share with a wrong index and a
+	// duplicated share are rejected.
+	bad, err := Sign(suite, shares[0], []byte("Hello"))
+	require.Nil(test, err)
+	require.Error(test, Verify(suite, pubPoly, msg, bad))
+	wrongIndex := append([]byte(nil), sigShares[1]...)
+	wrongIndex[1] = 2
+	require.Error(test, Verify(suite, pubPoly, msg, wrongIndex))
+	require.Error(test, Verify(suite, pubPoly, msg, []byte{0}))
+
+	mixed := [][]byte{bad, wrongIndex, sigShares[2], sigShares[2], sigShares[3]}
+	_, err = Recover(suite, pubPoly, msg, mixed, t, n)
+	require.Error(test, err)
+
+	mixed = append(mixed, sigShares[4])
+	sig, err := Recover(suite, pubPoly, msg, mixed, t, n)
+	require.Nil(test, err)
+	require.Nil(test, bls.Verify(suite, pubPoly.Commit(), msg, sig))
+}
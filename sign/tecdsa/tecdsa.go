@@ -0,0 +1,947 @@
+// +build vartime
+
+// Package tecdsa implements threshold ECDSA signing with identifiable
+// aborts, following the protocol of Gennaro and Goldfeder,
+// https://eprint.iacr.org/2020/540 (GG20).
+//
+// A group of n participants holds shares of an ECDSA private key, as
+// created by a trusted dealer with package share or by a distributed key
+// generation, such that any t of them can sign. The signatures created by
+// the protocol are standard ECDSA signatures, verified with package
+// sign/ecdsa or any other ECDSA implementation.
+//
+// Once, before signing, every participant creates a Setup, holding its
+// Paillier key, and broadcasts its PublicSetup, which every other
+// participant checks with VerifySetup. To sign a message, a set of at least
+// t participants then creates a Signer each and runs seven rounds, in which
+// every participant broadcasts a message and passes the messages of all
+// the participants of the set, its own included, to the next round:
+//
+//  1. commit to a nonce share and encrypt a second one, k_i;
+//  2. convert the products k_i*gamma_j and k_i*w_j to additive shares with
+//     Paillier encryption, the multiplicative-to-additive (MtA)
+//     subprotocol;
+//  3. publish a share of delta = k*gamma and commit to a share of
+//     sigma = k*x;
+//  4. open the commitment of round 1, revealing Gamma_i = gamma_i*G, so
+//     that everyone computes R = delta^-1 * Sum(Gamma_i) = k^-1 * G;
+//  5. publish k_i*R and prove that it is consistent with the encryption of
+//     k_i;
+//  6. publish sigma_i*R and prove that it is consistent with the
+//     commitment of round 3;
+//  7. publish the signature share s_i = m*k_i + r*sigma_i, which Signature
+//     combines into the signature.
+//
+// Every message is checked when it is passed to the next round, and all the
+// participants check the same values, so that they agree on which of them
+// misbehaved, returned as an AbortError. A participant that misbehaves
+// during the MtA subprotocol can only be identified by revealing the
+// nonces of the signing attempt: in that case a round returns
+// ErrRevealRequired, and the participants broadcast the result of Reveal
+// and find the culprits with Identify. The signing then restarts with new
+// nonces and without the culprits.
+//
+// The zero-knowledge proofs of the protocol are made non-interactive with
+// the transcripts of package proof/transcript, bound to the signing
+// session and to their prover and verifier. The proofs of the setups are
+// made with the XOF of the suite given to NewSetup, which VerifySetup must
+// be given as well.
+//
+// The package uses math/big and is not constant time, so it must be
+// compiled with the "vartime" compilation flag.
+package tecdsa
+
+import (
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/encrypt/paillier"
+	"github.com/dedis/kyber/internal/bigint"
+	"github.com/dedis/kyber/proof/transcript"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/sign/ecdsa"
+	"github.com/dedis/kyber/util/random"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+// paillierBits is the size of the Paillier moduli, which must be larger
+// than q^8 for the proofs of the MtA subprotocol.
+const paillierBits = 2048
+
+var one = big.NewInt(1)
+
+// Suite represents the set of functionalities needed by the package tecdsa,
+// which are those of package sign/ecdsa, the XOF of the transcripts of the
+// proofs, and a source of randomness.
+type Suite interface {
+	ecdsa.Suite
+	kyber.XOFFactory
+	kyber.Random
+}
+
+// ErrRevealRequired is returned when a check fails that only the Reveal
+// and Identify steps can attribute.
+var ErrRevealRequired = errors.New("tecdsa: inconsistent shares, nonces must be revealed")
+
+var errRound = errors.New("tecdsa: round called out of order")
+
+// AbortError is returned when the protocol aborts because of the
+// participants of the given indices.
+type AbortError struct {
+	Culprits []int
+	Reason   string
+}
+
+func (e *AbortError) Error() string {
+	return fmt.Sprintf("tecdsa: %s by participants %v", e.Reason, e.Culprits)
+}
+
+// Setup holds the Paillier key of a participant.
+type Setup struct {
+	sk     *paillier.PrivateKey
+	public *PublicSetup
+}
+
+// PublicSetup holds the Paillier public key of a participant and
+// ring-Pedersen parameters modulo the same modulus, with proofs of their
+// correctness.
+type PublicSetup struct {
+	Index         int
+	Paillier      *paillier.PublicKey
+	Pedersen      *paillier.PedersenParams
+	ModulusProof  *paillier.ModulusProof
+	PedersenProof *paillier.PedersenProof
+}
+
+// NewSetup generates the Paillier key of the participant of the given
+// index, with proofs made with the XOF of the suite. It takes a few
+// seconds.
+func NewSetup(suite Suite, index int) (*Setup, error) {
+	rand := random.New()
+	sk, err := paillier.GenerateKey(paillierBits, rand)
+	if err != nil {
+		return nil, err
+	}
+	p := &PublicSetup{Index: index, Paillier: &sk.PublicKey}
+	p.Pedersen, p.PedersenProof = paillier.NewPedersenParams(setupTranscript(suite, index), sk, rand)
+	if p.ModulusProof, err = paillier.ProveModulus(setupTranscript(suite, index), sk, rand); err != nil {
+		return nil, err
+	}
+	return &Setup{sk: sk, public: p}, nil
+}
+
+// setupTranscript returns the transcript of a proof of the setup of the
+// participant of the given index.
+func setupTranscript(suite Suite, index int) *transcript.Transcript {
+	t := transcript.New(suite, "tecdsa setup")
+	t.Append("index", indexBytes(index))
+	return t
+}
+
+// indexBytes returns the encoding of the index of a participant in
+// transcripts.
+func indexBytes(index int) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(index))
+	return b[:]
+}
+
+// Public returns the public part of the setup, to be broadcast to the other
+// participants.
+func (s *Setup) Public() *PublicSetup {
+	return s.public
+}
+
+// VerifySetup checks the public setup of a participant, whose proofs were
+// made with the XOF of the suite. It returns nil if the setup is valid, and
+// an error otherwise.
+func VerifySetup(suite Suite, p *PublicSetup) error {
+	if p == nil || p.Paillier == nil || p.Pedersen == nil || p.Paillier.N == nil ||
+		p.Paillier.N.BitLen() < paillierBits {
+		return errors.New("tecdsa: Paillier modulus too short")
+	}
+	if err := p.ModulusProof.Verify(setupTranscript(suite, p.Index), p.Paillier.N); err != nil {
+		return err
+	}
+	if p.Pedersen.N == nil || p.Pedersen.N.Cmp(p.Paillier.N) != 0 {
+		return errors.New("tecdsa: ring-Pedersen and Paillier moduli differ")
+	}
+	return p.PedersenProof.Verify(setupTranscript(suite, p.Index), p.Pedersen)
+}
+
+// Round1 is the message of the first round: a commitment to Gamma_i, and
+// the encryption K of k_i with proofs, for every other participant, that it
+// is in range.
+type Round1 struct {
+	Index      int
+	Commitment []byte
+	K          *big.Int
+	Proofs     map[int]*paillier.RangeProof
+}
+
+// Round2 holds the answers of a participant to the encrypted k_j of every
+// other participant.
+type Round2 struct {
+	Index int
+	MtA   map[int]*MtA
+}
+
+// MtA holds the answers of a participant i to the encrypted k_j of
+// participant j: the encryptions of k_j*gamma_i + beta_ij and
+// k_j*w_i + nu_ij, and proofs of their correctness.
+type MtA struct {
+	Gamma, W           *big.Int
+	GammaProof, WProof *paillier.AffineProof
+}
+
+// Round3 is the message of the third round: a share of delta, and a
+// commitment T = sigma_i*G + l_i*H to a share of sigma.
+type Round3 struct {
+	Index int
+	Delta *big.Int
+	T     kyber.Point
+	Proof *STProof
+}
+
+// Round4 opens the commitment of the first round.
+type Round4 struct {
+	Index   int
+	Gamma   kyber.Point
+	Opening []byte
+	Proof   *DlogProof
+}
+
+// Round5 is the message of the fifth round: RBar = k_i*R, with proofs of
+// consistency with the encryption of k_i for every other participant.
+type Round5 struct {
+	Index  int
+	RBar   kyber.Point
+	Proofs map[int]*paillier.LogProof
+}
+
+// Round6 is the message of the sixth round: S = sigma_i*R, with a proof of
+// consistency with the commitment T of the third round.
+type Round6 struct {
+	Index int
+	S     kyber.Point
+	Proof *STProof
+}
+
+// Round7 holds the signature share of a participant.
+type Round7 struct {
+	Index int
+	S     *big.Int
+}
+
+// Plaintext is a value encrypted by a Paillier ciphertext, with the nonce
+// of the encryption.
+type Plaintext struct {
+	M, R *big.Int
+}
+
+// Reveal holds the nonces of a participant and the values it used in the
+// MtA subprotocol for delta, which identify the participants that
+// misbehaved. For the MtA subprotocol for sigma, it holds the decryptions of
+// the answers to its encrypted k_i and the opening of its commitment T,
+// which do not reveal anything about the private key shares.
+type Reveal struct {
+	Index int
+	K     *Plaintext
+	Gamma *big.Int
+	L     *big.Int
+	Beta  map[int]*Plaintext // values added to the k_j*gamma_i products
+	Mu    map[int]*Plaintext // decryptions of the answers to k_i
+}
+
+// peer holds the public values of a participant.
+type peer struct {
+	pk  *paillier.PublicKey
+	ped *paillier.PedersenParams
+	W   kyber.Point // lambda_j*X_j
+}
+
+// Signer runs the signing protocol for one participant.
+type Signer struct {
+	suite   Suite
+	setup   *Setup
+	index   int
+	signers []int
+	peers   map[int]*peer
+	public  kyber.Point
+	session *transcript.Transcript
+	q       *big.Int
+	m       *big.Int
+	w       *big.Int
+	H       kyber.Point
+	round   int
+
+	k, kRand, gamma *big.Int
+	Gamma           kyber.Point
+	opening         []byte
+	beta, nu        map[int]*Plaintext
+	mu              map[int]*big.Int
+	delta, sigma    *big.Int
+	l               kyber.Scalar
+	R               kyber.Point
+	r               *big.Int
+	reveal          bool
+
+	r1 map[int]*Round1
+	r2 map[int]*Round2
+	r3 map[int]*Round3
+	r4 map[int]*Round4
+	r5 map[int]*Round5
+	r6 map[int]*Round6
+}
+
+// NewSigner returns the signer of msg for the private key share pri of
+// the key whose public polynomial is pub. The signing set is given by the
+// public setups of its members, the participant's own included, which must
+// have been checked with VerifySetup.
+func NewSigner(suite Suite, setup *Setup, pri *share.PriShare, pub *share.PubPoly, peers []*PublicSetup, msg []byte) (*Signer, error) {
+	if setup.public.Index != pri.I {
+		return nil, errors.New("tecdsa: setup and share indices differ")
+	}
+	s := &Signer{
+		suite:  suite,
+		setup:  setup,
+		index:  pri.I,
+		peers:  make(map[int]*peer),
+		public: pub.Commit(),
+		q:      suite.Order(),
+		beta:   make(map[int]*Plaintext),
+		nu:     make(map[int]*Plaintext),
+		mu:     make(map[int]*big.Int),
+	}
+	for _, p := range peers {
+		if _, ok := s.peers[p.Index]; ok {
+			return nil, errors.New("tecdsa: duplicate participant")
+		}
+		s.peers[p.Index] = &peer{
+			pk:  p.Paillier,
+			ped: p.Pedersen,
+		}
+		s.signers = append(s.signers, p.Index)
+	}
+	sort.Ints(s.signers)
+	if len(s.signers) < pub.Threshold() {
+		return nil, errors.New("tecdsa: not enough participants")
+	}
+	if _, ok := s.peers[s.index]; !ok {
+		return nil, errors.New("tecdsa: signer not in the signing set")
+	}
+
+	// Every participant converts its share to an additive share of the
+	// private key: x = Sum(lambda_j * x_j).
+	for _, j := range s.signers {
+		lambda := s.lagrange(j)
+		s.peers[j].W = suite.Point().Mul(scalar(suite, lambda), pub.Eval(j).V)
+		if j == s.index {
+			s.w = new(big.Int).Mul(lambda, toInt(pri.V))
+			s.w.Mod(s.w, s.q)
+		}
+	}
+
+	h := suite.Hash()
+	h.Write(msg)
+	s.m = ecdsa.HashToInt(h.Sum(nil), s.q)
+	s.session = transcript.New(suite, "tecdsa session")
+	s.session.AppendPoints("public", s.public)
+	s.session.Append("message", msg)
+	for _, j := range s.signers {
+		s.session.Append("signer", indexBytes(j))
+	}
+	s.H = suite.Point().Pick(blake.New([]byte("tecdsa H")))
+	return s, nil
+}
+
+// lagrange returns the Lagrange coefficient of participant j in the
+// signing set, at 0.
+func (s *Signer) lagrange(j int) *big.Int {
+	num, den := big.NewInt(1), big.NewInt(1)
+	xj := big.NewInt(int64(j + 1))
+	for _, i := range s.signers {
+		if i == j {
+			continue
+		}
+		xi := big.NewInt(int64(i + 1))
+		num.Mul(num, xi)
+		den.Mul(den, new(big.Int).Sub(xi, xj))
+	}
+	den.Mod(den, s.q)
+	num.Mul(num, den.ModInverse(den, s.q))
+	return num.Mod(num, s.q)
+}
+
+// context returns the transcript of a proof, bound to the signing session
+// and to its prover and verifier.
+func (s *Signer) context(from, to int) *transcript.Transcript {
+	t := s.session.Clone()
+	t.Append("prover", indexBytes(from))
+	t.Append("verifier", indexBytes(to))
+	return t
+}
+
+// commitment returns the commitment of participant j to Gamma in the
+// signing session, with the given opening.
+func (s *Signer) commitment(j int, Gamma kyber.Point, opening []byte) []byte {
+	t := s.session.Clone()
+	t.Append("committer", indexBytes(j))
+	t.AppendPoints("Gamma", Gamma)
+	t.Append("opening", opening)
+	return t.ChallengeBytes("commitment", 32)
+}
+
+// others returns the members of the signing set other than j.
+func (s *Signer) others(j int) []int {
+	var o []int
+	for _, i := range s.signers {
+		if i != j {
+			o = append(o, i)
+		}
+	}
+	return o
+}
+
+// collect maps every member of the signing set to its message, given the
+// indices of the messages. It fails unless every member sent exactly one
+// message.
+func (s *Signer) collect(indices []int) (map[int]int, error) {
+	pos := make(map[int]int)
+	var culprits []int
+	for n, i := range indices {
+		if _, ok := s.peers[i]; !ok {
+			return nil, errors.New("tecdsa: message from outside the signing set")
+		}
+		if _, ok := pos[i]; ok {
+			culprits = append(culprits, i)
+		}
+		pos[i] = n
+	}
+	for _, i := range s.signers {
+		if _, ok := pos[i]; !ok {
+			culprits = append(culprits, i)
+		}
+	}
+	if len(culprits) > 0 {
+		return nil, &AbortError{Culprits: culprits, Reason: "missing or duplicate message"}
+	}
+	return pos, nil
+}
+
+func abort(culprits []int, reason string) error {
+	if len(culprits) == 0 {
+		return nil
+	}
+	return &AbortError{Culprits: culprits, Reason: reason}
+}
+
+// Round1 draws the nonce shares of the participant and returns its message
+// for the first round.
+func (s *Signer) Round1() (*Round1, error) {
+	if s.round != 0 {
+		return nil, errRound
+	}
+	rand := s.suite.RandomStream()
+	s.k = random.Int(s.q, rand)
+	s.gamma = random.Int(s.q, rand)
+	own := s.peers[s.index].pk
+	K, kRand := own.Encrypt(s.k, rand)
+	s.kRand = kRand
+	s.Gamma = s.suite.Point().Mul(scalar(s.suite, s.gamma), nil)
+	s.opening = make([]byte, 32)
+	random.Bytes(s.opening, rand)
+	msg := &Round1{
+		Index:      s.index,
+		Commitment: s.commitment(s.index, s.Gamma, s.opening),
+		K:          K,
+		Proofs:     make(map[int]*paillier.RangeProof),
+	}
+	for _, j := range s.others(s.index) {
+		msg.Proofs[j] = paillier.ProveRange(s.context(s.index, j), s.q, own, s.peers[j].ped, K, s.k, kRand, rand)
+	}
+	s.round = 1
+	return msg, nil
+}
+
+// Round2 checks the messages of the first round and returns the
+// participant's answers in the MtA subprotocol.
+func (s *Signer) Round2(msgs []*Round1) (*Round2, error) {
+	if s.round != 1 {
+		return nil, errRound
+	}
+	indices := make([]int, len(msgs))
+	for n, m := range msgs {
+		indices[n] = m.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return nil, err
+	}
+	s.r1 = make(map[int]*Round1)
+	var culprits []int
+	for _, j := range s.signers {
+		m := msgs[pos[j]]
+		s.r1[j] = m
+		for _, i := range s.others(j) {
+			if m.Proofs[i].Verify(s.context(j, i), s.q, s.peers[j].pk, s.peers[i].ped, m.K) != nil {
+				culprits = append(culprits, j)
+				break
+			}
+		}
+	}
+	if err := abort(culprits, "invalid range proof"); err != nil {
+		return nil, err
+	}
+
+	msg := &Round2{Index: s.index, MtA: make(map[int]*MtA)}
+	q5 := new(big.Int).Exp(s.q, big.NewInt(5), nil)
+	rand := s.suite.RandomStream()
+	for _, j := range s.others(s.index) {
+		a := new(MtA)
+		// Both answers are proven in turn on the same transcript.
+		ctx := s.context(s.index, j)
+		pk, ped, K := s.peers[j].pk, s.peers[j].ped, s.r1[j].K
+		// Answer with Enc(k_j*b + b'), keeping -b' as additive share.
+		answer := func(b *big.Int, X kyber.Point) (*big.Int, *paillier.AffineProof, *Plaintext) {
+			bPrm := random.Int(q5, rand)
+			c, nonce := pk.Encrypt(bPrm, rand)
+			c = pk.Add(pk.Mul(K, b), c)
+			p := paillier.ProveAffine(ctx, s.suite, s.q, pk, ped, K, c, b, bPrm, nonce, X, rand)
+			return c, p, &Plaintext{bPrm, nonce}
+		}
+		a.Gamma, a.GammaProof, s.beta[j] = answer(s.gamma, nil)
+		a.W, a.WProof, s.nu[j] = answer(s.w, s.peers[s.index].W)
+		msg.MtA[j] = a
+	}
+	s.round = 2
+	return msg, nil
+}
+
+// Round3 checks the answers of the MtA subprotocol and returns the
+// participant's shares of delta and sigma.
+func (s *Signer) Round3(msgs []*Round2) (*Round3, error) {
+	if s.round != 2 {
+		return nil, errRound
+	}
+	indices := make([]int, len(msgs))
+	for n, m := range msgs {
+		indices[n] = m.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return nil, err
+	}
+	s.r2 = make(map[int]*Round2)
+	var culprits []int
+	for _, j := range s.signers {
+		m := msgs[pos[j]]
+		s.r2[j] = m
+		for _, i := range s.others(j) {
+			a := m.MtA[i]
+			ctx := s.context(j, i)
+			pk, ped, K := s.peers[i].pk, s.peers[i].ped, s.r1[i].K
+			if a == nil ||
+				a.GammaProof.Verify(ctx, s.suite, s.q, pk, ped, K, a.Gamma, nil) != nil ||
+				a.WProof.Verify(ctx, s.suite, s.q, pk, ped, K, a.W, s.peers[j].W) != nil {
+				culprits = append(culprits, j)
+				break
+			}
+		}
+	}
+	if err := abort(culprits, "invalid MtA answer"); err != nil {
+		return nil, err
+	}
+
+	// delta_i = k_i*gamma_i + Sum(alpha_ij + beta_ij)
+	// sigma_i = k_i*w_i + Sum(mu_ij + nu_ij)
+	s.delta = new(big.Int).Mul(s.k, s.gamma)
+	s.sigma = new(big.Int).Mul(s.k, s.w)
+	for _, j := range s.others(s.index) {
+		a := s.r2[j].MtA[s.index]
+		alpha, err := s.setup.sk.Decrypt(a.Gamma)
+		if err != nil {
+			return nil, err
+		}
+		mu, err := s.setup.sk.Decrypt(a.W)
+		if err != nil {
+			return nil, err
+		}
+		s.mu[j] = mu
+		s.delta.Add(s.delta, alpha)
+		s.delta.Sub(s.delta, s.beta[j].M)
+		s.sigma.Add(s.sigma, mu)
+		s.sigma.Sub(s.sigma, s.nu[j].M)
+	}
+	s.delta.Mod(s.delta, s.q)
+	s.sigma.Mod(s.sigma, s.q)
+
+	sigma := scalar(s.suite, s.sigma)
+	s.l = s.suite.Scalar().Pick(s.suite.RandomStream())
+	T := s.suite.Point().Add(s.suite.Point().Mul(sigma, nil), s.suite.Point().Mul(s.l, s.H))
+	s.round = 3
+	return &Round3{
+		Index: s.index,
+		Delta: s.delta,
+		T:     T,
+		Proof: proveST(s.context(s.index, s.index), s.suite, s.H, nil, nil, T, sigma, s.l),
+	}, nil
+}
+
+// Round4 checks the messages of the third round and opens the
+// participant's commitment of the first round.
+func (s *Signer) Round4(msgs []*Round3) (*Round4, error) {
+	if s.round != 3 {
+		return nil, errRound
+	}
+	indices := make([]int, len(msgs))
+	for n, m := range msgs {
+		indices[n] = m.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return nil, err
+	}
+	s.r3 = make(map[int]*Round3)
+	var culprits []int
+	delta := new(big.Int)
+	for _, j := range s.signers {
+		m := msgs[pos[j]]
+		s.r3[j] = m
+		if !bigint.InRange(m.Delta, s.q) || m.T == nil ||
+			!m.Proof.verify(s.context(j, j), s.suite, s.H, nil, nil, m.T) {
+			culprits = append(culprits, j)
+			continue
+		}
+		delta.Add(delta, m.Delta)
+	}
+	if err := abort(culprits, "invalid delta share"); err != nil {
+		return nil, err
+	}
+	if s.delta = delta.Mod(delta, s.q); s.delta.Sign() == 0 {
+		s.reveal = true
+		return nil, ErrRevealRequired
+	}
+	s.round = 4
+	return &Round4{
+		Index:   s.index,
+		Gamma:   s.Gamma,
+		Opening: s.opening,
+		Proof:   proveDlog(s.context(s.index, s.index), s.suite, scalar(s.suite, s.gamma), s.Gamma),
+	}, nil
+}
+
+// Round5 checks the openings of the fourth round, computes R, and returns
+// the participant's share of k*R.
+func (s *Signer) Round5(msgs []*Round4) (*Round5, error) {
+	if s.round != 4 {
+		return nil, errRound
+	}
+	indices := make([]int, len(msgs))
+	for n, m := range msgs {
+		indices[n] = m.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return nil, err
+	}
+	s.r4 = make(map[int]*Round4)
+	var culprits []int
+	Gamma := s.suite.Point().Null()
+	for _, j := range s.signers {
+		m := msgs[pos[j]]
+		s.r4[j] = m
+		if m.Gamma == nil ||
+			string(s.commitment(j, m.Gamma, m.Opening)) != string(s.r1[j].Commitment) ||
+			!m.Proof.verify(s.context(j, j), s.suite, m.Gamma) {
+			culprits = append(culprits, j)
+			continue
+		}
+		Gamma.Add(Gamma, m.Gamma)
+	}
+	if err := abort(culprits, "invalid opening"); err != nil {
+		return nil, err
+	}
+
+	// R = delta^-1 * Sum(Gamma_j) = k^-1 * G
+	inv := new(big.Int).ModInverse(s.delta, s.q)
+	s.R = s.suite.Point().Mul(scalar(s.suite, inv), Gamma)
+	x, err := ecdsa.XCoordinate(s.R)
+	if err != nil {
+		return nil, err
+	}
+	if s.r = x.Mod(x, s.q); s.r.Sign() == 0 {
+		return nil, errors.New("tecdsa: degenerate nonce")
+	}
+
+	own := s.peers[s.index].pk
+	rand := s.suite.RandomStream()
+	RBar := s.suite.Point().Mul(scalar(s.suite, s.k), s.R)
+	msg := &Round5{Index: s.index, RBar: RBar, Proofs: make(map[int]*paillier.LogProof)}
+	for _, j := range s.others(s.index) {
+		msg.Proofs[j] = paillier.ProveLog(s.context(s.index, j), s.suite, s.q, own, s.peers[j].ped, s.r1[s.index].K, s.R, RBar, s.k, s.kRand, rand)
+	}
+	s.round = 5
+	return msg, nil
+}
+
+// Round6 checks that the shares of k*R add up to G and returns the
+// participant's share of sigma*R.
+func (s *Signer) Round6(msgs []*Round5) (*Round6, error) {
+	if s.round != 5 {
+		return nil, errRound
+	}
+	indices := make([]int, len(msgs))
+	for n, m := range msgs {
+		indices[n] = m.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return nil, err
+	}
+	s.r5 = make(map[int]*Round5)
+	var culprits []int
+	sum := s.suite.Point().Null()
+	for _, j := range s.signers {
+		m := msgs[pos[j]]
+		s.r5[j] = m
+		if m.RBar == nil {
+			culprits = append(culprits, j)
+			continue
+		}
+		for _, i := range s.others(j) {
+			if m.Proofs[i].Verify(s.context(j, i), s.suite, s.q, s.peers[j].pk, s.peers[i].ped, s.r1[j].K, s.R, m.RBar) != nil {
+				culprits = append(culprits, j)
+				break
+			}
+		}
+		sum.Add(sum, m.RBar)
+	}
+	if err := abort(culprits, "invalid share of k*R"); err != nil {
+		return nil, err
+	}
+	if !sum.Equal(s.suite.Point().Base()) {
+		s.reveal = true
+		return nil, ErrRevealRequired
+	}
+
+	sigma := scalar(s.suite, s.sigma)
+	S := s.suite.Point().Mul(sigma, s.R)
+	s.round = 6
+	return &Round6{
+		Index: s.index,
+		S:     S,
+		Proof: proveST(s.context(s.index, s.index), s.suite, s.H, s.R, S, s.r3[s.index].T, sigma, s.l),
+	}, nil
+}
+
+// Round7 checks that the shares of sigma*R add up to the public key and
+// returns the participant's signature share.
+func (s *Signer) Round7(msgs []*Round6) (*Round7, error) {
+	if s.round != 6 {
+		return nil, errRound
+	}
+	indices := make([]int, len(msgs))
+	for n, m := range msgs {
+		indices[n] = m.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return nil, err
+	}
+	s.r6 = make(map[int]*Round6)
+	var culprits []int
+	sum := s.suite.Point().Null()
+	for _, j := range s.signers {
+		m := msgs[pos[j]]
+		s.r6[j] = m
+		if m.S == nil || !m.Proof.verify(s.context(j, j), s.suite, s.H, s.R, m.S, s.r3[j].T) {
+			culprits = append(culprits, j)
+			continue
+		}
+		sum.Add(sum, m.S)
+	}
+	if err := abort(culprits, "invalid share of sigma*R"); err != nil {
+		return nil, err
+	}
+	if !sum.Equal(s.public) {
+		s.reveal = true
+		return nil, ErrRevealRequired
+	}
+
+	// s_i = m*k_i + r*sigma_i
+	si := new(big.Int).Mul(s.m, s.k)
+	si.Add(si, new(big.Int).Mul(s.r, s.sigma))
+	si.Mod(si, s.q)
+	s.round = 7
+	return &Round7{Index: s.index, S: si}, nil
+}
+
+// Signature checks the signature shares of the seventh round and returns
+// the DER-encoded ECDSA signature, as created by package sign/ecdsa.
+func (s *Signer) Signature(msgs []*Round7) ([]byte, error) {
+	if s.round != 7 {
+		return nil, errRound
+	}
+	indices := make([]int, len(msgs))
+	for n, m := range msgs {
+		indices[n] = m.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return nil, err
+	}
+	var culprits []int
+	sig := new(big.Int)
+	m := scalar(s.suite, s.m)
+	r := scalar(s.suite, s.r)
+	for _, j := range s.signers {
+		sj := msgs[pos[j]].S
+		// s_j*R = m*RBar_j + r*S_j
+		if !bigint.InRange(sj, new(big.Int).Sub(s.q, one)) {
+			culprits = append(culprits, j)
+			continue
+		}
+		rhs := s.suite.Point().Mul(m, s.r5[j].RBar)
+		rhs.Add(rhs, s.suite.Point().Mul(r, s.r6[j].S))
+		if !s.suite.Point().Mul(scalar(s.suite, sj), s.R).Equal(rhs) {
+			culprits = append(culprits, j)
+			continue
+		}
+		sig.Add(sig, sj)
+	}
+	if err := abort(culprits, "invalid signature share"); err != nil {
+		return nil, err
+	}
+	if sig.Mod(sig, s.q).Sign() == 0 {
+		return nil, errors.New("tecdsa: degenerate signature")
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{s.r, sig})
+}
+
+// Reveal returns the nonces of the participant, once a round returned
+// ErrRevealRequired. The signing attempt cannot continue afterwards.
+func (s *Signer) Reveal() (*Reveal, error) {
+	if !s.reveal {
+		return nil, errors.New("tecdsa: nonces can only be revealed after a failed check")
+	}
+	rv := &Reveal{
+		Index: s.index,
+		K:     &Plaintext{s.k, s.kRand},
+		Gamma: s.gamma,
+		L:     toInt(s.l),
+		Beta:  s.beta,
+		Mu:    make(map[int]*Plaintext),
+	}
+	for j, mu := range s.mu {
+		nonce, err := s.setup.sk.Nonce(s.r2[j].MtA[s.index].W, mu)
+		if err != nil {
+			return nil, err
+		}
+		rv.Mu[j] = &Plaintext{mu, nonce}
+	}
+	s.round = -1
+	return rv, nil
+}
+
+// Identify finds the participants that misbehaved from the revealed
+// nonces of all the participants, and returns them as an AbortError.
+func (s *Signer) Identify(reveals []*Reveal) error {
+	if !s.reveal {
+		return errors.New("tecdsa: nothing to identify")
+	}
+	indices := make([]int, len(reveals))
+	for n, rv := range reveals {
+		indices[n] = rv.Index
+	}
+	pos, err := s.collect(indices)
+	if err != nil {
+		return err
+	}
+	rvs := make(map[int]*Reveal)
+	for _, j := range s.signers {
+		rvs[j] = reveals[pos[j]]
+	}
+
+	// A participant whose revealed values do not match its messages is a
+	// culprit.
+	var culprits []int
+	q5 := new(big.Int).Exp(s.q, big.NewInt(5), nil)
+	for _, j := range s.signers {
+		rv := rvs[j]
+		pk := s.peers[j].pk
+		valid := rv.K != nil && bigint.InRange(rv.K.M, s.q) && bigint.IsUnit(rv.K.R, pk.N) &&
+			pk.EncryptWithNonce(rv.K.M, rv.K.R).Cmp(s.r1[j].K) == 0 &&
+			bigint.InRange(rv.Gamma, s.q) && bigint.InRange(rv.L, s.q)
+		if valid && s.r4 != nil {
+			G := s.suite.Point().Mul(scalar(s.suite, rv.Gamma), nil)
+			valid = G.Equal(s.r4[j].Gamma)
+		}
+		for _, i := range s.others(j) {
+			if !valid {
+				break
+			}
+			// Gamma_ji = K_i^gamma_j * Enc_i(beta'_ji)
+			pki := s.peers[i].pk
+			b, mu := rv.Beta[i], rv.Mu[i]
+			valid = b != nil && bigint.InRange(b.M, q5) && bigint.IsUnit(b.R, pki.N) &&
+				pki.Add(pki.Mul(s.r1[i].K, rv.Gamma), pki.EncryptWithNonce(b.M, b.R)).Cmp(s.r2[j].MtA[i].Gamma) == 0 &&
+				mu != nil && bigint.InRange(mu.M, pk.N) && bigint.IsUnit(mu.R, pk.N) &&
+				pk.EncryptWithNonce(mu.M, mu.R).Cmp(s.r2[i].MtA[j].W) == 0
+		}
+		if !valid {
+			culprits = append(culprits, j)
+		}
+	}
+	if err := abort(culprits, "invalid reveal"); err != nil {
+		return err
+	}
+
+	// Recompute the shares of delta and the commitments to the shares of
+	// sigma from the revealed values.
+	for _, j := range s.signers {
+		rv := rvs[j]
+		kj := scalar(s.suite, rv.K.M)
+		// delta_j = k_j*gamma_j + Sum(k_j*gamma_i + beta'_ij - beta'_ji)
+		delta := new(big.Int).Mul(rv.K.M, rv.Gamma)
+		// sigma_j*G = k_j*W_j + Sum(mu_ji*G - (mu_ij*G - k_i*W_j))
+		sigma := s.suite.Point().Mul(kj, s.peers[j].W)
+		for _, i := range s.others(j) {
+			delta.Add(delta, new(big.Int).Mul(rv.K.M, rvs[i].Gamma))
+			delta.Add(delta, rvs[i].Beta[j].M)
+			delta.Sub(delta, rv.Beta[i].M)
+
+			sigma.Add(sigma, s.suite.Point().Mul(scalar(s.suite, rv.Mu[i].M), nil))
+			sigma.Sub(sigma, s.suite.Point().Mul(scalar(s.suite, rvs[i].Mu[j].M), nil))
+			sigma.Add(sigma, s.suite.Point().Mul(scalar(s.suite, rvs[i].K.M), s.peers[j].W))
+		}
+		T := sigma.Add(sigma, s.suite.Point().Mul(scalar(s.suite, rv.L), s.H))
+		if delta.Mod(delta, s.q).Cmp(s.r3[j].Delta) != 0 || !T.Equal(s.r3[j].T) {
+			culprits = append(culprits, j)
+		}
+	}
+	if len(culprits) == 0 {
+		return errors.New("tecdsa: no culprit found")
+	}
+	return &AbortError{Culprits: culprits, Reason: "inconsistent MtA shares"}
+}
+
+// scalar returns the scalar of the group with value v, reduced modulo the
+// order.
+func scalar(g kyber.Group, v *big.Int) kyber.Scalar {
+	return g.Scalar().SetBytes(v.Bytes())
+}
+
+// toInt returns the value of a big-endian scalar.
+func toInt(s kyber.Scalar) *big.Int {
+	b, err := s.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return new(big.Int).SetBytes(b)
+}
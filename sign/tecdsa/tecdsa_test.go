@@ -0,0 +1,340 @@
+// +build vartime
+
+package tecdsa
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/dedis/kyber/group/nist"
+	"github.com/dedis/kyber/group/secp256k1"
+	"github.com/dedis/kyber/share"
+	"github.com/dedis/kyber/sign/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+const n = 3
+
+var (
+	setupsOnce sync.Once
+	setups     []*Setup
+)
+
+// testSetups returns the setups of n participants, which are generated once
+// since they are slow to create.
+func testSetups(t *testing.T) []*Setup {
+	setupsOnce.Do(func() {
+		for i := 0; i < n; i++ {
+			s, err := NewSetup(secp256k1.NewBlakeSHA256Secp256k1(), i)
+			if err != nil {
+				panic(err)
+			}
+			setups = append(setups, s)
+		}
+	})
+	return setups
+}
+
+// newSigners shares a fresh private key with threshold th among n
+// participants and returns the signers of msg for the given signing set.
+func newSigners(t *testing.T, suite Suite, th int, set []int, msg []byte) (*share.PubPoly, []*Signer) {
+	setups := testSetups(t)
+	poly := share.NewPriPoly(suite, th, nil)
+	pub := poly.Commit(nil)
+	shares := poly.Shares(n)
+	var publics []*PublicSetup
+	for _, i := range set {
+		publics = append(publics, setups[i].Public())
+	}
+	var signers []*Signer
+	for _, i := range set {
+		s, err := NewSigner(suite, setups[i], shares[i], pub, publics, msg)
+		require.NoError(t, err)
+		signers = append(signers, s)
+	}
+	return pub, signers
+}
+
+// run runs the protocol, letting tamper modify the messages of every round
+// before they are delivered, and returns the signatures of the signers or
+// the first error of a round.
+func run(signers []*Signer, tamper func(msg interface{})) ([][]byte, error) {
+	if tamper == nil {
+		tamper = func(interface{}) {}
+	}
+	// Every signer runs each round, so that they all see its failures.
+	var failed error
+	r1 := make([]*Round1, len(signers))
+	for i, s := range signers {
+		m, err := s.Round1()
+		if err != nil {
+			failed = err
+			continue
+		}
+		tamper(m)
+		r1[i] = m
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	r2 := make([]*Round2, len(signers))
+	for i, s := range signers {
+		m, err := s.Round2(r1)
+		if err != nil {
+			failed = err
+			continue
+		}
+		tamper(m)
+		r2[i] = m
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	r3 := make([]*Round3, len(signers))
+	for i, s := range signers {
+		m, err := s.Round3(r2)
+		if err != nil {
+			failed = err
+			continue
+		}
+		tamper(m)
+		r3[i] = m
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	r4 := make([]*Round4, len(signers))
+	for i, s := range signers {
+		m, err := s.Round4(r3)
+		if err != nil {
+			failed = err
+			continue
+		}
+		tamper(m)
+		r4[i] = m
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	r5 := make([]*Round5, len(signers))
+	for i, s := range signers {
+		m, err := s.Round5(r4)
+		if err != nil {
+			failed = err
+			continue
+		}
+		tamper(m)
+		r5[i] = m
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	r6 := make([]*Round6, len(signers))
+	for i, s := range signers {
+		m, err := s.Round6(r5)
+		if err != nil {
+			failed = err
+			continue
+		}
+		tamper(m)
+		r6[i] = m
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	r7 := make([]*Round7, len(signers))
+	for i, s := range signers {
+		m, err := s.Round7(r6)
+		if err != nil {
+			failed = err
+			continue
+		}
+		tamper(m)
+		r7[i] = m
+	}
+	if failed != nil {
+		return nil, failed
+	}
+	sigs := make([][]byte, len(signers))
+	for i, s := range signers {
+		sig, err := s.Signature(r7)
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	return sigs, nil
+}
+
+// identify runs the Reveal and Identify steps and returns the culprits
+// found by every signer.
+func identify(t *testing.T, signers []*Signer) [][]int {
+	var reveals []*Reveal
+	for _, s := range signers {
+		rv, err := s.Reveal()
+		require.NoError(t, err)
+		reveals = append(reveals, rv)
+	}
+	var culprits [][]int
+	for _, s := range signers {
+		err := s.Identify(reveals)
+		require.IsType(t, &AbortError{}, err)
+		culprits = append(culprits, err.(*AbortError).Culprits)
+	}
+	return culprits
+}
+
+func TestSetup(t *testing.T) {
+	suite := secp256k1.NewBlakeSHA256Secp256k1()
+	p := *testSetups(t)[0].Public()
+	require.NoError(t, VerifySetup(suite, &p))
+
+	ped := *p.Pedersen
+	ped.H2 = new(big.Int).Add(ped.H2, one)
+	p.Pedersen = &ped
+	require.Error(t, VerifySetup(suite, &p))
+
+	p = *testSetups(t)[0].Public()
+	p.Paillier = testSetups(t)[1].Public().Paillier
+	require.Error(t, VerifySetup(suite, &p))
+
+	// The proofs are bound to the index of the participant.
+	p = *testSetups(t)[0].Public()
+	p.Index = 1
+	require.Error(t, VerifySetup(suite, &p))
+}
+
+func TestSign(t *testing.T) {
+	msg := []byte("Hello threshold ECDSA")
+	tests := []struct {
+		suite Suite
+		set   []int
+	}{
+		{secp256k1.NewBlakeSHA256Secp256k1(), []int{0, 2}},
+		{secp256k1.NewBlakeSHA256Secp256k1(), []int{0, 1, 2}},
+		{nist.NewBlakeSHA256P256(), []int{1, 2}},
+	}
+	for _, test := range tests {
+		pub, signers := newSigners(t, test.suite, 2, test.set, msg)
+		sigs, err := run(signers, nil)
+		require.NoError(t, err)
+		for _, sig := range sigs {
+			require.Equal(t, sigs[0], sig)
+		}
+		require.NoError(t, ecdsa.Verify(test.suite, pub.Commit(), msg, sigs[0]))
+		require.Error(t, ecdsa.Verify(test.suite, pub.Commit(), []byte("other"), sigs[0]))
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	suite := secp256k1.NewBlakeSHA256Secp256k1()
+	setups := testSetups(t)
+	poly := share.NewPriPoly(suite, 2, nil)
+	pub := poly.Commit(nil)
+	shares := poly.Shares(n)
+
+	_, err := NewSigner(suite, setups[0], shares[0], pub, []*PublicSetup{setups[0].Public()}, nil)
+	require.Error(t, err)
+	_, err = NewSigner(suite, setups[0], shares[1], pub, []*PublicSetup{setups[0].Public(), setups[1].Public()}, nil)
+	require.Error(t, err)
+	_, err = NewSigner(suite, setups[0], shares[0], pub, []*PublicSetup{setups[1].Public(), setups[2].Public()}, nil)
+	require.Error(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	suite := secp256k1.NewBlakeSHA256Secp256k1()
+	msg := []byte("Hello threshold ECDSA")
+	tests := []struct {
+		name   string
+		tamper func(msg interface{})
+	}{
+		{"range proof", func(msg interface{}) {
+			if m, ok := msg.(*Round1); ok && m.Index == 1 {
+				m.K = m.Proofs[0].U
+			}
+		}},
+		{"MtA answer", func(msg interface{}) {
+			if m, ok := msg.(*Round2); ok && m.Index == 1 {
+				m.MtA[0].W = m.MtA[0].Gamma
+			}
+		}},
+		{"opening", func(msg interface{}) {
+			if m, ok := msg.(*Round4); ok && m.Index == 1 {
+				m.Gamma = m.Gamma.Clone().Add(m.Gamma, m.Gamma)
+			}
+		}},
+		{"k*R", func(msg interface{}) {
+			if m, ok := msg.(*Round5); ok && m.Index == 1 {
+				m.RBar = m.RBar.Clone().Add(m.RBar, m.RBar)
+			}
+		}},
+		{"sigma*R", func(msg interface{}) {
+			if m, ok := msg.(*Round6); ok && m.Index == 1 {
+				m.S = m.S.Clone().Neg(m.S)
+			}
+		}},
+		{"signature share", func(msg interface{}) {
+			if m, ok := msg.(*Round7); ok && m.Index == 1 {
+				m.S = new(big.Int).Add(m.S, one)
+			}
+		}},
+	}
+	for _, test := range tests {
+		_, signers := newSigners(t, suite, 2, []int{0, 1}, msg)
+		_, err := run(signers, test.tamper)
+		require.IsType(t, &AbortError{}, err, test.name)
+		require.Equal(t, []int{1}, err.(*AbortError).Culprits, test.name)
+	}
+
+	// A missing message is blamed on its sender.
+	_, signers := newSigners(t, suite, 2, []int{0, 1, 2}, msg)
+	var r1 []*Round1
+	for _, s := range signers {
+		m, err := s.Round1()
+		require.NoError(t, err)
+		r1 = append(r1, m)
+	}
+	_, err := signers[0].Round2(r1[:2])
+	require.IsType(t, &AbortError{}, err)
+	require.Equal(t, []int{2}, err.(*AbortError).Culprits)
+}
+
+func TestIdentify(t *testing.T) {
+	suite := secp256k1.NewBlakeSHA256Secp256k1()
+	msg := []byte("Hello threshold ECDSA")
+
+	// A wrong share of delta is only detected by the sum of the k*R
+	// shares.
+	_, signers := newSigners(t, suite, 2, []int{0, 1}, msg)
+	_, err := run(signers, func(msg interface{}) {
+		if m, ok := msg.(*Round3); ok && m.Index == 1 {
+			m.Delta = new(big.Int).Add(m.Delta, one)
+		}
+	})
+	require.Equal(t, ErrRevealRequired, err)
+	for _, culprits := range identify(t, signers) {
+		require.Equal(t, []int{1}, culprits)
+	}
+
+	// A wrong share of sigma is only detected by the sum of the sigma*R
+	// shares.
+	_, signers = newSigners(t, suite, 2, []int{0, 1}, msg)
+	_, err = run(signers, func(msg interface{}) {
+		if m, ok := msg.(*Round2); ok && m.Index == 0 {
+			nu := signers[0].nu[1]
+			nu.M = new(big.Int).Add(nu.M, one)
+		}
+	})
+	require.Equal(t, ErrRevealRequired, err)
+	for _, culprits := range identify(t, signers) {
+		require.Equal(t, []int{0}, culprits)
+	}
+
+	// Nonces cannot be revealed once the checks passed.
+	_, signers = newSigners(t, suite, 2, []int{0, 1}, msg)
+	_, err = run(signers, nil)
+	require.NoError(t, err)
+	_, err = signers[0].Reveal()
+	require.Error(t, err)
+}
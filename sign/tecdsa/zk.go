@@ -0,0 +1,89 @@
+// +build vartime
+
+package tecdsa
+
+import (
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/proof/transcript"
+)
+
+// The proofs about Paillier ciphertexts are provided by package
+// encrypt/paillier; the remaining proofs of the protocol, about points
+// only, are made non-interactive with the same transcripts.
+
+// DlogProof proves knowledge of x with X = x*G.
+type DlogProof struct {
+	A kyber.Point
+	Z kyber.Scalar
+}
+
+func dlogChallenge(t *transcript.Transcript, X kyber.Point, p *DlogProof) kyber.Scalar {
+	t.Append("proof", []byte("tecdsa dlog"))
+	t.AppendPoints("point", X)
+	t.AppendPoints("commitment", p.A)
+	return t.Challenge("challenge")
+}
+
+func proveDlog(t *transcript.Transcript, s Suite, x kyber.Scalar, X kyber.Point) *DlogProof {
+	a := s.Scalar().Pick(s.RandomStream())
+	p := &DlogProof{A: s.Point().Mul(a, nil)}
+	e := dlogChallenge(t, X, p)
+	p.Z = s.Scalar().Add(a, s.Scalar().Mul(e, x))
+	return p
+}
+
+func (p *DlogProof) verify(t *transcript.Transcript, s Suite, X kyber.Point) bool {
+	if p == nil || p.A == nil || p.Z == nil {
+		return false
+	}
+	e := dlogChallenge(t, X, p)
+	rhs := s.Point().Add(p.A, s.Point().Mul(e, X))
+	return s.Point().Mul(p.Z, nil).Equal(rhs)
+}
+
+// STProof proves knowledge of x and l with T = x*G + l*H and, when it
+// carries B, S = x*R.
+type STProof struct {
+	A, B   kyber.Point
+	Z1, Z2 kyber.Scalar
+}
+
+func stChallenge(t *transcript.Transcript, H, R, S, T kyber.Point, p *STProof) kyber.Scalar {
+	t.Append("proof", []byte("tecdsa st"))
+	t.AppendPoints("points", H, T)
+	t.AppendPoints("commitment", p.A)
+	if R != nil {
+		t.AppendPoints("points", R, S)
+		t.AppendPoints("commitment", p.B)
+	}
+	return t.Challenge("challenge")
+}
+
+// proveST proves the knowledge of x and l with T = x*G + l*H, and also
+// S = x*R unless R is nil.
+func proveST(t *transcript.Transcript, s Suite, H, R, S, T kyber.Point, x, l kyber.Scalar) *STProof {
+	a := s.Scalar().Pick(s.RandomStream())
+	b := s.Scalar().Pick(s.RandomStream())
+	p := &STProof{A: s.Point().Add(s.Point().Mul(a, nil), s.Point().Mul(b, H))}
+	if R != nil {
+		p.B = s.Point().Mul(a, R)
+	}
+	e := stChallenge(t, H, R, S, T, p)
+	p.Z1 = s.Scalar().Add(a, s.Scalar().Mul(e, x))
+	p.Z2 = s.Scalar().Add(b, s.Scalar().Mul(e, l))
+	return p
+}
+
+func (p *STProof) verify(t *transcript.Transcript, s Suite, H, R, S, T kyber.Point) bool {
+	if p == nil || p.A == nil || p.Z1 == nil || p.Z2 == nil || (R != nil) != (p.B != nil) {
+		return false
+	}
+	e := stChallenge(t, H, R, S, T, p)
+	// z1*G + z2*H = A + e*T
+	lhs := s.Point().Add(s.Point().Mul(p.Z1, nil), s.Point().Mul(p.Z2, H))
+	if !lhs.Equal(s.Point().Add(p.A, s.Point().Mul(e, T))) {
+		return false
+	}
+	// z1*R = B + e*S
+	return R == nil || s.Point().Mul(p.Z1, R).Equal(s.Point().Add(p.B, s.Point().Mul(e, S)))
+}
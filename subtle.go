@@ -0,0 +1,57 @@
+package kyber
+
+import (
+	"crypto/subtle"
+)
+
+// ConstantTimeEqual reports whether a and b, typically two Scalars or two
+// Points of the same group, have the same binary encoding. The encodings
+// are compared in constant time, so that the time taken only depends on
+// their length, whereas the Equal methods of groups built on math/big
+// compare values in variable time. Computing the encodings takes the time
+// of MarshalBinary in the group. It returns false if either encoding fails.
+func ConstantTimeEqual(a, b Marshaling) bool {
+	ab, err := a.MarshalBinary()
+	if err != nil {
+		return false
+	}
+	bb, err := b.MarshalBinary()
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(ab, bb) == 1
+}
+
+// ConstantTimeSelectBytes returns a copy of x if v == 1 and of y if v == 0,
+// such as of the encodings of two Points, in constant time in the manner of
+// subtle.ConstantTimeSelect. Its behavior is undefined for other values of
+// v, and it panics if x and y differ in length.
+func ConstantTimeSelectBytes(v int, x, y []byte) []byte {
+	if len(x) != len(y) {
+		panic("kyber: selecting between encodings of different lengths")
+	}
+	b := make([]byte, len(y))
+	copy(b, y)
+	subtle.ConstantTimeCopy(v, b, x)
+	return b
+}
+
+// ConstantTimeSelect sets dst to x if v == 1 and to y if v == 0, and
+// returns dst. It selects between the encodings of x and y in constant
+// time, in the manner of subtle.ConstantTimeSelect, and takes the time of
+// MarshalBinary and UnmarshalBinary in the group otherwise. Its behavior is
+// undefined for other values of v.
+func ConstantTimeSelect(v int, dst, x, y Scalar) Scalar {
+	xb, err := x.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	yb, err := y.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	if err := dst.UnmarshalBinary(ConstantTimeSelectBytes(v, xb, yb)); err != nil {
+		panic(err)
+	}
+	return dst
+}
@@ -5,5 +5,7 @@ import (
 )
 
 func init() {
-	register(edwards25519.NewBlakeSHA256Ed25519())
+	Register(edwards25519.NewBlakeSHA256Ed25519())
+	Register(edwards25519.NewChaCha20SHA256Ed25519())
+	Register(edwards25519.NewBlakeSHA256Ristretto255())
 }
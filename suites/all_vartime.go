@@ -5,11 +5,19 @@ package suites
 import (
 	"github.com/dedis/kyber/group/curve25519"
 	"github.com/dedis/kyber/group/nist"
+	"github.com/dedis/kyber/group/secp256k1"
 )
 
 func init() {
-	register(curve25519.NewBlakeSHA256Curve25519(false))
-	register(curve25519.NewBlakeSHA256Curve25519(true))
-	register(nist.NewBlakeSHA256P256())
-	register(nist.NewBlakeSHA256QR512())
+	Register(curve25519.NewBlakeSHA256Curve25519(false))
+	Register(curve25519.NewBlakeSHA256Curve25519(true))
+	Register(curve25519.NewAsconCurve25519(false))
+	Register(curve25519.NewShakeSHA512Ed448())
+	Register(nist.NewBlakeSHA256P256())
+	Register(nist.NewBlakeSHA384P384())
+	Register(nist.NewBlakeSHA512P521())
+	registerFIPS(nist.NewShakeSHA256P256())
+	registerFIPS(nist.NewAESSHA256P256())
+	Register(nist.NewBlakeSHA256QR512())
+	Register(secp256k1.NewBlakeSHA256Secp256k1())
 }
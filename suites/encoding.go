@@ -0,0 +1,172 @@
+package suites
+
+import (
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/dedis/kyber"
+)
+
+// The functions below implement a self-describing encoding of points and
+// scalars: the binary encoding of the object is prefixed with the
+// identifier of its suite, a fixed number in two big-endian bytes. Decoding
+// looks the suite up with Find, so data from different suites cannot be
+// confused with each other.
+
+var errInvalidSuiteID = errors.New("invalid suite identifier")
+
+var errWrongSuite = errors.New("object does not belong to the suite")
+
+// firstExternalID is the first identifier left to the suites registered with
+// RegisterID.
+const firstExternalID = 0x8000
+
+// suiteIDs maps the names of the suites to their identifiers. The
+// identifiers are part of the encoding: they must never be changed or
+// reused, and new suites get new ones. Those below 0x8000 are kept for the
+// suites of Kyber, the others are left to RegisterID.
+var suiteIDs = map[string]uint16{
+	"ed25519":          1,
+	"ed25519-chacha20": 2,
+	"ristretto255":     3,
+	"curve25519":       4,
+	"curve25519-full":  5,
+	"curve25519-ascon": 6,
+	"ed448":            7,
+	"p256":             8,
+	"p384":             9,
+	"p521":             10,
+	"p256-shake256":    11,
+	"p256-aes":         12,
+	"residue512":       13,
+	"secp256k1":        14,
+}
+
+// suiteNames is the inverse of suiteIDs.
+var suiteNames = make(map[uint16]string, len(suiteIDs))
+
+func init() {
+	for name, id := range suiteIDs {
+		suiteNames[id] = name
+	}
+}
+
+// RegisterID registers s like Register, and gives it the identifier id, so
+// that its points and scalars can be encoded with MarshalPoint and
+// MarshalScalar. The identifiers below 0x8000 are kept for the suites of
+// Kyber, so that id must be at least 0x8000. It returns an error if id is
+// below 0x8000 or already the identifier of another suite, or if the suite
+// already has another identifier.
+func RegisterID(s Suite, id uint16) error {
+	if id < firstExternalID {
+		return errors.New("suite identifier kept for the suites of Kyber")
+	}
+	name := strings.ToLower(s.String())
+	if other, ok := suiteNames[id]; ok && other != name {
+		return errors.New("suite identifier already in use")
+	}
+	if other, ok := suiteIDs[name]; ok && other != id {
+		return errors.New("suite already has an identifier")
+	}
+	suiteIDs[name] = id
+	suiteNames[id] = name
+	Register(s)
+	return nil
+}
+
+// MarshalPoint returns the encoding of the point p of suite s, prefixed
+// with the identifier of s. It returns an error if p is not a point of s.
+func MarshalPoint(s Suite, p kyber.Point) ([]byte, error) {
+	return marshal(s, p, s.Point())
+}
+
+// MarshalScalar returns the encoding of the scalar x of suite s, prefixed
+// with the identifier of s. It returns an error if x is not a scalar of s.
+func MarshalScalar(s Suite, x kyber.Scalar) ([]byte, error) {
+	return marshal(s, x, s.Scalar())
+}
+
+// UnmarshalPoint decodes a point encoded by MarshalPoint, and returns it
+// together with its suite.
+func UnmarshalPoint(buf []byte) (Suite, kyber.Point, error) {
+	s, rest, err := readID(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	p := s.Point()
+	if err := p.UnmarshalBinary(rest); err != nil {
+		return nil, nil, err
+	}
+	return s, p, nil
+}
+
+// UnmarshalScalar decodes a scalar encoded by MarshalScalar, and returns it
+// together with its suite.
+func UnmarshalScalar(buf []byte) (Suite, kyber.Scalar, error) {
+	s, rest, err := readID(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	x := s.Scalar()
+	if len(rest) != x.MarshalSize() {
+		return nil, nil, errors.New("invalid scalar length")
+	}
+	if err := x.UnmarshalBinary(rest); err != nil {
+		return nil, nil, err
+	}
+	return s, x, nil
+}
+
+// SuiteID returns the identifier of s used to prefix encoded objects. The
+// suite must be registered, so that the identifier can be decoded, and be
+// either one of the suites of Kyber or registered with RegisterID, which
+// are the only ones with an identifier.
+func SuiteID(s Suite) ([]byte, error) {
+	name := strings.ToLower(s.String())
+	if _, err := Find(name); err != nil {
+		return nil, err
+	}
+	id, ok := suiteIDs[name]
+	if !ok {
+		return nil, errInvalidSuiteID
+	}
+	var buf [2]byte
+	binary.BigEndian.PutUint16(buf[:], id)
+	return buf[:], nil
+}
+
+// marshal encodes obj with the identifier of s, after checking that it has
+// the type and size of ref, an object of s.
+func marshal(s Suite, obj, ref kyber.Marshaling) ([]byte, error) {
+	if reflect.TypeOf(obj) != reflect.TypeOf(ref) || obj.MarshalSize() != ref.MarshalSize() {
+		return nil, errWrongSuite
+	}
+	id, err := SuiteID(s)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := obj.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append(id, buf...), nil
+}
+
+// readID reads a suite identifier from buf, and returns the suite together
+// with the remainder of buf.
+func readID(buf []byte) (Suite, []byte, error) {
+	if len(buf) < 2 {
+		return nil, nil, errInvalidSuiteID
+	}
+	name, ok := suiteNames[binary.BigEndian.Uint16(buf)]
+	if !ok {
+		return nil, nil, ErrUnknownSuite
+	}
+	s, err := Find(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, buf[2:], nil
+}
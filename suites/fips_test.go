@@ -0,0 +1,36 @@
+// +build !vartime
+
+package suites
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/internal/fips"
+)
+
+// Without the tag "vartime", there is no FIPS-approved suite.
+func TestRequireFIPSDefaultBuild(t *testing.T) {
+	defer fips.Required.Store(false)
+	if len(Names()) == 0 {
+		t.Fatal("no suites registered")
+	}
+
+	RequireFIPS()
+	if names := Names(); len(names) != 0 {
+		t.Fatal("unexpected suites in FIPS mode:", names)
+	}
+	if _, err := Find("ed25519"); err != ErrNotFIPSApproved {
+		t.Fatal("expected ErrNotFIPSApproved, got", err)
+	}
+	if _, err := edwards25519.NewBlakeSHA256Ed25519Checked(); !errors.Is(err, ErrNotFIPSApproved) {
+		t.Fatal("expected ErrNotFIPSApproved, got", err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("MustFind returned a suite that is not FIPS approved")
+		}
+	}()
+	MustFind("ed25519")
+}
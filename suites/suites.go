@@ -1,19 +1,30 @@
 // Package suites allows callers to look up Kyber suites by name.
 //
-// Currently, only the "ed25519" suite is available by default. To
-// have access to "curve25519" and the NIST suites (i.e. "P256"),
+// Suites register themselves with Register; protocols can then exchange
+// suite names on the wire and instantiate the matching suite with Find.
+//
+// Currently, only the "ed25519" and "ristretto255" suites are available by
+// default. To have access to "curve25519" and the NIST suites (i.e. "P256"),
 // one needs to call the "go" tool with the tag "vartime", such as:
 //
 //   go build -tags vartime
 //   go install -tags vartime
 //   go test -tags vartime
+//
+// Programs that must only use FIPS-approved algorithms can call RequireFIPS
+// at startup, after which Find and Names only know of the suites built from
+// such algorithms, "P256-SHAKE256" and "P256-AES". Both are NIST suites,
+// which need the tag "vartime": without it, no suite is available in FIPS
+// mode.
 package suites
 
 import (
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/internal/fips"
 )
 
 // Suite is the sum of all suites mix-ins in Kyber.
@@ -27,25 +38,89 @@ type Suite interface {
 
 var suites = map[string]Suite{}
 
-// register is called by suites to make themselves known to Kyber.
-//
-func register(s Suite) {
-	suites[strings.ToLower(s.String())] = s
+// fipsApproved records the names of the suites whose group, hash, XOF and
+// random source are all FIPS-approved algorithms.
+var fipsApproved = map[string]bool{}
+
+// Register makes a suite known to Kyber under the name returned by its
+// String method, so that it can later be looked up with Find. Names are
+// case-insensitive, and registering a suite under an existing name
+// replaces the previous one, which is no longer considered FIPS approved.
+// Register is not safe for concurrent use and is meant to be called from
+// init functions.
+func Register(s Suite) {
+	name := strings.ToLower(s.String())
+	suites[name] = s
+	delete(fipsApproved, name)
+}
+
+// registerFIPS registers a suite that only uses FIPS-approved algorithms.
+func registerFIPS(s Suite) {
+	Register(s)
+	fipsApproved[strings.ToLower(s.String())] = true
 }
 
 // ErrUnknownSuite indicates that the suite was not one of the
 // registered suites.
 var ErrUnknownSuite = errors.New("unknown suite")
 
+// ErrNotFIPSApproved indicates that the suite is registered but uses
+// algorithms that are not FIPS approved, while RequireFIPS is in effect.
+var ErrNotFIPSApproved = fips.ErrNotApproved
+
+// RequireFIPS restricts the suites to those that only use FIPS-approved
+// algorithms. Find returns ErrNotFIPSApproved for the others, Names leaves
+// them out and MustFind panics. The constructors of the other suites have a
+// Checked variant, such as edwards25519.NewBlakeSHA256Ed25519Checked, that
+// returns an error wrapping ErrNotFIPSApproved; the plain constructors have
+// no error to return, and do not check the mode. RequireFIPS cannot be
+// undone, and should be called once at program startup, before any suite is
+// created or looked up. It is safe for concurrent use.
+func RequireFIPS() {
+	fips.Required.Store(true)
+}
+
+// FIPSRequired reports whether RequireFIPS has been called.
+func FIPSRequired() bool {
+	return fips.Required.Load()
+}
+
+// IsFIPSApproved reports whether the named suite is registered and only
+// uses FIPS-approved algorithms.
+func IsFIPSApproved(name string) bool {
+	return fipsApproved[strings.ToLower(name)]
+}
+
+// Names returns the names of all registered suites, in lowercase and
+// sorted order, or only of the FIPS-approved ones once RequireFIPS has been
+// called.
+func Names() []string {
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		if FIPSRequired() && !fipsApproved[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Find looks up a suite by name.
 func Find(name string) (Suite, error) {
-	if s, ok := suites[strings.ToLower(name)]; ok {
-		return s, nil
+	s, ok := suites[strings.ToLower(name)]
+	if !ok {
+		return nil, ErrUnknownSuite
+	}
+	if FIPSRequired() && !IsFIPSApproved(name) {
+		return nil, ErrNotFIPSApproved
 	}
-	return nil, ErrUnknownSuite
+	return s, nil
 }
 
-// MustFind looks up a suite by name and panics if it is not found.
+// MustFind looks up a suite by name and panics if Find returns an error,
+// either because the suite is not registered or because it is not FIPS
+// approved while RequireFIPS is in effect.
 func MustFind(name string) Suite {
 	s, err := Find(name)
 	if err != nil {
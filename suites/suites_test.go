@@ -0,0 +1,287 @@
+// +build vartime
+
+package suites
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/group/nist"
+	"github.com/dedis/kyber/internal/fips"
+)
+
+type namedSuite struct {
+	*edwards25519.SuiteEd25519
+}
+
+func (s namedSuite) String() string { return "Test-Suite" }
+
+func TestRegister(t *testing.T) {
+	defer delete(suites, "test-suite")
+
+	if _, err := Find("test-suite"); err != ErrUnknownSuite {
+		t.Fatal("expected ErrUnknownSuite, got", err)
+	}
+	s := namedSuite{edwards25519.NewBlakeSHA256Ed25519()}
+	Register(s)
+	found, err := Find("TEST-SUITE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != Suite(s) {
+		t.Fatal("Find returned a different suite")
+	}
+
+	names := Names()
+	listed := false
+	for i, name := range names {
+		if i > 0 && names[i-1] >= name {
+			t.Fatal("names are not sorted:", names)
+		}
+		listed = listed || name == "test-suite"
+	}
+	if !listed {
+		t.Fatal("registered suite missing from", names)
+	}
+}
+
+func TestRequireFIPS(t *testing.T) {
+	defer fips.Required.Store(false)
+
+	if IsFIPSApproved("Ed25519") || !IsFIPSApproved("P256-SHAKE256") {
+		t.Fatal("unexpected FIPS metadata")
+	}
+	if _, err := Find("ed25519"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := nist.NewBlakeSHA256P256Checked(); err != nil {
+		t.Fatal(err)
+	}
+
+	RequireFIPS()
+	if !FIPSRequired() {
+		t.Fatal("FIPS mode not recorded")
+	}
+	if _, err := Find("ed25519"); err != ErrNotFIPSApproved {
+		t.Fatal("expected ErrNotFIPSApproved, got", err)
+	}
+	if _, err := Find("unknown"); err != ErrUnknownSuite {
+		t.Fatal("expected ErrUnknownSuite, got", err)
+	}
+	s, err := Find("p256-shake256")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.String() != "P256-SHAKE256" {
+		t.Fatal("unexpected suite", s)
+	}
+
+	names := Names()
+	if len(names) != 2 || names[0] != "p256-aes" || names[1] != "p256-shake256" {
+		t.Fatal("unexpected suites in FIPS mode:", names)
+	}
+
+	// The checked constructors of the other suites return an error, and
+	// only MustFind panics.
+	if _, err := nist.NewBlakeSHA256P256Checked(); !errors.Is(err, ErrNotFIPSApproved) {
+		t.Fatal("expected ErrNotFIPSApproved, got", err)
+	}
+	nist.NewBlakeSHA256P256()
+	nist.NewShakeSHA256P256()
+	nist.NewAESSHA256P256()
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("MustFind returned a suite that is not FIPS approved")
+			}
+		}()
+		MustFind("p256")
+	}()
+}
+
+func TestSuiteEncoding(t *testing.T) {
+	for _, name := range []string{"Ed25519", "P256"} {
+		s := MustFind(name)
+		p := s.Point().Pick(s.RandomStream())
+		x := s.Scalar().Pick(s.RandomStream())
+
+		buf, err := MarshalPoint(s, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s2, p2, err := UnmarshalPoint(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s2 != s || !p2.Equal(p) {
+			t.Fatalf("%s: point does not round-trip", name)
+		}
+
+		buf, err = MarshalScalar(s, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s2, x2, err := UnmarshalScalar(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s2 != s || !x2.Equal(x) {
+			t.Fatalf("%s: scalar does not round-trip", name)
+		}
+	}
+
+	// A point of one suite cannot be decoded as belonging to another.
+	ed := MustFind("Ed25519")
+	buf, _ := MarshalPoint(ed, ed.Point().Base())
+	if s, _, _ := UnmarshalPoint(buf); s.String() != ed.String() {
+		t.Fatal("decoded point with the wrong suite")
+	}
+	buf[0], buf[1] = 0xff, 0xff
+	if _, _, err := UnmarshalPoint(buf); err != ErrUnknownSuite {
+		t.Fatal("expected ErrUnknownSuite, got", err)
+	}
+
+	for _, bad := range [][]byte{nil, {7}} {
+		if _, _, err := UnmarshalPoint(bad); err == nil {
+			t.Fatalf("accepted invalid encoding %x", bad)
+		}
+	}
+	if _, err := MarshalPoint(namedSuite{edwards25519.NewBlakeSHA256Ed25519()}, ed.Point()); err != ErrUnknownSuite {
+		t.Fatal("encoded a point of an unregistered suite")
+	}
+
+	// Nor can an object be encoded with the identifier of another suite.
+	p256 := MustFind("P256")
+	if _, err := MarshalPoint(ed, p256.Point().Base()); err != errWrongSuite {
+		t.Fatal("expected errWrongSuite, got", err)
+	}
+	if _, err := MarshalScalar(p256, ed.Scalar().One()); err != errWrongSuite {
+		t.Fatal("expected errWrongSuite, got", err)
+	}
+	if _, err := MarshalPoint(MustFind("Ristretto255"), ed.Point().Base()); err != errWrongSuite {
+		t.Fatal("expected errWrongSuite, got", err)
+	}
+}
+
+func TestSuiteID(t *testing.T) {
+	// The identifiers are part of the encoding, and must not change.
+	for name, want := range map[string][]byte{
+		"ed25519":      {0, 1},
+		"ristretto255": {0, 3},
+	} {
+		id, err := SuiteID(MustFind(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(id, want) {
+			t.Fatalf("%s: identifier %x, expected %x", name, id, want)
+		}
+	}
+
+	// Every registered suite has an identifier, which decodes back to it.
+	for _, name := range Names() {
+		s := MustFind(name)
+		id, err := SuiteID(s)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(id) != 2 {
+			t.Fatalf("%s: identifier %x is not two bytes long", name, id)
+		}
+		s2, rest, err := readID(id)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if s2 != s || len(rest) != 0 {
+			t.Fatalf("%s: identifier %x does not decode to the suite", name, id)
+		}
+	}
+
+	// And no two suites share one.
+	if len(suiteNames) != len(suiteIDs) {
+		t.Fatal("suite identifiers are not distinct")
+	}
+	for id, name := range suiteNames {
+		if suiteIDs[name] != id {
+			t.Fatalf("%s: identifier %d is not the inverse of %d", name, id, suiteIDs[name])
+		}
+	}
+}
+
+func TestRegisterID(t *testing.T) {
+	s := namedSuite{edwards25519.NewBlakeSHA256Ed25519()}
+	defer func() {
+		delete(suites, "test-suite")
+		delete(suiteIDs, "test-suite")
+		delete(suiteNames, 0x8000)
+	}()
+	if err := RegisterID(s, 1); err == nil {
+		t.Fatal("identifier of ed25519 reused")
+	}
+	if err := RegisterID(s, 15); err == nil {
+		t.Fatal("identifier kept for Kyber accepted")
+	}
+	if err := RegisterID(s, 0x8000); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterID(s, 0x8001); err == nil {
+		t.Fatal("second identifier accepted")
+	}
+	if err := RegisterID(s, 0x8000); err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := SuiteID(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(id, []byte{0x80, 0}) {
+		t.Fatalf("identifier %x, expected 8000", id)
+	}
+	p := s.Point().Pick(s.RandomStream())
+	buf, err := MarshalPoint(s, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, p2, err := UnmarshalPoint(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s2.String() != s.String() || !p2.Equal(p) {
+		t.Fatal("point of an external suite does not round-trip")
+	}
+	x := s.Scalar().Pick(s.RandomStream())
+	buf, err = MarshalScalar(s, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, x2, err := UnmarshalScalar(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s2.String() != s.String() || !x2.Equal(x) {
+		t.Fatal("scalar of an external suite does not round-trip")
+	}
+}
+
+// fakeSuite registers another suite under the name of a FIPS-approved one.
+type fakeSuite struct{ Suite }
+
+func (fakeSuite) String() string { return "P256-SHAKE256" }
+
+func TestRegisterReplacesFIPS(t *testing.T) {
+	defer fips.Required.Store(false)
+	orig := MustFind("P256-SHAKE256")
+	defer registerFIPS(orig)
+
+	Register(fakeSuite{edwards25519.NewBlakeSHA256Ed25519()})
+	if IsFIPSApproved("P256-SHAKE256") {
+		t.Fatal("replaced suite still FIPS approved")
+	}
+	RequireFIPS()
+	if _, err := Find("P256-SHAKE256"); err != ErrNotFIPSApproved {
+		t.Fatal("expected ErrNotFIPSApproved, got", err)
+	}
+}
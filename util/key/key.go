@@ -3,8 +3,15 @@ package key
 
 import (
 	"crypto/cipher"
+	"errors"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/util/secmem"
+)
+
+var (
+	errIdentity = errors.New("key: public key is the identity")
+	errInvalid  = errors.New("key: public key is not in the prime-order group")
 )
 
 // Generator is a type that needs to implement a special case in order
@@ -42,23 +49,59 @@ func NewHidingKeyPair(suite Suite) *Pair {
 	return kp
 }
 
+// NewSecureKeyPair creates a secret/public key pair whose private key is
+// held in secure memory, whether or not secmem.Enable was called. Unlike
+// Gen, it returns an error if the suite does not implement
+// secmem.Allocator or if the memory cannot be allocated, for instance
+// because mlock fails beyond the RLIMIT_MEMLOCK of the process, so that
+// callers can decide whether to fall back to a key on the Go heap.
+func NewSecureKeyPair(suite Suite) (*Pair, error) {
+	private := newPrivate(suite)
+	s, err := secmem.Protect(suite, private)
+	if err != nil {
+		kyber.Destroy(private)
+		return nil, err
+	}
+	return &Pair{Public: suite.Point().Mul(s, nil), Private: s}, nil
+}
+
 // Gen creates a fresh public/private keypair with the given
 // ciphersuite, using a given source of cryptographic randomness. If
 // suite implements key.Generator, then suite.NewKey is called
 // to generate the private key, otherwise the normal technique
 // of choosing a random scalar from the group is used.
+//
+// If secure memory is enabled with secmem.Enable and the suite implements
+// secmem.Allocator, the private key is moved to secure memory, which
+// Destroy frees; Gen panics if it cannot be allocated. NewSecureKeyPair
+// returns the error instead.
 func (p *Pair) Gen(suite Suite) {
+	p.Private = newPrivate(suite)
+	if _, ok := suite.(secmem.Allocator); ok && secmem.Enabled() {
+		s, err := secmem.Protect(suite, p.Private)
+		if err != nil {
+			panic(err)
+		}
+		p.Private = s
+	}
+	p.Public = suite.Point().Mul(p.Private, nil)
+}
+
+// newPrivate returns a fresh private key of suite, from suite.NewKey if the
+// suite implements Generator.
+func newPrivate(suite Suite) kyber.Scalar {
 	random := suite.RandomStream()
 	if g, ok := suite.(Generator); ok {
-		p.Private = g.NewKey(random)
-	} else {
-		p.Private = suite.Scalar().Pick(random)
+		return g.NewKey(random)
 	}
-	p.Public = suite.Point().Mul(p.Private, nil)
+	return suite.Scalar().Pick(random)
 }
 
 // GenHiding will generate key pairs repeatedly until one is found where the
-// public key has the property that it can be hidden.
+// public key has the property that it can be hidden. For suites whose
+// HideEncode succeeds depending on the random bits, a later HideEncode of
+// the public key may still fail, so callers that need the encoding itself
+// should rather loop over Gen and HideEncode, as sign/anon does.
 func (p *Pair) GenHiding(suite Suite) {
 	rand := suite.RandomStream()
 	p.Gen(suite)
@@ -69,6 +112,30 @@ func (p *Pair) GenHiding(suite Suite) {
 			p.Hiding = Xh
 			return // success
 		}
+		p.Destroy()
 		p.Gen(suite)
 	}
 }
+
+// Destroy overwrites the private key of the pair, if its Scalar is
+// kyber.Destroyable, and frees it if it is held in secure memory. The
+// public key is kept.
+func (p *Pair) Destroy() {
+	secmem.Release(p.Private)
+}
+
+// ValidatePublicKey checks that a public key received from a peer is fit
+// for use with a secret scalar in the group g: it must not be the identity
+// and, if the point implements kyber.ValidatablePoint, it must be valid, so
+// that it is on the curve and has no small-order component that would leak
+// the secret scalar modulo its order. The points of kyber implement
+// kyber.ValidatablePoint; for the others, only the identity is rejected.
+func ValidatePublicKey(g kyber.Group, public kyber.Point) error {
+	if public.Equal(g.Point().Null()) {
+		return errIdentity
+	}
+	if v, ok := public.(kyber.ValidatablePoint); ok && !v.Valid() {
+		return errInvalid
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+// +build amd64 arm64 ppc64 ppc64le mips64 mips64le riscv64 s390x
+
+package key
+
+import (
+	"testing"
+
+	"github.com/dedis/kyber/group/edwards25519"
+)
+
+// hugeSuite has scalars too large for secure memory to be allocated, a
+// size which only fits in an int on 64-bit targets.
+type hugeSuite struct{ *edwards25519.SuiteEd25519 }
+
+func (hugeSuite) ScalarLen() int { return 1 << 50 }
+
+func TestNewSecureKeyPairHuge(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	if _, err := NewSecureKeyPair(hugeSuite{suite}); err == nil {
+		t.Fatal("secure key pair without secure memory")
+	}
+}
@@ -2,10 +2,12 @@ package key
 
 import (
 	"crypto/cipher"
+	"encoding/hex"
 	"testing"
 
 	"github.com/dedis/kyber"
 	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/secmem"
 )
 
 func TestNewKeyPair(t *testing.T) {
@@ -37,3 +39,75 @@ func TestNewKeyPairGen(t *testing.T) {
 		t.Fatalf("expected fixed private key, got %v", key.Private)
 	}
 }
+
+func TestValidatePublicKey(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	kp := NewKeyPair(suite)
+	if err := ValidatePublicKey(suite, kp.Public); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidatePublicKey(suite, suite.Point().Null()); err == nil {
+		t.Fatal("identity accepted")
+	}
+
+	// A point of order 8, alone and added to the key
+	b, _ := hex.DecodeString("c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a")
+	T := suite.Point()
+	if err := T.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidatePublicKey(suite, T); err == nil {
+		t.Fatal("small-order point accepted")
+	}
+	if err := ValidatePublicKey(suite, suite.Point().Add(kp.Public, T)); err == nil {
+		t.Fatal("point outside the subgroup accepted")
+	}
+}
+
+func TestPairDestroy(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	kp := NewKeyPair(suite)
+	pub := kp.Public.Clone()
+	kp.Destroy()
+	if !kp.Private.Equal(suite.Scalar().Zero()) {
+		t.Fatal("private key not overwritten")
+	}
+	if !kp.Public.Equal(pub) {
+		t.Fatal("public key modified")
+	}
+}
+
+func TestGenSecureMemory(t *testing.T) {
+	if err := secmem.Enable(true); err != nil {
+		t.Skip("secure memory is unavailable:", err)
+	}
+	defer secmem.Enable(false)
+
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	for i := 0; i < 3; i++ {
+		kp := NewHidingKeyPair(suite)
+		if !suite.Point().Mul(kp.Private, nil).Equal(kp.Public) {
+			t.Fatal("public and private keys in secure memory don't match")
+		}
+		kp.Destroy()
+	}
+}
+
+// plainSuite hides the secmem.Allocator of its suite.
+type plainSuite struct{ Suite }
+
+func TestNewSecureKeyPair(t *testing.T) {
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	if _, err := NewSecureKeyPair(plainSuite{suite}); err == nil {
+		t.Fatal("secure key pair of a suite without secmem.Allocator")
+	}
+
+	kp, err := NewSecureKeyPair(suite)
+	if err != nil {
+		t.Skip("secure memory is unavailable:", err)
+	}
+	if !suite.Point().Mul(kp.Private, nil).Equal(kp.Public) {
+		t.Fatal("public and private keys in secure memory don't match")
+	}
+	kp.Destroy()
+}
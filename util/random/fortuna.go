@@ -0,0 +1,202 @@
+package random
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"sync"
+	"time"
+)
+
+const (
+	fortunaPools = 32
+	// minPoolSize is the amount of event data that must be in pool 0
+	// before a reseed happens.
+	minPoolSize = 64
+	// reseedInterval is the minimum time between two reseeds.
+	reseedInterval = 100 * time.Millisecond
+	// maxRequest is the maximum number of bytes generated under a
+	// single key.
+	maxRequest = 1 << 20
+)
+
+// Source numbers of the events gathered by Fortuna itself.
+const (
+	sourceSystem = iota
+	sourceTiming
+	// SourceUser is the first source number available for events
+	// added through AddRandomEvent.
+	SourceUser
+)
+
+// Fortuna is a cryptographically secure random number generator that
+// pools entropy from several sources, following the Fortuna design of
+// Ferguson and Schneier. Events from every source are spread over 32
+// entropy pools, and the generator is reseeded from an increasing number
+// of pools as they fill up, so that it recovers from a compromise of its
+// state even if some sources are weak or controlled by an attacker.
+//
+// On every read, Fortuna gathers an event from crypto/rand and a timing
+// event; callers can contribute more sources with AddRandomEvent. A
+// Fortuna generator implements cipher.Stream and can be used from
+// multiple goroutines.
+type Fortuna struct {
+	sync.Mutex
+
+	// Generator state: AES-256 in counter mode, rekeyed after every
+	// request.
+	key     [32]byte
+	counter [aes.BlockSize]byte
+	block   cipher.Block
+
+	pools     [fortunaPools]hash.Hash
+	pool0Size int
+	next      map[byte]int // next pool of each source
+	reseeds   uint32
+	last      time.Time
+
+	// now returns the current time; it is replaced in tests.
+	now func() time.Time
+}
+
+// NewFortuna returns a Fortuna generator, initially seeded from Go's
+// crypto/rand package.
+func NewFortuna() *Fortuna {
+	f := &Fortuna{next: make(map[byte]int), now: time.Now}
+	for i := range f.pools {
+		f.pools[i] = sha256.New()
+	}
+	var seed [32]byte
+	if _, err := rand.Read(seed[:]); err != nil {
+		panic(err)
+	}
+	f.reseed(seed[:])
+	return f
+}
+
+// AddRandomEvent mixes data from the given source into the entropy pools.
+// Successive events of a source are spread over all pools in turn. Source
+// numbers below SourceUser are reserved for Fortuna's own sources. Data
+// longer than 32 bytes is hashed first.
+func (f *Fortuna) AddRandomEvent(source byte, data []byte) {
+	f.Lock()
+	defer f.Unlock()
+	f.addEvent(source, data)
+}
+
+func (f *Fortuna) addEvent(source byte, data []byte) {
+	if len(data) > sha256.Size {
+		h := sha256.Sum256(data)
+		data = h[:]
+	}
+	i := f.next[source]
+	f.next[source] = (i + 1) % fortunaPools
+	f.pools[i].Write([]byte{source, byte(len(data))})
+	f.pools[i].Write(data)
+	if i == 0 {
+		f.pool0Size += 2 + len(data)
+	}
+}
+
+// XORKeyStream XORs src with random bytes, writing the result to dst.
+func (f *Fortuna) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("dst too short")
+	}
+	f.Lock()
+	defer f.Unlock()
+
+	f.gather()
+	now := f.now()
+	if f.pool0Size >= minPoolSize && now.Sub(f.last) >= reseedInterval {
+		f.reseedFromPools(now)
+	}
+
+	for len(src) > 0 {
+		n := len(src)
+		if n > maxRequest {
+			n = maxRequest
+		}
+		f.generate(dst[:n], src[:n])
+		dst, src = dst[n:], src[n:]
+	}
+}
+
+// gather collects one event from each built-in source.
+func (f *Fortuna) gather() {
+	var sys [32]byte
+	if _, err := rand.Read(sys[:]); err != nil {
+		panic(err)
+	}
+	f.addEvent(sourceSystem, sys[:])
+	var t [8]byte
+	binary.BigEndian.PutUint64(t[:], uint64(f.now().UnixNano()))
+	f.addEvent(sourceTiming, t[:])
+}
+
+// reseedFromPools reseeds the generator from pool i whenever 2^i divides
+// the number of reseeds, and empties those pools.
+func (f *Fortuna) reseedFromPools(now time.Time) {
+	f.reseeds++
+	var seed []byte
+	for i := range f.pools {
+		if i > 0 && f.reseeds&(1<<uint(i-1)) != 0 {
+			break
+		}
+		seed = f.pools[i].Sum(seed)
+		f.pools[i].Reset()
+	}
+	f.pool0Size = 0
+	f.last = now
+	f.reseed(seed)
+}
+
+// reseed replaces the key with SHA-256d(key || seed).
+func (f *Fortuna) reseed(seed []byte) {
+	h := sha256.New()
+	h.Write(f.key[:])
+	h.Write(seed)
+	k := h.Sum(nil)
+	f.key = sha256.Sum256(k)
+	f.rekey()
+	incrCounter(&f.counter)
+}
+
+func (f *Fortuna) rekey() {
+	b, err := aes.NewCipher(f.key[:])
+	if err != nil {
+		panic(err)
+	}
+	f.block = b
+}
+
+// generate XORs src with key stream, then replaces the key with fresh
+// key stream so that earlier outputs cannot be recovered from the state.
+func (f *Fortuna) generate(dst, src []byte) {
+	var buf [aes.BlockSize]byte
+	for i := 0; i < len(src); i += aes.BlockSize {
+		f.block.Encrypt(buf[:], f.counter[:])
+		incrCounter(&f.counter)
+		for j := 0; j < aes.BlockSize && i+j < len(src); j++ {
+			dst[i+j] = src[i+j] ^ buf[j]
+		}
+	}
+	for i := 0; i < len(f.key); i += aes.BlockSize {
+		f.block.Encrypt(f.key[i:], f.counter[:])
+		incrCounter(&f.counter)
+	}
+	f.rekey()
+}
+
+// incrCounter increments the little-endian counter block.
+func incrCounter(c *[aes.BlockSize]byte) {
+	for i := range c {
+		c[i]++
+		if c[i] != 0 {
+			return
+		}
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"math/big"
+	"sync"
+
+	"github.com/dedis/kyber"
 )
 
 // Bits chooses a uniform random BigInt with a given maximum BitLen.
@@ -76,3 +79,25 @@ func (r *randstream) XORKeyStream(dst, src []byte) {
 func New() cipher.Stream {
 	return &randstream{}
 }
+
+type deterministicStream struct {
+	sync.Mutex
+	xof kyber.XOF
+}
+
+func (d *deterministicStream) XORKeyStream(dst, src []byte) {
+	d.Lock()
+	defer d.Unlock()
+	d.xof.XORKeyStream(dst, src)
+}
+
+// NewDeterministicStream returns a cipher.Stream whose key stream is
+// derived from seed with the XOF of suite. Streams created from the same
+// seed produce the same key stream, which makes protocol executions
+// reproducible, for instance in tests or for verifiable key derivation.
+// The stream is only as secret as the seed, which should therefore have
+// enough entropy when the stream is used to pick secrets. The resulting
+// cipher.Stream can be used in multiple threads.
+func NewDeterministicStream(suite kyber.XOFFactory, seed []byte) cipher.Stream {
+	return &deterministicStream{xof: suite.XOF(seed)}
+}
@@ -0,0 +1,88 @@
+package random
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/xof/blake"
+)
+
+type blakeFactory struct{}
+
+func (blakeFactory) XOF(seed []byte) kyber.XOF { return blake.New(seed) }
+
+var bigMod = new(big.Int).Lsh(big.NewInt(1), 255)
+
+func TestDeterministicStream(t *testing.T) {
+	s1 := NewDeterministicStream(blakeFactory{}, []byte("seed"))
+	s2 := NewDeterministicStream(blakeFactory{}, []byte("seed"))
+	s3 := NewDeterministicStream(blakeFactory{}, []byte("other seed"))
+
+	b1 := make([]byte, 100)
+	b2 := make([]byte, 100)
+	b3 := make([]byte, 100)
+	Bytes(b1[:30], s1)
+	Bytes(b1[30:], s1)
+	Bytes(b2, s2)
+	Bytes(b3, s3)
+	if !bytes.Equal(b1, b2) {
+		t.Fatal("streams with the same seed differ")
+	}
+	if bytes.Equal(b1, b3) {
+		t.Fatal("streams with different seeds are equal")
+	}
+
+	// Objects picked from the stream are reproducible as well.
+	i1 := Int(bigMod, NewDeterministicStream(blakeFactory{}, []byte("seed")))
+	i2 := Int(bigMod, NewDeterministicStream(blakeFactory{}, []byte("seed")))
+	if i1.Cmp(i2) != 0 {
+		t.Fatal("picked integers differ")
+	}
+}
+
+func TestFortuna(t *testing.T) {
+	f := NewFortuna()
+	clock := time.Unix(0, 0)
+	f.now = func() time.Time { return clock }
+	f.last = clock
+
+	b1 := make([]byte, 100)
+	b2 := make([]byte, 100)
+	Bytes(b1, f)
+	Bytes(b2, f)
+	if bytes.Equal(b1, b2) {
+		t.Fatal("successive outputs are equal")
+	}
+	if bytes.Equal(b1, make([]byte, 100)) {
+		t.Fatal("output is all zeros")
+	}
+
+	f.AddRandomEvent(SourceUser, []byte("a caller-supplied event"))
+	f.AddRandomEvent(SourceUser, bytes.Repeat([]byte{1}, 100))
+
+	// Reseeds happen once pool 0 has gathered enough events, and no more
+	// often than the reseed interval.
+	for i := 0; i < 100; i++ {
+		Bytes(b1, f)
+	}
+	if f.reseeds != 0 {
+		t.Fatal("reseeded before the reseed interval elapsed")
+	}
+	for i := 0; i < 100; i++ {
+		clock = clock.Add(time.Second)
+		Bytes(b1, f)
+	}
+	if f.reseeds == 0 {
+		t.Fatal("generator was never reseeded")
+	}
+
+	// Requests larger than the per-key limit are split.
+	big := make([]byte, maxRequest+16)
+	Bytes(big, f)
+	if bytes.Equal(big[:16], big[maxRequest:maxRequest+16]) {
+		t.Fatal("key stream repeats across requests")
+	}
+}
@@ -0,0 +1,45 @@
+package secmem
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// alloc maps size bytes between two guard pages, locked into RAM and
+// excluded from core dumps, and returns the whole mapping and the last size
+// bytes before the trailing guard page.
+func alloc(size int) (mem, data []byte, err error) {
+	page := os.Getpagesize()
+	n := (size + page - 1) / page
+	if n == 0 {
+		n = 1
+	}
+	mem, err = unix.Mmap(-1, 0, (n+2)*page, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANON)
+	if err != nil {
+		return nil, nil, err
+	}
+	inner := mem[page : (n+1)*page]
+	if err = unix.Mprotect(mem[:page], unix.PROT_NONE); err == nil {
+		err = unix.Mprotect(mem[(n+1)*page:], unix.PROT_NONE)
+	}
+	if err == nil {
+		err = unix.Mlock(inner)
+	}
+	if err == nil {
+		err = unix.Madvise(inner, unix.MADV_DONTDUMP)
+	}
+	if err != nil {
+		_ = unix.Munmap(mem)
+		return nil, nil, err
+	}
+	return mem, inner[len(inner)-size:], nil
+}
+
+// free unlocks and unmaps a mapping of alloc, whose data the caller has
+// overwritten.
+func free(mem []byte) {
+	page := os.Getpagesize()
+	_ = unix.Munlock(mem[page : len(mem)-page])
+	_ = unix.Munmap(mem)
+}
@@ -0,0 +1,9 @@
+// +build !linux
+
+package secmem
+
+func alloc(size int) (mem, data []byte, err error) {
+	return nil, nil, errUnsupported
+}
+
+func free(mem []byte) {}
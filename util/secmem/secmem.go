@@ -0,0 +1,148 @@
+// Package secmem allocates memory for secrets outside of the Go heap, for
+// servers that must keep private keys in memory without an HSM. The memory
+// is locked into RAM, so that it is never written to swap, excluded from
+// core dumps, and surrounded by inaccessible guard pages, so that reading or
+// writing past its bounds faults instead of reaching other data. Secure
+// memory is only supported on Linux.
+//
+// The Scalars of groups implementing Allocator, such as those of
+// edwards25519, can be held in secure memory with NewScalar and Protect.
+// Once Enable(true) is called, util/key also generates private keys of such
+// groups in secure memory. Only the final value is protected: the
+// computations that produce a Scalar may leave copies on the Go heap and
+// stack, as may methods such as Clone that return new Scalars.
+package secmem
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dedis/kyber"
+)
+
+var (
+	errUnsupported = errors.New("secmem: secure memory is not supported on this platform")
+	errGroup       = errors.New("secmem: the scalars of the group cannot be held in secure memory")
+)
+
+// Buffer is a fixed-size region of secure memory. Its bytes end right
+// before a guard page, so that overflows fault immediately.
+type Buffer struct {
+	mem  []byte // whole mapping, guard pages included
+	data []byte
+}
+
+// New allocates a Buffer of size bytes of secure memory, initially zero. It
+// returns an error if the platform does not support secure memory or if the
+// memory cannot be locked, for instance beyond the RLIMIT_MEMLOCK of the
+// process. Every Buffer takes at least three pages, so Buffers suit a few
+// long-lived secrets rather than many short-lived ones.
+func New(size int) (*Buffer, error) {
+	mem, data, err := alloc(size)
+	if err != nil {
+		return nil, err
+	}
+	return &Buffer{mem: mem, data: data}, nil
+}
+
+// Bytes returns the memory of the Buffer, until Destroy is called.
+func (b *Buffer) Bytes() []byte {
+	return b.data
+}
+
+// Destroy overwrites the memory of the Buffer with zeros and frees it, after
+// which the slice returned by Bytes must not be used.
+func (b *Buffer) Destroy() {
+	if b.mem == nil {
+		return
+	}
+	for i := range b.data {
+		b.data[i] = 0
+	}
+	free(b.mem)
+	b.mem, b.data = nil, nil
+}
+
+// Allocator is implemented by the groups whose Scalars can be stored in
+// memory that the caller provides, because they have a fixed size and hold
+// no Go pointers, which must not be stored outside of the Go heap.
+type Allocator interface {
+	// ScalarAt returns a zero Scalar stored in the first ScalarLen bytes
+	// of b, which must outlive it.
+	ScalarAt(b []byte) kyber.Scalar
+}
+
+var (
+	mu      sync.Mutex
+	buffers = map[kyber.Scalar]*Buffer{}
+)
+
+// NewScalar returns a new zero Scalar of the group g held in secure memory,
+// which Release frees. It returns an error if g does not implement
+// Allocator or if the memory cannot be allocated.
+func NewScalar(g kyber.Group) (kyber.Scalar, error) {
+	a, ok := g.(Allocator)
+	if !ok {
+		return nil, errGroup
+	}
+	b, err := New(g.ScalarLen())
+	if err != nil {
+		return nil, err
+	}
+	s := a.ScalarAt(b.Bytes())
+	mu.Lock()
+	buffers[s] = b
+	mu.Unlock()
+	return s.Zero(), nil
+}
+
+// Protect returns a copy of s held in secure memory, like NewScalar, and
+// destroys s if it is kyber.Destroyable. On error, s is left untouched.
+func Protect(g kyber.Group, s kyber.Scalar) (kyber.Scalar, error) {
+	t, err := NewScalar(g)
+	if err != nil {
+		return nil, err
+	}
+	t.Set(s)
+	kyber.Destroy(s)
+	return t, nil
+}
+
+// Release destroys s and, if it is held in secure memory, frees it, after
+// which s must not be used.
+func Release(s kyber.Scalar) {
+	kyber.Destroy(s)
+	mu.Lock()
+	b, ok := buffers[s]
+	delete(buffers, s)
+	mu.Unlock()
+	if ok {
+		b.Destroy()
+	}
+}
+
+var enabled atomic.Bool
+
+// Enable sets whether util/key generates private keys in secure memory, for
+// the groups that implement Allocator. Key generation panics if the memory
+// cannot be allocated while enabled, rather than silently keep the key on
+// the Go heap; key.NewSecureKeyPair returns that error to the caller
+// instead. Enable returns an error, and leaves secure memory disabled, if
+// the platform does not support it.
+func Enable(enable bool) error {
+	if enable {
+		b, err := New(1)
+		if err != nil {
+			return err
+		}
+		b.Destroy()
+	}
+	enabled.Store(enable)
+	return nil
+}
+
+// Enabled reports whether util/key generates private keys in secure memory.
+func Enabled() bool {
+	return enabled.Load()
+}
@@ -0,0 +1,82 @@
+package secmem
+
+import (
+	"os"
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+// newBuffer allocates a Buffer, or skips the test where secure memory is
+// unavailable.
+func newBuffer(t *testing.T, size int) *Buffer {
+	b, err := New(size)
+	if runtime.GOOS != "linux" {
+		require.Error(t, err)
+		t.Skip("secure memory is not supported on", runtime.GOOS)
+	}
+	if err != nil {
+		t.Skip("secure memory cannot be allocated:", err)
+	}
+	return b
+}
+
+func TestBuffer(t *testing.T) {
+	b := newBuffer(t, 100)
+	require.Len(t, b.Bytes(), 100)
+	for _, c := range b.Bytes() {
+		require.Zero(t, c)
+	}
+	copy(b.Bytes(), "secret")
+	b.Destroy()
+	require.Nil(t, b.Bytes())
+	b.Destroy()
+}
+
+func TestGuardPages(t *testing.T) {
+	b := newBuffer(t, 32)
+	defer b.Destroy()
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	touch := func(i int) (faulted bool) {
+		defer func() { faulted = recover() != nil }()
+		b.mem[i]++
+		return false
+	}
+	page := os.Getpagesize()
+	require.True(t, touch(0))
+	require.True(t, touch(page-1))
+	require.False(t, touch(len(b.mem)-page-1))
+	require.True(t, touch(len(b.mem)-page))
+}
+
+func TestScalar(t *testing.T) {
+	newBuffer(t, 1).Destroy()
+	suite := edwards25519.NewBlakeSHA256Ed25519()
+	rand := random.New()
+
+	s, err := NewScalar(suite)
+	require.NoError(t, err)
+	require.True(t, s.Equal(suite.Scalar().Zero()))
+	a := suite.Scalar().Pick(rand)
+	b := suite.Scalar().Pick(rand)
+	s.Mul(a, b)
+	require.True(t, s.Equal(suite.Scalar().Mul(a, b)))
+	require.True(t, suite.Point().Mul(s, nil).Equal(suite.Point().Mul(a, suite.Point().Mul(b, nil))))
+	Release(s)
+
+	want := a.Clone()
+	p, err := Protect(suite, a)
+	require.NoError(t, err)
+	require.True(t, p.Equal(want))
+	require.True(t, a.Equal(suite.Scalar().Zero()))
+	Release(p)
+
+	// A group that does not implement Allocator.
+	_, err = NewScalar(struct{ kyber.Group }{suite})
+	require.Error(t, err)
+}
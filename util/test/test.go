@@ -130,6 +130,89 @@ func testScalarClone(g kyber.Group, rand cipher.Stream) {
 	}
 }
 
+// testPointBlinding checks that blinded multiplications agree with unblinded
+// ones, if the points of g implement kyber.BlindablePoint.
+func testPointBlinding(g kyber.Group, rand cipher.Stream) {
+	if _, ok := g.Point().(kyber.BlindablePoint); !ok {
+		return
+	}
+	for i := 0; i < 3; i++ {
+		s := g.Scalar().Pick(rand)
+		P := g.Point().Pick(rand)
+		B := g.Point()
+		B.(kyber.BlindablePoint).SetBlinding(rand)
+		if !B.Mul(s, P).Equal(g.Point().Mul(s, P)) {
+			panic("blinded Mul gives a different point")
+		}
+		if !B.Mul(s, nil).Equal(g.Point().Mul(s, nil)) {
+			panic("blinded Mul of the base point gives a different point")
+		}
+		if !B.Mul(s, B).Equal(g.Point().Mul(s, g.Point().Mul(s, nil))) {
+			panic("blinded Mul in place gives a different point")
+		}
+		B.(kyber.BlindablePoint).SetBlinding(nil)
+		if !B.Mul(s, P).Equal(g.Point().Mul(s, P)) {
+			panic("Mul after disabling blinding gives a different point")
+		}
+	}
+}
+
+// testPointPrecompute checks that the products of the table of Precompute,
+// and those of the base point by Mul(s, nil), which use such a table, agree
+// with Mul, if the points of g implement kyber.PrecomputablePoint.
+func testPointPrecompute(g kyber.Group, rand cipher.Stream) {
+	if _, ok := g.Point().(kyber.PrecomputablePoint); !ok {
+		return
+	}
+	P := g.Point().Pick(rand)
+	Q := P.Clone()
+	f := P.(kyber.PrecomputablePoint).Precompute()
+	P.Null() // must not change the table
+	B := g.Point().Base()
+	for i := 0; i < 4; i++ {
+		s := g.Scalar().Pick(rand)
+		switch i {
+		case 0:
+			s.Zero()
+		case 1:
+			s.SetInt64(-1) // the largest scalar
+		}
+		if !f.Mul(s).Equal(g.Point().Mul(s, Q)) {
+			panic("product of a precomputed point differs from Mul")
+		}
+		if !g.Point().Mul(s, nil).Equal(g.Point().Mul(s, B)) {
+			panic("product of the base point differs from Mul")
+		}
+	}
+}
+
+// testUnmarshalPoints checks that kyber.UnmarshalPoints decodes points as
+// UnmarshalBinary does, and fails on an invalid encoding.
+func testUnmarshalPoints(g kyber.Group, rand cipher.Stream) {
+	data := make([][]byte, 4)
+	for i := range data {
+		P := g.Point().Pick(rand)
+		if i == 0 {
+			P.Null()
+		}
+		data[i], _ = P.MarshalBinary()
+	}
+	points, err := kyber.UnmarshalPoints(g, data)
+	if err != nil {
+		panic("UnmarshalPoints: " + err.Error())
+	}
+	for i, P := range points {
+		Q := g.Point()
+		if err := Q.UnmarshalBinary(data[i]); err != nil || !P.Equal(Q) {
+			panic("UnmarshalPoints decodes differently from UnmarshalBinary")
+		}
+	}
+	data[2] = nil
+	if _, err := kyber.UnmarshalPoints(g, data); err == nil {
+		panic("UnmarshalPoints accepts an empty encoding")
+	}
+}
+
 // Apply a generic set of validation tests to a cryptographic Group,
 // using a given source of [pseudo-]randomness.
 //
@@ -336,6 +419,9 @@ func testGroup(g kyber.Group, rand cipher.Stream) []kyber.Point {
 	testPointClone(g, rand)
 	testScalarSet(g, rand)
 	testScalarClone(g, rand)
+	testPointBlinding(g, rand)
+	testPointPrecompute(g, rand)
+	testUnmarshalPoints(g, rand)
 
 	return points
 }
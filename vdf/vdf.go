@@ -0,0 +1,339 @@
+// +build vartime
+
+// Package vdf implements the verifiable delay function of Wesolowski ("Efficient
+// verifiable delay functions", Eurocrypt 2019) in an RSA group.
+//
+// Evaluating the function on an input takes t sequential squarings in a
+// group of unknown order, which cannot be sped up by parallelism, while its
+// output comes with a proof that anyone checks with two short
+// exponentiations. Protocols use it to impose a delay, for example between
+// the commitment to a random value and its revelation, so that no one can
+// bias the randomness of a beacon by withholding its contribution.
+//
+// The group is Z_N^* modulo {1, -1}, for an RSA modulus N whose
+// factorization no one must know: either a well-known modulus such as the
+// one of the RSA-2048 challenge, or one generated by GenerateGroup in a
+// trusted setup that discards the factors. Elements are encoded as
+// big-endian integers of the size of N.
+//
+// The package uses math/big and is not constant time, so it must be compiled
+// with the "vartime" compilation flag.
+package vdf
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/big"
+)
+
+// primeBits is the size of the challenge primes of the proofs.
+const primeBits = 128
+
+// maxCheckpoints is the largest number of intermediate values kept by Eval
+// to compute a proof, 4 MiB for a modulus of 2048 bits.
+const maxCheckpoints = 1 << 14
+
+// maxDigitBits bounds the size k of the digits of the proof computation,
+// which needs 2^k buckets. It is below primeBits.
+const maxDigitBits = 16
+
+var (
+	one = big.NewInt(1)
+	two = big.NewInt(2)
+)
+
+var errInvalidProof = errors.New("vdf: invalid proof")
+
+// Group is an RSA group of unknown order, Z_N^* modulo {1, -1}.
+type Group struct {
+	N *big.Int
+}
+
+// NewGroup returns the group of the modulus N, or an error if N is too
+// short or even. The factorization of N must be unknown to everyone.
+func NewGroup(N *big.Int) (*Group, error) {
+	if N.BitLen() < 1024 || N.Bit(0) == 0 {
+		return nil, errors.New("vdf: invalid modulus")
+	}
+	return &Group{N: new(big.Int).Set(N)}, nil
+}
+
+// GenerateGroup returns a group whose modulus has the given number of bits,
+// at least 1024. Whoever runs it learns the factors of the modulus, with
+// which the function is evaluated fast, and must discard them.
+func GenerateGroup(bits int, random cipher.Stream) (*Group, error) {
+	if bits < 1024 {
+		return nil, errors.New("vdf: modulus too short")
+	}
+	r := &streamReader{random}
+	for {
+		p, err := rand.Prime(r, bits/2)
+		if err != nil {
+			return nil, err
+		}
+		q, err := rand.Prime(r, bits-bits/2)
+		if err != nil {
+			return nil, err
+		}
+		N := new(big.Int).Mul(p, q)
+		if p.Cmp(q) != 0 && N.BitLen() == bits {
+			return &Group{N: N}, nil
+		}
+	}
+}
+
+// Eval evaluates the function on input, with a delay of t squarings. It
+// returns the output y = x^(2^t), where x is the hash of input to the group,
+// and the proof pi = x^floor(2^t / l) for the challenge prime l.
+//
+// The proof is computed from intermediate values of the squarings, with
+// the algorithm of section 4.1 of the paper of Wesolowski, in a fraction of
+// the time of the squarings. At most maxCheckpoints of them are kept, so
+// that the memory used does not grow with t.
+func (g *Group) Eval(input []byte, t uint64) (output, proof []byte) {
+	return g.eval(input, t, maxCheckpoints)
+}
+
+func (g *Group) eval(input []byte, t uint64, checkpoints uint64) (output, proof []byte) {
+	x := g.hashToGroup(input)
+	k, gamma := proofParams(t, checkpoints)
+	y, cs := g.square(x, t, uint64(k)*gamma)
+	y = g.canonical(y)
+
+	l := g.hashToPrime(x, y, t)
+	pi := g.canonical(g.prove(cs, t, l, k, gamma))
+	return g.bytes(y), g.bytes(pi)
+}
+
+// square returns x^(2^t), and the checkpoints x^(2^(i*every)) for every i
+// with i*every < t.
+func (g *Group) square(x *big.Int, t, every uint64) (*big.Int, []*big.Int) {
+	var cs []*big.Int
+	y := new(big.Int).Set(x)
+	for i := uint64(0); i < t; i++ {
+		if i%every == 0 {
+			cs = append(cs, new(big.Int).Set(y))
+		}
+		y.Mul(y, y).Mod(y, g.N)
+	}
+	return y, cs
+}
+
+// proofParams returns the parameters k and gamma of the computation of a
+// proof for a delay of t, which keeps at most the given number of
+// checkpoints. The quotient floor(2^t / l) is split in digits of k bits,
+// and a checkpoint is kept every gamma digits. The proof then takes about
+// t/k multiplications, and gamma*2^(k+1) more to combine the digits, which
+// k and gamma minimize.
+func proofParams(t, checkpoints uint64) (uint, uint64) {
+	bestK, bestGamma, best := uint(1), uint64(1), math.Inf(1)
+	for k := uint(1); k <= maxDigitBits; k++ {
+		per := uint64(k) * checkpoints
+		gamma := t / per
+		if t%per != 0 || gamma == 0 {
+			gamma++
+		}
+		cost := float64(t)/float64(k) + float64(gamma)*float64(uint64(2)<<k+uint64(2*k))
+		if cost < best {
+			bestK, bestGamma, best = k, gamma, cost
+		}
+	}
+	return bestK, bestGamma
+}
+
+// prove returns x^floor(2^t / l) from the checkpoints x^(2^(i*k*gamma)).
+//
+// Written in base 2^k, floor(2^t / l) has the digits
+// d_m = floor(2^k * (2^(t - k*(m+1)) mod l) / l), for k*(m+1) <= t, the
+// others being zero since l > 2^k. The proof is the product of the
+// x^(d_m * 2^(k*m)). For each offset j of m = i*gamma + j, the checkpoints
+// of index i are gathered in buckets by digit and the buckets combined
+// into the product of the checkpoints raised to their digit, which is then
+// raised to 2^(k*j) by Horner's rule over j.
+func (g *Group) prove(cs []*big.Int, t uint64, l *big.Int, k uint, gamma uint64) *big.Int {
+	k1 := k / 2
+	k0 := k - k1
+	// step = 2^(k*gamma) mod l moves a digit from index m to m - gamma.
+	step := new(big.Int).Exp(two, new(big.Int).SetUint64(uint64(k)*gamma), l)
+	pow2k := new(big.Int).Lsh(one, k)
+	buckets := make([]*big.Int, 1<<k)
+	pi := big.NewInt(1)
+	for j := gamma; j > 0; j-- {
+		for i := uint(0); i < k; i++ {
+			pi.Mul(pi, pi).Mod(pi, g.N)
+		}
+		for b := range buckets {
+			buckets[b] = nil
+		}
+
+		// The digits of the indices i*gamma + j - 1, from the largest i
+		// with a nonzero digit down.
+		var r *big.Int
+		d := new(big.Int)
+		for i := len(cs) - 1; i >= 0; i-- {
+			m := uint64(i)*gamma + j - 1
+			if uint64(k)*(m+1) > t {
+				continue
+			}
+			if r == nil {
+				r = new(big.Int).Exp(two, new(big.Int).SetUint64(t-uint64(k)*(m+1)), l)
+			} else {
+				r.Mul(r, step).Mod(r, l)
+			}
+			d.Mul(r, pow2k).Div(d, l)
+			if b := d.Uint64(); b != 0 {
+				buckets[b] = g.mul(buckets[b], cs[i])
+			}
+		}
+
+		// The product of the buckets raised to their digit b, split as
+		// b1*2^k0 + b0.
+		e := new(big.Int)
+		for b1 := uint64(1); b1 < 1<<k1; b1++ {
+			var z *big.Int
+			for b0 := uint64(0); b0 < 1<<k0; b0++ {
+				z = g.mul(z, buckets[b1<<k0|b0])
+			}
+			if z != nil {
+				pi = g.mul(pi, z.Exp(z, e.SetUint64(b1<<k0), g.N))
+			}
+		}
+		for b0 := uint64(1); b0 < 1<<k0; b0++ {
+			var z *big.Int
+			for b1 := uint64(0); b1 < 1<<k1; b1++ {
+				z = g.mul(z, buckets[b1<<k0|b0])
+			}
+			if z != nil {
+				pi = g.mul(pi, z.Exp(z, e.SetUint64(b0), g.N))
+			}
+		}
+	}
+	return pi
+}
+
+// mul returns z*x mod N, in place in z unless z is nil, which stands for
+// 1. A nil x also stands for 1.
+func (g *Group) mul(z, x *big.Int) *big.Int {
+	switch {
+	case x == nil:
+		return z
+	case z == nil:
+		return new(big.Int).Set(x)
+	}
+	return z.Mul(z, x).Mod(z, g.N)
+}
+
+// Verify checks that output is the output of the function on input with a
+// delay of t squarings, using its proof: pi^l * x^r = y, where r = 2^t mod
+// l.
+func (g *Group) Verify(input []byte, t uint64, output, proof []byte) error {
+	y, err := g.element(output)
+	if err != nil {
+		return err
+	}
+	pi, err := g.element(proof)
+	if err != nil {
+		return err
+	}
+	x := g.hashToGroup(input)
+	l := g.hashToPrime(x, y, t)
+	r := new(big.Int).Exp(two, new(big.Int).SetUint64(t), l)
+	lhs := new(big.Int).Exp(pi, l, g.N)
+	lhs.Mul(lhs, new(big.Int).Exp(x, r, g.N)).Mod(lhs, g.N)
+	if g.canonical(lhs).Cmp(y) != 0 {
+		return errInvalidProof
+	}
+	return nil
+}
+
+// canonical returns the representative of the class {x, -x} of x, the
+// smaller of the two, in place.
+func (g *Group) canonical(x *big.Int) *big.Int {
+	neg := new(big.Int).Sub(g.N, x)
+	if neg.Cmp(x) < 0 {
+		x.Set(neg)
+	}
+	return x
+}
+
+// size returns the size in bytes of the encoding of elements.
+func (g *Group) size() int {
+	return (g.N.BitLen() + 7) / 8
+}
+
+func (g *Group) bytes(x *big.Int) []byte {
+	buf := make([]byte, g.size())
+	b := x.Bytes()
+	copy(buf[len(buf)-len(b):], b)
+	return buf
+}
+
+// element decodes an element, which must be in its canonical form.
+func (g *Group) element(buf []byte) (*big.Int, error) {
+	if len(buf) != g.size() {
+		return nil, errors.New("vdf: invalid element length")
+	}
+	x := new(big.Int).SetBytes(buf)
+	half := new(big.Int).Rsh(g.N, 1)
+	if x.Sign() == 0 || x.Cmp(half) > 0 || new(big.Int).GCD(nil, nil, x, g.N).Cmp(one) != 0 {
+		return nil, errors.New("vdf: invalid element")
+	}
+	return x, nil
+}
+
+// hashToGroup hashes the input to an element of the group, in its
+// canonical form, with SHA-512 in counter mode.
+func (g *Group) hashToGroup(input []byte) *big.Int {
+	var buf []byte
+	for i := uint32(0); len(buf) < g.size()+16; i++ {
+		h := sha512.New()
+		h.Write([]byte("vdf input"))
+		var ctr [4]byte
+		binary.BigEndian.PutUint32(ctr[:], i)
+		h.Write(ctr[:])
+		h.Write(input)
+		buf = h.Sum(buf)
+	}
+	x := new(big.Int).SetBytes(buf)
+	return g.canonical(x.Mod(x, g.N))
+}
+
+// hashToPrime derives the challenge prime l of the proof from the input,
+// the output and the delay, as the first prime of primeBits bits among the
+// successive hashes of them.
+func (g *Group) hashToPrime(x, y *big.Int, t uint64) *big.Int {
+	l := new(big.Int)
+	for i := uint32(0); ; i++ {
+		h := sha256.New()
+		h.Write([]byte("vdf prime"))
+		var b [12]byte
+		binary.BigEndian.PutUint32(b[:4], i)
+		binary.BigEndian.PutUint64(b[4:], t)
+		h.Write(b[:])
+		h.Write(g.bytes(x))
+		h.Write(g.bytes(y))
+		l.SetBytes(h.Sum(nil)[:primeBits/8])
+		l.SetBit(l, primeBits-1, 1)
+		if l.ProbablyPrime(20) {
+			return l
+		}
+	}
+}
+
+// streamReader reads random bytes from a cipher.Stream.
+type streamReader struct {
+	cipher.Stream
+}
+
+func (r *streamReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 0
+	}
+	r.XORKeyStream(b, b)
+	return len(b), nil
+}
@@ -0,0 +1,101 @@
+// +build vartime
+
+package vdf
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVDF(t *testing.T) {
+	p, err := rand.Prime(rand.Reader, 512)
+	require.NoError(t, err)
+	q, err := rand.Prime(rand.Reader, 512)
+	require.NoError(t, err)
+	g, err := NewGroup(new(big.Int).Mul(p, q))
+	require.NoError(t, err)
+
+	input, delay := []byte("Hello VDF"), uint64(2000)
+	y, pi := g.Eval(input, delay)
+	require.NoError(t, g.Verify(input, delay, y, pi))
+
+	// With the factors of N, the output is x^(2^t mod phi(N)).
+	phi := new(big.Int).Mul(new(big.Int).Sub(p, one), new(big.Int).Sub(q, one))
+	e := new(big.Int).Exp(two, new(big.Int).SetUint64(delay), phi)
+	x := g.hashToGroup(input)
+	require.Equal(t, g.bytes(g.canonical(new(big.Int).Exp(x, e, g.N))), y)
+
+	require.Error(t, g.Verify([]byte("other"), delay, y, pi))
+	require.Error(t, g.Verify(input, delay+1, y, pi))
+	require.Error(t, g.Verify(input, delay, pi, y))
+	require.Error(t, g.Verify(input, delay, y, y))
+	require.Error(t, g.Verify(input, delay, y[1:], pi))
+
+	// Only canonical encodings are accepted.
+	neg := new(big.Int).Sub(g.N, new(big.Int).SetBytes(y))
+	require.Error(t, g.Verify(input, delay, g.bytes(neg), pi))
+
+	// A delay of zero outputs the input itself.
+	y, pi = g.Eval(input, 0)
+	require.Equal(t, g.bytes(x), y)
+	require.NoError(t, g.Verify(input, 0, y, pi))
+}
+
+func TestGroup(t *testing.T) {
+	g, err := GenerateGroup(1024, random.New())
+	require.NoError(t, err)
+	require.Equal(t, 1024, g.N.BitLen())
+	_, err = GenerateGroup(512, random.New())
+	require.Error(t, err)
+
+	_, err = NewGroup(big.NewInt(15))
+	require.Error(t, err)
+	_, err = NewGroup(new(big.Int).Lsh(g.N, 1))
+	require.Error(t, err)
+	h, err := NewGroup(g.N)
+	require.NoError(t, err)
+
+	y, pi := g.Eval([]byte("input"), 100)
+	require.NoError(t, h.Verify([]byte("input"), 100, y, pi))
+}
+
+func TestProof(t *testing.T) {
+	g, err := GenerateGroup(1024, random.New())
+	require.NoError(t, err)
+	input := []byte("Hello VDF")
+	x := g.hashToGroup(input)
+	for _, delay := range []uint64{1, 2, 127, 128, 129, 1000, 3001} {
+		for _, checkpoints := range []uint64{1, 3, 7, maxCheckpoints} {
+			y, pi := g.eval(input, delay, checkpoints)
+			require.NoError(t, g.Verify(input, delay, y, pi))
+
+			// pi = x^floor(2^t / l), computed directly.
+			l := g.hashToPrime(x, new(big.Int).SetBytes(y), delay)
+			q := new(big.Int).Lsh(one, uint(delay))
+			q.Div(q, l)
+			require.Equal(t, g.bytes(g.canonical(new(big.Int).Exp(x, q, g.N))), pi)
+		}
+	}
+}
+
+func TestProofParams(t *testing.T) {
+	for _, delay := range []uint64{0, 1, 1000, 1 << 30, 1e12, 1<<64 - 1} {
+		k, gamma := proofParams(delay, maxCheckpoints)
+		require.True(t, k >= 1 && k <= maxDigitBits)
+		require.True(t, gamma >= 1)
+
+		// The checkpoints, one every k*gamma squarings, fit in the bound.
+		every := uint64(k) * gamma
+		require.True(t, delay/every <= maxCheckpoints)
+		require.True(t, delay/every < maxCheckpoints || delay%every == 0)
+	}
+
+	// Long delays spend about a tenth of their time on the proof.
+	k, gamma := proofParams(1e9, maxCheckpoints)
+	cost := 1e9/float64(k) + float64(gamma)*float64(uint64(2)<<k)
+	require.True(t, cost < 1.5e8)
+}
@@ -0,0 +1,232 @@
+// Package vrf implements the ECVRF-EDWARDS25519-SHA512-TAI verifiable random
+// function of RFC 9381.
+//
+// A VRF is the public-key analogue of a keyed hash: only the holder of a
+// private key can compute the output beta of an input alpha, but the proof
+// pi that comes with it lets anyone holding the public key check that beta
+// is the unique correct output. This makes VRFs suitable for leader
+// election and sortition, where a party must derive an unpredictable value
+// that it cannot bias and that others can verify.
+//
+// Keys are Ed25519 key pairs from package sign/eddsa.
+package vrf
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+
+	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/group/edwards25519"
+	"github.com/dedis/kyber/sign/eddsa"
+)
+
+const (
+	// ProofSize is the size in bytes of a proof pi.
+	ProofSize = ptLen + cLen + qLen
+	// OutputSize is the size in bytes of an output beta.
+	OutputSize = sha512.Size
+
+	ptLen = 32
+	cLen  = 16
+	qLen  = 32
+
+	suiteString = 0x03
+	cofactor    = 8
+)
+
+var group = new(edwards25519.Curve)
+
+var (
+	errInvalidProof = errors.New("vrf: invalid proof")
+	errInvalidKey   = errors.New("vrf: invalid public key")
+)
+
+// Prove returns the proof pi that beta, as returned by ProofToHash(pi), is
+// the VRF output of alpha under the key pair e.
+func Prove(e *eddsa.EdDSA, alpha []byte) ([]byte, error) {
+	buff, err := e.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	Y := buff[32:]
+	H := encodeToCurve(Y, alpha)
+	Hbuff, err := H.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	Gamma := group.Point().Mul(e.Secret, H)
+
+	// Nonce generation of RFC 8032, from the second half of the hashed
+	// seed.
+	prefix := sha512.Sum512(buff[:32])
+	hash := sha512.New()
+	hash.Write(prefix[32:])
+	hash.Write(Hbuff)
+	var nonce [64]byte
+	hash.Sum(nonce[:0])
+	k := kyber.SetBytesWide(group.Scalar(), nonce)
+
+	U := group.Point().Mul(k, nil)
+	V := group.Point().Mul(k, H)
+	c := challenge(Y, Hbuff, Gamma, U, V)
+
+	// s = k + c * x
+	s := group.Scalar().Mul(group.Scalar().SetBytes(c), e.Secret)
+	s.Add(k, s)
+
+	Gbuff, err := Gamma.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	sBuff, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	pi := make([]byte, 0, ProofSize)
+	pi = append(pi, Gbuff...)
+	pi = append(pi, c...)
+	return append(pi, sBuff...), nil
+}
+
+// Verify checks that pi is a valid proof for alpha under the public key,
+// and returns the corresponding VRF output beta. It returns an error if the
+// proof or the public key is invalid.
+func Verify(public kyber.Point, alpha, pi []byte) ([]byte, error) {
+	Y, err := public.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decodePoint(Y); err != nil {
+		return nil, errInvalidKey
+	}
+	if isSmallOrder(public) {
+		return nil, errInvalidKey
+	}
+	Gamma, c, s, err := decodeProof(pi)
+	if err != nil {
+		return nil, err
+	}
+
+	H := encodeToCurve(Y, alpha)
+	Hbuff, err := H.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	cs := group.Scalar().SetBytes(c)
+
+	// U = s*B - c*Y, V = s*H - c*Gamma
+	U := group.Point().Mul(s, nil)
+	U.Sub(U, kyber.VarTimePoint(group).Mul(cs, public))
+	V := kyber.VarTimePoint(group).Mul(s, H)
+	V.Sub(V, kyber.VarTimePoint(group).Mul(cs, Gamma))
+
+	if !bytes.Equal(c, challenge(Y, Hbuff, Gamma, U, V)) {
+		return nil, errInvalidProof
+	}
+	return proofToHash(Gamma), nil
+}
+
+// ProofToHash returns the VRF output beta of the proof pi. It does not
+// check the proof, which must be done with Verify before beta is trusted.
+func ProofToHash(pi []byte) ([]byte, error) {
+	Gamma, _, _, err := decodeProof(pi)
+	if err != nil {
+		return nil, err
+	}
+	return proofToHash(Gamma), nil
+}
+
+func proofToHash(Gamma kyber.Point) []byte {
+	hash := sha512.New()
+	hash.Write([]byte{suiteString, 0x03})
+	hash.Write(pointBytes(clearCofactor(Gamma)))
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)
+}
+
+// encodeToCurve hashes alpha to a point, salted with the public key, using
+// the try-and-increment method.
+func encodeToCurve(Y, alpha []byte) kyber.Point {
+	for ctr := 0; ctr < 256; ctr++ {
+		hash := sha512.New()
+		hash.Write([]byte{suiteString, 0x01})
+		hash.Write(Y)
+		hash.Write(alpha)
+		hash.Write([]byte{byte(ctr), 0x00})
+		H, err := decodePoint(hash.Sum(nil)[:ptLen])
+		if err == nil {
+			return clearCofactor(H)
+		}
+	}
+	// Each attempt succeeds with probability about 1/2.
+	panic("vrf: encoding to the curve failed")
+}
+
+// challenge returns the truncated hash of the points of the proof.
+func challenge(Y, H []byte, Gamma, U, V kyber.Point) []byte {
+	hash := sha512.New()
+	hash.Write([]byte{suiteString, 0x02})
+	hash.Write(Y)
+	hash.Write(H)
+	hash.Write(pointBytes(Gamma))
+	hash.Write(pointBytes(U))
+	hash.Write(pointBytes(V))
+	hash.Write([]byte{0x00})
+	return hash.Sum(nil)[:cLen]
+}
+
+func decodeProof(pi []byte) (Gamma kyber.Point, c []byte, s kyber.Scalar, err error) {
+	if len(pi) != ProofSize {
+		return nil, nil, nil, errInvalidProof
+	}
+	Gamma, err = decodePoint(pi[:ptLen])
+	if err != nil {
+		return nil, nil, nil, errInvalidProof
+	}
+	c = pi[ptLen : ptLen+cLen]
+	sBuff := pi[ptLen+cLen:]
+	s = group.Scalar().SetBytes(sBuff)
+	// s must be fully reduced modulo the group order.
+	if !bytes.Equal(sBuff, scalarBytes(s)) {
+		return nil, nil, nil, errInvalidProof
+	}
+	return Gamma, c, s, nil
+}
+
+// decodePoint decodes a point as specified by RFC 8032, which unlike
+// UnmarshalBinary rejects non-canonical encodings.
+func decodePoint(b []byte) (kyber.Point, error) {
+	P := group.Point()
+	if err := P.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(b, pointBytes(P)) {
+		return nil, errors.New("vrf: non-canonical point encoding")
+	}
+	return P, nil
+}
+
+func clearCofactor(P kyber.Point) kyber.Point {
+	return group.Point().Mul(group.Scalar().SetInt64(cofactor), P)
+}
+
+func isSmallOrder(P kyber.Point) bool {
+	return clearCofactor(P).Equal(group.Point().Null())
+}
+
+func pointBytes(P kyber.Point) []byte {
+	b, err := P.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func scalarBytes(s kyber.Scalar) []byte {
+	b, err := s.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
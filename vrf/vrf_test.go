@@ -0,0 +1,114 @@
+package vrf
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dedis/kyber/sign/eddsa"
+	"github.com/dedis/kyber/util/random"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from RFC 9381, section B.3.
+var vectors = []struct {
+	private string
+	public  string
+	alpha   string
+	pi      string
+	beta    string
+}{
+	{"9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+		"",
+		"8657106690b5526245a92b003bb079ccd1a92130477671f6fc01ad16f26f723f26f8a57ccaed74ee1b190bed1f479d9727d2d0f9b005a6e456a35d4fb0daab1268a1b0db10836d9826a528ca76567805",
+		"90cf1df3b703cce59e2a35b925d411164068269d7b2d29f3301c03dd757876ff66b71dda49d2de59d03450451af026798e8f81cd2e333de5cdf4f3e140fdd8ae"},
+	{"4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+		"3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+		"72",
+		"f3141cd382dc42909d19ec5110469e4feae18300e94f304590abdced48aed5933bf0864a62558b3ed7f2fea45c92a465301b3bbf5e3e54ddf2d935be3b67926da3ef39226bbc355bdc9850112c8f4b02",
+		"eb4440665d3891d668e7e0fcaf587f1b4bd7fbfe99d0eb2211ccec90496310eb5e33821bc613efb94db5e5b54c70a848a0bef4553a41befc57663b56373a5031"},
+	{"c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+		"fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+		"af82",
+		"9bc0f79119cc5604bf02d23b4caede71393cedfbb191434dd016d30177ccbf8096bb474e53895c362d8628ee9f9ea3c0e52c7a5c691b6c18c9979866568add7a2d41b00b05081ed0f58ee5e31b3a970e",
+		"645427e5d00c62a23fb703732fa5d892940935942101e456ecca7bb217c61c452118fec1219202a0edcf038bb6373241578be7217ba85a2687f7a0310b2df19f"},
+}
+
+func fromHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestVectors(t *testing.T) {
+	for i, v := range vectors {
+		e := new(eddsa.EdDSA)
+		require.NoError(t, e.UnmarshalBinary(fromHex(t, v.private+v.public)))
+		alpha := fromHex(t, v.alpha)
+
+		pi, err := Prove(e, alpha)
+		require.NoError(t, err)
+		assert.Equal(t, v.pi, hex.EncodeToString(pi), "vector %d", i)
+
+		beta, err := ProofToHash(pi)
+		require.NoError(t, err)
+		assert.Equal(t, v.beta, hex.EncodeToString(beta), "vector %d", i)
+
+		beta, err = Verify(e.Public, alpha, pi)
+		require.NoError(t, err)
+		assert.Equal(t, v.beta, hex.EncodeToString(beta), "vector %d", i)
+	}
+}
+
+func TestProveVerify(t *testing.T) {
+	e := eddsa.NewEdDSA(random.New())
+	alpha := []byte("round 42")
+	pi, err := Prove(e, alpha)
+	require.NoError(t, err)
+	require.Len(t, pi, ProofSize)
+
+	beta, err := Verify(e.Public, alpha, pi)
+	require.NoError(t, err)
+	require.Len(t, beta, OutputSize)
+
+	// The output is unique: proving again gives the same proof.
+	pi2, err := Prove(e, alpha)
+	require.NoError(t, err)
+	assert.Equal(t, pi, pi2)
+
+	// Wrong input or key.
+	_, err = Verify(e.Public, []byte("round 43"), pi)
+	assert.Error(t, err)
+	other := eddsa.NewEdDSA(random.New())
+	_, err = Verify(other.Public, alpha, pi)
+	assert.Error(t, err)
+
+	// Tampered proofs.
+	for _, i := range []int{0, ptLen, ptLen + cLen, ProofSize - 1} {
+		bad := append([]byte(nil), pi...)
+		bad[i] ^= 1
+		_, err = Verify(e.Public, alpha, bad)
+		assert.Error(t, err, "byte %d", i)
+	}
+	_, err = Verify(e.Public, alpha, pi[:ProofSize-1])
+	assert.Error(t, err)
+	_, err = ProofToHash(pi[1:])
+	assert.Error(t, err)
+
+	// An unreduced s is rejected.
+	bad := append([]byte(nil), pi...)
+	bad[ProofSize-1] |= 0xf0
+	_, err = Verify(e.Public, alpha, bad)
+	assert.Error(t, err)
+}
+
+func TestSmallOrderKey(t *testing.T) {
+	e := eddsa.NewEdDSA(random.New())
+	pi, err := Prove(e, nil)
+	require.NoError(t, err)
+
+	// The identity is a point of small order.
+	_, err = Verify(group.Point().Null(), nil, pi)
+	assert.Equal(t, errInvalidKey, err)
+}
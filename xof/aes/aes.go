@@ -0,0 +1,153 @@
+// Package aes provides an implementation of kyber.XOF composed of
+// FIPS-approved primitives: SHA-256, HMAC-SHA-256 and AES-256 in counter
+// mode.
+//
+// Input written to the XOF is absorbed with SHA-256. On the first Read,
+// an AES-256 key is derived from the digest with HMAC-SHA-256, and the
+// output is the AES-CTR key stream starting from an all-zero counter
+// block.
+package aes
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding"
+	"hash"
+
+	"github.com/dedis/kyber"
+)
+
+// keyLabel is the HMAC message from which the AES key is derived.
+var keyLabel = []byte("kyber AES-CTR XOF key")
+
+type xof struct {
+	h hash.Hash
+	// block is nil while the XOF is absorbing input.
+	block   cipher.Block
+	counter [aes.BlockSize]byte
+	buf     [aes.BlockSize]byte
+	offset  int
+	// key is here to not make excess garbage during repeated calls
+	// to XORKeyStream.
+	key []byte
+}
+
+// New creates a new XOF using AES-CTR and HMAC-SHA-256.
+func New(seed []byte) kyber.XOF {
+	x := &xof{h: sha256.New()}
+	x.h.Write(seed)
+	return x
+}
+
+func (x *xof) Clone() kyber.XOF {
+	y := *x
+	y.key = nil
+	if x.block != nil {
+		// The block cipher is stateless, and can be shared.
+		return &y
+	}
+	state, err := x.h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		panic("sha256 state cannot be marshaled: " + err.Error())
+	}
+	y.h = sha256.New()
+	if err := y.h.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+		panic("sha256 state cannot be unmarshaled: " + err.Error())
+	}
+	return &y
+}
+
+// Destroy overwrites the counter and buffers of the XOF. The AES key
+// schedule, which clones share, can only be released.
+func (x *xof) Destroy() {
+	if x.h != nil {
+		x.h.Reset()
+		x.h = nil
+	}
+	x.block = nil
+	x.counter = [aes.BlockSize]byte{}
+	x.buf = [aes.BlockSize]byte{}
+	for i := range x.key {
+		x.key[i] = 0
+	}
+}
+
+func (x *xof) Write(src []byte) (int, error) {
+	if x.block != nil {
+		panic("aes xof: write after read")
+	}
+	return x.h.Write(src)
+}
+
+func (x *xof) Read(dst []byte) (int, error) {
+	if x.block == nil {
+		mac := hmac.New(sha256.New, x.h.Sum(nil))
+		mac.Write(keyLabel)
+		b, err := aes.NewCipher(mac.Sum(nil))
+		if err != nil {
+			panic("aes.NewCipher should not return error: " + err.Error())
+		}
+		x.block = b
+		x.h = nil
+		x.offset = aes.BlockSize
+	}
+	n := len(dst)
+	for len(dst) > 0 {
+		if x.offset == aes.BlockSize {
+			x.block.Encrypt(x.buf[:], x.counter[:])
+			incr(&x.counter)
+			x.offset = 0
+		}
+		c := copy(dst, x.buf[x.offset:])
+		x.offset += c
+		dst = dst[c:]
+	}
+	return n, nil
+}
+
+// incr increments the big-endian counter block.
+func incr(ctr *[aes.BlockSize]byte) {
+	for i := len(ctr) - 1; i >= 0; i-- {
+		ctr[i]++
+		if ctr[i] != 0 {
+			return
+		}
+	}
+}
+
+func (x *xof) Reseed() {
+	if len(x.key) < 128 {
+		x.key = make([]byte, 128)
+	} else {
+		x.key = x.key[0:128]
+	}
+	x.Read(x.key)
+	y := New(x.key).(*xof)
+	y.key = x.key
+	*x = *y
+}
+
+func (x *xof) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("dst too short")
+	}
+	if len(x.key) < len(src) {
+		x.key = make([]byte, len(src))
+	} else {
+		x.key = x.key[0:len(src)]
+	}
+
+	n, err := x.Read(x.key)
+	if err != nil {
+		panic("aes xof error: " + err.Error())
+	}
+	if n != len(src) {
+		panic("short read on key")
+	}
+
+	for i := range src {
+		dst[i] = src[i] ^ x.key[i]
+	}
+}
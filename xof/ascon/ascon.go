@@ -0,0 +1,217 @@
+// Package ascon provides an implementation of kyber.XOF based on the
+// Ascon-Xof extendable output function, and the Ascon-Hash hash function,
+// as specified in version 1.2 of the Ascon submission to the NIST
+// lightweight cryptography standardization process.
+//
+// Ascon only needs a 320-bit state, which makes it suitable for
+// constrained devices without AES hardware.
+package ascon
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+
+	"github.com/dedis/kyber"
+)
+
+const (
+	// Size is the size in bytes of an Ascon-Hash digest.
+	Size = 32
+	// BlockSize is the rate of the Ascon sponge in bytes.
+	BlockSize = 8
+
+	ivXOF  = 0x00400c0000000000
+	ivHash = 0x00400c0000000100
+)
+
+type state [5]uint64
+
+// permute applies the 12-round Ascon permutation p^12.
+func (s *state) permute() {
+	x0, x1, x2, x3, x4 := s[0], s[1], s[2], s[3], s[4]
+	for i := uint64(0); i < 12; i++ {
+		// Round constant
+		x2 ^= (0xf-i)<<4 | i
+		// Substitution layer
+		x0 ^= x4
+		x4 ^= x3
+		x2 ^= x1
+		t0 := ^x0 & x1
+		t1 := ^x1 & x2
+		t2 := ^x2 & x3
+		t3 := ^x3 & x4
+		t4 := ^x4 & x0
+		x0 ^= t1
+		x1 ^= t2
+		x2 ^= t3
+		x3 ^= t4
+		x4 ^= t0
+		x1 ^= x0
+		x0 ^= x4
+		x3 ^= x2
+		x2 = ^x2
+		// Linear diffusion layer
+		x0 ^= bits.RotateLeft64(x0, -19) ^ bits.RotateLeft64(x0, -28)
+		x1 ^= bits.RotateLeft64(x1, -61) ^ bits.RotateLeft64(x1, -39)
+		x2 ^= bits.RotateLeft64(x2, -1) ^ bits.RotateLeft64(x2, -6)
+		x3 ^= bits.RotateLeft64(x3, -10) ^ bits.RotateLeft64(x3, -17)
+		x4 ^= bits.RotateLeft64(x4, -7) ^ bits.RotateLeft64(x4, -41)
+	}
+	s[0], s[1], s[2], s[3], s[4] = x0, x1, x2, x3, x4
+}
+
+// sponge is the common state of Ascon-Xof and Ascon-Hash.
+type sponge struct {
+	s   state
+	buf [BlockSize]byte
+	// n is the number of buffered input bytes while absorbing, and the
+	// number of output bytes already read from buf while squeezing.
+	n         int
+	squeezing bool
+}
+
+func (sp *sponge) init(iv uint64) {
+	*sp = sponge{s: state{iv}}
+	sp.s.permute()
+}
+
+func (sp *sponge) write(p []byte) {
+	if sp.squeezing {
+		panic("ascon: write after read")
+	}
+	for len(p) > 0 {
+		c := copy(sp.buf[sp.n:], p)
+		sp.n += c
+		p = p[c:]
+		if sp.n == BlockSize {
+			sp.s[0] ^= binary.BigEndian.Uint64(sp.buf[:])
+			sp.s.permute()
+			sp.n = 0
+		}
+	}
+}
+
+func (sp *sponge) read(p []byte) {
+	if !sp.squeezing {
+		// Pad the last block with a single 1 bit.
+		for i := sp.n; i < BlockSize; i++ {
+			sp.buf[i] = 0
+		}
+		sp.buf[sp.n] = 0x80
+		sp.s[0] ^= binary.BigEndian.Uint64(sp.buf[:])
+		sp.s.permute()
+		binary.BigEndian.PutUint64(sp.buf[:], sp.s[0])
+		sp.n = 0
+		sp.squeezing = true
+	}
+	for len(p) > 0 {
+		if sp.n == BlockSize {
+			sp.s.permute()
+			binary.BigEndian.PutUint64(sp.buf[:], sp.s[0])
+			sp.n = 0
+		}
+		c := copy(p, sp.buf[sp.n:])
+		sp.n += c
+		p = p[c:]
+	}
+}
+
+type xof struct {
+	sp sponge
+	// key is here to not make excess garbage during repeated calls
+	// to XORKeyStream.
+	key []byte
+}
+
+// New creates a new XOF using Ascon-Xof.
+func New(seed []byte) kyber.XOF {
+	x := &xof{}
+	x.sp.init(ivXOF)
+	x.sp.write(seed)
+	return x
+}
+
+func (x *xof) Clone() kyber.XOF {
+	return &xof{sp: x.sp}
+}
+
+// Destroy overwrites the sponge of the XOF.
+func (x *xof) Destroy() {
+	x.sp = sponge{}
+	for i := range x.key {
+		x.key[i] = 0
+	}
+}
+
+func (x *xof) Read(dst []byte) (int, error) {
+	x.sp.read(dst)
+	return len(dst), nil
+}
+
+func (x *xof) Write(src []byte) (int, error) {
+	x.sp.write(src)
+	return len(src), nil
+}
+
+func (x *xof) Reseed() {
+	if len(x.key) < 128 {
+		x.key = make([]byte, 128)
+	} else {
+		x.key = x.key[0:128]
+	}
+	x.Read(x.key)
+	x.sp.init(ivXOF)
+	x.sp.write(x.key)
+}
+
+func (x *xof) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("dst too short")
+	}
+	if len(x.key) < len(src) {
+		x.key = make([]byte, len(src))
+	} else {
+		x.key = x.key[0:len(src)]
+	}
+
+	n, err := x.Read(x.key)
+	if err != nil {
+		panic("xof error getting key: " + err.Error())
+	}
+	if n != len(src) {
+		panic("short read on key")
+	}
+
+	for i := range src {
+		dst[i] = src[i] ^ x.key[i]
+	}
+}
+
+type digest struct {
+	sp sponge
+}
+
+// NewHash returns a new hash.Hash computing Ascon-Hash.
+func NewHash() hash.Hash {
+	d := &digest{}
+	d.Reset()
+	return d
+}
+
+func (d *digest) Write(p []byte) (int, error) {
+	d.sp.write(p)
+	return len(p), nil
+}
+
+func (d *digest) Sum(b []byte) []byte {
+	// Squeeze from a copy so that more data can still be written.
+	sp := d.sp
+	out := make([]byte, Size)
+	sp.read(out)
+	return append(b, out...)
+}
+
+func (d *digest) Reset()         { d.sp.init(ivHash) }
+func (d *digest) Size() int      { return Size }
+func (d *digest) BlockSize() int { return BlockSize }
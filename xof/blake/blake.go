@@ -40,6 +40,16 @@ func (x *xof) Clone() kyber.XOF {
 	return &xof{impl: x.impl.Clone()}
 }
 
+// Destroy resets the Blake2b state of the XOF and releases it, as its
+// memory cannot be overwritten from outside of x/crypto.
+func (x *xof) Destroy() {
+	x.impl.Reset()
+	x.impl = nil
+	for i := range x.key {
+		x.key[i] = 0
+	}
+}
+
 func (x *xof) Read(dst []byte) (int, error) {
 	return x.impl.Read(dst)
 }
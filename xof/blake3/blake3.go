@@ -0,0 +1,341 @@
+// Package blake3 provides an implementation of kyber.XOF based on the
+// BLAKE3 hash function, in its regular, keyed and key derivation modes.
+//
+// See https://github.com/BLAKE3-team/BLAKE3-specs for the specification.
+package blake3
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/dedis/kyber"
+)
+
+const (
+	// KeySize is the size in bytes of the key used by NewKeyed.
+	KeySize = 32
+
+	blockLen = 64
+	chunkLen = 1024
+)
+
+const (
+	flagChunkStart = 1 << iota
+	flagChunkEnd
+	flagParent
+	flagRoot
+	flagKeyedHash
+	flagDeriveKeyContext
+	flagDeriveKeyMaterial
+)
+
+var iv = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a,
+	0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+var msgPermutation = [16]int{2, 6, 3, 10, 7, 0, 4, 13, 1, 11, 12, 5, 9, 14, 15, 8}
+
+func g(s *[16]uint32, a, b, c, d int, mx, my uint32) {
+	s[a] += s[b] + mx
+	s[d] = bits.RotateLeft32(s[d]^s[a], -16)
+	s[c] += s[d]
+	s[b] = bits.RotateLeft32(s[b]^s[c], -12)
+	s[a] += s[b] + my
+	s[d] = bits.RotateLeft32(s[d]^s[a], -8)
+	s[c] += s[d]
+	s[b] = bits.RotateLeft32(s[b]^s[c], -7)
+}
+
+func round(s *[16]uint32, m *[16]uint32) {
+	// Mix the columns.
+	g(s, 0, 4, 8, 12, m[0], m[1])
+	g(s, 1, 5, 9, 13, m[2], m[3])
+	g(s, 2, 6, 10, 14, m[4], m[5])
+	g(s, 3, 7, 11, 15, m[6], m[7])
+	// Mix the diagonals.
+	g(s, 0, 5, 10, 15, m[8], m[9])
+	g(s, 1, 6, 11, 12, m[10], m[11])
+	g(s, 2, 7, 8, 13, m[12], m[13])
+	g(s, 3, 4, 9, 14, m[14], m[15])
+}
+
+// compress is the BLAKE3 compression function.
+func compress(cv *[8]uint32, block *[16]uint32, counter uint64, blen uint32, flags uint32) [16]uint32 {
+	s := [16]uint32{
+		cv[0], cv[1], cv[2], cv[3], cv[4], cv[5], cv[6], cv[7],
+		iv[0], iv[1], iv[2], iv[3],
+		uint32(counter), uint32(counter >> 32), blen, flags,
+	}
+	m := *block
+	for r := 0; r < 7; r++ {
+		round(&s, &m)
+		if r < 6 {
+			var p [16]uint32
+			for i := range p {
+				p[i] = m[msgPermutation[i]]
+			}
+			m = p
+		}
+	}
+	for i := 0; i < 8; i++ {
+		s[i] ^= s[i+8]
+		s[i+8] ^= cv[i]
+	}
+	return s
+}
+
+func wordsFromBytes(b []byte, w []uint32) {
+	for i := range w {
+		w[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+}
+
+// output holds the inputs of a compression whose result is either a
+// chaining value or the root of the tree.
+type output struct {
+	cv      [8]uint32
+	block   [16]uint32
+	counter uint64
+	blen    uint32
+	flags   uint32
+}
+
+func (o *output) chainingValue() [8]uint32 {
+	var cv [8]uint32
+	s := compress(&o.cv, &o.block, o.counter, o.blen, o.flags)
+	copy(cv[:], s[:8])
+	return cv
+}
+
+// rootBlock writes the 64-byte root output block with the given index.
+func (o *output) rootBlock(index uint64, out []byte) {
+	s := compress(&o.cv, &o.block, index, o.blen, o.flags|flagRoot)
+	for i, w := range s {
+		binary.LittleEndian.PutUint32(out[4*i:], w)
+	}
+}
+
+type chunkState struct {
+	cv         [8]uint32
+	counter    uint64
+	block      [blockLen]byte
+	blen       int
+	compressed int
+	flags      uint32
+}
+
+func newChunkState(key *[8]uint32, counter uint64, flags uint32) chunkState {
+	return chunkState{cv: *key, counter: counter, flags: flags}
+}
+
+func (c *chunkState) len() int {
+	return blockLen*c.compressed + c.blen
+}
+
+func (c *chunkState) startFlag() uint32 {
+	if c.compressed == 0 {
+		return flagChunkStart
+	}
+	return 0
+}
+
+func (c *chunkState) update(in []byte) {
+	for len(in) > 0 {
+		// Compress a full block only once more input is known to follow,
+		// since the last block of the chunk is compressed by output.
+		if c.blen == blockLen {
+			var w [16]uint32
+			wordsFromBytes(c.block[:], w[:])
+			s := compress(&c.cv, &w, c.counter, blockLen, c.flags|c.startFlag())
+			copy(c.cv[:], s[:8])
+			c.compressed++
+			c.block = [blockLen]byte{}
+			c.blen = 0
+		}
+		n := copy(c.block[c.blen:], in)
+		c.blen += n
+		in = in[n:]
+	}
+}
+
+func (c *chunkState) output() output {
+	o := output{
+		cv:      c.cv,
+		counter: c.counter,
+		blen:    uint32(c.blen),
+		flags:   c.flags | c.startFlag() | flagChunkEnd,
+	}
+	wordsFromBytes(c.block[:], o.block[:])
+	return o
+}
+
+func parentOutput(left, right [8]uint32, key *[8]uint32, flags uint32) output {
+	o := output{cv: *key, blen: blockLen, flags: flags | flagParent}
+	copy(o.block[:8], left[:])
+	copy(o.block[8:], right[:])
+	return o
+}
+
+// xof is an incremental BLAKE3 hasher that becomes an output stream once
+// it is read from.
+type xof struct {
+	key   [8]uint32
+	flags uint32
+	chunk chunkState
+	stack [][8]uint32
+
+	// Output state, once reading has started.
+	reading bool
+	root    output
+	index   uint64
+	buf     [blockLen]byte
+	offset  int
+
+	// tmp is here to not make excess garbage during repeated calls
+	// to XORKeyStream.
+	tmp []byte
+}
+
+func newXOF(key *[8]uint32, flags uint32) *xof {
+	return &xof{key: *key, flags: flags, chunk: newChunkState(key, 0, flags)}
+}
+
+// New creates a new XOF using the BLAKE3 hash in its regular mode,
+// feeding seed to it.
+func New(seed []byte) kyber.XOF {
+	x := newXOF(&iv, 0)
+	x.Write(seed)
+	return x
+}
+
+// NewKeyed creates a new XOF using the BLAKE3 hash in its keyed mode, which
+// acts as a MAC or PRF. The key must be KeySize bytes long.
+func NewKeyed(key []byte) kyber.XOF {
+	if len(key) != KeySize {
+		panic("blake3: key must be 32 bytes")
+	}
+	var k [8]uint32
+	wordsFromBytes(key, k[:])
+	return newXOF(&k, flagKeyedHash)
+}
+
+// NewDeriveKey creates a new XOF using the BLAKE3 hash in its key derivation
+// mode, bound to the given context string. The key material is fed to the
+// XOF via its Write method, and derived keys are read from it. The context
+// should be a hardcoded, globally unique and application-specific string.
+func NewDeriveKey(context string) kyber.XOF {
+	h := newXOF(&iv, flagDeriveKeyContext)
+	h.Write([]byte(context))
+	var ck [KeySize]byte
+	h.Read(ck[:])
+	var k [8]uint32
+	wordsFromBytes(ck[:], k[:])
+	return newXOF(&k, flagDeriveKeyMaterial)
+}
+
+func (x *xof) Write(src []byte) (int, error) {
+	if x.reading {
+		panic("blake3: write to XOF after read")
+	}
+	n := len(src)
+	for len(src) > 0 {
+		if x.chunk.len() == chunkLen {
+			cv := x.chunk.output()
+			x.pushChunk(cv.chainingValue(), x.chunk.counter+1)
+			x.chunk = newChunkState(&x.key, x.chunk.counter+1, x.flags)
+		}
+		take := chunkLen - x.chunk.len()
+		if take > len(src) {
+			take = len(src)
+		}
+		x.chunk.update(src[:take])
+		src = src[take:]
+	}
+	return n, nil
+}
+
+// pushChunk adds the chaining value of a completed chunk to the stack,
+// merging the subtrees it completes, as determined by the number of
+// chunks hashed so far.
+func (x *xof) pushChunk(cv [8]uint32, total uint64) {
+	for total&1 == 0 {
+		top := x.stack[len(x.stack)-1]
+		x.stack = x.stack[:len(x.stack)-1]
+		p := parentOutput(top, cv, &x.key, x.flags)
+		cv = p.chainingValue()
+		total >>= 1
+	}
+	x.stack = append(x.stack, cv)
+}
+
+func (x *xof) Read(dst []byte) (int, error) {
+	if !x.reading {
+		o := x.chunk.output()
+		for i := len(x.stack) - 1; i >= 0; i-- {
+			o = parentOutput(x.stack[i], o.chainingValue(), &x.key, x.flags)
+		}
+		x.root = o
+		x.reading = true
+		x.offset = blockLen
+	}
+	n := len(dst)
+	for len(dst) > 0 {
+		if x.offset == blockLen {
+			x.root.rootBlock(x.index, x.buf[:])
+			x.index++
+			x.offset = 0
+		}
+		c := copy(dst, x.buf[x.offset:])
+		x.offset += c
+		dst = dst[c:]
+	}
+	return n, nil
+}
+
+func (x *xof) Clone() kyber.XOF {
+	y := *x
+	y.stack = append([][8]uint32(nil), x.stack...)
+	y.tmp = nil
+	return &y
+}
+
+// Destroy overwrites the hasher and output state of the XOF.
+func (x *xof) Destroy() {
+	for i := range x.stack {
+		x.stack[i] = [8]uint32{}
+	}
+	for i := range x.tmp {
+		x.tmp[i] = 0
+	}
+	*x = xof{}
+}
+
+func (x *xof) Reseed() {
+	key := make([]byte, 128)
+	x.Read(key)
+	*x = *(New(key).(*xof))
+}
+
+func (x *xof) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("dst too short")
+	}
+	if len(x.tmp) < len(src) {
+		x.tmp = make([]byte, len(src))
+	} else {
+		x.tmp = x.tmp[0:len(src)]
+	}
+
+	n, err := x.Read(x.tmp)
+	if err != nil {
+		panic("blake3 xof error: " + err.Error())
+	}
+	if n != len(src) {
+		panic("short read on key")
+	}
+
+	for i := range src {
+		dst[i] = src[i] ^ x.tmp[i]
+	}
+}
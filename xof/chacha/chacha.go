@@ -0,0 +1,141 @@
+// Package chacha provides an implementation of kyber.XOF based on the
+// ChaCha20 stream cipher, which is fast in software on platforms without
+// AES hardware.
+//
+// Input written to the XOF is absorbed with SHA-256. On the first Read,
+// the digest becomes the ChaCha20 key, with an all-zero nonce, and the
+// output is the ChaCha20 key stream.
+package chacha
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"hash"
+	"io"
+
+	"github.com/dedis/kyber"
+	"golang.org/x/crypto/chacha20"
+)
+
+// maxOutput is the length of the ChaCha20 key stream for a single nonce:
+// 2^32 blocks of 64 bytes.
+const maxOutput = 1 << 38
+
+type xof struct {
+	h hash.Hash
+	// c is nil while the XOF is absorbing input.
+	c         *chacha20.Cipher
+	remaining uint64
+	// key is here to not make excess garbage during repeated calls
+	// to XORKeyStream.
+	key []byte
+}
+
+// New creates a new XOF using the ChaCha20 stream cipher.
+func New(seed []byte) kyber.XOF {
+	x := &xof{h: sha256.New()}
+	x.h.Write(seed)
+	return x
+}
+
+func (x *xof) Clone() kyber.XOF {
+	y := &xof{remaining: x.remaining}
+	if x.c != nil {
+		c := *x.c
+		y.c = &c
+		return y
+	}
+	state, err := x.h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		panic("sha256 state cannot be marshaled: " + err.Error())
+	}
+	y.h = sha256.New()
+	if err := y.h.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+		panic("sha256 state cannot be unmarshaled: " + err.Error())
+	}
+	return y
+}
+
+// Destroy overwrites the ChaCha20 state of the XOF, or resets its hash if
+// it is still absorbing input.
+func (x *xof) Destroy() {
+	if x.c != nil {
+		*x.c = chacha20.Cipher{}
+		x.c = nil
+	}
+	if x.h != nil {
+		x.h.Reset()
+		x.h = nil
+	}
+	x.remaining = 0
+	for i := range x.key {
+		x.key[i] = 0
+	}
+}
+
+func (x *xof) Write(src []byte) (int, error) {
+	if x.c != nil {
+		panic("chacha xof: write after read")
+	}
+	return x.h.Write(src)
+}
+
+func (x *xof) Read(dst []byte) (int, error) {
+	if x.c == nil {
+		c, err := chacha20.NewUnauthenticatedCipher(x.h.Sum(nil), make([]byte, chacha20.NonceSize))
+		if err != nil {
+			panic("chacha20.NewUnauthenticatedCipher should not return error: " + err.Error())
+		}
+		x.c = c
+		x.h = nil
+		x.remaining = maxOutput
+	}
+	if x.remaining == 0 {
+		return 0, io.EOF
+	}
+	if uint64(len(dst)) > x.remaining {
+		dst = dst[:x.remaining]
+	}
+	for i := range dst {
+		dst[i] = 0
+	}
+	x.c.XORKeyStream(dst, dst)
+	x.remaining -= uint64(len(dst))
+	return len(dst), nil
+}
+
+func (x *xof) Reseed() {
+	if len(x.key) < 128 {
+		x.key = make([]byte, 128)
+	} else {
+		x.key = x.key[0:128]
+	}
+	x.Read(x.key)
+	y := New(x.key)
+	// Steal the state of the new XOF, and put it inside of x.
+	x.h = y.(*xof).h
+	x.c = nil
+}
+
+func (x *xof) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("dst too short")
+	}
+	if len(x.key) < len(src) {
+		x.key = make([]byte, len(src))
+	} else {
+		x.key = x.key[0:len(src)]
+	}
+
+	n, err := x.Read(x.key)
+	if err != nil {
+		panic("chacha xof error: " + err.Error())
+	}
+	if n != len(src) {
+		panic("short read on key")
+	}
+
+	for i := range src {
+		dst[i] = src[i] ^ x.key[i]
+	}
+}
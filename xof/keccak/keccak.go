@@ -1,8 +1,14 @@
 // Package keccak provides an implementation of kyber.XOF based on the
 // Shake256 hash.
+//
+// The XOFs returned by New also implement encoding.BinaryMarshaler and
+// encoding.BinaryUnmarshaler, which save and restore the sponge state.
 package keccak
 
 import (
+	"encoding"
+	"errors"
+
 	"github.com/dedis/kyber"
 	"golang.org/x/crypto/sha3"
 )
@@ -21,10 +27,45 @@ func New(seed []byte) kyber.XOF {
 	return &xof{sh: sh}
 }
 
+var errNoState = errors.New("keccak: sponge state cannot be serialized")
+
+// MarshalBinary returns the current state of the sponge, so that it can
+// later be restored with UnmarshalBinary to resume absorbing or squeezing
+// at the same position.
+func (x *xof) MarshalBinary() ([]byte, error) {
+	m, ok := x.sh.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, errNoState
+	}
+	return m.MarshalBinary()
+}
+
+// UnmarshalBinary restores a sponge state returned by MarshalBinary.
+func (x *xof) UnmarshalBinary(data []byte) error {
+	sh := sha3.NewShake256()
+	u, ok := sh.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return errNoState
+	}
+	if err := u.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	x.sh = sh
+	return nil
+}
+
 func (x *xof) Clone() kyber.XOF {
 	return &xof{sh: x.sh.Clone()}
 }
 
+// Destroy resets the Shake256 state of the XOF, which zeroes its sponge.
+func (x *xof) Destroy() {
+	x.sh.Reset()
+	for i := range x.key {
+		x.key[i] = 0
+	}
+}
+
 func (x *xof) Reseed() {
 	if len(x.key) < 128 {
 		x.key = make([]byte, 128)
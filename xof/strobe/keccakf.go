@@ -0,0 +1,52 @@
+package strobe
+
+import "math/bits"
+
+var roundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// rotations holds the rho offsets of lane x+5y.
+var rotations = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// KeccakF1600 applies the Keccak-f[1600] permutation to the state a, whose
+// lane x+5y holds the 64-bit word at coordinates (x, y).
+func KeccakF1600(a *[25]uint64) {
+	var b [25]uint64
+	var c, d [5]uint64
+	for _, rc := range roundConstants {
+		// Theta
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d[x] = c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+		}
+		// Rho and pi
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				i := x + 5*y
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(a[i]^d[x], rotations[i])
+			}
+		}
+		// Chi
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+		// Iota
+		a[0] ^= rc
+	}
+}
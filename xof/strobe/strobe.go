@@ -0,0 +1,213 @@
+// Package strobe implements the STROBE protocol framework on top of the
+// Keccak-f[1600] duplex construction, following version 1.0.2 of the
+// specification at https://strobe.sourceforge.io/specs/.
+//
+// A Strobe object keeps a running transcript of everything a protocol
+// has done: every operation absorbs its framing and data into the duplex
+// state, so that derived keys, ciphertexts and MACs depend on the whole
+// history. Two parties running the same sequence of operations stay in
+// sync, with the Send operations of one matched to the Recv operations of
+// the other.
+//
+// Every operation takes a more argument. When set, the data is appended to
+// the previous operation, which must have been of the same kind, so that
+// a message can be processed in several pieces.
+package strobe
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+)
+
+// Operation flags, as defined by the specification.
+const (
+	flagI = 1 << iota // inbound
+	flagA             // application
+	flagC             // cipher
+	flagT             // transport
+	flagM             // meta
+	flagK             // keytree, unsupported
+)
+
+// roleUndecided is the role of a Strobe object that has not yet sent or
+// received over the transport.
+const roleUndecided = 0xff
+
+// Strobe is an instance of the STROBE framework.
+type Strobe struct {
+	st       [200]byte
+	rate     int
+	pos      int
+	posBegin int
+	curFlags byte
+	role     byte
+}
+
+// New returns a Strobe object for the given protocol name at the given
+// security level, in bits, which must be either 128 or 256.
+func New(protocol []byte, security int) *Strobe {
+	if security != 128 && security != 256 {
+		panic("strobe: security must be 128 or 256 bits")
+	}
+	s := &Strobe{rate: 200 - security/4 - 2, role: roleUndecided}
+	copy(s.st[:], []byte{1, byte(s.rate + 2), 1, 0, 1, 96})
+	copy(s.st[6:], "STROBEv1.0.2")
+	s.permute()
+	s.MetaAD(protocol, false)
+	return s
+}
+
+// Clone returns a copy of s in its current state, which can be used to
+// fork a transcript.
+func (s *Strobe) Clone() *Strobe {
+	c := *s
+	return &c
+}
+
+// Destroy overwrites the state of the Strobe object, which holds the keys
+// of the protocol.
+func (s *Strobe) Destroy() {
+	*s = Strobe{}
+}
+
+// AD absorbs associated data into the transcript.
+func (s *Strobe) AD(data []byte, more bool) {
+	s.operate(flagA, data, more)
+}
+
+// MetaAD absorbs framing metadata, such as labels and lengths, into the
+// transcript.
+func (s *Strobe) MetaAD(data []byte, more bool) {
+	s.operate(flagM|flagA, data, more)
+}
+
+// Key replaces part of the state with a secret key. All subsequent outputs
+// depend on the key.
+func (s *Strobe) Key(key []byte, more bool) {
+	s.operate(flagA|flagC, copyOf(key), more)
+}
+
+// PRF fills dst with pseudo-random bytes derived from the transcript.
+func (s *Strobe) PRF(dst []byte, more bool) {
+	copy(dst, s.operate(flagI|flagA|flagC, make([]byte, len(dst)), more))
+}
+
+// SendCLR absorbs data that is sent in the clear.
+func (s *Strobe) SendCLR(data []byte, more bool) {
+	s.operate(flagA|flagT, data, more)
+}
+
+// RecvCLR absorbs data that was received in the clear.
+func (s *Strobe) RecvCLR(data []byte, more bool) {
+	s.operate(flagI|flagA|flagT, data, more)
+}
+
+// SendENC encrypts the plaintext, which is not modified, and returns the
+// ciphertext to send. It is only secure after a Key operation.
+func (s *Strobe) SendENC(plaintext []byte, more bool) []byte {
+	return s.operate(flagA|flagC|flagT, copyOf(plaintext), more)
+}
+
+// RecvENC decrypts the received ciphertext, which is not modified, and
+// returns the plaintext. It must be followed by RecvMAC before the
+// plaintext can be trusted.
+func (s *Strobe) RecvENC(ciphertext []byte, more bool) []byte {
+	return s.operate(flagI|flagA|flagC|flagT, copyOf(ciphertext), more)
+}
+
+// SendMAC fills dst with a MAC of the transcript, to be sent to the peer.
+func (s *Strobe) SendMAC(dst []byte, more bool) {
+	copy(dst, s.operate(flagC|flagT, make([]byte, len(dst)), more))
+}
+
+// RecvMAC checks a MAC received from the peer, and reports whether it
+// matches the transcript. The state is updated even if the check fails,
+// so the transcript should be discarded in that case.
+func (s *Strobe) RecvMAC(mac []byte) bool {
+	out := s.operate(flagI|flagC|flagT, copyOf(mac), false)
+	return subtle.ConstantTimeCompare(out, make([]byte, len(out))) == 1
+}
+
+// Ratchet erases n bytes of the state, so that a later compromise of the
+// state cannot reveal earlier secrets.
+func (s *Strobe) Ratchet(n int, more bool) {
+	s.operate(flagC, make([]byte, n), more)
+}
+
+// operate runs the operation given by flags on data, which it modifies in
+// place and returns.
+func (s *Strobe) operate(flags byte, data []byte, more bool) []byte {
+	if more {
+		if flags != s.curFlags {
+			panic("strobe: continued operation must have the same flags")
+		}
+	} else {
+		s.beginOp(flags)
+	}
+	cafter := flags&(flagC|flagI|flagT) == flagC|flagT
+	cbefore := flags&flagC != 0 && !cafter
+	s.duplex(data, cbefore, cafter)
+	return data
+}
+
+func (s *Strobe) beginOp(flags byte) {
+	s.curFlags = flags
+	if flags&flagT != 0 {
+		// The first party to send becomes the initiator, and the
+		// direction bit of transport operations is relative to it.
+		if s.role == roleUndecided {
+			s.role = flags & flagI
+		}
+		flags ^= s.role
+	}
+	old := s.posBegin
+	s.posBegin = s.pos + 1
+	s.duplex([]byte{byte(old), flags}, false, false)
+	if flags&(flagC|flagK) != 0 && s.pos != 0 {
+		s.runF()
+	}
+}
+
+// duplex absorbs data into the state. With cbefore, data is first
+// replaced by its XOR with the state; with cafter, data is replaced by
+// the new state.
+func (s *Strobe) duplex(data []byte, cbefore, cafter bool) {
+	for i := range data {
+		if cbefore {
+			data[i] ^= s.st[s.pos]
+		}
+		s.st[s.pos] ^= data[i]
+		if cafter {
+			data[i] = s.st[s.pos]
+		}
+		s.pos++
+		if s.pos == s.rate {
+			s.runF()
+		}
+	}
+}
+
+// runF pads the current block and applies the permutation.
+func (s *Strobe) runF() {
+	s.st[s.pos] ^= byte(s.posBegin)
+	s.st[s.pos+1] ^= 0x04
+	s.st[s.rate+1] ^= 0x80
+	s.permute()
+	s.pos = 0
+	s.posBegin = 0
+}
+
+func (s *Strobe) permute() {
+	var a [25]uint64
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(s.st[8*i:])
+	}
+	KeccakF1600(&a)
+	for i := range a {
+		binary.LittleEndian.PutUint64(s.st[8*i:], a[i])
+	}
+}
+
+func copyOf(b []byte) []byte {
+	return append([]byte(nil), b...)
+}
@@ -0,0 +1,128 @@
+package strobe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+)
+
+func TestKeccakF1600(t *testing.T) {
+	// A single permutation of the padded empty message yields SHAKE256("").
+	var a [25]uint64
+	a[0] = 0x1f
+	a[16] = 0x80 << 56 // last byte of the 136-byte rate
+	KeccakF1600(&a)
+	out := make([]byte, 64)
+	for i := 0; i < 8; i++ {
+		binary.LittleEndian.PutUint64(out[8*i:], a[i])
+	}
+	want := make([]byte, 64)
+	sha3.ShakeSum256(want, nil)
+	require.Equal(t, want, out)
+}
+
+// merlin implements the Merlin transcript construction, whose published
+// test vector checks this package against an independent implementation.
+type merlin struct{ s *Strobe }
+
+func newMerlin(label string) *merlin {
+	m := &merlin{New([]byte("Merlin v1.0"), 128)}
+	m.append("dom-sep", []byte(label))
+	return m
+}
+
+func (m *merlin) append(label string, msg []byte) {
+	var n [4]byte
+	binary.LittleEndian.PutUint32(n[:], uint32(len(msg)))
+	m.s.MetaAD([]byte(label), false)
+	m.s.MetaAD(n[:], true)
+	m.s.AD(msg, false)
+}
+
+func (m *merlin) challenge(label string, size int) []byte {
+	var n [4]byte
+	binary.LittleEndian.PutUint32(n[:], uint32(size))
+	m.s.MetaAD([]byte(label), false)
+	m.s.MetaAD(n[:], true)
+	out := make([]byte, size)
+	m.s.PRF(out, false)
+	return out
+}
+
+func TestMerlinVector(t *testing.T) {
+	m := newMerlin("test protocol")
+	m.append("some label", []byte("some data"))
+	require.Equal(t, "d5a21972d0d5fe320c0d263fac7fffb8145aa640af6e9bca177c03c7efcf0615",
+		hex.EncodeToString(m.challenge("challenge", 32)))
+}
+
+func TestSendRecv(t *testing.T) {
+	alice := New([]byte("test protocol"), 256)
+	bob := New([]byte("test protocol"), 256)
+	key := []byte("shared secret key")
+	alice.Key(key, false)
+	bob.Key(key, false)
+
+	msg := []byte("a message longer than a single block of the duplex, " +
+		"so that the permutation runs in the middle of the operation " +
+		"and the continuation logic is exercised as well")
+	alice.SendCLR([]byte("header"), false)
+	bob.RecvCLR([]byte("header"), false)
+	ct := alice.SendENC(msg[:10], false)
+	ct = append(ct, alice.SendENC(msg[10:], true)...)
+	require.False(t, bytes.Contains(ct, []byte("message")))
+	mac := make([]byte, 16)
+	alice.SendMAC(mac, false)
+
+	pt := bob.RecvENC(ct, false)
+	require.Equal(t, msg, pt)
+	bad := append([]byte(nil), mac...)
+	bad[0] ^= 1
+	require.False(t, bob.Clone().RecvMAC(bad))
+	require.True(t, bob.RecvMAC(mac))
+
+	// Replies travel in the other direction.
+	reply := bob.SendENC([]byte("reply"), false)
+	ca := alice.Clone()
+	require.Equal(t, []byte("reply"), alice.RecvENC(reply, false))
+	require.NotEqual(t, []byte("reply"), ca.SendENC(reply, false))
+}
+
+func TestTranscript(t *testing.T) {
+	s1 := New([]byte("proto"), 128)
+	s2 := New([]byte("proto"), 128)
+	s1.AD([]byte("hello world"), false)
+	s2.AD([]byte("hello "), false)
+	s2.AD([]byte("world"), true)
+
+	o1 := make([]byte, 300)
+	o2 := make([]byte, 300)
+	s1.Clone().PRF(o1, false)
+	s2.Clone().PRF(o2, false)
+	require.Equal(t, o1, o2)
+
+	// Different framing gives different outputs.
+	s3 := New([]byte("proto"), 128)
+	s3.AD([]byte("hello "), false)
+	s3.AD([]byte("world"), false)
+	s3.PRF(o2, false)
+	require.NotEqual(t, o1, o2)
+
+	// Ratcheting changes the outputs.
+	s1.Ratchet(32, false)
+	s1.PRF(o2, false)
+	require.NotEqual(t, o1, o2)
+
+	require.Panics(t, func() { s1.AD(nil, true) })
+}
+
+func TestDestroy(t *testing.T) {
+	s := New([]byte("destroy"), 128)
+	s.Key([]byte("secret key"), false)
+	s.Destroy()
+	require.Equal(t, Strobe{}, *s)
+}
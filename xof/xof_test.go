@@ -2,11 +2,21 @@ package xof
 
 import (
 	"bytes"
+	stdaes "crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
 	"math"
 	"testing"
 
 	"github.com/dedis/kyber"
+	"github.com/dedis/kyber/xof/aes"
+	"github.com/dedis/kyber/xof/ascon"
 	"github.com/dedis/kyber/xof/blake"
+	"github.com/dedis/kyber/xof/blake3"
+	"github.com/dedis/kyber/xof/chacha"
 	"github.com/dedis/kyber/xof/keccak"
 	"github.com/stretchr/testify/require"
 )
@@ -19,7 +29,23 @@ type keccakF struct{}
 
 func (b *keccakF) XOF(seed []byte) kyber.XOF { return keccak.New(seed) }
 
-var impls = []kyber.XOFFactory{&blakeF{}, &keccakF{}}
+type chachaF struct{}
+
+func (b *chachaF) XOF(seed []byte) kyber.XOF { return chacha.New(seed) }
+
+type blake3F struct{}
+
+func (b *blake3F) XOF(seed []byte) kyber.XOF { return blake3.New(seed) }
+
+type asconF struct{}
+
+func (b *asconF) XOF(seed []byte) kyber.XOF { return ascon.New(seed) }
+
+type aesF struct{}
+
+func (b *aesF) XOF(seed []byte) kyber.XOF { return aes.New(seed) }
+
+var impls = []kyber.XOFFactory{&blakeF{}, &keccakF{}, &chachaF{}, &blake3F{}, &asconF{}, &aesF{}}
 
 func TestEncDec(t *testing.T) {
 	lengths := []int{0, 1, 16, 1024, 8192}
@@ -237,3 +263,157 @@ func testEncDecMismatch(t *testing.T, s kyber.XOFFactory) {
 		t.Fatal("wrong decode")
 	}
 }
+
+// Test vectors in the format of the BLAKE3 reference test vectors: the
+// input is the byte sequence 0, 1, ..., 250, 0, 1, ... of the given length.
+func TestBlake3Vectors(t *testing.T) {
+	key := []byte("whats the Elvish word for friend")
+	context := "BLAKE3 2019-12-27 16:29:52 test vectors context"
+	vectors := []struct {
+		len                    int
+		hash, keyed, deriveKey string
+	}{
+		{0, "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262", "92b2b75604ed3c761f9d6f62392c8a9227ad0ea3f09573e783f1498a4ed60d26", "2cc39783c223154fea8dfb7c1b1660f2ac2dcbd1c1de8277b0b0dd39b7e50d7d"},
+		{1023, "10108970eeda3eb932baac1428c7a2163b0e924c9a9e25b35bba72b28f70bd11", "", ""},
+		{1024, "42214739f095a406f3fc83deb889744ac00df831c10daa55189b5d121c855af7", "", ""},
+		{1025, "d00278ae47eb27b34faecf67b4fe263f82d5412916c1ffd97c8cb7fb814b8444", "357dc55de0c7e382c900fd6e320acc04146be01db6a8ce7210b7189bd664ea69", "effaa245f065fbf82ac186839a249707c3bddf6d3fdda22d1b95a3c970379bcb"},
+		{2048, "e776b6028c7cd22a4d0ba182a8bf62205d2ef576467e838ed6f2529b85fba24a", "", ""},
+		{2049, "5f4d72f40d7a5f82b15ca2b2e44b1de3c2ef86c426c95c1af0b6879522563030", "", ""},
+		{3072, "b98cb0ff3623be03326b373de6b9095218513e64f1ee2edd2525c7ad1e5cffd2", "", ""},
+		{3073, "7124b49501012f81cc7f11ca069ec9226cecb8a2c850cfe644e327d22d3e1cd3", "", ""},
+		{4097, "9b4052b38f1c5fc8b1f9ff7ac7b27cd242487b3d890d15c96a1c25b8aa0fb995", "", ""},
+		{8193, "bab6c09cb8ce8cf459261398d2e7aef35700bf488116ceb94a36d0f5f1b7bc3b", "954a2a75420c8d6547e3ba5b98d963e6fa6491addc8c023189cc519821b4a1f5", "af1e0346e389b17c23200270a64aa4e1ead98c61695d917de7d5b00491c9b0f1"},
+		{31745, "5c80ce0c3bbe9a6f432a1c6c2ccbde45923d23249386988a30f512d23919eb98", "", ""},
+	}
+	for _, v := range vectors {
+		in := make([]byte, v.len)
+		for i := range in {
+			in[i] = byte(i % 251)
+		}
+		check := func(x kyber.XOF, want string) {
+			if want == "" {
+				return
+			}
+			// Write in uneven pieces to exercise block and chunk boundaries.
+			for rest := in; len(rest) > 0; {
+				n := 1 + len(rest)/3
+				x.Write(rest[:n])
+				rest = rest[n:]
+			}
+			out := make([]byte, 32)
+			x.Read(out)
+			require.Equal(t, want, hex.EncodeToString(out), "input length %d", v.len)
+		}
+		check(blake3.New(nil), v.hash)
+		check(blake3.NewKeyed(key), v.keyed)
+		check(blake3.NewDeriveKey(context), v.deriveKey)
+	}
+
+	// Extended output is the continuation of the 32-byte digest.
+	x := blake3.New([]byte("abc"))
+	out := make([]byte, 100)
+	x.Read(out[:7])
+	x.Read(out[7:])
+	require.Equal(t, "6437b3ac38465133ffb63b75273a8db548c558465d79db03fd359c6cd5bd9d85", hex.EncodeToString(out[:32]))
+	y := blake3.New([]byte("abc"))
+	out2 := make([]byte, 100)
+	y.Read(out2)
+	require.Equal(t, out, out2)
+}
+
+func TestAsconVectors(t *testing.T) {
+	out := make([]byte, 32)
+	ascon.New(nil).Read(out)
+	require.Equal(t, "5d4cbde6350ea4c174bd65b5b332f8408f99740b81aa02735eaefbcf0ba0339e", hex.EncodeToString(out))
+
+	// Output is read across block boundaries in uneven pieces.
+	x := ascon.New([]byte("ab"))
+	x.Write([]byte("c"))
+	out = make([]byte, 64)
+	x.Read(out[:3])
+	x.Read(out[3:20])
+	x.Read(out[20:])
+	require.Equal(t, "c90213a9e93b192c1d47f8aa20545f6f86686527896cb8d6530bbae9554e6dc5"+
+		"9b037c848e1cb3aa369cf29746226495939c448fd7f0a8e2770042be2ff78905", hex.EncodeToString(out))
+
+	h := ascon.NewHash()
+	require.Equal(t, "7346bc14f036e87ae03d0997913088f5f68411434b3cf8b54fa796a80d251f91", hex.EncodeToString(h.Sum(nil)))
+	h.Write([]byte("abc"))
+	sum := h.Sum(nil)
+	h.Reset()
+	h.Write([]byte("abc"))
+	require.Equal(t, sum, h.Sum(nil))
+}
+
+func TestAESCTR(t *testing.T) {
+	seed := []byte("seed")
+	digest := sha256.Sum256(seed)
+	mac := hmac.New(sha256.New, digest[:])
+	mac.Write([]byte("kyber AES-CTR XOF key"))
+	block, err := stdaes.NewCipher(mac.Sum(nil))
+	require.NoError(t, err)
+	want := make([]byte, 1000)
+	cipher.NewCTR(block, make([]byte, stdaes.BlockSize)).XORKeyStream(want, want)
+
+	x := aes.New(seed[:2])
+	x.Write(seed[2:])
+	out := make([]byte, 1000)
+	x.Read(out[:5])
+	x.Read(out[5:])
+	require.Equal(t, want, out)
+}
+
+func TestKeccakState(t *testing.T) {
+	x1 := keccak.New([]byte("seed"))
+	x1.Write([]byte("transcript"))
+	buf := make([]byte, 100)
+	x1.Read(buf)
+
+	state, err := x1.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+
+	x2 := keccak.New(nil)
+	require.NoError(t, x2.(encoding.BinaryUnmarshaler).UnmarshalBinary(state))
+
+	out1 := make([]byte, 300)
+	out2 := make([]byte, 300)
+	x1.Read(out1)
+	x2.Read(out2)
+	require.Equal(t, out1, out2)
+
+	// A restored absorbing state accepts more input.
+	x3 := keccak.New([]byte("seed"))
+	state, err = x3.(encoding.BinaryMarshaler).MarshalBinary()
+	require.NoError(t, err)
+	x4 := keccak.New(nil)
+	require.NoError(t, x4.(encoding.BinaryUnmarshaler).UnmarshalBinary(state))
+	x3.Write([]byte("more"))
+	x4.Write([]byte("more"))
+	x3.Read(out1)
+	x4.Read(out2)
+	require.Equal(t, out1, out2)
+
+	require.Error(t, x4.(encoding.BinaryUnmarshaler).UnmarshalBinary(state[:10]))
+}
+
+func TestDestroy(t *testing.T) {
+	for _, i := range impls {
+		// Destroying a clone, while absorbing or squeezing, leaves the
+		// original XOF untouched.
+		x1 := i.XOF([]byte("secret seed"))
+		x2 := i.XOF([]byte("secret seed"))
+		x1.Clone().(kyber.Destroyable).Destroy()
+		buf := make([]byte, 64)
+		x1.Read(buf)
+		x2.Read(buf)
+		x1.Clone().(kyber.Destroyable).Destroy()
+
+		out1 := make([]byte, 64)
+		out2 := make([]byte, 64)
+		x1.Read(out1)
+		x2.Read(out2)
+		require.Equal(t, out1, out2)
+
+		x1.(kyber.Destroyable).Destroy()
+	}
+}